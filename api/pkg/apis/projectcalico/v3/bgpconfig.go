@@ -26,6 +26,30 @@ const (
 
 type BindMode string
 
+// BFDConfiguration contains the Bidirectional Forwarding Detection (BFD) settings for BGP sessions.
+type BFDConfiguration struct {
+	// Enabled sets whether BFD is used to detect the failure of BGP sessions.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinRxInterval is the minimum interval between received BFD control packets that the node
+	// supports.  [Default: 10ms]
+	// +optional
+	MinRxInterval *metav1.Duration `json:"minRxInterval,omitempty"`
+
+	// MinTxInterval is the desired minimum interval between transmitted BFD control packets.
+	// [Default: 100ms]
+	// +optional
+	MinTxInterval *metav1.Duration `json:"minTxInterval,omitempty"`
+
+	// Multiplier is the number of consecutive BFD control packets that may be missed before the
+	// session is declared down.  [Default: 5]
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=255
+	// +optional
+	Multiplier *int32 `json:"multiplier,omitempty" validate:"omitempty,gte=1,lte=255"`
+}
+
 const (
 	BindModeNone   BindMode = "None"
 	BindModeNodeIP BindMode = "NodeIP"
@@ -98,6 +122,20 @@ type BGPConfigurationSpec struct {
 	// +optional
 	NodeMeshMaxRestartTime *metav1.Duration `json:"nodeMeshMaxRestartTime,omitempty" confignamev1:"node_mesh_restart_time"`
 
+	// Time to retain stale routes from a node-to-node mesh peer after its graceful restart time has
+	// expired.  When specified, long-lived graceful restart (RFC 9494) is enabled for node-to-mesh
+	// peerings.  This field can only be set on the default BGPConfiguration instance and requires
+	// that NodeMesh is enabled.
+	// +optional
+	NodeMeshLongLivedStaleTime *metav1.Duration `json:"nodeMeshLongLivedStaleTime,omitempty"`
+
+	// BFD configures Bidirectional Forwarding Detection (BFD) for BGP sessions.  When enabled on the
+	// default BGPConfiguration instance, BFD is used for node-to-node mesh peerings and for any
+	// BGPPeer that does not configure BFD itself.  The interval and multiplier settings may be
+	// overridden on a node-specific BGPConfiguration instance.
+	// +optional
+	BFD *BFDConfiguration `json:"bfd,omitempty" validate:"omitempty"`
+
 	// BindMode indicates whether to listen for BGP connections on all addresses (None)
 	// or only on the node's canonical IP address Node.Spec.BGP.IPvXAddress (NodeIP).
 	// Default behaviour is to listen for BGP connections on all addresses.
@@ -94,6 +94,17 @@ type BGPPeerSpec struct {
 	// Time to allow for software restart.  When specified, this is configured as the graceful
 	// restart timeout.  When not specified, the BIRD default of 120s is used.
 	MaxRestartTime *metav1.Duration `json:"maxRestartTime,omitempty"`
+	// Time to retain stale routes from the peer after its graceful restart time has expired.  When
+	// specified, long-lived graceful restart (RFC 9494) is enabled for the peerings generated by this
+	// BGPPeer resource.
+	// +optional
+	LongLivedStaleTime *metav1.Duration `json:"longLivedStaleTime,omitempty"`
+	// BFD configures Bidirectional Forwarding Detection (BFD) for the peerings generated by this
+	// BGPPeer resource, overriding the BFD configuration in BGPConfiguration.  BIRD applies BFD
+	// interval and multiplier settings per node, so when several peers of a node set them, the
+	// shortest intervals and the smallest multiplier are used.
+	// +optional
+	BFD *BFDConfiguration `json:"bfd,omitempty" validate:"omitempty"`
 	// Maximum number of local AS numbers that are allowed in the AS path for received routes.
 	// This removes BGP loop prevention and should only be used if absolutely necessary.
 	// +optional
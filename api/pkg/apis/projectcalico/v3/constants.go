@@ -40,6 +40,11 @@ const (
 	// orchestrator.
 	LabelOrchestrator = "projectcalico.org/orchestrator"
 
+	// Label used to denote the CNI network of a pod's secondary workload endpoint.  This is added by
+	// Calico to the workload endpoints for a pod's additional Calico interfaces and may be used for
+	// label matches by Policy selectors.
+	LabelNetwork = "projectcalico.org/network"
+
 	// Known orchestrators.  Orchestrators are not limited to this list.
 	OrchestratorKubernetes = "k8s"
 	OrchestratorCNI        = "cni"
@@ -97,6 +97,14 @@ const (
 	BPFConnectTimeLBDisabled BPFConnectTimeLBType = "Disabled"
 )
 
+// +kubebuilder:validation:Enum=Random;Maglev
+type BPFServiceBackendSelection string
+
+const (
+	BPFServiceBackendSelectionRandom BPFServiceBackendSelection = "Random"
+	BPFServiceBackendSelectionMaglev BPFServiceBackendSelection = "Maglev"
+)
+
 // +kubebuilder:validation:Enum=Auto;Userspace;BPFProgram
 type BPFConntrackMode string
 
@@ -144,6 +152,12 @@ type FelixConfigurationSpec struct {
 	// Deprecated: replaced by the generic HealthTimeoutOverrides.
 	DataplaneWatchdogTimeout *metav1.Duration `json:"dataplaneWatchdogTimeout,omitempty" configv1timescale:"seconds"`
 
+	// DataplaneDryRun, if true, Felix calculates the changes that it would make to its iptables or nftables rules
+	// and IP sets and logs them instead of applying them.  Other parts of the dataplane, such as routes, are still
+	// programmed.  Useful for checking the effect of a policy change on a canary node.  Not supported in BPF mode.
+	// [Default: false]
+	DataplaneDryRun *bool `json:"dataplaneDryRun,omitempty"`
+
 	// IPv6Support controls whether Felix enables support for IPv6 (if supported by the in-use dataplane).
 	IPv6Support *bool `json:"ipv6Support,omitempty" confignamev1:"Ipv6Support"`
 
@@ -756,6 +770,19 @@ type FelixConfigurationSpec struct {
 	// enable that feature.
 	BPFMapSizeNATAffinity *int `json:"bpfMapSizeNATAffinity,omitempty"`
 
+	// BPFServiceBackendSelection controls how the eBPF kube-proxy replacement picks a backend for a new
+	// connection to a service.  'Random' picks a backend at random.  'Maglev' uses a Maglev consistent-hashing
+	// lookup table so that every node picks the same backend for the same connection, and so that few
+	// connections are remapped when the backends change.  Individual services can override this with the
+	// projectcalico.org/backendSelection annotation. [Default: Random]
+	BPFServiceBackendSelection *BPFServiceBackendSelection `json:"bpfServiceBackendSelection,omitempty" validate:"omitempty,oneof=Random Maglev"`
+
+	// BPFMaglevMaxServices sets the maximum number of services that can use Maglev backend selection.  Each
+	// such service uses a lookup table with 1021 entries in a BPF map that is sized accordingly.  Services
+	// beyond the limit fall back to random backend selection. [Default: 256]
+	// +kubebuilder:validation:Minimum=1
+	BPFMaglevMaxServices *int `json:"bpfMaglevMaxServices,omitempty" validate:"omitempty,gte=1"`
+
 	// BPFMapSizeRoute sets the size for the routes map.  The routes map should be large enough
 	// to hold one entry per workload and a handful of entries per host (enough to cover its own IPs and
 	// tunnel IPs).
@@ -925,6 +952,22 @@ type FelixConfigurationSpec struct {
 	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
 	WireguardPersistentKeepAlive *metav1.Duration `json:"wireguardKeepAlive,omitempty"`
 
+	// WireguardKeyRotationInterval controls how often Felix rotates the Wireguard private key of each node.
+	// When the key reaches this age, Felix generates a new key and publishes it alongside the current key;
+	// the node switches to the new key once WireguardKeyRotationGracePeriod has passed and all of its peers
+	// have accepted it. Set 0 to disable rotation. [Default: 0]
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	WireguardKeyRotationInterval *metav1.Duration `json:"wireguardKeyRotationInterval,omitempty"`
+
+	// WireguardKeyRotationGracePeriod is the minimum time that a node publishes its next Wireguard public key
+	// before switching to it. During this period, peers program the next key so that they accept handshakes
+	// with either key. The node also waits for all of its peers to accept the next key before it switches.
+	// [Default: 5m]
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\\.[0-9]+)?(ms|s|m|h))*$`
+	WireguardKeyRotationGracePeriod *metav1.Duration `json:"wireguardKeyRotationGracePeriod,omitempty"`
+
 	// AWSSrcDstCheck controls whether Felix will try to change the "source/dest check" setting on the EC2 instance
 	// on which it is running. A value of "Disable" will try to disable the source/dest check. Disabling the check
 	// allows for sending workload traffic without encapsulation within the same AWS subnet.
@@ -991,6 +1034,49 @@ type FelixConfigurationSpec struct {
 	// [Default: -1]
 	// +optional
 	GoMaxProcs *int `json:"goMaxProcs,omitempty" validate:"omitempty,gte=-1"`
+
+	// CaptureDir controls the directory in which Felix writes the pcap files of PacketCaptures.  Each
+	// capture is written to a <namespace>/<name> subdirectory. [Default: /var/log/calico/pcap]
+	// +optional
+	CaptureDir *string `json:"captureDir,omitempty" validate:"omitempty,gt=0"`
+
+	// CaptureMaxFileSizeBytes is the size at which a capture file is rotated, for PacketCaptures that do
+	// not set their own limit. [Default: 10000000]
+	// +optional
+	CaptureMaxFileSizeBytes *int `json:"captureMaxFileSizeBytes,omitempty" validate:"omitempty,gt=0"`
+
+	// CaptureMaxFiles is the number of capture files, including the file currently being written, that
+	// Felix keeps for each interface, for PacketCaptures that do not set their own limit. [Default: 2]
+	// +optional
+	CaptureMaxFiles *int `json:"captureMaxFiles,omitempty" validate:"omitempty,gt=0"`
+
+	// HostEndpointTemplates makes Felix create and maintain HostEndpoints for the interfaces of its own
+	// host.  For each template, Felix creates a HostEndpoint for every interface whose name matches the
+	// template's interface pattern, and deletes it when the interface goes away.  This is intended for
+	// hosts that are not Kubernetes nodes; in a Kubernetes cluster, use the host endpoint controller in
+	// kube-controllers instead.  Not supported with the Kubernetes datastore.
+	// +optional
+	HostEndpointTemplates []HostEndpointTemplate `json:"hostEndpointTemplates,omitempty" validate:"omitempty,dive"`
+}
+
+// HostEndpointTemplate describes the HostEndpoints that Felix creates for its host's interfaces.
+type HostEndpointTemplate struct {
+	// GenerateName is included in the names of the generated HostEndpoints, which are of the
+	// form <hostname>-<generateName>-<interface name>.
+	// +kubebuilder:validation:MaxLength=253
+	GenerateName string `json:"generateName" validate:"name"`
+
+	// InterfacePattern is a regular expression; a HostEndpoint is generated for each interface whose
+	// name matches it.  The expression is anchored at both ends, so "eth0" matches only eth0.
+	InterfacePattern string `json:"interfacePattern" validate:"regexp"`
+
+	// Labels are the labels of the generated HostEndpoints.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" validate:"omitempty,labels"`
+
+	// Profiles are the profiles applied to the generated HostEndpoints.
+	// +optional
+	Profiles []string `json:"profiles,omitempty" validate:"omitempty,dive,name"`
 }
 
 type HealthTimeoutOverride struct {
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindIPPoolMigration     = "IPPoolMigration"
+	KindIPPoolMigrationList = "IPPoolMigrationList"
+)
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPPoolMigrationList contains a list of IPPoolMigration resources.
+type IPPoolMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []IPPoolMigration `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPPoolMigration moves the pods in the selected namespaces from one IPPool to another.  The
+// IPPoolMigration controller in kube-controllers points the selected namespaces at the target pool
+// with the cni.projectcalico.org/ipv4pools or ipv6pools annotation, restarts the pods that have
+// source pool addresses, and then releases the affinities of the source pool's empty blocks.  The
+// migration fails if a restarted pod is assigned an address outside of the target pool.
+type IPPoolMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   IPPoolMigrationSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status IPPoolMigrationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// IPPoolMigrationSpec contains the specification for an IPPoolMigration resource.
+type IPPoolMigrationSpec struct {
+	// SourcePool is the name of the IPPool that pods are moved off.  The pool stays enabled for
+	// the pods in namespaces that are not selected.
+	SourcePool string `json:"sourcePool" validate:"name"`
+
+	// TargetPool is the name of the IPPool that pods are moved to.  It must be enabled and of
+	// the same IP family as the source pool.
+	TargetPool string `json:"targetPool" validate:"name"`
+
+	// NamespaceSelector is an expression used to pick out the namespaces whose pods are
+	// migrated.  It uses the same syntax as the namespaceSelector of a NetworkPolicy; if it
+	// is empty, pods in all namespaces are migrated.
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"omitempty,selector"`
+
+	// PodsPerMinute limits how many pods are restarted per minute.  Pods are restarted one at
+	// a time, and the next pod is only restarted once the replacement of the previous one has
+	// been assigned an address.  [Default: 10]
+	PodsPerMinute *int `json:"podsPerMinute,omitempty" validate:"omitempty,gt=0"`
+}
+
+type IPPoolMigrationPhase string
+
+const (
+	IPPoolMigrationPhasePending   IPPoolMigrationPhase = "Pending"
+	IPPoolMigrationPhaseRunning   IPPoolMigrationPhase = "Running"
+	IPPoolMigrationPhaseCompleted IPPoolMigrationPhase = "Completed"
+	IPPoolMigrationPhaseFailed    IPPoolMigrationPhase = "Failed"
+)
+
+// IPPoolMigrationStatus reports the progress of an IPPoolMigration.
+type IPPoolMigrationStatus struct {
+	// Phase is the stage the migration has reached.
+	Phase IPPoolMigrationPhase `json:"phase,omitempty"`
+
+	// Message gives detail on the phase, for example why the migration is waiting or failed.
+	Message string `json:"message,omitempty"`
+
+	// StartTime is when the controller started the migration.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the migration completed or failed.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// LastRestartTime is when the controller last restarted a pod.
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+
+	// PodsRestarted is the number of pods that the controller has evicted.
+	PodsRestarted int `json:"podsRestarted,omitempty"`
+
+	// PodsRemaining is the number of selected pods that still have source pool addresses and
+	// will be restarted.
+	PodsRemaining int `json:"podsRemaining,omitempty"`
+
+	// PodsOnTargetPool is the number of selected pods that have target pool addresses.
+	PodsOnTargetPool int `json:"podsOnTargetPool,omitempty"`
+
+	// PodsOutsideTargetPool is the number of selected pods that were created after the
+	// migration started but were assigned addresses outside of the target pool, for example
+	// because of an IP pool annotation on the pod.  The migration fails if there are any.
+	PodsOutsideTargetPool int `json:"podsOutsideTargetPool,omitempty"`
+
+	// PodsNotRestartable is the number of selected pods that have source pool addresses but
+	// are not restarted because no controller would recreate them.
+	PodsNotRestartable int `json:"podsNotRestartable,omitempty"`
+
+	// PodsBlockedByDisruptionBudget is the number of selected pods whose eviction was last
+	// refused because of a PodDisruptionBudget.
+	PodsBlockedByDisruptionBudget int `json:"podsBlockedByDisruptionBudget,omitempty"`
+
+	// SourceBlocksReleased is true once the affinities of the source pool's empty blocks have
+	// been released.
+	SourceBlocksReleased bool `json:"sourceBlocksReleased,omitempty"`
+}
+
+// NewIPPoolMigration creates a new (zeroed) IPPoolMigration struct with the TypeMetadata initialised to the current
+// version.
+func NewIPPoolMigration() *IPPoolMigration {
+	return &IPPoolMigration{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindIPPoolMigration,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
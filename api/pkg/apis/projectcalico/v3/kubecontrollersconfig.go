@@ -87,6 +87,9 @@ type ControllersConfig struct {
 
 	// LoadBalancer enables and configures the LoadBalancer controller. Enabled by default, set to nil to disable.
 	LoadBalancer *LoadBalancerControllerConfig `json:"loadBalancer,omitempty"`
+
+	// IPPoolMigration enables and configures the IPPoolMigration controller. Disabled by default, set to enable.
+	IPPoolMigration *IPPoolMigrationControllerConfig `json:"ipPoolMigration,omitempty"`
 }
 
 // NodeControllerConfig configures the node controller, which automatically cleans up configuration
@@ -171,6 +174,13 @@ type LoadBalancerControllerConfig struct {
 	AssignIPs AssignIPs `json:"assignIPs,omitempty" validate:"omitempty,assignIPs"`
 }
 
+// IPPoolMigrationControllerConfig configures the IPPoolMigration controller, which restarts pods to move
+// them between IP pools as requested by IPPoolMigration resources.
+type IPPoolMigrationControllerConfig struct {
+	// ReconcilerPeriod is the period between checks of the progress of running migrations. [Default: 30s]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+}
+
 type AssignIPs string
 
 const (
@@ -106,6 +106,37 @@ type CalicoNodeBGPStatus struct {
 
 	// PeersV6 represents IPv6 BGP peers status on the node.
 	PeersV6 []CalicoNodePeer `json:"peersV6,omitempty"`
+
+	// BFDSessionsV4 represents the IPv4 BFD sessions on the node.
+	BFDSessionsV4 []CalicoNodeBFDSession `json:"bfdSessionsV4,omitempty"`
+
+	// BFDSessionsV6 represents the IPv6 BFD sessions on the node.
+	BFDSessionsV6 []CalicoNodeBFDSession `json:"bfdSessionsV6,omitempty"`
+
+	// GracefulShutdown reports the progress of a graceful shutdown of the node's BGP sessions,
+	// which calico/node starts when the node is cordoned or drained.  It is not set when the
+	// node is not shutting down.
+	GracefulShutdown *CalicoNodeBGPGracefulShutdownStatus `json:"gracefulShutdown,omitempty"`
+}
+
+// CalicoNodeBGPGracefulShutdownStatus defines the observed state of a graceful BGP shutdown.
+type CalicoNodeBGPGracefulShutdownStatus struct {
+	// Phase is the current phase of the shutdown.  In the Signalling phase the node advertises
+	// its routes with the GRACEFUL_SHUTDOWN community (RFC 8326) so that peers move traffic to
+	// alternative paths.  In the Withdrawn phase the node no longer advertises any routes.
+	Phase BGPGracefulShutdownPhase `json:"phase,omitempty"`
+
+	// Reason is the reason that the shutdown was started.
+	Reason BGPGracefulShutdownReason `json:"reason,omitempty"`
+
+	// StartTime is the time at which the shutdown was started.
+	// +nullable
+	StartTime metav1.Time `json:"startTime,omitempty"`
+
+	// WithdrawTime is the earliest time at which the node's routes are withdrawn.  The routes
+	// of a cordoned node are only withdrawn once its workload pods have also been evicted.
+	// +nullable
+	WithdrawTime metav1.Time `json:"withdrawTime,omitempty"`
 }
 
 // CalicoNodeBGPRouteStatus defines the observed state of routes status on the node.
@@ -151,6 +182,28 @@ type CalicoNodePeer struct {
 	Since string `json:"since,omitempty"`
 }
 
+// CalicoNodeBFDSession contains the status of a BFD session on the node.
+type CalicoNodeBFDSession struct {
+	// IP address of the peer at the other end of the session.
+	PeerIP string `json:"peerIP,omitempty" validate:"omitempty,ip"`
+
+	// Interface is the interface that the session runs over, if it is a single-hop session.
+	Interface string `json:"interface,omitempty"`
+
+	// State is the BFD session state.
+	State BFDSessionState `json:"state,omitempty"`
+
+	// Since the state last changed.
+	Since string `json:"since,omitempty"`
+
+	// Interval is the interval between transmitted BFD control packets.
+	Interval string `json:"interval,omitempty"`
+
+	// Timeout is the time after which the session is declared down if no BFD control packet
+	// is received.
+	Timeout string `json:"timeout,omitempty"`
+}
+
 // CalicoNodeRoute contains the status of BGP routes on the node.
 type CalicoNodeRoute struct {
 	// Type indicates if the route is being used for forwarding or not.
@@ -229,6 +282,30 @@ const (
 	BGPDaemonStateNotReady BGPDaemonState = "NotReady"
 )
 
+type BGPGracefulShutdownPhase string
+
+const (
+	BGPGracefulShutdownPhaseSignalling BGPGracefulShutdownPhase = "Signalling"
+	BGPGracefulShutdownPhaseWithdrawn  BGPGracefulShutdownPhase = "Withdrawn"
+)
+
+type BGPGracefulShutdownReason string
+
+const (
+	BGPGracefulShutdownReasonCordoned    BGPGracefulShutdownReason = "Cordoned"
+	BGPGracefulShutdownReasonAnnotation  BGPGracefulShutdownReason = "Annotation"
+	BGPGracefulShutdownReasonTerminating BGPGracefulShutdownReason = "Terminating"
+)
+
+type BFDSessionState string
+
+const (
+	BFDSessionStateAdminDown BFDSessionState = "AdminDown"
+	BFDSessionStateDown      BFDSessionState = "Down"
+	BFDSessionStateInit      BFDSessionState = "Init"
+	BFDSessionStateUp        BFDSessionState = "Up"
+)
+
 type BGPSessionState string
 
 const (
@@ -0,0 +1,85 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindPacketCapture     = "PacketCapture"
+	KindPacketCaptureList = "PacketCaptureList"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PacketCaptureList is a list of PacketCapture objects.
+type PacketCaptureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []PacketCapture `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PacketCapture requests that Felix captures the traffic of the workload endpoints that
+// it selects.  Each Felix captures on the interfaces of its local matching endpoints and
+// writes the packets to rotated pcap files on its own host.
+type PacketCapture struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec PacketCaptureSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// PacketCaptureSpec contains the specification for a PacketCapture resource.
+type PacketCaptureSpec struct {
+	// The selector is an expression used to pick out the workload endpoints to capture on.
+	// Only endpoints in the same namespace as the PacketCapture are considered.  The
+	// selector uses the same syntax as the endpoint selector of a NetworkPolicy; if it
+	// is empty, all endpoints in the namespace are selected.
+	Selector string `json:"selector,omitempty" validate:"selector"`
+
+	// Filter is a BPF filter expression, using the same syntax as tcpdump, that limits
+	// the captured packets.  If empty, all packets are captured.
+	Filter string `json:"filter,omitempty"`
+
+	// Duration limits how long the capture runs for, measured from the creation of the
+	// PacketCapture resource.  If not specified, the capture runs until the resource is
+	// deleted.
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// MaxFileSizeBytes is the size at which the capture file of an interface is rotated.
+	// If not specified, Felix's CaptureMaxFileSizeBytes setting is used.
+	MaxFileSizeBytes *int `json:"maxFileSizeBytes,omitempty" validate:"omitempty,gt=0"`
+
+	// MaxFiles is the number of capture files, including the file currently being written,
+	// that are kept for each interface.  When a rotation would exceed this, the oldest file
+	// is deleted.  If not specified, Felix's CaptureMaxFiles setting is used.
+	MaxFiles *int `json:"maxFiles,omitempty" validate:"omitempty,gt=0"`
+}
+
+// NewPacketCapture creates a new (zeroed) PacketCapture struct with the TypeMetadata initialised to the current
+// version.
+func NewPacketCapture() *PacketCapture {
+	return &PacketCapture{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindPacketCapture,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
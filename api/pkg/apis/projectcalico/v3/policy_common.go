@@ -61,20 +61,91 @@ type Rule struct {
 	// HTTP contains match criteria that apply to HTTP requests.
 	HTTP *HTTPMatch `json:"http,omitempty" validate:"omitempty"`
 
+	// GRPC contains match criteria that apply to gRPC calls.
+	GRPC *GRPCMatch `json:"grpc,omitempty" validate:"omitempty"`
+
+	// Limit is an optional field that limits the connections that the rule allows.  Connections
+	// over the limit are dropped.  Only valid on rules with the Allow action, and not supported
+	// in DoNotTrack policies.
+	Limit *RuleLimit `json:"limit,omitempty" validate:"omitempty"`
+
 	// Metadata contains additional information for this rule
 	Metadata *RuleMetadata `json:"metadata,omitempty" validate:"omitempty"`
 }
 
 // HTTPPath specifies an HTTP path to match. It may be either of the form:
 // exact: <path>: which matches the path exactly or
-// prefix: <path-prefix>: which matches the path prefix
+// prefix: <path-prefix>: which matches the path prefix or
+// regex: <regular expression>: which matches the path against a regular expression
 type HTTPPath struct {
 	Exact  string `json:"exact,omitempty" validate:"omitempty"`
 	Prefix string `json:"prefix,omitempty" validate:"omitempty"`
+	// Regex is an RE2 regular expression that must match the whole path.
+	Regex string `json:"regex,omitempty" validate:"omitempty"`
+}
+
+// HTTPHeaderMatch specifies an HTTP request header to match.  Exactly one of Exact, Prefix, Regex and
+// Present must be set.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header.  Header names are matched case-insensitively.
+	Name string `json:"name" validate:"required"`
+	// Exact matches if the header has exactly this value.
+	Exact string `json:"exact,omitempty" validate:"omitempty"`
+	// Prefix matches if the header value starts with this prefix.
+	Prefix string `json:"prefix,omitempty" validate:"omitempty"`
+	// Regex is an RE2 regular expression that must match the whole header value.
+	Regex string `json:"regex,omitempty" validate:"omitempty"`
+	// Present matches if the header is present (true) or absent (false), whatever its value.
+	Present *bool `json:"present,omitempty" validate:"omitempty"`
+}
+
+// HTTPQueryParamMatch specifies a query parameter of an HTTP request to match.  Exactly one of Exact,
+// Prefix, Regex and Present must be set.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter.
+	Name string `json:"name" validate:"required"`
+	// Exact matches if the parameter has exactly this value.
+	Exact string `json:"exact,omitempty" validate:"omitempty"`
+	// Prefix matches if the parameter value starts with this prefix.
+	Prefix string `json:"prefix,omitempty" validate:"omitempty"`
+	// Regex is an RE2 regular expression that must match the whole parameter value.
+	Regex string `json:"regex,omitempty" validate:"omitempty"`
+	// Present matches if the parameter is present (true) or absent (false), whatever its value.
+	Present *bool `json:"present,omitempty" validate:"omitempty"`
+}
+
+// HTTPJWTMatch matches HTTP requests that carry a JSON Web Token, as a bearer token in the Authorization
+// header, whose signature is verified by one of the keys in JWKS and whose claims match.  Expired tokens,
+// and tokens that are not yet valid, do not match.
+type HTTPJWTMatch struct {
+	// JWKS is a JSON Web Key Set document holding the public keys used to verify the token's signature.
+	// RSA and EC keys are supported.
+	JWKS string `json:"jwks" validate:"required"`
+	// Issuer, if set, must equal the token's "iss" claim.
+	Issuer string `json:"issuer,omitempty" validate:"omitempty"`
+	// Audiences, if set, restricts the match to tokens whose "aud" claim contains one of the listed
+	// audiences.
+	Audiences []string `json:"audiences,omitempty" validate:"omitempty"`
+	// Claims are further claims that the token must have.  All of the listed claims must match.
+	Claims []HTTPJWTClaimMatch `json:"claims,omitempty" validate:"omitempty"`
+	// Algorithms lists the JWS algorithms that the token may be signed with: RS256, RS384, RS512,
+	// PS256, PS384, PS512, ES256, ES384 or ES512.  Tokens signed with any other algorithm do not
+	// match.  [Default: RS256]
+	Algorithms []string `json:"algorithms,omitempty" validate:"omitempty"`
+}
+
+// HTTPJWTClaimMatch specifies a JSON Web Token claim to match.
+type HTTPJWTClaimMatch struct {
+	// Name is the name of the claim.  Nested claims may be named with a "." separated path, for
+	// example "realm_access.roles".
+	Name string `json:"name" validate:"required"`
+	// Values lists the values that the claim may have.  If the claim is a list, it matches if any of its
+	// members is one of the values.  If Values is empty, the claim only has to be present.
+	Values []string `json:"values,omitempty" validate:"omitempty"`
 }
 
 // HTTPMatch is an optional field that apply only to HTTP requests
-// The Methods and Path fields are joined with AND
+// The Methods, Paths, Hosts, Headers, QueryParams and JWT fields are joined with AND
 type HTTPMatch struct {
 	// Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed
 	// HTTP Methods (e.g. GET, PUT, etc.)
@@ -86,10 +157,69 @@ type HTTPMatch struct {
 	// e.g:
 	// - exact: /foo
 	// - prefix: /bar
-	// NOTE: Each entry may ONLY specify either a `exact` or a `prefix` match. The validator will check for it.
+	// - regex: /users/[0-9]+
+	// NOTE: Each entry may ONLY specify one of `exact`, `prefix` or `regex`. The validator will check for it.
 	Paths []HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	// Hosts is an optional field that restricts the rule to apply to HTTP requests for one of the listed
+	// hosts, as given by the Host header (or :authority pseudo-header).  A leading "*." matches any
+	// subdomain, e.g. "*.example.com".
+	// Multiple hosts are OR'd together.
+	Hosts []string `json:"hosts,omitempty" validate:"omitempty"`
+	// Headers is an optional field that restricts the rule to apply to HTTP requests whose headers match.
+	// Multiple headers are AND'd together.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" validate:"omitempty"`
+	// QueryParams is an optional field that restricts the rule to apply to HTTP requests whose query
+	// parameters match.
+	// Multiple query parameters are AND'd together.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty" validate:"omitempty"`
+	// JWT is an optional field that restricts the rule to apply to HTTP requests that carry a verified
+	// JSON Web Token with matching claims.
+	JWT *HTTPJWTMatch `json:"jwt,omitempty" validate:"omitempty"`
+}
+
+// GRPCMatch is an optional field that apply only to gRPC calls, which are identified by their
+// application/grpc content type.
+// The Services and Methods fields are joined with AND
+type GRPCMatch struct {
+	// Services is an optional field that restricts the rule to apply only to calls to one of the listed
+	// fully-qualified gRPC services (e.g. helloworld.Greeter).
+	// Multiple services are OR'd together.
+	Services []string `json:"services,omitempty" validate:"omitempty"`
+	// Methods is an optional field that restricts the rule to apply only to calls to one of the listed
+	// gRPC methods (e.g. SayHello).
+	// Multiple methods are OR'd together.
+	Methods []string `json:"methods,omitempty" validate:"omitempty"`
 }
 
+// RuleLimit limits the connections that a rule allows.  At least one of NewConnectionsPerSecond
+// and MaxConnections must be set.
+type RuleLimit struct {
+	// NewConnectionsPerSecond is the maximum rate at which the rule allows new connections.  Once the
+	// burst is used up, new connections over the rate are dropped.
+	// +kubebuilder:validation:Minimum=1
+	NewConnectionsPerSecond *uint32 `json:"newConnectionsPerSecond,omitempty" validate:"omitempty,gt=0"`
+	// Burst is the number of new connections that the rule allows over the rate before it starts to
+	// drop them.  Only valid with NewConnectionsPerSecond.  [Default: 5]
+	// +kubebuilder:validation:Minimum=1
+	Burst *uint32 `json:"burst,omitempty" validate:"omitempty,gt=0"`
+	// MaxConnections is the maximum number of concurrent connections that the rule allows.  New
+	// connections over the maximum are dropped.
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *uint32 `json:"maxConnections,omitempty" validate:"omitempty,gt=0"`
+	// Scope controls what the limits count.  PerSource applies them to each source IP address
+	// separately.  PerRule applies them to all of the traffic on the node that matches the rule.
+	// [Default: PerSource]
+	Scope RuleLimitScope `json:"scope,omitempty" validate:"omitempty,ruleLimitScope"`
+}
+
+// +kubebuilder:validation:Enum=PerSource;PerRule
+type RuleLimitScope string
+
+const (
+	RuleLimitScopePerSource RuleLimitScope = "PerSource"
+	RuleLimitScopePerRule   RuleLimitScope = "PerRule"
+)
+
 // ICMPFields defines structure for ICMP and NotICMP sub-struct for ICMP code and type
 type ICMPFields struct {
 	// Match on a specific ICMP type.  For example a value of 8 refers to ICMP Echo Request
@@ -42,6 +42,8 @@ var (
 		&HostEndpointList{},
 		&IPPool{},
 		&IPPoolList{},
+		&IPPoolMigration{},
+		&IPPoolMigrationList{},
 		&IPReservation{},
 		&IPReservationList{},
 		&BGPConfiguration{},
@@ -60,6 +62,8 @@ var (
 		&ClusterInformationList{},
 		&NetworkSet{},
 		&NetworkSetList{},
+		&PacketCapture{},
+		&PacketCaptureList{},
 		&CalicoNodeStatus{},
 		&CalicoNodeStatusList{},
 		&IPAMConfiguration{},
@@ -76,6 +80,7 @@ var (
 		&StagedKubernetesNetworkPolicyList{},
 		&StagedNetworkPolicy{},
 		&StagedNetworkPolicyList{},
+		&RevisionHistory{},
 	}
 )
 
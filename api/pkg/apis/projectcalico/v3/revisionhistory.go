@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	KindRevisionHistory = "RevisionHistory"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RevisionHistory contains the recorded changes to a single policy, tier or network set
+// resource, oldest first.  It is returned by the "history" subresource of those resources
+// and is not stored in the datastore.
+type RevisionHistory struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []Revision `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RevisionOperation is the type of change recorded by a Revision.
+type RevisionOperation string
+
+const (
+	RevisionOperationCreate RevisionOperation = "Create"
+	RevisionOperationUpdate RevisionOperation = "Update"
+	RevisionOperationDelete RevisionOperation = "Delete"
+)
+
+// Revision is a single recorded change to a resource.
+type Revision struct {
+	// Revision is the revision number.  Revision numbers start at 1 and increase by one
+	// with each change to the resource.
+	Revision int64 `json:"revision"`
+	// Operation is the type of change.
+	Operation RevisionOperation `json:"operation"`
+	// User is the name of the user that made the change.
+	User string `json:"user,omitempty"`
+	// Timestamp is the time at which the change was made.
+	Timestamp metav1.Time `json:"timestamp"`
+	// Diff is a human readable description of the change to the spec.  It is empty for
+	// creates and deletes.
+	Diff string `json:"diff,omitempty"`
+	// Spec is the spec of the resource after the change.  For a delete, this is the spec of
+	// the resource that was deleted.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec runtime.RawExtension `json:"spec,omitempty"`
+}
+
+// NewRevisionHistory creates a new (zeroed) RevisionHistory struct with the TypeMetadata
+// initialised to the current version.
+func NewRevisionHistory() *RevisionHistory {
+	return &RevisionHistory{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindRevisionHistory,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
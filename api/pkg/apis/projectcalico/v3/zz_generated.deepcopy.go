@@ -38,6 +38,42 @@ func (in *AutoHostEndpointConfig) DeepCopy() *AutoHostEndpointConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BFDConfiguration) DeepCopyInto(out *BFDConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinRxInterval != nil {
+		in, out := &in.MinRxInterval, &out.MinRxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinTxInterval != nil {
+		in, out := &in.MinTxInterval, &out.MinTxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BFDConfiguration.
+func (in *BFDConfiguration) DeepCopy() *BFDConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BFDConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPConfiguration) DeepCopyInto(out *BGPConfiguration) {
 	*out = *in
@@ -148,6 +184,16 @@ func (in *BGPConfigurationSpec) DeepCopyInto(out *BGPConfigurationSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.NodeMeshLongLivedStaleTime != nil {
+		in, out := &in.NodeMeshLongLivedStaleTime, &out.NodeMeshLongLivedStaleTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BindMode != nil {
 		in, out := &in.BindMode, &out.BindMode
 		*out = new(BindMode)
@@ -479,6 +525,16 @@ func (in *BGPPeerSpec) DeepCopyInto(out *BGPPeerSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.LongLivedStaleTime != nil {
+		in, out := &in.LongLivedStaleTime, &out.LongLivedStaleTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BFDConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NumAllowedLocalASNumbers != nil {
 		in, out := &in.NumAllowedLocalASNumbers, &out.NumAllowedLocalASNumbers
 		*out = new(int32)
@@ -657,6 +713,40 @@ func (in *CalicoNodeAgentStatus) DeepCopy() *CalicoNodeAgentStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoNodeBFDSession) DeepCopyInto(out *CalicoNodeBFDSession) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNodeBFDSession.
+func (in *CalicoNodeBFDSession) DeepCopy() *CalicoNodeBFDSession {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoNodeBFDSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoNodeBGPGracefulShutdownStatus) DeepCopyInto(out *CalicoNodeBGPGracefulShutdownStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.WithdrawTime.DeepCopyInto(&out.WithdrawTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNodeBGPGracefulShutdownStatus.
+func (in *CalicoNodeBGPGracefulShutdownStatus) DeepCopy() *CalicoNodeBGPGracefulShutdownStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoNodeBGPGracefulShutdownStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalicoNodeBGPRouteStatus) DeepCopyInto(out *CalicoNodeBGPRouteStatus) {
 	*out = *in
@@ -696,6 +786,21 @@ func (in *CalicoNodeBGPStatus) DeepCopyInto(out *CalicoNodeBGPStatus) {
 		*out = make([]CalicoNodePeer, len(*in))
 		copy(*out, *in)
 	}
+	if in.BFDSessionsV4 != nil {
+		in, out := &in.BFDSessionsV4, &out.BFDSessionsV4
+		*out = make([]CalicoNodeBFDSession, len(*in))
+		copy(*out, *in)
+	}
+	if in.BFDSessionsV6 != nil {
+		in, out := &in.BFDSessionsV6, &out.BFDSessionsV6
+		*out = make([]CalicoNodeBFDSession, len(*in))
+		copy(*out, *in)
+	}
+	if in.GracefulShutdown != nil {
+		in, out := &in.GracefulShutdown, &out.GracefulShutdown
+		*out = new(CalicoNodeBGPGracefulShutdownStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -995,6 +1100,11 @@ func (in *ControllersConfig) DeepCopyInto(out *ControllersConfig) {
 		*out = new(LoadBalancerControllerConfig)
 		**out = **in
 	}
+	if in.IPPoolMigration != nil {
+		in, out := &in.IPPoolMigration, &out.IPPoolMigration
+		*out = new(IPPoolMigrationControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1144,6 +1254,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.DataplaneDryRun != nil {
+		in, out := &in.DataplaneDryRun, &out.DataplaneDryRun
+		*out = new(bool)
+		**out = **in
+	}
 	if in.IPv6Support != nil {
 		in, out := &in.IPv6Support, &out.IPv6Support
 		*out = new(bool)
@@ -1560,6 +1675,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.BPFServiceBackendSelection != nil {
+		in, out := &in.BPFServiceBackendSelection, &out.BPFServiceBackendSelection
+		*out = new(BPFServiceBackendSelection)
+		**out = **in
+	}
+	if in.BPFMaglevMaxServices != nil {
+		in, out := &in.BPFMaglevMaxServices, &out.BPFMaglevMaxServices
+		*out = new(int)
+		**out = **in
+	}
 	if in.BPFMapSizeRoute != nil {
 		in, out := &in.BPFMapSizeRoute, &out.BPFMapSizeRoute
 		*out = new(int)
@@ -1717,6 +1842,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.WireguardKeyRotationInterval != nil {
+		in, out := &in.WireguardKeyRotationInterval, &out.WireguardKeyRotationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WireguardKeyRotationGracePeriod != nil {
+		in, out := &in.WireguardKeyRotationGracePeriod, &out.WireguardKeyRotationGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.AWSSrcDstCheck != nil {
 		in, out := &in.AWSSrcDstCheck, &out.AWSSrcDstCheck
 		*out = new(AWSSrcDstCheckOption)
@@ -1747,6 +1882,28 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CaptureDir != nil {
+		in, out := &in.CaptureDir, &out.CaptureDir
+		*out = new(string)
+		**out = **in
+	}
+	if in.CaptureMaxFileSizeBytes != nil {
+		in, out := &in.CaptureMaxFileSizeBytes, &out.CaptureMaxFileSizeBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.CaptureMaxFiles != nil {
+		in, out := &in.CaptureMaxFiles, &out.CaptureMaxFiles
+		*out = new(int)
+		**out = **in
+	}
+	if in.HostEndpointTemplates != nil {
+		in, out := &in.HostEndpointTemplates, &out.HostEndpointTemplates
+		*out = make([]HostEndpointTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1760,6 +1917,32 @@ func (in *FelixConfigurationSpec) DeepCopy() *FelixConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCMatch) DeepCopyInto(out *GRPCMatch) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCMatch.
+func (in *GRPCMatch) DeepCopy() *GRPCMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalNetworkPolicy) DeepCopyInto(out *GlobalNetworkPolicy) {
 	*out = *in
@@ -1946,6 +2129,81 @@ func (in *GlobalNetworkSetSpec) DeepCopy() *GlobalNetworkSetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
+	*out = *in
+	if in.Present != nil {
+		in, out := &in.Present, &out.Present
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJWTClaimMatch) DeepCopyInto(out *HTTPJWTClaimMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJWTClaimMatch.
+func (in *HTTPJWTClaimMatch) DeepCopy() *HTTPJWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPJWTMatch) DeepCopyInto(out *HTTPJWTMatch) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]HTTPJWTClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Algorithms != nil {
+		in, out := &in.Algorithms, &out.Algorithms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPJWTMatch.
+func (in *HTTPJWTMatch) DeepCopy() *HTTPJWTMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPJWTMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPMatch) DeepCopyInto(out *HTTPMatch) {
 	*out = *in
@@ -1959,6 +2217,30 @@ func (in *HTTPMatch) DeepCopyInto(out *HTTPMatch) {
 		*out = make([]HTTPPath, len(*in))
 		copy(*out, *in)
 	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]HTTPQueryParamMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(HTTPJWTMatch)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1988,6 +2270,27 @@ func (in *HTTPPath) DeepCopy() *HTTPPath {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPQueryParamMatch) DeepCopyInto(out *HTTPQueryParamMatch) {
+	*out = *in
+	if in.Present != nil {
+		in, out := &in.Present, &out.Present
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPQueryParamMatch.
+func (in *HTTPQueryParamMatch) DeepCopy() *HTTPQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPQueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthTimeoutOverride) DeepCopyInto(out *HealthTimeoutOverride) {
 	*out = *in
@@ -2096,6 +2399,34 @@ func (in *HostEndpointSpec) DeepCopy() *HostEndpointSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostEndpointTemplate) DeepCopyInto(out *HostEndpointTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostEndpointTemplate.
+func (in *HostEndpointTemplate) DeepCopy() *HostEndpointTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HostEndpointTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICMPFields) DeepCopyInto(out *ICMPFields) {
 	*out = *in
@@ -2274,6 +2605,137 @@ func (in *IPPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolMigration) DeepCopyInto(out *IPPoolMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolMigration.
+func (in *IPPoolMigration) DeepCopy() *IPPoolMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPoolMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolMigrationControllerConfig) DeepCopyInto(out *IPPoolMigrationControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolMigrationControllerConfig.
+func (in *IPPoolMigrationControllerConfig) DeepCopy() *IPPoolMigrationControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolMigrationControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolMigrationList) DeepCopyInto(out *IPPoolMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPPoolMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolMigrationList.
+func (in *IPPoolMigrationList) DeepCopy() *IPPoolMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPoolMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolMigrationSpec) DeepCopyInto(out *IPPoolMigrationSpec) {
+	*out = *in
+	if in.PodsPerMinute != nil {
+		in, out := &in.PodsPerMinute, &out.PodsPerMinute
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolMigrationSpec.
+func (in *IPPoolMigrationSpec) DeepCopy() *IPPoolMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolMigrationStatus) DeepCopyInto(out *IPPoolMigrationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolMigrationStatus.
+func (in *IPPoolMigrationStatus) DeepCopy() *IPPoolMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 	*out = *in
@@ -2757,6 +3219,97 @@ func (in *NodeControllerConfig) DeepCopy() *NodeControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCapture) DeepCopyInto(out *PacketCapture) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCapture.
+func (in *PacketCapture) DeepCopy() *PacketCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketCapture) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureList) DeepCopyInto(out *PacketCaptureList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PacketCapture, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureList.
+func (in *PacketCaptureList) DeepCopy() *PacketCaptureList {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketCaptureList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureSpec) DeepCopyInto(out *PacketCaptureSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxFileSizeBytes != nil {
+		in, out := &in.MaxFileSizeBytes, &out.MaxFileSizeBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxFiles != nil {
+		in, out := &in.MaxFiles, &out.MaxFiles
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureSpec.
+func (in *PacketCaptureSpec) DeepCopy() *PacketCaptureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyControllerConfig) DeepCopyInto(out *PolicyControllerConfig) {
 	*out = *in
@@ -2912,6 +3465,57 @@ func (in *ProtoPort) DeepCopy() *ProtoPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Revision) DeepCopyInto(out *Revision) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Revision.
+func (in *Revision) DeepCopy() *Revision {
+	if in == nil {
+		return nil
+	}
+	out := new(Revision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionHistory) DeepCopyInto(out *RevisionHistory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Revision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionHistory.
+func (in *RevisionHistory) DeepCopy() *RevisionHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RevisionHistory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTableIDRange) DeepCopyInto(out *RouteTableIDRange) {
 	*out = *in
@@ -2999,6 +3603,16 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 		*out = new(HTTPMatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(RuleLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = new(RuleMetadata)
@@ -3017,6 +3631,37 @@ func (in *Rule) DeepCopy() *Rule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleLimit) DeepCopyInto(out *RuleLimit) {
+	*out = *in
+	if in.NewConnectionsPerSecond != nil {
+		in, out := &in.NewConnectionsPerSecond, &out.NewConnectionsPerSecond
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleLimit.
+func (in *RuleLimit) DeepCopy() *RuleLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleMetadata) DeepCopyInto(out *RuleMetadata) {
 	*out = *in
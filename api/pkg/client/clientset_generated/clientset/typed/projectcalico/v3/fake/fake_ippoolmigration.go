@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	projectcalicov3 "github.com/projectcalico/api/pkg/client/clientset_generated/clientset/typed/projectcalico/v3"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeIPPoolMigrations implements IPPoolMigrationInterface
+type fakeIPPoolMigrations struct {
+	*gentype.FakeClientWithList[*v3.IPPoolMigration, *v3.IPPoolMigrationList]
+	Fake *FakeProjectcalicoV3
+}
+
+func newFakeIPPoolMigrations(fake *FakeProjectcalicoV3) projectcalicov3.IPPoolMigrationInterface {
+	return &fakeIPPoolMigrations{
+		gentype.NewFakeClientWithList[*v3.IPPoolMigration, *v3.IPPoolMigrationList](
+			fake.Fake,
+			"",
+			v3.SchemeGroupVersion.WithResource("ippoolmigrations"),
+			v3.SchemeGroupVersion.WithKind("IPPoolMigration"),
+			func() *v3.IPPoolMigration { return &v3.IPPoolMigration{} },
+			func() *v3.IPPoolMigrationList { return &v3.IPPoolMigrationList{} },
+			func(dst, src *v3.IPPoolMigrationList) { dst.ListMeta = src.ListMeta },
+			func(list *v3.IPPoolMigrationList) []*v3.IPPoolMigration { return gentype.ToPointerSlice(list.Items) },
+			func(list *v3.IPPoolMigrationList, items []*v3.IPPoolMigration) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
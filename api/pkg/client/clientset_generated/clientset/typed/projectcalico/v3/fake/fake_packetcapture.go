@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	projectcalicov3 "github.com/projectcalico/api/pkg/client/clientset_generated/clientset/typed/projectcalico/v3"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakePacketCaptures implements PacketCaptureInterface
+type fakePacketCaptures struct {
+	*gentype.FakeClientWithList[*v3.PacketCapture, *v3.PacketCaptureList]
+	Fake *FakeProjectcalicoV3
+}
+
+func newFakePacketCaptures(fake *FakeProjectcalicoV3, namespace string) projectcalicov3.PacketCaptureInterface {
+	return &fakePacketCaptures{
+		gentype.NewFakeClientWithList[*v3.PacketCapture, *v3.PacketCaptureList](
+			fake.Fake,
+			namespace,
+			v3.SchemeGroupVersion.WithResource("packetcaptures"),
+			v3.SchemeGroupVersion.WithKind("PacketCapture"),
+			func() *v3.PacketCapture { return &v3.PacketCapture{} },
+			func() *v3.PacketCaptureList { return &v3.PacketCaptureList{} },
+			func(dst, src *v3.PacketCaptureList) { dst.ListMeta = src.ListMeta },
+			func(list *v3.PacketCaptureList) []*v3.PacketCapture { return gentype.ToPointerSlice(list.Items) },
+			func(list *v3.PacketCaptureList, items []*v3.PacketCapture) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
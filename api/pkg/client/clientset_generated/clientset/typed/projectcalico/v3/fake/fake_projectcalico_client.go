@@ -62,6 +62,10 @@ func (c *FakeProjectcalicoV3) IPPools() v3.IPPoolInterface {
 	return newFakeIPPools(c)
 }
 
+func (c *FakeProjectcalicoV3) IPPoolMigrations() v3.IPPoolMigrationInterface {
+	return newFakeIPPoolMigrations(c)
+}
+
 func (c *FakeProjectcalicoV3) IPReservations() v3.IPReservationInterface {
 	return newFakeIPReservations(c)
 }
@@ -78,6 +82,10 @@ func (c *FakeProjectcalicoV3) NetworkSets(namespace string) v3.NetworkSetInterfa
 	return newFakeNetworkSets(c, namespace)
 }
 
+func (c *FakeProjectcalicoV3) PacketCaptures(namespace string) v3.PacketCaptureInterface {
+	return newFakePacketCaptures(c, namespace)
+}
+
 func (c *FakeProjectcalicoV3) Profiles() v3.ProfileInterface {
 	return newFakeProfiles(c)
 }
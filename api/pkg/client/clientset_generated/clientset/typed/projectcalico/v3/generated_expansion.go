@@ -28,6 +28,8 @@ type IPAMConfigurationExpansion interface{}
 
 type IPPoolExpansion interface{}
 
+type IPPoolMigrationExpansion interface{}
+
 type IPReservationExpansion interface{}
 
 type KubeControllersConfigurationExpansion interface{}
@@ -36,6 +38,8 @@ type NetworkPolicyExpansion interface{}
 
 type NetworkSetExpansion interface{}
 
+type PacketCaptureExpansion interface{}
+
 type ProfileExpansion interface{}
 
 type StagedGlobalNetworkPolicyExpansion interface{}
@@ -0,0 +1,54 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	context "context"
+
+	projectcalicov3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	scheme "github.com/projectcalico/api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// IPPoolMigrationsGetter has a method to return a IPPoolMigrationInterface.
+// A group's client should implement this interface.
+type IPPoolMigrationsGetter interface {
+	IPPoolMigrations() IPPoolMigrationInterface
+}
+
+// IPPoolMigrationInterface has methods to work with IPPoolMigration resources.
+type IPPoolMigrationInterface interface {
+	Create(ctx context.Context, iPPoolMigration *projectcalicov3.IPPoolMigration, opts v1.CreateOptions) (*projectcalicov3.IPPoolMigration, error)
+	Update(ctx context.Context, iPPoolMigration *projectcalicov3.IPPoolMigration, opts v1.UpdateOptions) (*projectcalicov3.IPPoolMigration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*projectcalicov3.IPPoolMigration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*projectcalicov3.IPPoolMigrationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *projectcalicov3.IPPoolMigration, err error)
+	IPPoolMigrationExpansion
+}
+
+// iPPoolMigrations implements IPPoolMigrationInterface
+type iPPoolMigrations struct {
+	*gentype.ClientWithList[*projectcalicov3.IPPoolMigration, *projectcalicov3.IPPoolMigrationList]
+}
+
+// newIPPoolMigrations returns a IPPoolMigrations
+func newIPPoolMigrations(c *ProjectcalicoV3Client) *iPPoolMigrations {
+	return &iPPoolMigrations{
+		gentype.NewClientWithList[*projectcalicov3.IPPoolMigration, *projectcalicov3.IPPoolMigrationList](
+			"ippoolmigrations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *projectcalicov3.IPPoolMigration { return &projectcalicov3.IPPoolMigration{} },
+			func() *projectcalicov3.IPPoolMigrationList { return &projectcalicov3.IPPoolMigrationList{} },
+		),
+	}
+}
@@ -26,10 +26,12 @@ type ProjectcalicoV3Interface interface {
 	HostEndpointsGetter
 	IPAMConfigurationsGetter
 	IPPoolsGetter
+	IPPoolMigrationsGetter
 	IPReservationsGetter
 	KubeControllersConfigurationsGetter
 	NetworkPoliciesGetter
 	NetworkSetsGetter
+	PacketCapturesGetter
 	ProfilesGetter
 	StagedGlobalNetworkPoliciesGetter
 	StagedKubernetesNetworkPoliciesGetter
@@ -90,6 +92,10 @@ func (c *ProjectcalicoV3Client) IPPools() IPPoolInterface {
 	return newIPPools(c)
 }
 
+func (c *ProjectcalicoV3Client) IPPoolMigrations() IPPoolMigrationInterface {
+	return newIPPoolMigrations(c)
+}
+
 func (c *ProjectcalicoV3Client) IPReservations() IPReservationInterface {
 	return newIPReservations(c)
 }
@@ -106,6 +112,10 @@ func (c *ProjectcalicoV3Client) NetworkSets(namespace string) NetworkSetInterfac
 	return newNetworkSets(c, namespace)
 }
 
+func (c *ProjectcalicoV3Client) PacketCaptures(namespace string) PacketCaptureInterface {
+	return newPacketCaptures(c, namespace)
+}
+
 func (c *ProjectcalicoV3Client) Profiles() ProfileInterface {
 	return newProfiles(c)
 }
@@ -34,6 +34,8 @@ type Interface interface {
 	IPAMConfigurations() IPAMConfigurationInformer
 	// IPPools returns a IPPoolInformer.
 	IPPools() IPPoolInformer
+	// IPPoolMigrations returns a IPPoolMigrationInformer.
+	IPPoolMigrations() IPPoolMigrationInformer
 	// IPReservations returns a IPReservationInformer.
 	IPReservations() IPReservationInformer
 	// KubeControllersConfigurations returns a KubeControllersConfigurationInformer.
@@ -42,6 +44,8 @@ type Interface interface {
 	NetworkPolicies() NetworkPolicyInformer
 	// NetworkSets returns a NetworkSetInformer.
 	NetworkSets() NetworkSetInformer
+	// PacketCaptures returns a PacketCaptureInformer.
+	PacketCaptures() PacketCaptureInformer
 	// Profiles returns a ProfileInformer.
 	Profiles() ProfileInformer
 	// StagedGlobalNetworkPolicies returns a StagedGlobalNetworkPolicyInformer.
@@ -125,6 +129,11 @@ func (v *version) IPPools() IPPoolInformer {
 	return &iPPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// IPPoolMigrations returns a IPPoolMigrationInformer.
+func (v *version) IPPoolMigrations() IPPoolMigrationInformer {
+	return &iPPoolMigrationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // IPReservations returns a IPReservationInformer.
 func (v *version) IPReservations() IPReservationInformer {
 	return &iPReservationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -145,6 +154,11 @@ func (v *version) NetworkSets() NetworkSetInformer {
 	return &networkSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PacketCaptures returns a PacketCaptureInformer.
+func (v *version) PacketCaptures() PacketCaptureInformer {
+	return &packetCaptureInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Profiles returns a ProfileInformer.
 func (v *version) Profiles() ProfileInformer {
 	return &profileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	context "context"
+	time "time"
+
+	apisprojectcalicov3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	clientset "github.com/projectcalico/api/pkg/client/clientset_generated/clientset"
+	internalinterfaces "github.com/projectcalico/api/pkg/client/informers_generated/externalversions/internalinterfaces"
+	projectcalicov3 "github.com/projectcalico/api/pkg/client/listers_generated/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IPPoolMigrationInformer provides access to a shared informer and lister for
+// IPPoolMigrations.
+type IPPoolMigrationInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() projectcalicov3.IPPoolMigrationLister
+}
+
+type iPPoolMigrationInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewIPPoolMigrationInformer constructs a new informer for IPPoolMigration type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewIPPoolMigrationInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredIPPoolMigrationInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredIPPoolMigrationInformer constructs a new informer for IPPoolMigration type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredIPPoolMigrationInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ProjectcalicoV3().IPPoolMigrations().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ProjectcalicoV3().IPPoolMigrations().Watch(context.TODO(), options)
+			},
+		},
+		&apisprojectcalicov3.IPPoolMigration{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *iPPoolMigrationInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredIPPoolMigrationInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *iPPoolMigrationInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisprojectcalicov3.IPPoolMigration{}, f.defaultInformer)
+}
+
+func (f *iPPoolMigrationInformer) Lister() projectcalicov3.IPPoolMigrationLister {
+	return projectcalicov3.NewIPPoolMigrationLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	context "context"
+	time "time"
+
+	apisprojectcalicov3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	clientset "github.com/projectcalico/api/pkg/client/clientset_generated/clientset"
+	internalinterfaces "github.com/projectcalico/api/pkg/client/informers_generated/externalversions/internalinterfaces"
+	projectcalicov3 "github.com/projectcalico/api/pkg/client/listers_generated/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// PacketCaptureInformer provides access to a shared informer and lister for
+// PacketCaptures.
+type PacketCaptureInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() projectcalicov3.PacketCaptureLister
+}
+
+type packetCaptureInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewPacketCaptureInformer constructs a new informer for PacketCapture type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewPacketCaptureInformer(client clientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredPacketCaptureInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredPacketCaptureInformer constructs a new informer for PacketCapture type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredPacketCaptureInformer(client clientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ProjectcalicoV3().PacketCaptures(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ProjectcalicoV3().PacketCaptures(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&apisprojectcalicov3.PacketCapture{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *packetCaptureInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredPacketCaptureInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *packetCaptureInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisprojectcalicov3.PacketCapture{}, f.defaultInformer)
+}
+
+func (f *packetCaptureInformer) Lister() projectcalicov3.PacketCaptureLister {
+	return projectcalicov3.NewPacketCaptureLister(f.Informer().GetIndexer())
+}
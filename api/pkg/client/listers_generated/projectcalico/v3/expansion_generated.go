@@ -52,6 +52,10 @@ type IPAMConfigurationListerExpansion interface{}
 // IPPoolLister.
 type IPPoolListerExpansion interface{}
 
+// IPPoolMigrationListerExpansion allows custom methods to be added to
+// IPPoolMigrationLister.
+type IPPoolMigrationListerExpansion interface{}
+
 // IPReservationListerExpansion allows custom methods to be added to
 // IPReservationLister.
 type IPReservationListerExpansion interface{}
@@ -76,6 +80,14 @@ type NetworkSetListerExpansion interface{}
 // NetworkSetNamespaceLister.
 type NetworkSetNamespaceListerExpansion interface{}
 
+// PacketCaptureListerExpansion allows custom methods to be added to
+// PacketCaptureLister.
+type PacketCaptureListerExpansion interface{}
+
+// PacketCaptureNamespaceListerExpansion allows custom methods to be added to
+// PacketCaptureNamespaceLister.
+type PacketCaptureNamespaceListerExpansion interface{}
+
 // ProfileListerExpansion allows custom methods to be added to
 // ProfileLister.
 type ProfileListerExpansion interface{}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v3
+
+import (
+	projectcalicov3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IPPoolMigrationLister helps list IPPoolMigrations.
+// All objects returned here must be treated as read-only.
+type IPPoolMigrationLister interface {
+	// List lists all IPPoolMigrations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*projectcalicov3.IPPoolMigration, err error)
+	// Get retrieves the IPPoolMigration from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*projectcalicov3.IPPoolMigration, error)
+	IPPoolMigrationListerExpansion
+}
+
+// iPPoolMigrationLister implements the IPPoolMigrationLister interface.
+type iPPoolMigrationLister struct {
+	listers.ResourceIndexer[*projectcalicov3.IPPoolMigration]
+}
+
+// NewIPPoolMigrationLister returns a new IPPoolMigrationLister.
+func NewIPPoolMigrationLister(indexer cache.Indexer) IPPoolMigrationLister {
+	return &iPPoolMigrationLister{listers.New[*projectcalicov3.IPPoolMigration](indexer, projectcalicov3.Resource("ippoolmigration"))}
+}
@@ -18,116 +18,136 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.AutoHostEndpointConfig":             schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfiguration":                   schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfigurationList":               schema_pkg_apis_projectcalico_v3_BGPConfigurationList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfigurationSpec":               schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPDaemonStatus":                    schema_pkg_apis_projectcalico_v3_BGPDaemonStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilter":                          schema_pkg_apis_projectcalico_v3_BGPFilter(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterList":                      schema_pkg_apis_projectcalico_v3_BGPFilterList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV4":            schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV4(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV6":            schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV6(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterRuleV4":                    schema_pkg_apis_projectcalico_v3_BGPFilterRuleV4(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterRuleV6":                    schema_pkg_apis_projectcalico_v3_BGPFilterRuleV6(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSpec":                      schema_pkg_apis_projectcalico_v3_BGPFilterSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword":                        schema_pkg_apis_projectcalico_v3_BGPPassword(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeer":                            schema_pkg_apis_projectcalico_v3_BGPPeer(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerList":                        schema_pkg_apis_projectcalico_v3_BGPPeerList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerSpec":                        schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BPFConntrackTimeouts":               schema_pkg_apis_projectcalico_v3_BPFConntrackTimeouts(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinity":                      schema_pkg_apis_projectcalico_v3_BlockAffinity(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinityList":                  schema_pkg_apis_projectcalico_v3_BlockAffinityList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinitySpec":                  schema_pkg_apis_projectcalico_v3_BlockAffinitySpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeAgentStatus":              schema_pkg_apis_projectcalico_v3_CalicoNodeAgentStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPRouteStatus":           schema_pkg_apis_projectcalico_v3_CalicoNodeBGPRouteStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPStatus":                schema_pkg_apis_projectcalico_v3_CalicoNodeBGPStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodePeer":                     schema_pkg_apis_projectcalico_v3_CalicoNodePeer(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeRoute":                    schema_pkg_apis_projectcalico_v3_CalicoNodeRoute(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeRouteLearnedFrom":         schema_pkg_apis_projectcalico_v3_CalicoNodeRouteLearnedFrom(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatus":                   schema_pkg_apis_projectcalico_v3_CalicoNodeStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatusList":               schema_pkg_apis_projectcalico_v3_CalicoNodeStatusList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatusSpec":               schema_pkg_apis_projectcalico_v3_CalicoNodeStatusSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatusStatus":             schema_pkg_apis_projectcalico_v3_CalicoNodeStatusStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ClusterInformation":                 schema_pkg_apis_projectcalico_v3_ClusterInformation(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ClusterInformationList":             schema_pkg_apis_projectcalico_v3_ClusterInformationList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ClusterInformationSpec":             schema_pkg_apis_projectcalico_v3_ClusterInformationSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Community":                          schema_pkg_apis_projectcalico_v3_Community(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ControllersConfig":                  schema_pkg_apis_projectcalico_v3_ControllersConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EndpointPort":                       schema_pkg_apis_projectcalico_v3_EndpointPort(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EntityRule":                         schema_pkg_apis_projectcalico_v3_EntityRule(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfiguration":                 schema_pkg_apis_projectcalico_v3_FelixConfiguration(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfigurationList":             schema_pkg_apis_projectcalico_v3_FelixConfigurationList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfigurationSpec":             schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicy":                schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicy(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicyList":            schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicyList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicySpec":            schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicySpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSet":                   schema_pkg_apis_projectcalico_v3_GlobalNetworkSet(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSetList":               schema_pkg_apis_projectcalico_v3_GlobalNetworkSetList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSetSpec":               schema_pkg_apis_projectcalico_v3_GlobalNetworkSetSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch":                          schema_pkg_apis_projectcalico_v3_HTTPMatch(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath":                           schema_pkg_apis_projectcalico_v3_HTTPPath(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HealthTimeoutOverride":              schema_pkg_apis_projectcalico_v3_HealthTimeoutOverride(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpoint":                       schema_pkg_apis_projectcalico_v3_HostEndpoint(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointList":                   schema_pkg_apis_projectcalico_v3_HostEndpointList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointSpec":                   schema_pkg_apis_projectcalico_v3_HostEndpointSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ICMPFields":                         schema_pkg_apis_projectcalico_v3_ICMPFields(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfiguration":                  schema_pkg_apis_projectcalico_v3_IPAMConfiguration(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfigurationList":              schema_pkg_apis_projectcalico_v3_IPAMConfigurationList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfigurationSpec":              schema_pkg_apis_projectcalico_v3_IPAMConfigurationSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration":                  schema_pkg_apis_projectcalico_v3_IPIPConfiguration(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool":                             schema_pkg_apis_projectcalico_v3_IPPool(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolList":                         schema_pkg_apis_projectcalico_v3_IPPoolList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec":                         schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservation":                      schema_pkg_apis_projectcalico_v3_IPReservation(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservationList":                  schema_pkg_apis_projectcalico_v3_IPReservationList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservationSpec":                  schema_pkg_apis_projectcalico_v3_IPReservationSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfiguration":       schema_pkg_apis_projectcalico_v3_KubeControllersConfiguration(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationList":   schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationSpec":   schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationStatus": schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationStatus(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig":       schema_pkg_apis_projectcalico_v3_LoadBalancerControllerConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NamespaceControllerConfig":          schema_pkg_apis_projectcalico_v3_NamespaceControllerConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkPolicy":                      schema_pkg_apis_projectcalico_v3_NetworkPolicy(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkPolicyList":                  schema_pkg_apis_projectcalico_v3_NetworkPolicyList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkPolicySpec":                  schema_pkg_apis_projectcalico_v3_NetworkPolicySpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSet":                         schema_pkg_apis_projectcalico_v3_NetworkSet(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSetList":                     schema_pkg_apis_projectcalico_v3_NetworkSetList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSetSpec":                     schema_pkg_apis_projectcalico_v3_NetworkSetSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig":               schema_pkg_apis_projectcalico_v3_NodeControllerConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig":             schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement":                schema_pkg_apis_projectcalico_v3_PrefixAdvertisement(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Profile":                            schema_pkg_apis_projectcalico_v3_Profile(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProfileList":                        schema_pkg_apis_projectcalico_v3_ProfileList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProfileSpec":                        schema_pkg_apis_projectcalico_v3_ProfileSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProtoPort":                          schema_pkg_apis_projectcalico_v3_ProtoPort(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableIDRange":                  schema_pkg_apis_projectcalico_v3_RouteTableIDRange(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableRange":                    schema_pkg_apis_projectcalico_v3_RouteTableRange(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule":                               schema_pkg_apis_projectcalico_v3_Rule(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata":                       schema_pkg_apis_projectcalico_v3_RuleMetadata(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig":     schema_pkg_apis_projectcalico_v3_ServiceAccountControllerConfig(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountMatch":                schema_pkg_apis_projectcalico_v3_ServiceAccountMatch(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock":              schema_pkg_apis_projectcalico_v3_ServiceClusterIPBlock(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock":             schema_pkg_apis_projectcalico_v3_ServiceExternalIPBlock(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock":         schema_pkg_apis_projectcalico_v3_ServiceLoadBalancerIPBlock(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceMatch":                       schema_pkg_apis_projectcalico_v3_ServiceMatch(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicy":          schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicy(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicyList":      schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicyList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicySpec":      schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicySpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedKubernetesNetworkPolicy":      schema_pkg_apis_projectcalico_v3_StagedKubernetesNetworkPolicy(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedKubernetesNetworkPolicyList":  schema_pkg_apis_projectcalico_v3_StagedKubernetesNetworkPolicyList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedKubernetesNetworkPolicySpec":  schema_pkg_apis_projectcalico_v3_StagedKubernetesNetworkPolicySpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedNetworkPolicy":                schema_pkg_apis_projectcalico_v3_StagedNetworkPolicy(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedNetworkPolicyList":            schema_pkg_apis_projectcalico_v3_StagedNetworkPolicyList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedNetworkPolicySpec":            schema_pkg_apis_projectcalico_v3_StagedNetworkPolicySpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Template":                           schema_pkg_apis_projectcalico_v3_Template(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Tier":                               schema_pkg_apis_projectcalico_v3_Tier(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.TierList":                           schema_pkg_apis_projectcalico_v3_TierList(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.TierSpec":                           schema_pkg_apis_projectcalico_v3_TierSpec(ref),
-		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig":   schema_pkg_apis_projectcalico_v3_WorkloadEndpointControllerConfig(ref),
-		"github.com/projectcalico/api/pkg/lib/numorstring.Port":                                     schema_api_pkg_lib_numorstring_Port(ref),
-		"github.com/projectcalico/api/pkg/lib/numorstring.Protocol":                                 schema_api_pkg_lib_numorstring_Protocol(ref),
-		"github.com/projectcalico/api/pkg/lib/numorstring.Uint8OrString":                            schema_api_pkg_lib_numorstring_Uint8OrString(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                       schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.AutoHostEndpointConfig":              schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration":                    schema_pkg_apis_projectcalico_v3_BFDConfiguration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfiguration":                    schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfigurationList":                schema_pkg_apis_projectcalico_v3_BGPConfigurationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPConfigurationSpec":                schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPDaemonStatus":                     schema_pkg_apis_projectcalico_v3_BGPDaemonStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilter":                           schema_pkg_apis_projectcalico_v3_BGPFilter(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterList":                       schema_pkg_apis_projectcalico_v3_BGPFilterList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV4":             schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV4(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterPrefixLengthV6":             schema_pkg_apis_projectcalico_v3_BGPFilterPrefixLengthV6(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterRuleV4":                     schema_pkg_apis_projectcalico_v3_BGPFilterRuleV4(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterRuleV6":                     schema_pkg_apis_projectcalico_v3_BGPFilterRuleV6(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPFilterSpec":                       schema_pkg_apis_projectcalico_v3_BGPFilterSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword":                         schema_pkg_apis_projectcalico_v3_BGPPassword(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeer":                             schema_pkg_apis_projectcalico_v3_BGPPeer(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerList":                         schema_pkg_apis_projectcalico_v3_BGPPeerList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPeerSpec":                         schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BPFConntrackTimeouts":                schema_pkg_apis_projectcalico_v3_BPFConntrackTimeouts(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinity":                       schema_pkg_apis_projectcalico_v3_BlockAffinity(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinityList":                   schema_pkg_apis_projectcalico_v3_BlockAffinityList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BlockAffinitySpec":                   schema_pkg_apis_projectcalico_v3_BlockAffinitySpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeAgentStatus":               schema_pkg_apis_projectcalico_v3_CalicoNodeAgentStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession":                schema_pkg_apis_projectcalico_v3_CalicoNodeBFDSession(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPGracefulShutdownStatus": schema_pkg_apis_projectcalico_v3_CalicoNodeBGPGracefulShutdownStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPRouteStatus":            schema_pkg_apis_projectcalico_v3_CalicoNodeBGPRouteStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPStatus":                 schema_pkg_apis_projectcalico_v3_CalicoNodeBGPStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodePeer":                      schema_pkg_apis_projectcalico_v3_CalicoNodePeer(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeRoute":                     schema_pkg_apis_projectcalico_v3_CalicoNodeRoute(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeRouteLearnedFrom":          schema_pkg_apis_projectcalico_v3_CalicoNodeRouteLearnedFrom(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatus":                    schema_pkg_apis_projectcalico_v3_CalicoNodeStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatusList":                schema_pkg_apis_projectcalico_v3_CalicoNodeStatusList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatusSpec":                schema_pkg_apis_projectcalico_v3_CalicoNodeStatusSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeStatusStatus":              schema_pkg_apis_projectcalico_v3_CalicoNodeStatusStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ClusterInformation":                  schema_pkg_apis_projectcalico_v3_ClusterInformation(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ClusterInformationList":              schema_pkg_apis_projectcalico_v3_ClusterInformationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ClusterInformationSpec":              schema_pkg_apis_projectcalico_v3_ClusterInformationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Community":                           schema_pkg_apis_projectcalico_v3_Community(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ControllersConfig":                   schema_pkg_apis_projectcalico_v3_ControllersConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EndpointPort":                        schema_pkg_apis_projectcalico_v3_EndpointPort(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EntityRule":                          schema_pkg_apis_projectcalico_v3_EntityRule(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfiguration":                  schema_pkg_apis_projectcalico_v3_FelixConfiguration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfigurationList":              schema_pkg_apis_projectcalico_v3_FelixConfigurationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.FelixConfigurationSpec":              schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GRPCMatch":                           schema_pkg_apis_projectcalico_v3_GRPCMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicy":                 schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicy(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicyList":             schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicyList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkPolicySpec":             schema_pkg_apis_projectcalico_v3_GlobalNetworkPolicySpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSet":                    schema_pkg_apis_projectcalico_v3_GlobalNetworkSet(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSetList":                schema_pkg_apis_projectcalico_v3_GlobalNetworkSetList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.GlobalNetworkSetSpec":                schema_pkg_apis_projectcalico_v3_GlobalNetworkSetSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch":                     schema_pkg_apis_projectcalico_v3_HTTPHeaderMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPJWTClaimMatch":                   schema_pkg_apis_projectcalico_v3_HTTPJWTClaimMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPJWTMatch":                        schema_pkg_apis_projectcalico_v3_HTTPJWTMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch":                           schema_pkg_apis_projectcalico_v3_HTTPMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath":                            schema_pkg_apis_projectcalico_v3_HTTPPath(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPQueryParamMatch":                 schema_pkg_apis_projectcalico_v3_HTTPQueryParamMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HealthTimeoutOverride":               schema_pkg_apis_projectcalico_v3_HealthTimeoutOverride(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpoint":                        schema_pkg_apis_projectcalico_v3_HostEndpoint(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointList":                    schema_pkg_apis_projectcalico_v3_HostEndpointList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointSpec":                    schema_pkg_apis_projectcalico_v3_HostEndpointSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointTemplate":                schema_pkg_apis_projectcalico_v3_HostEndpointTemplate(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ICMPFields":                          schema_pkg_apis_projectcalico_v3_ICMPFields(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfiguration":                   schema_pkg_apis_projectcalico_v3_IPAMConfiguration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfigurationList":               schema_pkg_apis_projectcalico_v3_IPAMConfigurationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPAMConfigurationSpec":               schema_pkg_apis_projectcalico_v3_IPAMConfigurationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPIPConfiguration":                   schema_pkg_apis_projectcalico_v3_IPIPConfiguration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool":                              schema_pkg_apis_projectcalico_v3_IPPool(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolList":                          schema_pkg_apis_projectcalico_v3_IPPoolList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigration":                     schema_pkg_apis_projectcalico_v3_IPPoolMigration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationControllerConfig":     schema_pkg_apis_projectcalico_v3_IPPoolMigrationControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationList":                 schema_pkg_apis_projectcalico_v3_IPPoolMigrationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationSpec":                 schema_pkg_apis_projectcalico_v3_IPPoolMigrationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationStatus":               schema_pkg_apis_projectcalico_v3_IPPoolMigrationStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolSpec":                          schema_pkg_apis_projectcalico_v3_IPPoolSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservation":                       schema_pkg_apis_projectcalico_v3_IPReservation(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservationList":                   schema_pkg_apis_projectcalico_v3_IPReservationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPReservationSpec":                   schema_pkg_apis_projectcalico_v3_IPReservationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfiguration":        schema_pkg_apis_projectcalico_v3_KubeControllersConfiguration(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationList":    schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationSpec":    schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.KubeControllersConfigurationStatus":  schema_pkg_apis_projectcalico_v3_KubeControllersConfigurationStatus(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig":        schema_pkg_apis_projectcalico_v3_LoadBalancerControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NamespaceControllerConfig":           schema_pkg_apis_projectcalico_v3_NamespaceControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkPolicy":                       schema_pkg_apis_projectcalico_v3_NetworkPolicy(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkPolicyList":                   schema_pkg_apis_projectcalico_v3_NetworkPolicyList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkPolicySpec":                   schema_pkg_apis_projectcalico_v3_NetworkPolicySpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSet":                          schema_pkg_apis_projectcalico_v3_NetworkSet(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSetList":                      schema_pkg_apis_projectcalico_v3_NetworkSetList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NetworkSetSpec":                      schema_pkg_apis_projectcalico_v3_NetworkSetSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig":                schema_pkg_apis_projectcalico_v3_NodeControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCapture":                       schema_pkg_apis_projectcalico_v3_PacketCapture(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCaptureList":                   schema_pkg_apis_projectcalico_v3_PacketCaptureList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCaptureSpec":                   schema_pkg_apis_projectcalico_v3_PacketCaptureSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig":              schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement":                 schema_pkg_apis_projectcalico_v3_PrefixAdvertisement(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Profile":                             schema_pkg_apis_projectcalico_v3_Profile(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProfileList":                         schema_pkg_apis_projectcalico_v3_ProfileList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProfileSpec":                         schema_pkg_apis_projectcalico_v3_ProfileSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProtoPort":                           schema_pkg_apis_projectcalico_v3_ProtoPort(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Revision":                            schema_pkg_apis_projectcalico_v3_Revision(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RevisionHistory":                     schema_pkg_apis_projectcalico_v3_RevisionHistory(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableIDRange":                   schema_pkg_apis_projectcalico_v3_RouteTableIDRange(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableRange":                     schema_pkg_apis_projectcalico_v3_RouteTableRange(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Rule":                                schema_pkg_apis_projectcalico_v3_Rule(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleLimit":                           schema_pkg_apis_projectcalico_v3_RuleLimit(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata":                        schema_pkg_apis_projectcalico_v3_RuleMetadata(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig":      schema_pkg_apis_projectcalico_v3_ServiceAccountControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountMatch":                 schema_pkg_apis_projectcalico_v3_ServiceAccountMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock":               schema_pkg_apis_projectcalico_v3_ServiceClusterIPBlock(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock":              schema_pkg_apis_projectcalico_v3_ServiceExternalIPBlock(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock":          schema_pkg_apis_projectcalico_v3_ServiceLoadBalancerIPBlock(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceMatch":                        schema_pkg_apis_projectcalico_v3_ServiceMatch(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicy":           schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicy(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicyList":       schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicyList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedGlobalNetworkPolicySpec":       schema_pkg_apis_projectcalico_v3_StagedGlobalNetworkPolicySpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedKubernetesNetworkPolicy":       schema_pkg_apis_projectcalico_v3_StagedKubernetesNetworkPolicy(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedKubernetesNetworkPolicyList":   schema_pkg_apis_projectcalico_v3_StagedKubernetesNetworkPolicyList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedKubernetesNetworkPolicySpec":   schema_pkg_apis_projectcalico_v3_StagedKubernetesNetworkPolicySpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedNetworkPolicy":                 schema_pkg_apis_projectcalico_v3_StagedNetworkPolicy(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedNetworkPolicyList":             schema_pkg_apis_projectcalico_v3_StagedNetworkPolicyList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.StagedNetworkPolicySpec":             schema_pkg_apis_projectcalico_v3_StagedNetworkPolicySpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Template":                            schema_pkg_apis_projectcalico_v3_Template(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Tier":                                schema_pkg_apis_projectcalico_v3_Tier(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.TierList":                            schema_pkg_apis_projectcalico_v3_TierList(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.TierSpec":                            schema_pkg_apis_projectcalico_v3_TierSpec(ref),
+		"github.com/projectcalico/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig":    schema_pkg_apis_projectcalico_v3_WorkloadEndpointControllerConfig(ref),
+		"github.com/projectcalico/api/pkg/lib/numorstring.Port":                                      schema_api_pkg_lib_numorstring_Port(ref),
+		"github.com/projectcalico/api/pkg/lib/numorstring.Protocol":                                  schema_api_pkg_lib_numorstring_Protocol(ref),
+		"github.com/projectcalico/api/pkg/lib/numorstring.Uint8OrString":                             schema_api_pkg_lib_numorstring_Uint8OrString(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                        schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                    schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AppArmorProfile":                             schema_k8sio_api_core_v1_AppArmorProfile(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                              schema_k8sio_api_core_v1_AttachedVolume(ref),
@@ -481,6 +501,47 @@ func schema_pkg_apis_projectcalico_v3_AutoHostEndpointConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_BFDConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BFDConfiguration contains the Bidirectional Forwarding Detection (BFD) settings for BGP sessions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled sets whether BFD is used to detect the failure of BGP sessions.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minRxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinRxInterval is the minimum interval between received BFD control packets that the node supports.  [Default: 10ms]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"minTxInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinTxInterval is the desired minimum interval between transmitted BFD control packets. [Default: 100ms]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"multiplier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Multiplier is the number of consecutive BFD control packets that may be missed before the session is declared down.  [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_BGPConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -687,6 +748,18 @@ func schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref common.ReferenceC
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"nodeMeshLongLivedStaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time to retain stale routes from a node-to-node mesh peer after its graceful restart time has expired.  When specified, long-lived graceful restart (RFC 9494) is enabled for node-to-mesh peerings.  This field can only be set on the default BGPConfiguration instance and requires that NodeMesh is enabled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"bfd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFD configures Bidirectional Forwarding Detection (BFD) for BGP sessions.  When enabled on the default BGPConfiguration instance, BFD is used for node-to-node mesh peerings and for any BGPPeer that does not configure BFD itself.  The interval and multiplier settings may be overridden on a node-specific BGPConfiguration instance.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration"),
+						},
+					},
 					"bindMode": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BindMode indicates whether to listen for BGP connections on all addresses (None) or only on the node's canonical IP address Node.Spec.BGP.IPvXAddress (NodeIP). Default behaviour is to listen for BGP connections on all addresses.",
@@ -727,7 +800,7 @@ func schema_pkg_apis_projectcalico_v3_BGPConfigurationSpec(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Community", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.Community", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PrefixAdvertisement", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceClusterIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceExternalIPBlock", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceLoadBalancerIPBlock", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1269,6 +1342,18 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback)
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"longLivedStaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time to retain stale routes from the peer after its graceful restart time has expired.  When specified, long-lived graceful restart (RFC 9494) is enabled for the peerings generated by this BGPPeer resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"bfd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFD configures Bidirectional Forwarding Detection (BFD) for the peerings generated by this BGPPeer resource, overriding the BFD configuration in BGPConfiguration.  BIRD applies BFD interval and multiplier settings per node, so when several peers of a node set them, the shortest intervals and the smallest multiplier are used.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration"),
+						},
+					},
 					"numAllowedLocalASNumbers": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Maximum number of local AS numbers that are allowed in the AS path for received routes. This removes BGP loop prevention and should only be used if absolutely necessary.",
@@ -1316,7 +1401,7 @@ func schema_pkg_apis_projectcalico_v3_BGPPeerSpec(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BFDConfiguration", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.BGPPassword", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -1554,6 +1639,102 @@ func schema_pkg_apis_projectcalico_v3_CalicoNodeAgentStatus(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_CalicoNodeBFDSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalicoNodeBFDSession contains the status of a BFD session on the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"peerIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP address of the peer at the other end of the session.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interface is the interface that the session runs over, if it is a single-hop session.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the BFD session state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"since": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Since the state last changed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the interval between transmitted BFD control packets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time after which the session is declared down if no BFD control packet is received.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_CalicoNodeBGPGracefulShutdownStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalicoNodeBGPGracefulShutdownStatus defines the observed state of a graceful BGP shutdown.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the shutdown.  In the Signalling phase the node advertises its routes with the GRACEFUL_SHUTDOWN community (RFC 8326) so that peers move traffic to alternative paths.  In the Withdrawn phase the node no longer advertises any routes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason that the shutdown was started.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time at which the shutdown was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"withdrawTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WithdrawTime is the earliest time at which the node's routes are withdrawn.  The routes of a cordoned node are only withdrawn once its workload pods have also been evicted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_CalicoNodeBGPRouteStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1664,12 +1845,46 @@ func schema_pkg_apis_projectcalico_v3_CalicoNodeBGPStatus(ref common.ReferenceCa
 							},
 						},
 					},
+					"bfdSessionsV4": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFDSessionsV4 represents the IPv4 BFD sessions on the node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession"),
+									},
+								},
+							},
+						},
+					},
+					"bfdSessionsV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BFDSessionsV6 represents the IPv6 BFD sessions on the node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession"),
+									},
+								},
+							},
+						},
+					},
+					"gracefulShutdown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GracefulShutdown reports the progress of a graceful shutdown of the node's BGP sessions, which calico/node starts when the node is cordoned or drained.  It is not set when the node is not shutting down.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPGracefulShutdownStatus"),
+						},
+					},
 				},
 				Required: []string{"numberEstablishedV4", "numberNotEstablishedV4", "numberEstablishedV6", "numberNotEstablishedV6"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodePeer"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBFDSession", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodeBGPGracefulShutdownStatus", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.CalicoNodePeer"},
 	}
 }
 
@@ -2177,11 +2392,17 @@ func schema_pkg_apis_projectcalico_v3_ControllersConfig(ref common.ReferenceCall
 							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig"),
 						},
 					},
+					"ipPoolMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPPoolMigration enables and configures the IPPoolMigration controller. Disabled by default, set to enable.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationControllerConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NamespaceControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.LoadBalancerControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NamespaceControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.NodeControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.PolicyControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ServiceAccountControllerConfig", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.WorkloadEndpointControllerConfig"},
 	}
 }
 
@@ -2439,6 +2660,13 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"dataplaneDryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataplaneDryRun, if true, Felix calculates the changes that it would make to its iptables or nftables rules and IP sets and logs them instead of applying them.  Other parts of the dataplane, such as routes, are still programmed.  Useful for checking the effect of a policy change on a canary node.  Not supported in BPF mode. [Default: false]",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"ipv6Support": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IPv6Support controls whether Felix enables support for IPv6 (if supported by the in-use dataplane).",
@@ -3269,6 +3497,20 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "int32",
 						},
 					},
+					"bpfServiceBackendSelection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFServiceBackendSelection controls how the eBPF kube-proxy replacement picks a backend for a new connection to a service.  'Random' picks a backend at random.  'Maglev' uses a Maglev consistent-hashing lookup table so that every node picks the same backend for the same connection, and so that few connections are remapped when the backends change.  Individual services can override this with the projectcalico.org/backendSelection annotation. [Default: Random]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bpfMaglevMaxServices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BPFMaglevMaxServices sets the maximum number of services that can use Maglev backend selection.  Each such service uses a lookup table with 1021 entries in a BPF map that is sized accordingly.  Services beyond the limit fall back to random backend selection. [Default: 256]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"bpfMapSizeRoute": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BPFMapSizeRoute sets the size for the routes map.  The routes map should be large enough to hold one entry per workload and a handful of entries per host (enough to cover its own IPs and tunnel IPs).",
@@ -3548,6 +3790,18 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"wireguardKeyRotationInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardKeyRotationInterval controls how often Felix rotates the Wireguard private key of each node. When the key reaches this age, Felix generates a new key and publishes it alongside the current key; the node switches to the new key once WireguardKeyRotationGracePeriod has passed and all of its peers have accepted it. Set 0 to disable rotation. [Default: 0]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"wireguardKeyRotationGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardKeyRotationGracePeriod is the minimum time that a node publishes its next Wireguard public key before switching to it. During this period, peers program the next key so that they accept handshakes with either key. The node also waits for all of its peers to accept the next key before it switches. [Default: 5m]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"awsSrcDstCheck": {
 						SchemaProps: spec.SchemaProps{
 							Description: "AWSSrcDstCheck controls whether Felix will try to change the \"source/dest check\" setting on the EC2 instance on which it is running. A value of \"Disable\" will try to disable the source/dest check. Disabling the check allows for sending workload traffic without encapsulation within the same AWS subnet. [Default: DoNothing]",
@@ -3611,11 +3865,89 @@ func schema_pkg_apis_projectcalico_v3_FelixConfigurationSpec(ref common.Referenc
 							Format:      "int32",
 						},
 					},
+					"captureDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureDir controls the directory in which Felix writes the pcap files of PacketCaptures.  Each capture is written to a <namespace>/<name> subdirectory. [Default: /var/log/calico/pcap]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"captureMaxFileSizeBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureMaxFileSizeBytes is the size at which a capture file is rotated, for PacketCaptures that do not set their own limit. [Default: 10000000]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"captureMaxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CaptureMaxFiles is the number of capture files, including the file currently being written, that Felix keeps for each interface, for PacketCaptures that do not set their own limit. [Default: 2]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"hostEndpointTemplates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostEndpointTemplates makes Felix create and maintain HostEndpoints for the interfaces of its own host.  For each template, Felix creates a HostEndpoint for every interface whose name matches the template's interface pattern, and deletes it when the interface goes away.  This is intended for hosts that are not Kubernetes nodes; in a Kubernetes cluster, use the host endpoint controller in kube-controllers instead.  Not supported with the Kubernetes datastore.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointTemplate"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BPFConntrackTimeouts", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HealthTimeoutOverride", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProtoPort", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableIDRange", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableRange", "github.com/projectcalico/api/pkg/lib/numorstring.Port", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.BPFConntrackTimeouts", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HealthTimeoutOverride", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HostEndpointTemplate", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ProtoPort", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableIDRange", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RouteTableRange", "github.com/projectcalico/api/pkg/lib/numorstring.Port", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_GRPCMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCMatch is an optional field that apply only to gRPC calls, which are identified by their application/grpc content type. The Services and Methods fields are joined with AND",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is an optional field that restricts the rule to apply only to calls to one of the listed fully-qualified gRPC services (e.g. helloworld.Greeter). Multiple services are OR'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"methods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Methods is an optional field that restricts the rule to apply only to calls to one of the listed gRPC methods (e.g. SayHello). Multiple methods are OR'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3953,31 +4285,193 @@ func schema_pkg_apis_projectcalico_v3_GlobalNetworkSetSpec(ref common.ReferenceC
 	}
 }
 
-func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_projectcalico_v3_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPMatch is an optional field that apply only to HTTP requests The Methods and Path fields are joined with AND",
+				Description: "HTTPHeaderMatch specifies an HTTP request header to match.  Exactly one of Exact, Prefix, Regex and Present must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"methods": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed HTTP Methods (e.g. GET, PUT, etc.) Multiple methods are OR'd together.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Name is the name of the header.  Header names are matched case-insensitively.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exact matches if the header has exactly this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix matches if the header value starts with this prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex is an RE2 regular expression that must match the whole header value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"present": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Present matches if the header is present (true) or absent (false), whatever its value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_HTTPJWTClaimMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPJWTClaimMatch specifies a JSON Web Token claim to match.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the claim.  Nested claims may be named with a \".\" separated path, for example \"realm_access.roles\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values lists the values that the claim may have.  If the claim is a list, it matches if any of its members is one of the values.  If Values is empty, the claim only has to be present.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_HTTPJWTMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPJWTMatch matches HTTP requests that carry a JSON Web Token, as a bearer token in the Authorization header, whose signature is verified by one of the keys in JWKS and whose claims match.  Expired tokens, and tokens that are not yet valid, do not match.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jwks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWKS is a JSON Web Key Set document holding the public keys used to verify the token's signature. RSA and EC keys are supported.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer, if set, must equal the token's \"iss\" claim.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"audiences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audiences, if set, restricts the match to tokens whose \"aud\" claim contains one of the listed audiences.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"claims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Claims are further claims that the token must have.  All of the listed claims must match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPJWTClaimMatch"),
+									},
+								},
+							},
+						},
+					},
+					"algorithms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Algorithms lists the JWS algorithms that the token may be signed with: RS256, RS384, RS512, PS256, PS384, PS512, ES256, ES384 or ES512.  Tokens signed with any other algorithm do not match.  [Default: RS256]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"jwks"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPJWTClaimMatch"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPMatch is an optional field that apply only to HTTP requests The Methods, Paths, Hosts, Headers, QueryParams and JWT fields are joined with AND",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"methods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Methods is an optional field that restricts the rule to apply only to HTTP requests that use one of the listed HTTP Methods (e.g. GET, PUT, etc.) Multiple methods are OR'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 					"paths": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Paths is an optional field that restricts the rule to apply to HTTP requests that use one of the listed HTTP Paths. Multiple paths are OR'd together. e.g: - exact: /foo - prefix: /bar NOTE: Each entry may ONLY specify either a `exact` or a `prefix` match. The validator will check for it.",
+							Description: "Paths is an optional field that restricts the rule to apply to HTTP requests that use one of the listed HTTP Paths. Multiple paths are OR'd together. e.g: - exact: /foo - prefix: /bar - regex: /users/[0-9]+ NOTE: Each entry may ONLY specify one of `exact`, `prefix` or `regex`. The validator will check for it.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3989,11 +4483,60 @@ func schema_pkg_apis_projectcalico_v3_HTTPMatch(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"hosts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hosts is an optional field that restricts the rule to apply to HTTP requests for one of the listed hosts, as given by the Host header (or :authority pseudo-header).  A leading \"*.\" matches any subdomain, e.g. \"*.example.com\". Multiple hosts are OR'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers is an optional field that restricts the rule to apply to HTTP requests whose headers match. Multiple headers are AND'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams is an optional field that restricts the rule to apply to HTTP requests whose query parameters match. Multiple query parameters are AND'd together.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+					"jwt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWT is an optional field that restricts the rule to apply to HTTP requests that carry a verified JSON Web Token with matching claims.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPJWTMatch"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPHeaderMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPJWTMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPPath", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPQueryParamMatch"},
 	}
 }
 
@@ -4001,7 +4544,7 @@ func schema_pkg_apis_projectcalico_v3_HTTPPath(ref common.ReferenceCallback) com
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPPath specifies an HTTP path to match. It may be either of the form: exact: <path>: which matches the path exactly or prefix: <path-prefix>: which matches the path prefix",
+				Description: "HTTPPath specifies an HTTP path to match. It may be either of the form: exact: <path>: which matches the path exactly or prefix: <path-prefix>: which matches the path prefix or regex: <regular expression>: which matches the path against a regular expression",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"exact": {
@@ -4016,7 +4559,64 @@ func schema_pkg_apis_projectcalico_v3_HTTPPath(ref common.ReferenceCallback) com
 							Format: "",
 						},
 					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex is an RE2 regular expression that must match the whole path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch specifies a query parameter of an HTTP request to match.  Exactly one of Exact, Prefix, Regex and Present must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the query parameter.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exact matches if the parameter has exactly this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix matches if the parameter value starts with this prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regex is an RE2 regular expression that must match the whole parameter value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"present": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Present matches if the parameter is present (true) or absent (false), whatever its value.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -4211,6 +4811,67 @@ func schema_pkg_apis_projectcalico_v3_HostEndpointSpec(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_HostEndpointTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostEndpointTemplate describes the HostEndpoints that Felix creates for its host's interfaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generateName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GenerateName is included in the names of the generated HostEndpoints, which are of the form <hostname>-<generateName>-<interface name>.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interfacePattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfacePattern is a regular expression; a HostEndpoint is generated for each interface whose name matches it.  The expression is anchored at both ends, so \"eth0\" matches only eth0.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are the labels of the generated HostEndpoints.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"profiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profiles are the profiles applied to the generated HostEndpoints.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"generateName", "interfacePattern"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_ICMPFields(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4464,11 +5125,268 @@ func schema_pkg_apis_projectcalico_v3_IPPoolList(ref common.ReferenceCallback) c
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolMigration moves the pods in the selected namespaces from one IPPool to another.  The IPPoolMigration controller in kube-controllers points the selected namespaces at the target pool with the cni.projectcalico.org/ipv4pools or ipv6pools annotation, restarts the pods that have source pool addresses, and then releases the affinities of the source pool's empty blocks.  The migration fails if a restarted pod is assigned an address outside of the target pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationSpec", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigrationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolMigrationControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolMigrationControllerConfig configures the IPPoolMigration controller, which restarts pods to move them between IP pools as requested by IPPoolMigration resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reconcilerPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilerPeriod is the period between checks of the progress of running migrations. [Default: 30s]",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolMigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolMigrationList contains a list of IPPoolMigration resources.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigration"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPoolMigration", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolMigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolMigrationSpec contains the specification for an IPPoolMigration resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sourcePool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourcePool is the name of the IPPool that pods are moved off.  The pool stays enabled for the pods in namespaces that are not selected.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetPool is the name of the IPPool that pods are moved to.  It must be enabled and of the same IP family as the source pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector is an expression used to pick out the namespaces whose pods are migrated.  It uses the same syntax as the namespaceSelector of a NetworkPolicy; if it is empty, pods in all namespaces are migrated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podsPerMinute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsPerMinute limits how many pods are restarted per minute.  Pods are restarted one at a time, and the next pod is only restarted once the replacement of the previous one has been assigned an address.  [Default: 10]",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"sourcePool", "targetPool"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_IPPoolMigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPPoolMigrationStatus reports the progress of an IPPoolMigration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the stage the migration has reached.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message gives detail on the phase, for example why the migration is waiting or failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is when the controller started the migration.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is when the migration completed or failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastRestartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRestartTime is when the controller last restarted a pod.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"podsRestarted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsRestarted is the number of pods that the controller has evicted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podsRemaining": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsRemaining is the number of selected pods that still have source pool addresses and will be restarted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podsOnTargetPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsOnTargetPool is the number of selected pods that have target pool addresses.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podsOutsideTargetPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsOutsideTargetPool is the number of selected pods that were created after the migration started but were assigned addresses outside of the target pool, for example because of an IP pool annotation on the pod.  The migration fails if there are any.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podsNotRestartable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsNotRestartable is the number of selected pods that have source pool addresses but are not restarted because no controller would recreate them.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podsBlockedByDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodsBlockedByDisruptionBudget is the number of selected pods whose eviction was last refused because of a PodDisruptionBudget.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"sourceBlocksReleased": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceBlocksReleased is true once the affinities of the source pool's empty blocks have been released.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.IPPool", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5273,6 +6191,145 @@ func schema_pkg_apis_projectcalico_v3_NodeControllerConfig(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_PacketCapture(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketCapture requests that Felix captures the traffic of the workload endpoints that it selects.  Each Felix captures on the interfaces of its local matching endpoints and writes the packets to rotated pcap files on its own host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCaptureSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCaptureSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_PacketCaptureList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketCaptureList is a list of PacketCapture objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCapture"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.PacketCapture", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_PacketCaptureSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PacketCaptureSpec contains the specification for a PacketCapture resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The selector is an expression used to pick out the workload endpoints to capture on. Only endpoints in the same namespace as the PacketCapture are considered.  The selector uses the same syntax as the endpoint selector of a NetworkPolicy; if it is empty, all endpoints in the namespace are selected.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is a BPF filter expression, using the same syntax as tcpdump, that limits the captured packets.  If empty, all packets are captured.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration limits how long the capture runs for, measured from the creation of the PacketCapture resource.  If not specified, the capture runs until the resource is deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxFileSizeBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFileSizeBytes is the size at which the capture file of an interface is rotated. If not specified, Felix's CaptureMaxFileSizeBytes setting is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxFiles is the number of capture files, including the file currently being written, that are kept for each interface.  When a rotation would exceed this, the oldest file is deleted.  If not specified, Felix's CaptureMaxFiles setting is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_PolicyControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5510,6 +6567,113 @@ func schema_pkg_apis_projectcalico_v3_ProtoPort(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_projectcalico_v3_Revision(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Revision is a single recorded change to a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision is the revision number.  Revision numbers start at 1 and increase by one with each change to the resource.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation is the type of change.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the name of the user that made the change.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is the time at which the change was made.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"diff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Diff is a human readable description of the change to the spec.  It is empty for creates and deletes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the spec of the resource after the change.  For a delete, this is the spec of the resource that was deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"revision", "operation", "timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_RevisionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RevisionHistory contains the recorded changes to a single policy, tier or network set resource, oldest first.  It is returned by the \"history\" subresource of those resources and is not stored in the datastore.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.Revision"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.Revision", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_projectcalico_v3_RouteTableIDRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5629,6 +6793,18 @@ func schema_pkg_apis_projectcalico_v3_Rule(ref common.ReferenceCallback) common.
 							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GRPC contains match criteria that apply to gRPC calls.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.GRPCMatch"),
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limit is an optional field that limits the connections that the rule allows.  Connections over the limit are dropped.  Only valid on rules with the Allow action, and not supported in DoNotTrack policies.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleLimit"),
+						},
+					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Metadata contains additional information for this rule",
@@ -5640,7 +6816,48 @@ func schema_pkg_apis_projectcalico_v3_Rule(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EntityRule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ICMPFields", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata", "github.com/projectcalico/api/pkg/lib/numorstring.Protocol"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EntityRule", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.GRPCMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.HTTPMatch", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.ICMPFields", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleLimit", "github.com/projectcalico/api/pkg/apis/projectcalico/v3.RuleMetadata", "github.com/projectcalico/api/pkg/lib/numorstring.Protocol"},
+	}
+}
+
+func schema_pkg_apis_projectcalico_v3_RuleLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleLimit limits the connections that a rule allows.  At least one of NewConnectionsPerSecond and MaxConnections must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"newConnectionsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewConnectionsPerSecond is the maximum rate at which the rule allows new connections.  Once the burst is used up, new connections over the rate are dropped.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of new connections that the rule allows over the rate before it starts to drop them.  Only valid with NewConnectionsPerSecond.  [Default: 5]",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConnections is the maximum number of concurrent connections that the rule allows.  New connections over the maximum are dropped.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope controls what the limits count.  PerSource applies them to each source IP address separately.  PerRule applies them to all of the traffic on the node that matches the rule. [Default: PerSource]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -37,9 +37,13 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/projectcalico/calico/apiserver/pkg/apiserver"
+	calicoauthorizer "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/authorizer"
 	calicotls "github.com/projectcalico/calico/crypto/pkg/tls"
 )
 
+// defaultHistoryNamespace is the namespace used for revision histories if POD_NAMESPACE isn't set.
+const defaultHistoryNamespace = "calico-apiserver"
+
 // CalicoServerOptions contains the aggregation of configuration structs for
 // the calico server. It contains everything needed to configure a basic API server.
 // It is public so that integration tests can access it.
@@ -151,6 +155,8 @@ func (o *CalicoServerOptions) Config() (*apiserver.Config, error) {
 		if err := o.RecommendedOptions.Authorization.ApplyTo(&serverConfig.Authorization); err != nil {
 			return nil, err
 		}
+		// List and watch access to tiered policies is authorized per tier by the policy storage.
+		serverConfig.Authorization.Authorizer = calicoauthorizer.NewTieredPolicyCollectionAuthorizer(serverConfig.Authorization.Authorizer)
 	} else {
 		// Validating Admission Policy is generally available in k8s 1.30 [1].
 		// The admission plugin "ValidatingAdmissionPolicy" fails to initialize due to
@@ -210,11 +216,18 @@ func (o *CalicoServerOptions) Config() (*apiserver.Config, error) {
 		}
 	}
 
+	// Revision histories are kept in the API server's own namespace.
+	historyNamespace := os.Getenv("POD_NAMESPACE")
+	if historyNamespace == "" {
+		historyNamespace = defaultHistoryNamespace
+	}
+
 	config := &apiserver.Config{
 		GenericConfig: serverConfig,
 		ExtraConfig: apiserver.ExtraConfig{
 			KubernetesAPIServerConfig:  serverConfig.ClientConfig,
 			MinResourceRefreshInterval: minResourceRefreshInterval,
+			HistoryNamespace:           historyNamespace,
 		},
 	}
 
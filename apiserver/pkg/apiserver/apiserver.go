@@ -19,12 +19,14 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	rbacv1listers "k8s.io/client-go/listers/rbac/v1"
 	"k8s.io/client-go/rest"
 	utilversion "k8s.io/component-base/version"
 
 	"github.com/projectcalico/calico/apiserver/pkg/rbac"
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/history"
 	calicorest "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/rest"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/util"
 	"github.com/projectcalico/calico/apiserver/pkg/storage/calico"
@@ -63,6 +65,10 @@ type ExtraConfig struct {
 	// Place you custom config here.
 	KubernetesAPIServerConfig  *rest.Config
 	MinResourceRefreshInterval time.Duration
+
+	// HistoryNamespace is the namespace of the ConfigMaps that hold the revision histories of
+	// resources.
+	HistoryNamespace string
 }
 
 type Config struct {
@@ -110,8 +116,17 @@ func (c completedConfig) New() (*ProjectCalicoServer, error) {
 	apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(GroupName, Scheme, metav1.ParameterCodec, Codecs)
 	apiGroupInfo.NegotiatedSerializer = newProtocolShieldSerializer(&Codecs)
 
+	// Revision histories are persisted in ConfigMaps so that they are shared between replicas.
+	kubeClient, err := kubernetes.NewForConfig(c.ExtraConfig.KubernetesAPIServerConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: Make the storage type configurable
-	calicostore := calicorest.RESTStorageProvider{StorageType: "calico"}
+	calicostore := calicorest.RESTStorageProvider{
+		StorageType:  "calico",
+		HistoryStore: history.NewConfigMapStore(kubeClient, c.ExtraConfig.HistoryNamespace),
+	}
 
 	// Create a backend Calico v3 clientset.
 	cc := calico.CreateClientFromConfig().(backendClient).Backend()
@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package authorizer
+
+import (
+	"context"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/sirupsen/logrus"
+	k8sauth "k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// tieredPolicyResources are the resource types whose access is controlled per tier using the
+// tier.<resource> RBAC resource types.
+var tieredPolicyResources = map[string]bool{
+	"networkpolicies":             true,
+	"globalnetworkpolicies":       true,
+	"stagednetworkpolicies":       true,
+	"stagedglobalnetworkpolicies": true,
+}
+
+type collectionAuthorizer struct {
+	k8sauth.Authorizer
+}
+
+// NewTieredPolicyCollectionAuthorizer returns an authorizer that wraps the provided standard
+// authorizer, used to authorize requests before they reach the resource storage.
+//
+// A user that has been granted list or watch access to the policies in some tiers (using the
+// tier.<resource> resource types) does not usually have list or watch access to the whole policy
+// collection, so the standard authorizer would reject the request.  Instead, list and watch
+// requests for tiered policies that the standard authorizer has no opinion on are allowed here
+// and authorized by the policy storage, which restricts the results to the tiers that the user
+// has access to, and rejects the request if the user has access to none of them.
+func NewTieredPolicyCollectionAuthorizer(a k8sauth.Authorizer) k8sauth.Authorizer {
+	return &collectionAuthorizer{a}
+}
+
+// Authorize implements the k8s Authorizer interface.
+func (a *collectionAuthorizer) Authorize(ctx context.Context, attrs k8sauth.Attributes) (k8sauth.Decision, string, error) {
+	decision, reason, err := a.Authorizer.Authorize(ctx, attrs)
+	if decision != k8sauth.DecisionNoOpinion || !isTieredPolicyCollectionRequest(attrs) {
+		return decision, reason, err
+	}
+	logrus.Trace("Deferring authorization of tiered policy collection request to the policy storage")
+	logAuthorizerAttributes(attrs)
+	return k8sauth.DecisionAllow, "access to tiered policies is authorized per tier", nil
+}
+
+func isTieredPolicyCollectionRequest(attrs k8sauth.Attributes) bool {
+	if !attrs.IsResourceRequest() || attrs.GetAPIGroup() != calico.GroupName || attrs.GetSubresource() != "" {
+		return false
+	}
+	switch attrs.GetVerb() {
+	case "list", "watch":
+		return tieredPolicyResources[attrs.GetResource()]
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package authorizer_test
+
+import (
+	"context"
+	"testing"
+
+	k8sauth "k8s.io/apiserver/pkg/authorization/authorizer"
+
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/authorizer"
+)
+
+type fixedAuth struct {
+	decision k8sauth.Decision
+}
+
+func (f *fixedAuth) Authorize(ctx context.Context, a k8sauth.Attributes) (k8sauth.Decision, string, error) {
+	return f.decision, "", nil
+}
+
+func collectionAttr(verb, resource, subresource string) k8sauth.Attributes {
+	return k8sauth.AttributesRecord{
+		User:            testUser,
+		Verb:            verb,
+		Namespace:       "test-namespace",
+		APIGroup:        "projectcalico.org",
+		APIVersion:      "v3",
+		Resource:        resource,
+		Subresource:     subresource,
+		ResourceRequest: true,
+	}
+}
+
+func TestTieredPolicyCollectionAuthorizer(t *testing.T) {
+	tests := []struct {
+		name     string
+		delegate k8sauth.Decision
+		attrs    k8sauth.Attributes
+		expected k8sauth.Decision
+	}{
+		{"list networkpolicies", k8sauth.DecisionNoOpinion, collectionAttr("list", "networkpolicies", ""), k8sauth.DecisionAllow},
+		{"watch globalnetworkpolicies", k8sauth.DecisionNoOpinion, collectionAttr("watch", "globalnetworkpolicies", ""), k8sauth.DecisionAllow},
+		{"list stagednetworkpolicies", k8sauth.DecisionNoOpinion, collectionAttr("list", "stagednetworkpolicies", ""), k8sauth.DecisionAllow},
+		{"watch stagedglobalnetworkpolicies", k8sauth.DecisionNoOpinion, collectionAttr("watch", "stagedglobalnetworkpolicies", ""), k8sauth.DecisionAllow},
+		{"explicit deny", k8sauth.DecisionDeny, collectionAttr("list", "networkpolicies", ""), k8sauth.DecisionDeny},
+		{"get networkpolicies", k8sauth.DecisionNoOpinion, collectionAttr("get", "networkpolicies", ""), k8sauth.DecisionNoOpinion},
+		{"deletecollection networkpolicies", k8sauth.DecisionNoOpinion, collectionAttr("deletecollection", "networkpolicies", ""), k8sauth.DecisionNoOpinion},
+		{"list subresource", k8sauth.DecisionNoOpinion, collectionAttr("list", "networkpolicies", "status"), k8sauth.DecisionNoOpinion},
+		{"list untiered resource", k8sauth.DecisionNoOpinion, collectionAttr("list", "stagedkubernetesnetworkpolicies", ""), k8sauth.DecisionNoOpinion},
+		{"list networksets", k8sauth.DecisionNoOpinion, collectionAttr("list", "networksets", ""), k8sauth.DecisionNoOpinion},
+		{"list kubernetes networkpolicies", k8sauth.DecisionNoOpinion, k8sauth.AttributesRecord{
+			User:            testUser,
+			Verb:            "list",
+			APIGroup:        "networking.k8s.io",
+			APIVersion:      "v1",
+			Resource:        "networkpolicies",
+			ResourceRequest: true,
+		}, k8sauth.DecisionNoOpinion},
+	}
+
+	for _, tc := range tests {
+		a := authorizer.NewTieredPolicyCollectionAuthorizer(&fixedAuth{tc.delegate})
+		decision, _, err := a.Authorize(context.Background(), tc.attrs)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+		}
+		if decision != tc.expected {
+			t.Errorf("%s: expected decision %v, got %v", tc.name, tc.expected, decision)
+		}
+	}
+}
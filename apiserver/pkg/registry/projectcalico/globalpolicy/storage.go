@@ -31,6 +31,7 @@ import (
 
 	"github.com/projectcalico/calico/apiserver/pkg/rbac"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/authorizer"
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/history"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/util"
 )
@@ -42,6 +43,7 @@ type REST struct {
 	authorizer   authorizer.TierAuthorizer
 	watchManager *util.WatchManager
 	shortNames   []string
+	history      *history.Recorder
 }
 
 // EmptyObject returns an empty instance
@@ -57,6 +59,7 @@ func NewList() runtime.Object {
 // NewREST returns a RESTStorage object that will work against API services.
 func NewREST(scheme *runtime.Scheme, opts server.Options, calicoResourceLister rbac.CalicoResourceLister, watchManager *util.WatchManager) (*REST, error) {
 	strategy := NewStrategy(scheme)
+	strategy.linter = opts.PolicyLinter
 
 	prefix := "/" + opts.ResourcePrefix()
 	// We adapt the store's keyFunc so that we can use it with the StorageDecorator
@@ -105,7 +108,9 @@ func NewREST(scheme *runtime.Scheme, opts server.Options, calicoResourceLister r
 		DestroyFunc: dFunc,
 	}
 
-	return &REST{store, calicoResourceLister, authorizer.NewTierAuthorizer(opts.Authorizer), watchManager, opts.ShortNames}, nil
+	opts.History.Decorate(store, calico.KindGlobalNetworkPolicy)
+
+	return &REST{store, calicoResourceLister, authorizer.NewTierAuthorizer(opts.Authorizer), watchManager, opts.ShortNames, opts.History}, nil
 }
 
 func (r *REST) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
@@ -158,7 +163,11 @@ func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.Va
 		return nil, false, err
 	}
 
-	return r.Store.Delete(ctx, name, deleteValidation, options)
+	obj, deleted, err := r.Store.Delete(ctx, name, deleteValidation, options)
+	if err == nil {
+		r.history.RecordDelete(ctx, calico.KindGlobalNetworkPolicy, obj)
+	}
+	return obj, deleted, err
 }
 
 func (r *REST) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
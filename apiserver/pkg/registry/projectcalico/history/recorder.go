@@ -0,0 +1,242 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+)
+
+const (
+	// DefaultMaxRevisions is the default number of revisions kept for each resource.
+	DefaultMaxRevisions = 25
+
+	// DefaultDeletedRetention is the default time for which the history of a deleted resource
+	// is kept.
+	DefaultDeletedRetention = 30 * 24 * time.Hour
+
+	// pruneInterval is the minimum interval between removals of expired histories.
+	pruneInterval = time.Hour
+
+	storeTimeout  = 5 * time.Second
+	maxPutRetries = 3
+)
+
+// Recorder keeps a bounded revision history of the changes made to resources through the API
+// server, including the user that made each change and a diff of the spec.
+//
+// The histories are persisted in a Store, which bounds the size of each history.  The history
+// of a deleted resource is removed once it has been deleted for longer than the retention
+// period.
+//
+// A nil *Recorder is valid and records nothing.
+type Recorder struct {
+	maxRevisions     int
+	deletedRetention time.Duration
+	store            Store
+
+	// lock serializes updates from this API server.  Updates from other replicas are detected
+	// by the store.
+	lock      sync.Mutex
+	lastPrune time.Time
+}
+
+// NewRecorder returns a Recorder that keeps up to maxRevisions revisions for each resource in
+// the given store.  If store is nil, the histories are held in memory.
+func NewRecorder(maxRevisions int, store Store) *Recorder {
+	if maxRevisions <= 0 {
+		maxRevisions = DefaultMaxRevisions
+	}
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &Recorder{
+		maxRevisions:     maxRevisions,
+		deletedRetention: DefaultDeletedRetention,
+		store:            store,
+	}
+}
+
+// Decorate installs hooks on the store that record creates and updates of resources of the
+// given kind.  Deletes must be recorded by the caller using RecordDelete, since the store
+// doesn't provide a delete hook with access to the request context.
+func (r *Recorder) Decorate(store *genericregistry.Store, kind string) {
+	if r == nil {
+		return
+	}
+	store.BeginCreate = func(ctx context.Context, obj runtime.Object, options *metav1.CreateOptions) (genericregistry.FinishFunc, error) {
+		return func(ctx context.Context, success bool) {
+			if success {
+				r.record(ctx, kind, calico.RevisionOperationCreate, nil, obj)
+			}
+		}, nil
+	}
+	store.BeginUpdate = func(ctx context.Context, obj, old runtime.Object, options *metav1.UpdateOptions) (genericregistry.FinishFunc, error) {
+		return func(ctx context.Context, success bool) {
+			if success {
+				r.record(ctx, kind, calico.RevisionOperationUpdate, old, obj)
+			}
+		}, nil
+	}
+}
+
+// RecordDelete records the deletion of the given resource.
+func (r *Recorder) RecordDelete(ctx context.Context, kind string, obj runtime.Object) {
+	if r == nil {
+		return
+	}
+	r.record(ctx, kind, calico.RevisionOperationDelete, nil, obj)
+}
+
+// History returns the recorded revisions of the given resource, oldest first.
+func (r *Recorder) History(ctx context.Context, kind, namespace, name string) ([]calico.Revision, error) {
+	if r == nil {
+		return nil, nil
+	}
+	revisions, _, err := r.store.Get(ctx, Key{Kind: kind, Namespace: namespace, Name: name})
+	return revisions, err
+}
+
+func (r *Recorder) record(ctx context.Context, kind string, op calico.RevisionOperation, old, obj runtime.Object) {
+	logCtx := logrus.WithFields(logrus.Fields{"kind": kind, "operation": op})
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		logCtx.WithError(err).Warn("Unable to record revision, failed to access object metadata")
+		return
+	}
+	logCtx = logCtx.WithFields(logrus.Fields{"namespace": accessor.GetNamespace(), "name": accessor.GetName()})
+
+	spec, err := specOf(obj)
+	if err != nil {
+		logCtx.WithError(err).Warn("Unable to record revision")
+		return
+	}
+
+	rev := calico.Revision{
+		Operation: op,
+		Timestamp: metav1.Now(),
+		Spec:      runtime.RawExtension{Raw: spec},
+	}
+	if user, ok := genericapirequest.UserFrom(ctx); ok {
+		rev.User = user.GetName()
+	}
+	if old != nil {
+		oldSpec, err := specOf(old)
+		if err != nil {
+			logCtx.WithError(err).Warn("Unable to record revision")
+			return
+		}
+		rev.Diff, err = specDiff(oldSpec, spec)
+		if err != nil {
+			logCtx.WithError(err).Warn("Unable to record revision")
+			return
+		}
+		if rev.Diff == "" {
+			// Only changes to the spec are recorded.
+			return
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// The change has already been made, so the history is written independently of the request.
+	storeCtx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	k := Key{Kind: kind, Namespace: accessor.GetNamespace(), Name: accessor.GetName()}
+	for i := 0; ; i++ {
+		revisions, version, err := r.store.Get(storeCtx, k)
+		if err != nil {
+			logCtx.WithError(err).Warn("Unable to record revision, failed to read history")
+			return
+		}
+		rev.Revision = 1
+		if len(revisions) > 0 {
+			rev.Revision = revisions[len(revisions)-1].Revision + 1
+		}
+		revisions = append(revisions, rev)
+		if len(revisions) > r.maxRevisions {
+			revisions = revisions[len(revisions)-r.maxRevisions:]
+		}
+		err = r.store.Put(storeCtx, k, revisions, version)
+		if err == nil {
+			break
+		} else if !errors.Is(err, ErrConflict) || i >= maxPutRetries {
+			logCtx.WithError(err).Warn("Unable to record revision, failed to write history")
+			return
+		}
+		logCtx.Debug("History modified concurrently, retrying")
+	}
+	logCtx.WithField("revision", rev.Revision).Debug("Recorded revision")
+
+	r.maybePrune(storeCtx)
+}
+
+// maybePrune removes the histories of resources that have been deleted for longer than the
+// retention period, at most once per prune interval.  Must be called with the lock held.
+func (r *Recorder) maybePrune(ctx context.Context) {
+	now := time.Now()
+	if now.Sub(r.lastPrune) < pruneInterval {
+		return
+	}
+	r.lastPrune = now
+	if err := r.store.DeleteExpired(ctx, now.Add(-r.deletedRetention)); err != nil {
+		logrus.WithError(err).Warn("Failed to remove expired histories")
+	}
+}
+
+// specOf returns the JSON encoding of the spec of the given resource.
+func specOf(obj runtime.Object) ([]byte, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	spec, ok := fields["spec"]
+	if !ok {
+		return nil, fmt.Errorf("resource has no spec")
+	}
+	return spec, nil
+}
+
+// specDiff returns a human readable diff between two JSON encoded specs, or "" if they are
+// equivalent.
+func specDiff(oldSpec, newSpec []byte) (string, error) {
+	var o, n interface{}
+	if err := json.Unmarshal(oldSpec, &o); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal(newSpec, &n); err != nil {
+		return "", err
+	}
+	return cmp.Diff(o, n), nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package history_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/history"
+)
+
+func testContext(username string) context.Context {
+	ctx := genericapirequest.WithNamespace(genericapirequest.NewContext(), "default")
+	return genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: username})
+}
+
+func testNetworkSet(nets ...string) *calico.NetworkSet {
+	ns := calico.NewNetworkSet()
+	ns.ObjectMeta = metav1.ObjectMeta{Name: "netset", Namespace: "default"}
+	ns.Spec.Nets = nets
+	return ns
+}
+
+func create(t *testing.T, store *genericregistry.Store, ctx context.Context, obj *calico.NetworkSet) {
+	finish, err := store.BeginCreate(ctx, obj, &metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("BeginCreate failed: %v", err)
+	}
+	finish(ctx, true)
+}
+
+func update(t *testing.T, store *genericregistry.Store, ctx context.Context, obj, old *calico.NetworkSet, success bool) {
+	finish, err := store.BeginUpdate(ctx, obj, old, &metav1.UpdateOptions{})
+	if err != nil {
+		t.Fatalf("BeginUpdate failed: %v", err)
+	}
+	finish(ctx, success)
+}
+
+func getHistory(t *testing.T, r *history.Recorder, kind, namespace, name string) []calico.Revision {
+	revs, err := r.History(context.Background(), kind, namespace, name)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	return revs
+}
+
+func TestRecordsChanges(t *testing.T) {
+	r := history.NewRecorder(history.DefaultMaxRevisions, nil)
+	store := &genericregistry.Store{}
+	r.Decorate(store, calico.KindNetworkSet)
+
+	v1 := testNetworkSet("10.0.0.0/24")
+	v2 := testNetworkSet("10.0.0.0/24", "10.0.1.0/24")
+	create(t, store, testContext("alice"), v1)
+	update(t, store, testContext("bob"), v2, v1, true)
+	// Failed updates, and updates that don't change the spec, are not recorded.
+	update(t, store, testContext("bob"), testNetworkSet("10.0.2.0/24"), v2, false)
+	v2Labelled := v2.DeepCopy()
+	v2Labelled.Labels = map[string]string{"a": "b"}
+	update(t, store, testContext("bob"), v2Labelled, v2, true)
+	r.RecordDelete(testContext("carol"), calico.KindNetworkSet, v2Labelled)
+
+	revs := getHistory(t, r, calico.KindNetworkSet, "default", "netset")
+	if len(revs) != 3 {
+		t.Fatalf("Expected 3 revisions, got %d: %+v", len(revs), revs)
+	}
+	expected := []struct {
+		op   calico.RevisionOperation
+		user string
+	}{
+		{calico.RevisionOperationCreate, "alice"},
+		{calico.RevisionOperationUpdate, "bob"},
+		{calico.RevisionOperationDelete, "carol"},
+	}
+	for i, e := range expected {
+		if revs[i].Revision != int64(i+1) || revs[i].Operation != e.op || revs[i].User != e.user {
+			t.Errorf("Unexpected revision %d: %+v", i, revs[i])
+		}
+	}
+	if string(revs[0].Spec.Raw) != `{"nets":["10.0.0.0/24"]}` {
+		t.Errorf("Unexpected spec for first revision: %s", revs[0].Spec.Raw)
+	}
+	if revs[0].Diff != "" {
+		t.Errorf("Expected no diff for a create, got %q", revs[0].Diff)
+	}
+	if !strings.Contains(revs[1].Diff, "10.0.1.0/24") {
+		t.Errorf("Expected the diff to contain the added net, got %q", revs[1].Diff)
+	}
+
+	if revs := getHistory(t, r, calico.KindNetworkSet, "other", "netset"); len(revs) != 0 {
+		t.Errorf("Expected no history in another namespace, got %+v", revs)
+	}
+	if revs := getHistory(t, r, calico.KindGlobalNetworkSet, "", "netset"); len(revs) != 0 {
+		t.Errorf("Expected no history for another kind, got %+v", revs)
+	}
+}
+
+func TestHistoryIsBounded(t *testing.T) {
+	r := history.NewRecorder(3, nil)
+	store := &genericregistry.Store{}
+	r.Decorate(store, calico.KindNetworkSet)
+
+	ctx := testContext("alice")
+	old := testNetworkSet("10.0.0.0/32")
+	create(t, store, ctx, old)
+	for _, n := range []string{"10.0.0.1/32", "10.0.0.2/32", "10.0.0.3/32", "10.0.0.4/32"} {
+		obj := testNetworkSet(n)
+		update(t, store, ctx, obj, old, true)
+		old = obj
+	}
+
+	revs := getHistory(t, r, calico.KindNetworkSet, "default", "netset")
+	if len(revs) != 3 {
+		t.Fatalf("Expected 3 revisions, got %d", len(revs))
+	}
+	for i, rev := range revs {
+		if rev.Revision != int64(i+3) {
+			t.Errorf("Expected revision %d at index %d, got %d", i+3, i, rev.Revision)
+		}
+	}
+}
+
+func TestNilRecorder(t *testing.T) {
+	var r *history.Recorder
+	store := &genericregistry.Store{}
+	r.Decorate(store, calico.KindNetworkSet)
+	if store.BeginCreate != nil || store.BeginUpdate != nil {
+		t.Error("Expected a nil recorder not to install hooks")
+	}
+	r.RecordDelete(testContext("alice"), calico.KindNetworkSet, testNetworkSet())
+	if revs := getHistory(t, r, calico.KindNetworkSet, "default", "netset"); revs != nil {
+		t.Errorf("Expected no history, got %+v", revs)
+	}
+}
+
+// newFakeClient returns a fake clientset that maintains the resource versions of ConfigMaps,
+// and rejects updates of stale versions, like the API server.
+func newFakeClient() *fake.Clientset {
+	client := fake.NewSimpleClientset()
+	var resourceVersion int
+	client.PrependReactor("create", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		resourceVersion++
+		action.(k8stesting.CreateAction).GetObject().(*corev1.ConfigMap).ResourceVersion = fmt.Sprint(resourceVersion)
+		return false, nil, nil
+	})
+	client.PrependReactor("update", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		cm := action.(k8stesting.UpdateAction).GetObject().(*corev1.ConfigMap)
+		current, err := client.Tracker().Get(corev1.SchemeGroupVersion.WithResource("configmaps"), cm.Namespace, cm.Name)
+		if err != nil {
+			return true, nil, err
+		}
+		if current.(*corev1.ConfigMap).ResourceVersion != cm.ResourceVersion {
+			return true, nil, kerrors.NewConflict(corev1.Resource("configmaps"), cm.Name, fmt.Errorf("stale resource version"))
+		}
+		resourceVersion++
+		cm.ResourceVersion = fmt.Sprint(resourceVersion)
+		return false, nil, nil
+	})
+	return client
+}
+
+func TestConfigMapStore(t *testing.T) {
+	client := newFakeClient()
+	store := history.NewConfigMapStore(client, "calico-apiserver")
+	r := history.NewRecorder(3, store)
+	genericStore := &genericregistry.Store{}
+	r.Decorate(genericStore, calico.KindNetworkSet)
+
+	ctx := testContext("alice")
+	old := testNetworkSet("10.0.0.0/32")
+	create(t, genericStore, ctx, old)
+	for _, n := range []string{"10.0.0.1/32", "10.0.0.2/32", "10.0.0.3/32"} {
+		obj := testNetworkSet(n)
+		update(t, genericStore, ctx, obj, old, true)
+		old = obj
+	}
+
+	// A second recorder sharing the store, as another replica would, sees the same history and
+	// continues the revision numbering.
+	other := history.NewRecorder(3, history.NewConfigMapStore(client, "calico-apiserver"))
+	other.RecordDelete(testContext("bob"), calico.KindNetworkSet, old)
+
+	revs := getHistory(t, r, calico.KindNetworkSet, "default", "netset")
+	if len(revs) != 3 {
+		t.Fatalf("Expected 3 revisions, got %d: %+v", len(revs), revs)
+	}
+	for i, rev := range revs {
+		if rev.Revision != int64(i+3) {
+			t.Errorf("Expected revision %d at index %d, got %d", i+3, i, rev.Revision)
+		}
+	}
+	if last := revs[2]; last.Operation != calico.RevisionOperationDelete || last.User != "bob" {
+		t.Errorf("Unexpected last revision: %+v", last)
+	}
+
+	cms, err := client.CoreV1().ConfigMaps("calico-apiserver").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list ConfigMaps: %v", err)
+	}
+	if len(cms.Items) != 1 {
+		t.Fatalf("Expected one ConfigMap, got %d", len(cms.Items))
+	}
+
+	// Histories of deleted resources are removed once the retention period has passed.
+	if err := store.DeleteExpired(context.Background(), time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("DeleteExpired failed: %v", err)
+	}
+	if revs := getHistory(t, r, calico.KindNetworkSet, "default", "netset"); len(revs) != 3 {
+		t.Fatalf("Expected the history to be retained, got %d revisions", len(revs))
+	}
+	if err := store.DeleteExpired(context.Background(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("DeleteExpired failed: %v", err)
+	}
+	if revs := getHistory(t, r, calico.KindNetworkSet, "default", "netset"); len(revs) != 0 {
+		t.Fatalf("Expected the history to be removed, got %+v", revs)
+	}
+}
+
+func TestStoreConflict(t *testing.T) {
+	for name, store := range map[string]history.Store{
+		"memory":    history.NewMemoryStore(),
+		"configmap": history.NewConfigMapStore(newFakeClient(), "calico-apiserver"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			k := history.Key{Kind: calico.KindNetworkSet, Namespace: "default", Name: "netset"}
+			revs := []calico.Revision{{Revision: 1, Operation: calico.RevisionOperationCreate}}
+			if err := store.Put(ctx, k, revs, ""); err != nil {
+				t.Fatalf("Put failed: %v", err)
+			}
+			if err := store.Put(ctx, k, revs, ""); !errors.Is(err, history.ErrConflict) {
+				t.Fatalf("Expected a conflict creating the history twice, got %v", err)
+			}
+			_, version, err := store.Get(ctx, k)
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			if err := store.Put(ctx, k, revs, version); err != nil {
+				t.Fatalf("Put failed: %v", err)
+			}
+			if err := store.Put(ctx, k, revs, version); !errors.Is(err, history.ErrConflict) {
+				t.Fatalf("Expected a conflict writing a stale version, got %v", err)
+			}
+		})
+	}
+}
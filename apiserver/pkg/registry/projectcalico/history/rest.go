@@ -0,0 +1,88 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"context"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/authorizer"
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/util"
+)
+
+// AuthorizeFunc checks whether the request in the context may read the history of the named
+// resource, in addition to the standard RBAC check on the history subresource.
+type AuthorizeFunc func(ctx context.Context, name string) error
+
+// TierAuthorizeFunc returns an AuthorizeFunc that applies the tiered policy authorization
+// checks to the policy name.
+func TierAuthorizeFunc(a authorizer.TierAuthorizer) AuthorizeFunc {
+	return func(ctx context.Context, name string) error {
+		tierName, _ := util.GetTierFromPolicyName(name)
+		return a.AuthorizeTierOperation(ctx, name, tierName)
+	}
+}
+
+// REST implements the "history" subresource, which returns the revision history of a
+// resource.
+type REST struct {
+	recorder  *Recorder
+	kind      string
+	authorize AuthorizeFunc
+}
+
+var (
+	_ rest.Storage = &REST{}
+	_ rest.Getter  = &REST{}
+)
+
+// NewREST returns the history subresource for resources of the given kind.  authorize may be
+// nil.
+func NewREST(recorder *Recorder, kind string, authorize AuthorizeFunc) *REST {
+	return &REST{recorder: recorder, kind: kind, authorize: authorize}
+}
+
+func (r *REST) New() runtime.Object {
+	return calico.NewRevisionHistory()
+}
+
+func (r *REST) Destroy() {
+}
+
+// Get returns the recorded revisions of the named resource.  A resource that hasn't been
+// changed since history recording was enabled has an empty history.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	if r.authorize != nil {
+		if err := r.authorize(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+	namespace, _ := genericapirequest.NamespaceFrom(ctx)
+	h := calico.NewRevisionHistory()
+	items, err := r.recorder.History(ctx, r.kind, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	h.Items = items
+	if h.Items == nil {
+		h.Items = []calico.Revision{}
+	}
+	return h, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// maxConfigMapDataBytes bounds the size of the revisions stored in a single ConfigMap, well
+	// within the 1MiB limit on the size of an object.
+	maxConfigMapDataBytes = 512 * 1024
+
+	configMapDataKey = "revisions"
+
+	kindLabel           = "projectcalico.org/history-kind"
+	deletedLabel        = "projectcalico.org/history-deleted"
+	namespaceAnnotation = "projectcalico.org/history-namespace"
+	nameAnnotation      = "projectcalico.org/history-name"
+	deletedAnnotation   = "projectcalico.org/history-deleted-at"
+)
+
+// ErrConflict is returned by Store.Put if the history was modified since it was read.
+var ErrConflict = errors.New("history was modified concurrently")
+
+// Key identifies the resource that a history belongs to.
+type Key struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// Store persists the revision histories of resources.
+type Store interface {
+	// Get returns the revisions of the resource, oldest first, along with a version to pass to
+	// Put.  A resource without a history has no revisions and an empty version.
+	Get(ctx context.Context, k Key) ([]calico.Revision, string, error)
+
+	// Put replaces the revisions of the resource.  It returns ErrConflict if the history was
+	// modified since the given version was read.
+	Put(ctx context.Context, k Key, revisions []calico.Revision, version string) error
+
+	// DeleteExpired removes the histories of resources that were deleted before the given time.
+	DeleteExpired(ctx context.Context, before time.Time) error
+}
+
+// NewMemoryStore returns a Store that holds histories in memory.  The histories don't survive
+// a restart and aren't shared between API server replicas.
+func NewMemoryStore() Store {
+	return &memoryStore{histories: map[Key]*memoryHistory{}}
+}
+
+type memoryStore struct {
+	lock      sync.Mutex
+	histories map[Key]*memoryHistory
+}
+
+type memoryHistory struct {
+	version   int
+	revisions []calico.Revision
+}
+
+func (s *memoryStore) Get(ctx context.Context, k Key) ([]calico.Revision, string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	h := s.histories[k]
+	if h == nil {
+		return nil, "", nil
+	}
+	return copyRevisions(h.revisions), fmt.Sprint(h.version), nil
+}
+
+func (s *memoryStore) Put(ctx context.Context, k Key, revisions []calico.Revision, version string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	h := s.histories[k]
+	if h == nil {
+		if version != "" {
+			return ErrConflict
+		}
+		h = &memoryHistory{}
+		s.histories[k] = h
+	} else if version != fmt.Sprint(h.version) {
+		return ErrConflict
+	}
+	h.version++
+	h.revisions = copyRevisions(revisions)
+	return nil
+}
+
+func (s *memoryStore) DeleteExpired(ctx context.Context, before time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for k, h := range s.histories {
+		if deletedAt, ok := deletionTime(h.revisions); ok && deletedAt.Before(before) {
+			delete(s.histories, k)
+		}
+	}
+	return nil
+}
+
+// NewConfigMapStore returns a Store that holds each history in a ConfigMap in the given
+// namespace, so that the histories survive restarts and are shared between API server
+// replicas.  The oldest revisions are dropped if a history grows too large for a ConfigMap.
+func NewConfigMapStore(client kubernetes.Interface, namespace string) Store {
+	return &configMapStore{client: client, namespace: namespace}
+}
+
+type configMapStore struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+// configMapName returns the name of the ConfigMap holding the history of the resource.  The
+// resource's namespace and name are hashed since together they may be too long for a name.
+func configMapName(k Key) string {
+	hash := sha256.Sum256([]byte(k.Namespace + "/" + k.Name))
+	return fmt.Sprintf("history-%s-%x", strings.ToLower(k.Kind), hash[:10])
+}
+
+func (s *configMapStore) Get(ctx context.Context, k Key) ([]calico.Revision, string, error) {
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, configMapName(k), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+	if cm.Annotations[namespaceAnnotation] != k.Namespace || cm.Annotations[nameAnnotation] != k.Name {
+		return nil, "", fmt.Errorf("history ConfigMap %s belongs to a different resource", cm.Name)
+	}
+	var revisions []calico.Revision
+	if data := cm.Data[configMapDataKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &revisions); err != nil {
+			return nil, "", fmt.Errorf("failed to parse history ConfigMap %s: %w", cm.Name, err)
+		}
+	}
+	return revisions, cm.ResourceVersion, nil
+}
+
+func (s *configMapStore) Put(ctx context.Context, k Key, revisions []calico.Revision, version string) error {
+	var data []byte
+	for {
+		var err error
+		data, err = json.Marshal(revisions)
+		if err != nil {
+			return err
+		}
+		if len(data) <= maxConfigMapDataBytes || len(revisions) <= 1 {
+			break
+		}
+		revisions = revisions[1:]
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            configMapName(k),
+			Namespace:       s.namespace,
+			ResourceVersion: version,
+			Labels:          map[string]string{kindLabel: strings.ToLower(k.Kind)},
+			Annotations: map[string]string{
+				namespaceAnnotation: k.Namespace,
+				nameAnnotation:      k.Name,
+			},
+		},
+		Data: map[string]string{configMapDataKey: string(data)},
+	}
+	if deletedAt, ok := deletionTime(revisions); ok {
+		cm.Labels[deletedLabel] = "true"
+		cm.Annotations[deletedAnnotation] = deletedAt.UTC().Format(time.RFC3339)
+	}
+
+	var err error
+	if version == "" {
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+	} else {
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err) {
+		return ErrConflict
+	}
+	return err
+}
+
+func (s *configMapStore) DeleteExpired(ctx context.Context, before time.Time) error {
+	cms, err := s.client.CoreV1().ConfigMaps(s.namespace).List(ctx, metav1.ListOptions{LabelSelector: deletedLabel + "=true"})
+	if err != nil {
+		return err
+	}
+	for _, cm := range cms.Items {
+		deletedAt, err := time.Parse(time.RFC3339, cm.Annotations[deletedAnnotation])
+		if err != nil || !deletedAt.Before(before) {
+			continue
+		}
+		err = s.client.CoreV1().ConfigMaps(s.namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: &cm.ResourceVersion},
+		})
+		if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deletionTime returns the time at which the resource was deleted, if the last revision is a
+// delete.
+func deletionTime(revisions []calico.Revision) (time.Time, bool) {
+	if len(revisions) == 0 || revisions[len(revisions)-1].Operation != calico.RevisionOperationDelete {
+		return time.Time{}, false
+	}
+	return revisions[len(revisions)-1].Timestamp.Time, true
+}
+
+func copyRevisions(revisions []calico.Revision) []calico.Revision {
+	if revisions == nil {
+		return nil
+	}
+	c := make([]calico.Revision, len(revisions))
+	for i := range revisions {
+		revisions[i].DeepCopyInto(&c[i])
+	}
+	return c
+}
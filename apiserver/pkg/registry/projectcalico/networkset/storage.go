@@ -15,13 +15,18 @@
 package networkset
 
 import (
+	"context"
+
 	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
 
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/history"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
 )
 
@@ -29,6 +34,7 @@ import (
 type REST struct {
 	*genericregistry.Store
 	shortNames []string
+	history    *history.Recorder
 }
 
 func (r *REST) ShortNames() []string {
@@ -96,5 +102,15 @@ func NewREST(scheme *runtime.Scheme, opts server.Options) (*REST, error) {
 		DestroyFunc: dFunc,
 	}
 
-	return &REST{store, opts.ShortNames}, nil
+	opts.History.Decorate(store, calico.KindNetworkSet)
+
+	return &REST{store, opts.ShortNames, opts.History}, nil
+}
+
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	obj, deleted, err := r.Store.Delete(ctx, name, deleteValidation, options)
+	if err == nil {
+		r.history.RecordDelete(ctx, calico.KindNetworkSet, obj)
+	}
+	return obj, deleted, err
 }
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 
 	"github.com/projectcalico/calico/apiserver/pkg/rbac"
+	calicoauthorizer "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/authorizer"
 	calicobgpconfiguration "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/bgpconfiguration"
 	calicobgpfilter "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/bgpfilter"
 	calicobgppeer "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/bgppeer"
@@ -33,13 +34,16 @@ import (
 	calicofelixconfig "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/felixconfig"
 	calicognetworkset "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/globalnetworkset"
 	calicogpolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/globalpolicy"
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/history"
 	calicohostendpoint "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/hostendpoint"
 	calicoipamconfig "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ipamconfig"
 	calicoippool "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ippool"
+	calicoippoolmigration "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ippoolmigration"
 	calicoipreservation "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/ipreservation"
 	calicokubecontrollersconfig "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/kubecontrollersconfig"
 	calicopolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/networkpolicy"
 	caliconetworkset "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/networkset"
+	calicopacketcapture "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/packetcapture"
 	calicoprofile "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/profile"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
 	calicostagedgpolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/stagedglobalnetworkpolicy"
@@ -49,12 +53,18 @@ import (
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/util"
 	calicostorage "github.com/projectcalico/calico/apiserver/pkg/storage/calico"
 	"github.com/projectcalico/calico/apiserver/pkg/storage/etcd"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/lint"
 )
 
 // RESTStorageProvider provides a factory method to create a new APIGroupInfo for
 // the calico API group. It implements (./pkg/apiserver).RESTStorageProvider
 type RESTStorageProvider struct {
 	StorageType server.StorageType
+
+	// HistoryStore persists the revision histories of resources.  If nil, the histories are
+	// held in memory.
+	HistoryStore history.Store
 }
 
 // NewV3Storage constructs v3 api storage.
@@ -65,6 +75,19 @@ func (p RESTStorageProvider) NewV3Storage(
 	calicoLister rbac.CalicoResourceLister,
 	watchManager *util.WatchManager,
 ) (map[string]rest.Storage, error) {
+	// Policy lint warnings are computed against a cache of the datastore state, so they're
+	// only available when using the Calico datastore for storage.
+	var policyLinter *lint.Linter
+	if p.StorageType == server.StorageTypeCalico {
+		lintCache := lint.NewCache(calicostorage.CreateClientFromConfig().(backendClient).Backend())
+		lintCache.Start()
+		policyLinter = lint.NewCachedLinter(lintCache)
+	}
+
+	// Changes to policies, tiers and network sets are recorded so that they can be read
+	// through the history subresource.
+	historyRecorder := history.NewRecorder(history.DefaultMaxRevisions, p.HistoryStore)
+
 	policyRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("networkpolicies"), nil)
 	if err != nil {
 		return nil, err
@@ -86,6 +109,7 @@ func (p RESTStorageProvider) NewV3Storage(
 		authorizer,
 		[]string{"cnp", "caliconetworkpolicy", "caliconetworkpolicies"},
 	)
+	policyOpts.PolicyLinter = policyLinter
 
 	stagedk8spolicyRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("stagedkubernetesnetworkpolicies"), nil)
 	if err != nil {
@@ -130,6 +154,7 @@ func (p RESTStorageProvider) NewV3Storage(
 		authorizer,
 		[]string{"snp"},
 	)
+	stagedpolicyOpts.PolicyLinter = policyLinter
 
 	networksetRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("networksets"), nil)
 	if err != nil {
@@ -153,6 +178,28 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{"netsets"},
 	)
 
+	packetCaptureRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("packetcaptures"), nil)
+	if err != nil {
+		return nil, err
+	}
+	packetCaptureOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   packetCaptureRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicopacketcapture.EmptyObject(),
+			ScopeStrategy: calicopacketcapture.NewStrategy(scheme),
+			NewListFunc:   calicopacketcapture.NewList,
+			GetAttrsFunc:  calicopacketcapture.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: packetCaptureRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"pcap"},
+	)
+
 	tierRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("tiers"), nil)
 	if err != nil {
 		return nil, err
@@ -196,6 +243,7 @@ func (p RESTStorageProvider) NewV3Storage(
 		authorizer,
 		[]string{"gnp", "cgnp", "calicoglobalnetworkpolicies"},
 	)
+	gpolicyOpts.PolicyLinter = policyLinter
 
 	stagedgpolicyRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("stagedglobalnetworkpolicies"), nil)
 	if err != nil {
@@ -218,6 +266,7 @@ func (p RESTStorageProvider) NewV3Storage(
 		authorizer,
 		[]string{"sgnp"},
 	)
+	stagedgpolicyOpts.PolicyLinter = policyLinter
 
 	gNetworkSetRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("globalnetworksets"), nil)
 	if err != nil {
@@ -417,6 +466,28 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{"felixconfig", "felixconfigs"},
 	)
 
+	ipPoolMigrationRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("ippoolmigrations"), nil)
+	if err != nil {
+		return nil, err
+	}
+	ipPoolMigrationOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   ipPoolMigrationRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicoippoolmigration.EmptyObject(),
+			ScopeStrategy: calicoippoolmigration.NewStrategy(scheme),
+			NewListFunc:   calicoippoolmigration.NewList,
+			GetAttrsFunc:  calicoippoolmigration.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: ipPoolMigrationRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"ippoolmigration", "ipm"},
+	)
+
 	kubeControllersConfigsRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("kubecontrollersconfigurations"), nil)
 	if err != nil {
 		return nil, err
@@ -527,6 +598,13 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{"blockaffinity", "affinity", "affinities"},
 	)
 
+	for _, opts := range []*server.Options{
+		policyOpts, stagedk8spolicyOpts, stagedpolicyOpts, networksetOpts,
+		tierOpts, gpolicyOpts, stagedgpolicyOpts, gNetworkSetOpts,
+	} {
+		opts.History = historyRecorder
+	}
+
 	storage := map[string]rest.Storage{}
 	storage["tiers"] = rESTInPeace(calicotier.NewREST(scheme, *tierOpts))
 	storage["networkpolicies"] = rESTInPeace(calicopolicy.NewREST(scheme, *policyOpts, calicoLister, watchManager))
@@ -536,6 +614,7 @@ func (p RESTStorageProvider) NewV3Storage(
 	storage["stagedglobalnetworkpolicies"] = rESTInPeace(calicostagedgpolicy.NewREST(scheme, *stagedgpolicyOpts, calicoLister, watchManager))
 	storage["globalnetworksets"] = rESTInPeace(calicognetworkset.NewREST(scheme, *gNetworkSetOpts))
 	storage["networksets"] = rESTInPeace(caliconetworkset.NewREST(scheme, *networksetOpts))
+	storage["packetcaptures"] = rESTInPeace(calicopacketcapture.NewREST(scheme, *packetCaptureOpts))
 	storage["hostendpoints"] = rESTInPeace(calicohostendpoint.NewREST(scheme, *hostEndpointOpts))
 	storage["ippools"] = rESTInPeace(calicoippool.NewREST(scheme, *ipPoolSetOpts))
 	storage["ipreservations"] = rESTInPeace(calicoipreservation.NewREST(scheme, *ipReservationSetOpts))
@@ -556,6 +635,24 @@ func (p RESTStorageProvider) NewV3Storage(
 	}
 	storage["kubecontrollersconfigurations"] = kubeControllersConfigsStorage
 	storage["kubecontrollersconfigurations/status"] = kubeControllersConfigsStatusStorage
+
+	ipPoolMigrationStorage, ipPoolMigrationStatusStorage, err := calicoippoolmigration.NewREST(scheme, *ipPoolMigrationOpts)
+	if err != nil {
+		err = fmt.Errorf("unable to create REST storage for a resource due to %v, will die", err)
+		panic(err)
+	}
+	storage["ippoolmigrations"] = ipPoolMigrationStorage
+	storage["ippoolmigrations/status"] = ipPoolMigrationStatusStorage
+
+	tierAuthorize := history.TierAuthorizeFunc(calicoauthorizer.NewTierAuthorizer(authorizer))
+	storage["tiers/history"] = history.NewREST(historyRecorder, calico.KindTier, nil)
+	storage["networkpolicies/history"] = history.NewREST(historyRecorder, calico.KindNetworkPolicy, tierAuthorize)
+	storage["stagednetworkpolicies/history"] = history.NewREST(historyRecorder, calico.KindStagedNetworkPolicy, tierAuthorize)
+	storage["stagedkubernetesnetworkpolicies/history"] = history.NewREST(historyRecorder, calico.KindStagedKubernetesNetworkPolicy, nil)
+	storage["globalnetworkpolicies/history"] = history.NewREST(historyRecorder, calico.KindGlobalNetworkPolicy, tierAuthorize)
+	storage["stagedglobalnetworkpolicies/history"] = history.NewREST(historyRecorder, calico.KindStagedGlobalNetworkPolicy, tierAuthorize)
+	storage["globalnetworksets/history"] = history.NewREST(historyRecorder, calico.KindGlobalNetworkSet, nil)
+	storage["networksets/history"] = history.NewREST(historyRecorder, calico.KindNetworkSet, nil)
 	return storage, nil
 }
 
@@ -574,3 +671,7 @@ func rESTInPeace(storage rest.Storage, err error) rest.Storage {
 	}
 	return storage
 }
+
+type backendClient interface {
+	Backend() bapi.Client
+}
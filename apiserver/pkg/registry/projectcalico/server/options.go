@@ -31,8 +31,10 @@ import (
 	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/history"
 	"github.com/projectcalico/calico/apiserver/pkg/storage/calico"
 	"github.com/projectcalico/calico/apiserver/pkg/storage/etcd"
+	"github.com/projectcalico/calico/libcalico-go/lib/lint"
 )
 
 type errUnsupportedStorageType struct {
@@ -78,6 +80,14 @@ type Options struct {
 	storageType   StorageType
 	Authorizer    authorizer.Authorizer
 	ShortNames    []string
+
+	// PolicyLinter, if set, is used by the policy strategies to return lint warnings when a
+	// policy is created or updated.
+	PolicyLinter *lint.Linter
+
+	// History, if set, records the revision history of the resources that support the
+	// history subresource.
+	History *history.Recorder
 }
 
 // NewOptions returns a new Options with the given parameters
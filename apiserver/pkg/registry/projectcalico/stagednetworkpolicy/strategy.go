@@ -25,16 +25,20 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/util"
+	"github.com/projectcalico/calico/libcalico-go/lib/lint"
 )
 
 type policyStrategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
+	linter *lint.Linter
 }
 
 // NewStrategy returns a new NamespaceScopedStrategy for instances
 func NewStrategy(typer runtime.ObjectTyper) policyStrategy {
-	return policyStrategy{typer, names.SimpleNameGenerator}
+	return policyStrategy{typer, names.SimpleNameGenerator, nil}
 }
 
 func (policyStrategy) NamespaceScoped() bool {
@@ -57,12 +61,12 @@ func (policyStrategy) AllowUnconditionalUpdate() bool {
 	return false
 }
 
-func (policyStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
-	return []string{}
+func (s policyStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return util.LintWarnings(ctx, s.linter, obj)
 }
 
-func (policyStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
-	return []string{}
+func (s policyStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return util.LintWarnings(ctx, s.linter, obj)
 }
 
 func (policyStrategy) Canonicalize(obj runtime.Object) {
@@ -0,0 +1,41 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package util
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/lint"
+)
+
+// lintTimeout bounds the time spent reading datastore state to lint a policy, so that a slow
+// datastore delays writes by at most this long.
+const lintTimeout = 5 * time.Second
+
+// LintWarnings runs the linter over the given object and returns any warnings, formatted for
+// return to the client.  Lint failures are logged and never block the write.
+func LintWarnings(ctx context.Context, linter *lint.Linter, obj runtime.Object) []string {
+	if linter == nil {
+		return []string{}
+	}
+	ctx, cancel := context.WithTimeout(ctx, lintTimeout)
+	defer cancel()
+
+	warnings, err := linter.Lint(ctx, obj)
+	if err == lint.ErrNotInSync {
+		logrus.Debug("Lint cache is not yet in sync, no lint warnings will be returned")
+		return []string{}
+	} else if err != nil {
+		logrus.WithError(err).Warn("Failed to lint policy, no lint warnings will be returned")
+		return []string{}
+	}
+	result := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		result = append(result, w.String())
+	}
+	return result
+}
@@ -20,6 +20,7 @@ import (
 
 const (
 	policyDelim = "."
+	tierLabel   = "projectcalico.org/tier"
 )
 
 // EnsureTierSelector parses the given options and ensures the correct tier selector is set.
@@ -47,11 +48,24 @@ func EnsureTierSelector(ctx context.Context, options *metainternalversion.ListOp
 			options.LabelSelector = labels.NewSelector()
 		}
 		options.LabelSelector = options.LabelSelector.Add(*tierSelector)
-	} else {
+	} else if len(tiers) == 1 {
 		err = authorizeTiers(ctx, tiers, authorizer)
 		if err != nil {
 			return err
 		}
+	} else {
+		// Multiple tiers were given - restrict the selector to the tiers the user has permissions
+		// to see, so that the user sees the policies in those tiers rather than being denied access
+		// to all of them.
+		allowedTiers, err := filterAuthorizedTiers(ctx, tiers, authorizer)
+		if err != nil {
+			return err
+		}
+		if len(allowedTiers) < len(tiers) {
+			if err := replaceTierSelector(options, allowedTiers); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -75,7 +89,7 @@ func getTiersFromSelector(options *metainternalversion.ListOptions) ([]string, e
 	if options.LabelSelector != nil {
 		requirements, _ := options.LabelSelector.Requirements()
 		for _, requirement := range requirements {
-			if requirement.Key() == "projectcalico.org/tier" {
+			if requirement.Key() == tierLabel {
 				if requirement.Operator() == selection.In {
 					return requirement.Values().List(), nil
 				}
@@ -134,8 +148,44 @@ func authorizeTiers(ctx context.Context, tiers []string, authorizer authorizer.T
 	return nil
 }
 
+// filterAuthorizedTiers returns the supplied Tiers that the user has access to.  An error is returned if the user
+// has access to none of them.
+func filterAuthorizedTiers(ctx context.Context, tiers []string, authorizer authorizer.TierAuthorizer) ([]string, error) {
+	var allowedTiers []string
+	var firstErr error
+	for _, tier := range tiers {
+		err := authorizer.AuthorizeTierOperation(ctx, "", tier)
+		if err == nil {
+			allowedTiers = append(allowedTiers, tier)
+		} else if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if len(allowedTiers) == 0 {
+		return nil, firstErr
+	}
+	return allowedTiers, nil
+}
+
+// replaceTierSelector replaces the tier requirement in the label selector with one matching the supplied Tiers.
+func replaceTierSelector(options *metainternalversion.ListOptions, tiers []string) error {
+	tierSelector, err := buildSelectorFromTiers(tiers)
+	if err != nil {
+		return err
+	}
+	selector := labels.NewSelector()
+	requirements, _ := options.LabelSelector.Requirements()
+	for _, requirement := range requirements {
+		if requirement.Key() != tierLabel {
+			selector = selector.Add(requirement)
+		}
+	}
+	options.LabelSelector = selector.Add(*tierSelector)
+	return nil
+}
+
 func buildSelectorFromTiers(tiers []string) (*labels.Requirement, error) {
-	requirement, err := labels.NewRequirement("projectcalico.org/tier", selection.In, tiers)
+	requirement, err := labels.NewRequirement(tierLabel, selection.In, tiers)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +75,10 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapiNetworkSet := &v3.NetworkSet{}
 		NetworkSetConverter{}.convertToAAPI(obj, aapiNetworkSet)
 		return aapiNetworkSet
+	case *v3.PacketCapture:
+		aapiPacketCapture := &v3.PacketCapture{}
+		PacketCaptureConverter{}.convertToAAPI(obj, aapiPacketCapture)
+		return aapiPacketCapture
 	case *v3.HostEndpoint:
 		aapi := &v3.HostEndpoint{}
 		HostEndpointConverter{}.convertToAAPI(obj, aapi)
@@ -107,6 +111,10 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapi := &v3.FelixConfiguration{}
 		FelixConfigurationConverter{}.convertToAAPI(obj, aapi)
 		return aapi
+	case *v3.IPPoolMigration:
+		aapi := &v3.IPPoolMigration{}
+		IPPoolMigrationConverter{}.convertToAAPI(obj, aapi)
+		return aapi
 	case *v3.KubeControllersConfiguration:
 		aapi := &v3.KubeControllersConfiguration{}
 		KubeControllersConfigurationConverter{}.convertToAAPI(obj, aapi)
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"context"
+	"reflect"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewIPPoolMigrationStorage creates a new libcalico-based storage.Interface implementation for IPPoolMigrations
+func NewIPPoolMigrationStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.IPPoolMigration)
+		return c.IPPoolMigrations().Create(ctx, res, oso)
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.IPPoolMigration)
+		return c.IPPoolMigrations().Update(ctx, res, oso)
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.IPPoolMigrations().Get(ctx, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		odo := opts.(options.DeleteOptions)
+		return c.IPPoolMigrations().Delete(ctx, name, odo)
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.IPPoolMigrations().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.IPPoolMigrations().Watch(ctx, olo)
+	}
+	// TODO(doublek): Inject codec, client for nicer testing.
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.IPPoolMigration{}),
+		aapiListType:      reflect.TypeOf(aapi.IPPoolMigrationList{}),
+		libCalicoType:     reflect.TypeOf(api.IPPoolMigration{}),
+		libCalicoListType: reflect.TypeOf(api.IPPoolMigrationList{}),
+		isNamespaced:      false,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "IPPoolMigration",
+		converter:         IPPoolMigrationConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type IPPoolMigrationConverter struct {
+}
+
+func (gc IPPoolMigrationConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	aapiIPPoolMigration := aapiObj.(*aapi.IPPoolMigration)
+	lcgIPPoolMigration := &api.IPPoolMigration{}
+	lcgIPPoolMigration.TypeMeta = aapiIPPoolMigration.TypeMeta
+	lcgIPPoolMigration.ObjectMeta = aapiIPPoolMigration.ObjectMeta
+	lcgIPPoolMigration.Kind = api.KindIPPoolMigration
+	lcgIPPoolMigration.APIVersion = api.GroupVersionCurrent
+	lcgIPPoolMigration.Spec = aapiIPPoolMigration.Spec
+	lcgIPPoolMigration.Status = aapiIPPoolMigration.Status
+	return lcgIPPoolMigration
+}
+
+func (gc IPPoolMigrationConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgIPPoolMigration := libcalicoObject.(*api.IPPoolMigration)
+	aapiIPPoolMigration := aapiObj.(*aapi.IPPoolMigration)
+	aapiIPPoolMigration.Spec = lcgIPPoolMigration.Spec
+	aapiIPPoolMigration.Status = lcgIPPoolMigration.Status
+	aapiIPPoolMigration.TypeMeta = lcgIPPoolMigration.TypeMeta
+	aapiIPPoolMigration.ObjectMeta = lcgIPPoolMigration.ObjectMeta
+}
+
+func (gc IPPoolMigrationConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	lcgIPPoolMigrationList := libcalicoListObject.(*api.IPPoolMigrationList)
+	aapiIPPoolMigrationList := aapiListObj.(*aapi.IPPoolMigrationList)
+	if libcalicoListObject == nil {
+		aapiIPPoolMigrationList.Items = []aapi.IPPoolMigration{}
+		return
+	}
+	aapiIPPoolMigrationList.TypeMeta = lcgIPPoolMigrationList.TypeMeta
+	aapiIPPoolMigrationList.ListMeta = lcgIPPoolMigrationList.ListMeta
+	for _, item := range lcgIPPoolMigrationList.Items {
+		aapiIPPoolMigration := aapi.IPPoolMigration{}
+		gc.convertToAAPI(&item, &aapiIPPoolMigration)
+		if matched, err := pred.Matches(&aapiIPPoolMigration); err == nil && matched {
+			aapiIPPoolMigrationList.Items = append(aapiIPPoolMigrationList.Items, aapiIPPoolMigration)
+		}
+	}
+}
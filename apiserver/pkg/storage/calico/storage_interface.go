@@ -41,6 +41,8 @@ func NewStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc)
 		return NewGlobalNetworkSetStorage(opts)
 	case "projectcalico.org/networksets":
 		return NewNetworkSetStorage(opts)
+	case "projectcalico.org/packetcaptures":
+		return NewPacketCaptureStorage(opts)
 	case "projectcalico.org/hostendpoints":
 		return NewHostEndpointStorage(opts)
 	case "projectcalico.org/ippools":
@@ -57,6 +59,8 @@ func NewStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc)
 		return NewProfileStorage(opts)
 	case "projectcalico.org/felixconfigurations":
 		return NewFelixConfigurationStorage(opts)
+	case "projectcalico.org/ippoolmigrations":
+		return NewIPPoolMigrationStorage(opts)
 	case "projectcalico.org/kubecontrollersconfigurations":
 		return NewKubeControllersConfigurationStorage(opts)
 	case "projectcalico.org/clusterinformations":
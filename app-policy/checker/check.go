@@ -65,7 +65,7 @@ const (
 
 // Evaluate evaluates the flow against the policy store and returns the trace of rules.
 func Evaluate(dir rules.RuleDir, store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, flow Flow) []*calc.RuleID {
-	_, trace := checkTiers(store, ep, dir, flow)
+	_, trace, _ := checkTiers(store, ep, dir, flow)
 	return trace
 }
 
@@ -103,14 +103,14 @@ func ipToEndpointKeys(store *policystore.PolicyStore, addr ip.Addr) []proto.Work
 // check passes or PERMISSION_DENIED if the check fails.
 func checkStore(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, dir flxrules.RuleDir, req Flow) (s status.Status) {
 	// Check using the configured policy
-	s, _ = checkTiers(store, ep, dir, req)
+	s, _, _ = checkTiers(store, ep, dir, req)
 	return
 }
 
 // checkTiers applies the tiered policy in the given store and returns OK if the check passes, or PERMISSION_DENIED if
 // the check fails. Note, if no policy matches, the default is PERMISSION_DENIED. It returns the trace of rules that
-// were evaluated.
-func checkTiers(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, dir flxrules.RuleDir, flow Flow) (s status.Status, trace []*calc.RuleID) {
+// were evaluated, and the rule that decided the verdict, which is nil if the verdict was a default action.
+func checkTiers(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, dir flxrules.RuleDir, flow Flow) (s status.Status, trace []*calc.RuleID, decider *proto.Rule) {
 	s = status.Status{Code: PERMISSION_DENIED}
 	if ep == nil {
 		return
@@ -149,10 +149,12 @@ func checkTiers(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, dir
 			case ALLOW:
 				s.Code = OK
 				trace = append(trace, calc.NewRuleID(tier.GetName(), policyName, policy.GetNamespace(), ruleIndex, dir, flxrules.RuleActionAllow))
+				decider = ruleAt(policy.GetInboundRules(), policy.GetOutboundRules(), dir, ruleIndex)
 				return
 			case DENY:
 				s.Code = PERMISSION_DENIED
 				trace = append(trace, calc.NewRuleID(tier.GetName(), policyName, policy.GetNamespace(), ruleIndex, dir, flxrules.RuleActionDeny))
+				decider = ruleAt(policy.GetInboundRules(), policy.GetOutboundRules(), dir, ruleIndex)
 				return
 			case PASS:
 				trace = append(trace, calc.NewRuleID(tier.GetName(), policyName, policy.GetNamespace(), ruleIndex, dir, flxrules.RuleActionPass))
@@ -190,10 +192,12 @@ func checkTiers(store *policystore.PolicyStore, ep *proto.WorkloadEndpoint, dir
 			case ALLOW:
 				s.Code = OK
 				trace = append(trace, calc.NewRuleID(profileStr, name, "", ruleIndex, dir, flxrules.RuleActionAllow))
+				decider = ruleAt(profile.GetInboundRules(), profile.GetOutboundRules(), dir, ruleIndex)
 				return
 			case DENY, PASS:
 				s.Code = PERMISSION_DENIED
 				trace = append(trace, calc.NewRuleID(profileStr, name, "", ruleIndex, dir, flxrules.RuleActionDeny))
+				decider = ruleAt(profile.GetInboundRules(), profile.GetOutboundRules(), dir, ruleIndex)
 				return
 			case LOG:
 				log.Debug("profile should never return LOG action")
@@ -250,6 +254,19 @@ func checkRules(rules []*proto.Rule, req *requestCache, policyNamespace string)
 	return NO_MATCH, tierDefaultActionIndex
 }
 
+// ruleAt returns the rule at the given index of the rules for the given direction, or nil if
+// there is no such rule.
+func ruleAt(inbound, outbound []*proto.Rule, dir flxrules.RuleDir, index int) *proto.Rule {
+	rules := inbound
+	if dir == flxrules.RuleDirEgress {
+		rules = outbound
+	}
+	if index < 0 || index >= len(rules) {
+		return nil
+	}
+	return rules[index]
+}
+
 // actionFromString converts a string to an Action. It panics if the string is not a valid action.
 // The string is case-insensitive.
 func actionFromString(s string) Action {
@@ -334,7 +351,9 @@ func getPolicyName(s string) string {
 	// If not one of the special prefixes, strip off the tier part
 	isSpecialPrefix := strings.HasPrefix(s, names.K8sNetworkPolicyNamePrefix) ||
 		strings.HasPrefix(s, names.K8sAdminNetworkPolicyNamePrefix) ||
-		strings.HasPrefix(s, names.K8sBaselineAdminNetworkPolicyNamePrefix)
+		strings.HasPrefix(s, names.K8sBaselineAdminNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(s, names.K8sAdminClusterNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(s, names.K8sBaselineClusterNetworkPolicyNamePrefix)
 
 	if !isSpecialPrefix {
 		if idx := strings.IndexByte(s, '.'); idx >= 0 && idx < len(s)-1 {
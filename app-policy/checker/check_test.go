@@ -419,7 +419,7 @@ func TestCheckNoIngressPolicyRulesInTier(t *testing.T) {
 		},
 	}}
 	flow := NewCheckRequestToFlowAdapter(req)
-	status, _ := checkTiers(store, store.Endpoint, rules.RuleDirIngress, flow)
+	status, _, _ := checkTiers(store, store.Endpoint, rules.RuleDirIngress, flow)
 	expectedStatus := rpc.Status{Code: OK}
 	Expect(status.Code).To(Equal(expectedStatus.Code))
 	Expect(status.Message).To(Equal(expectedStatus.Message))
@@ -1020,6 +1020,8 @@ type MockFlow struct {
 	Protocol        int
 	HttpMethod      *string
 	HttpPath        *string
+	HttpHost        *string
+	HttpHeaders     map[string]string
 	SourcePrincipal *string
 	DestPrincipal   *string
 	SourceLabels    map[string]string
@@ -1054,6 +1056,14 @@ func (m *MockFlow) GetHttpPath() *string {
 	return m.HttpPath
 }
 
+func (m *MockFlow) GetHttpHost() *string {
+	return m.HttpHost
+}
+
+func (m *MockFlow) GetHttpHeaders() map[string]string {
+	return m.HttpHeaders
+}
+
 func (m *MockFlow) GetSourcePrincipal() *string {
 	return m.SourcePrincipal
 }
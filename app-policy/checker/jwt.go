@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"k8s.io/utils/lru"
+)
+
+// jsonWebKey is a public key from a JSON Web Key Set.
+type jsonWebKey struct {
+	kid string
+	key crypto.PublicKey
+}
+
+// jwksCacheSize bounds the number of parsed key sets that are kept.  Policies normally share a
+// handful of key sets, so this only limits the memory used when key sets change often.
+const jwksCacheSize = 64
+
+// defaultJWTAlgorithms are the algorithms that tokens may be signed with if the rule doesn't
+// list any.
+var defaultJWTAlgorithms = []string{"RS256"}
+
+// jwksCache holds the parsed key sets, keyed by the JWKS document, so that each key set is only
+// parsed once rather than on every request.
+var jwksCache = lru.New(jwksCacheSize)
+
+// getJWKS returns the keys in the given JWKS document.
+func getJWKS(doc string) ([]jsonWebKey, error) {
+	if keys, ok := jwksCache.Get(doc); ok {
+		return keys.([]jsonWebKey), nil
+	}
+	keys, err := parseJWKS(doc)
+	if err != nil {
+		return nil, err
+	}
+	jwksCache.Add(doc, keys)
+	return keys, nil
+}
+
+// parseJWKS parses the RSA and EC public keys in a JSON Web Key Set (RFC 7517).  Keys of other
+// types are ignored.
+func parseJWKS(doc string) ([]jsonWebKey, error) {
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(doc), &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+
+	var keys []jsonWebKey
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			n, err := decodeBigInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RSA key %q: %w", k.Kid, err)
+			}
+			e, err := decodeBigInt(k.E)
+			if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+				return nil, fmt.Errorf("invalid RSA key %q: bad exponent", k.Kid)
+			}
+			keys = append(keys, jsonWebKey{kid: k.Kid, key: &rsa.PublicKey{N: n, E: int(e.Int64())}})
+		case "EC":
+			var curve elliptic.Curve
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				return nil, fmt.Errorf("invalid EC key %q: unsupported curve %q", k.Kid, k.Crv)
+			}
+			x, err := decodeBigInt(k.X)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EC key %q: %w", k.Kid, err)
+			}
+			y, err := decodeBigInt(k.Y)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EC key %q: %w", k.Kid, err)
+			}
+			keys = append(keys, jsonWebKey{kid: k.Kid, key: &ecdsa.PublicKey{Curve: curve, X: x, Y: y}})
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("invalid JWKS: no usable keys")
+	}
+	return keys, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// verifyJWT checks the signature of a compact serialized JSON Web Token (RFC 7519) against the
+// given keys, and that the token is valid at the given time.  Only tokens signed with one of the
+// given algorithms are accepted.  It returns the token's claims.
+func verifyJWT(token string, keys []jsonWebKey, algorithms []string, now time.Time) (map[string]interface{}, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(algorithms),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	)
+	claims := jwt.MapClaims{}
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		// Try each key that the token's key ID allows; keys without an ID may verify any token.
+		kid, _ := t.Header["kid"].(string)
+		var set jwt.VerificationKeySet
+		for _, k := range keys {
+			if kid == "" || k.kid == "" || kid == k.kid {
+				set.Keys = append(set.Keys, k.key)
+			}
+		}
+		if len(set.Keys) == 0 {
+			return nil, fmt.Errorf("no key with ID %q", kid)
+		}
+		return set, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
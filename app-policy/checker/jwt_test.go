@@ -0,0 +1,231 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func makeJWKS(rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	size := (ecKey.Curve.Params().BitSize + 7) / 8
+	doc, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"use": "sig",
+				"n":   b64(rsaKey.N.Bytes()),
+				"e":   b64(big.NewInt(int64(rsaKey.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   b64(ecKey.X.FillBytes(make([]byte, size))),
+				"y":   b64(ecKey.Y.FillBytes(make([]byte, size))),
+			},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return string(doc)
+}
+
+func signJWT(alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	body, _ := json.Marshal(claims)
+	signed := b64(header) + "." + b64(body)
+	if key == nil {
+		return signed + "."
+	}
+
+	digest := sha256.Sum256([]byte(signed))
+	var sig []byte
+	var err error
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		if err == nil {
+			sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+	return signed + "." + b64(sig)
+}
+
+func TestVerifyJWT(t *testing.T) {
+	RegisterTestingT(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	keys, err := parseJWKS(makeJWKS(rsaKey, ecKey))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(keys).To(HaveLen(2))
+
+	now := time.Now()
+	valid := map[string]interface{}{"sub": "alice", "exp": now.Add(time.Hour).Unix()}
+
+	all := []string{"RS256", "ES256", "ES384"}
+	testCases := []struct {
+		title      string
+		token      string
+		algorithms []string
+		valid      bool
+	}{
+		{"RS256", signJWT("RS256", "rsa", rsaKey, valid), all, true},
+		{"ES256", signJWT("ES256", "ec", ecKey, valid), all, true},
+		{"no kid", signJWT("RS256", "", rsaKey, valid), all, true},
+		{"wrong kid", signJWT("RS256", "ec", rsaKey, valid), all, false},
+		{"unknown kid", signJWT("RS256", "other", rsaKey, valid), all, false},
+		{"wrong key", signJWT("RS256", "rsa", otherKey, valid), all, false},
+		{"wrong alg", signJWT("ES384", "ec", ecKey, valid), all, false},
+		{"alg not allowed", signJWT("ES256", "ec", ecKey, valid), []string{"RS256"}, false},
+		{"alg none", signJWT("none", "rsa", nil, valid), append(all, "none"), false},
+		{"expired", signJWT("RS256", "rsa", rsaKey, map[string]interface{}{"exp": now.Add(-time.Minute).Unix()}), all, false},
+		{"not valid yet", signJWT("RS256", "rsa", rsaKey, map[string]interface{}{"nbf": now.Add(time.Minute).Unix()}), all, false},
+		{"malformed", "abc.def", all, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			claims, err := verifyJWT(tc.token, keys, tc.algorithms, now)
+			if tc.valid {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claims["sub"]).To(Equal("alice"))
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestParseJWKSInvalid(t *testing.T) {
+	for _, doc := range []string{
+		"",
+		`{"keys": []}`,
+		`{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`,
+		`{"keys": [{"kty": "RSA", "use": "enc", "n": "AQAB", "e": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "crv": "P-192", "x": "AQAB", "y": "AQAB"}]}`,
+	} {
+		t.Run(doc, func(t *testing.T) {
+			RegisterTestingT(t)
+			_, err := parseJWKS(doc)
+			Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestMatchJWT(t *testing.T) {
+	RegisterTestingT(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	jwks := makeJWKS(rsaKey, ecKey)
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss":    "https://issuer.example.com",
+		"aud":    []string{"orders", "payments"},
+		"exp":    now.Add(time.Hour).Unix(),
+		"groups": []string{"dev", "admin"},
+		"org":    map[string]interface{}{"tier": "gold", "id": 42},
+	}
+	token := signJWT("RS256", "rsa", rsaKey, claims)
+	headers := map[string]string{"authorization": "Bearer " + token}
+	ecHeaders := map[string]string{"authorization": "Bearer " + signJWT("ES256", "ec", ecKey, claims)}
+
+	testCases := []struct {
+		title   string
+		jwt     *proto.HTTPMatch_JWTMatch
+		headers map[string]string
+		result  bool
+	}{
+		{"no JWT match", nil, headers, true},
+		{"nil headers", &proto.HTTPMatch_JWTMatch{Jwks: jwks}, nil, false},
+		{"verified token", &proto.HTTPMatch_JWTMatch{Jwks: jwks}, headers, true},
+		{"ES256 not allowed by default", &proto.HTTPMatch_JWTMatch{Jwks: jwks}, ecHeaders, false},
+		{"ES256 allowed", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Algorithms: []string{"ES256"}}, ecHeaders, true},
+		{"RS256 not allowed", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Algorithms: []string{"ES256"}}, headers, false},
+		{"missing bearer token", &proto.HTTPMatch_JWTMatch{Jwks: jwks}, map[string]string{}, false},
+		{"basic auth", &proto.HTTPMatch_JWTMatch{Jwks: jwks}, map[string]string{"authorization": "Basic " + token}, false},
+		{"issuer", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Issuer: "https://issuer.example.com"}, headers, true},
+		{"issuer fail", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Issuer: "https://other.example.com"}, headers, false},
+		{"audience", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Audiences: []string{"payments"}}, headers, true},
+		{"audience fail", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Audiences: []string{"shipping"}}, headers, false},
+		{"list claim", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Claims: []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "groups", Values: []string{"admin"}}}}, headers, true},
+		{"nested claim", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Claims: []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "org.tier", Values: []string{"gold", "silver"}}}}, headers, true},
+		{"numeric claim", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Claims: []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "org.id", Values: []string{"42"}}}}, headers, true},
+		{"claim present", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Claims: []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "groups"}}}, headers, true},
+		{"missing claim", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Claims: []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "org.region"}}}, headers, false},
+		{"claim fail", &proto.HTTPMatch_JWTMatch{Jwks: jwks, Claims: []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "groups", Values: []string{"ops"}}}}, headers, false},
+		{"invalid JWKS", &proto.HTTPMatch_JWTMatch{Jwks: "{}"}, headers, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchJWT(tc.jwt, tc.headers, now)).To(Equal(tc.result))
+		})
+	}
+}
+
+func TestJWKSCacheIsBounded(t *testing.T) {
+	RegisterTestingT(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	doc := makeJWKS(rsaKey, ecKey)
+	for i := 0; i < 2*jwksCacheSize; i++ {
+		_, err := getJWKS(doc[:len(doc)-1] + fmt.Sprintf(`,"n":%d}`, i))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(jwksCache.Len()).To(Equal(jwksCacheSize))
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/app-policy/policystore"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/proto"
+	flxrules "github.com/projectcalico/calico/felix/rules"
+)
+
+// StatsReporter sends statistics about checked requests to Felix, which records them in flow logs.
+// Report must not block.
+type StatsReporter interface {
+	Report(*proto.DataplaneStats)
+}
+
+// l7DataplaneStats returns the statistics to report for a request if it is a gRPC call whose
+// verdict was decided by an application layer rule, so that the flow logs for the connection record
+// the gRPC service and method. It returns nil for any other request.
+func l7DataplaneStats(store *policystore.PolicyStore, req *authz.CheckRequest) *proto.DataplaneStats {
+	flow := NewCheckRequestToFlowAdapter(req)
+	path := flow.GetHttpPath()
+	if path == nil || !isGRPCContentType(flow.GetHttpHeaders()["content-type"]) {
+		return nil
+	}
+	service, method, ok := grpcCallFromPath(*path)
+	if !ok {
+		return nil
+	}
+	srcIP, dstIP := flow.GetSourceIP(), flow.GetDestIP()
+	if srcIP == nil || dstIP == nil {
+		return nil
+	}
+
+	// In per-pod mode the store holds the single endpoint that we are checking requests for; in
+	// per-host mode we look up the destination of the request.
+	endpoints := []*proto.WorkloadEndpoint{store.Endpoint}
+	if store.Endpoint == nil {
+		endpoints = store.IPToIndexes.Get(ip.FromNetIP(dstIP))
+	}
+	for _, ep := range endpoints {
+		s, _, decider := checkTiers(store, ep, flxrules.RuleDirIngress, flow)
+		if decider == nil || (decider.GetHttpMatch() == nil && decider.GetGrpcMatch() == nil) {
+			continue
+		}
+		action := proto.Action_ALLOWED
+		if s.Code != OK {
+			action = proto.Action_DENIED
+		}
+		log.WithFields(log.Fields{
+			"service": service,
+			"method":  method,
+			"action":  action,
+		}).Debug("gRPC call decided by application layer rule")
+		return &proto.DataplaneStats{
+			SrcIp:    srcIP.String(),
+			DstIp:    dstIP.String(),
+			SrcPort:  int32(flow.GetSourcePort()),
+			DstPort:  int32(flow.GetDestPort()),
+			Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Number{Number: int32(flow.GetProtocol())}},
+			Action:   action,
+			GrpcCall: &proto.GRPCCall{Service: service, Method: method},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authz "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/app-policy/policystore"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/types"
+)
+
+func grpcCheckRequest(path, contentType string) *authz.CheckRequest {
+	addr := func(ip string, port uint32) *core.Address {
+		return &core.Address{Address: &core.Address_SocketAddress{SocketAddress: &core.SocketAddress{
+			Address:       ip,
+			PortSpecifier: &core.SocketAddress_PortValue{PortValue: port},
+			Protocol:      core.SocketAddress_TCP,
+		}}}
+	}
+	return &authz.CheckRequest{Attributes: &authz.AttributeContext{
+		Source:      &authz.AttributeContext_Peer{Address: addr("10.0.0.1", 34567)},
+		Destination: &authz.AttributeContext_Peer{Address: addr("10.0.0.2", 50051)},
+		Request: &authz.AttributeContext_Request{Http: &authz.AttributeContext_HttpRequest{
+			Method:  "POST",
+			Path:    path,
+			Headers: map[string]string{"content-type": contentType},
+		}},
+	}}
+}
+
+// gRPC calls whose verdict is decided by an application layer rule are reported with the
+// service and method that was called.
+func TestL7DataplaneStats(t *testing.T) {
+	RegisterTestingT(t)
+
+	store := policystore.NewPolicyStore()
+	store.Endpoint = &proto.WorkloadEndpoint{
+		Tiers: []*proto.TierInfo{{
+			Name:            "tier1",
+			IngressPolicies: []string{"policy1"},
+			DefaultAction:   "Deny",
+		}},
+	}
+	policy := &proto.Policy{
+		InboundRules: []*proto.Rule{
+			{
+				Action:    "allow",
+				GrpcMatch: &proto.GRPCMatch{Services: []string{"helloworld.Greeter"}, Methods: []string{"SayHello"}},
+			},
+			{
+				Action:   "allow",
+				DstPorts: []*proto.PortRange{{First: 8080, Last: 8080}},
+			},
+		},
+	}
+	store.PolicyByID[types.ProtoToPolicyID(&proto.PolicyID{Tier: "tier1", Name: "policy1"})] = policy
+
+	stats := l7DataplaneStats(store, grpcCheckRequest("/helloworld.Greeter/SayHello", "application/grpc"))
+	Expect(stats).NotTo(BeNil())
+	Expect(stats.SrcIp).To(Equal("10.0.0.1"))
+	Expect(stats.DstIp).To(Equal("10.0.0.2"))
+	Expect(stats.SrcPort).To(Equal(int32(34567)))
+	Expect(stats.DstPort).To(Equal(int32(50051)))
+	Expect(stats.Protocol.GetNumber()).To(Equal(int32(6)))
+	Expect(stats.Action).To(Equal(proto.Action_ALLOWED))
+	Expect(stats.GrpcCall.GetService()).To(Equal("helloworld.Greeter"))
+	Expect(stats.GrpcCall.GetMethod()).To(Equal("SayHello"))
+
+	// A call that no rule allows is denied by the tier's default action, so no application layer
+	// rule decided it.
+	stats = l7DataplaneStats(store, grpcCheckRequest("/helloworld.Greeter/SayGoodbye", "application/grpc"))
+	Expect(stats).To(BeNil())
+
+	// Requests that aren't gRPC calls aren't reported.
+	stats = l7DataplaneStats(store, grpcCheckRequest("/helloworld.Greeter/SayHello", "application/json"))
+	Expect(stats).To(BeNil())
+
+	// Nor are calls that are decided by a layer 4 rule.
+	policy.InboundRules[0].GrpcMatch.Services = []string{"other.Service"}
+	policy.InboundRules[1].DstPorts[0] = &proto.PortRange{First: 50051, Last: 50051}
+	stats = l7DataplaneStats(store, grpcCheckRequest("/helloworld.Greeter/SayHello", "application/grpc"))
+	Expect(stats).To(BeNil())
+}
@@ -17,9 +17,13 @@ package checker
 import (
 	"fmt"
 	"net"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/utils/lru"
 
 	"github.com/projectcalico/calico/app-policy/policystore"
 	"github.com/projectcalico/calico/felix/proto"
@@ -60,6 +64,8 @@ type L4Flow interface {
 type L7Flow interface {
 	GetHttpMethod() *string
 	GetHttpPath() *string
+	GetHttpHost() *string
+	GetHttpHeaders() map[string]string
 	GetSourcePrincipal() *string
 	GetDestPrincipal() *string
 	GetSourceLabels() map[string]string
@@ -158,7 +164,14 @@ func computeNamespaceMatch(
 // Rule matches, false otherwise.
 func matchRequest(rule *proto.Rule, req *requestCache) bool {
 	log.WithField("request", req).Debug("Matching request.")
-	return matchHTTP(rule.GetHttpMatch(), req.GetHttpMethod(), req.GetHttpPath())
+	httpMatch := rule.GetHttpMatch()
+	// The query parameters are matched first, as matching the paths strips the query from the path.
+	return matchHTTPQueryParams(httpMatch.GetQueryParams(), req.GetHttpPath()) &&
+		matchGRPC(rule.GetGrpcMatch(), req.GetHttpPath(), req.GetHttpHeaders()) &&
+		matchHTTP(httpMatch, req.GetHttpMethod(), req.GetHttpPath()) &&
+		matchHTTPHosts(httpMatch.GetHosts(), req.GetHttpHost()) &&
+		matchHTTPHeaders(httpMatch.GetHeaders(), req.GetHttpHeaders()) &&
+		matchJWT(httpMatch.GetJwt(), req.GetHttpHeaders(), time.Now())
 }
 
 // matchServiceAccounts checks if the service account part of the Rule matches the request. It
@@ -339,12 +352,292 @@ func matchHTTPPaths(paths []*proto.HTTPMatch_PathMatch, reqPath *string) bool {
 				log.Debugf("HTTP Path prefix %s matched.", pathMatch.GetPrefix())
 				return true
 			}
+		case *proto.HTTPMatch_PathMatch_Regex:
+			if matchRegex(pathMatch.GetRegex(), *reqPath) {
+				log.Debugf("HTTP Path regex %s matched.", pathMatch.GetRegex())
+				return true
+			}
 		}
 	}
 	log.Debug("HTTP Path not matched.")
 	return false
 }
 
+// matchHTTPHosts checks if the request's host matches one of the hosts. It returns true if the hosts
+// match, false otherwise.
+func matchHTTPHosts(hosts []string, reqHost *string) bool {
+	if len(hosts) == 0 {
+		log.Debug("Rule has 0 HTTP Hosts, matched.")
+		return true
+	}
+	if reqHost == nil {
+		log.Debug("Request has nil HTTP Host.")
+		return true
+	}
+
+	host := strings.ToLower(*reqHost)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if strings.HasPrefix(h, "*.") {
+			if strings.HasSuffix(host, h[1:]) {
+				log.Debugf("HTTP Host wildcard %s matched.", h)
+				return true
+			}
+		} else if host == h {
+			log.Debug("HTTP Host matched.")
+			return true
+		}
+	}
+	log.Debug("HTTP Host not matched.")
+	return false
+}
+
+// matchHTTPHeaders checks if the request's headers match all of the header matches. It returns true
+// if the headers match, false otherwise.
+func matchHTTPHeaders(matches []*proto.HTTPMatch_KeyValueMatch, reqHeaders map[string]string) bool {
+	if len(matches) == 0 {
+		log.Debug("Rule has 0 HTTP Headers, matched.")
+		return true
+	}
+	if reqHeaders == nil {
+		log.Debug("Request has nil HTTP Headers.")
+		return true
+	}
+
+	for _, m := range matches {
+		// Header names are case-insensitive; Envoy passes them in lower case.
+		value, ok := reqHeaders[strings.ToLower(m.GetName())]
+		var values []string
+		if ok {
+			values = []string{value}
+		}
+		if !matchKeyValue(m, values) {
+			log.Debugf("HTTP Header %s not matched.", m.GetName())
+			return false
+		}
+	}
+	log.Debug("HTTP Headers matched.")
+	return true
+}
+
+// matchHTTPQueryParams checks if the query parameters in the request's path match all of the query
+// parameter matches. It returns true if the parameters match, false otherwise.
+func matchHTTPQueryParams(matches []*proto.HTTPMatch_KeyValueMatch, reqPath *string) bool {
+	if len(matches) == 0 {
+		log.Debug("Rule has 0 HTTP Query Params, matched.")
+		return true
+	}
+	if reqPath == nil {
+		log.Debug("Request has nil HTTP Path.")
+		return true
+	}
+
+	var query url.Values
+	if _, rawQuery, found := strings.Cut(*reqPath, "?"); found {
+		rawQuery, _, _ = strings.Cut(rawQuery, "#")
+		// ParseQuery returns the parameters it could parse along with the first error; the
+		// malformed parameters simply do not match.
+		query, _ = url.ParseQuery(rawQuery)
+	}
+	for _, m := range matches {
+		if !matchKeyValue(m, query[m.GetName()]) {
+			log.Debugf("HTTP Query Param %s not matched.", m.GetName())
+			return false
+		}
+	}
+	log.Debug("HTTP Query Params matched.")
+	return true
+}
+
+// matchKeyValue checks a header or query parameter match against the values that the request has for
+// it; values is nil if the request does not have the header or parameter at all.
+func matchKeyValue(m *proto.HTTPMatch_KeyValueMatch, values []string) bool {
+	if present, ok := m.GetValueMatch().(*proto.HTTPMatch_KeyValueMatch_Present); ok {
+		return present.Present == (values != nil)
+	}
+	for _, v := range values {
+		switch vm := m.GetValueMatch().(type) {
+		case *proto.HTTPMatch_KeyValueMatch_Exact:
+			if v == vm.Exact {
+				return true
+			}
+		case *proto.HTTPMatch_KeyValueMatch_Prefix:
+			if strings.HasPrefix(v, vm.Prefix) {
+				return true
+			}
+		case *proto.HTTPMatch_KeyValueMatch_Regex:
+			if matchRegex(vm.Regex, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// regexCacheSize bounds the number of compiled regular expressions that are kept.
+const regexCacheSize = 1024
+
+// regexCache holds compiled regular expressions, keyed by the expression, so that each expression
+// in the policy is only compiled once.
+var regexCache = lru.New(regexCacheSize)
+
+// matchRegex checks if the regular expression matches the whole of the value.  An expression that
+// does not compile never matches.
+func matchRegex(expr, value string) bool {
+	re, ok := regexCache.Get(expr)
+	if !ok {
+		compiled, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			log.WithError(err).Warnf("Invalid regex %q in policy", expr)
+			return false
+		}
+		regexCache.Add(expr, compiled)
+		re = compiled
+	}
+	return re.(*regexp.Regexp).MatchString(value)
+}
+
+// matchJWT checks if the request carries a bearer token that is verified by the rule's key set and
+// has matching claims. It returns true if the token matches, false otherwise.
+func matchJWT(jwt *proto.HTTPMatch_JWTMatch, reqHeaders map[string]string, now time.Time) bool {
+	if jwt == nil {
+		log.Debug("Rule has no JWT match, matched.")
+		return true
+	}
+	if reqHeaders == nil {
+		// Without the headers there is no token to verify.
+		log.Debug("Request has nil HTTP Headers, JWT not matched.")
+		return false
+	}
+
+	scheme, token, found := strings.Cut(reqHeaders["authorization"], " ")
+	if !found || !strings.EqualFold(scheme, "bearer") {
+		log.Debug("Request has no bearer token, JWT not matched.")
+		return false
+	}
+	keys, err := getJWKS(jwt.GetJwks())
+	if err != nil {
+		log.WithError(err).Warn("Invalid JWKS in policy, JWT not matched.")
+		return false
+	}
+	algorithms := jwt.GetAlgorithms()
+	if len(algorithms) == 0 {
+		algorithms = defaultJWTAlgorithms
+	}
+	claims, err := verifyJWT(strings.TrimSpace(token), keys, algorithms, now)
+	if err != nil {
+		log.WithError(err).Debug("JWT not verified.")
+		return false
+	}
+
+	if iss := jwt.GetIssuer(); iss != "" && claims["iss"] != iss {
+		log.Debug("JWT issuer not matched.")
+		return false
+	}
+	if auds := jwt.GetAudiences(); len(auds) > 0 && !matchClaimValues(claims["aud"], auds) {
+		log.Debug("JWT audience not matched.")
+		return false
+	}
+	for _, c := range jwt.GetClaims() {
+		value, ok := lookupClaim(claims, c.GetName())
+		if !ok || (len(c.GetValues()) > 0 && !matchClaimValues(value, c.GetValues())) {
+			log.Debugf("JWT claim %s not matched.", c.GetName())
+			return false
+		}
+	}
+	log.Debug("JWT matched.")
+	return true
+}
+
+// lookupClaim finds a claim by its "." separated path through nested claims.
+func lookupClaim(claims map[string]interface{}, name string) (interface{}, bool) {
+	var value interface{} = claims
+	for _, part := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// matchClaimValues checks if a claim, or any member of a list claim, is one of the values.
+func matchClaimValues(claim interface{}, values []string) bool {
+	var members []interface{}
+	if l, ok := claim.([]interface{}); ok {
+		members = l
+	} else {
+		members = []interface{}{claim}
+	}
+	for _, m := range members {
+		if m == nil {
+			continue
+		}
+		if matchName(values, fmt.Sprint(m)) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGRPC checks if the gRPC match of a rule matches the request. It returns true if the
+// request is a call to one of the rule's services and methods, false otherwise.
+func matchGRPC(rule *proto.GRPCMatch, reqPath *string, reqHeaders map[string]string) bool {
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.WithFields(log.Fields{
+			"rule":    rule,
+			"reqPath": reqPath,
+		}).Debug("Matching gRPC.")
+	}
+	if rule == nil {
+		log.Debug("nil GRPCMatch. Return true")
+		return true
+	}
+	if reqPath == nil {
+		log.Debug("Request has nil HTTP Path.")
+		return true
+	}
+	if reqHeaders != nil && !isGRPCContentType(reqHeaders["content-type"]) {
+		log.Debug("Request is not a gRPC call.")
+		return false
+	}
+	service, method, ok := grpcCallFromPath(*reqPath)
+	if !ok {
+		log.Debug("Request path does not name a gRPC method.")
+		return false
+	}
+	return matchName(rule.GetServices(), service) && matchName(rule.GetMethods(), method)
+}
+
+// isGRPCContentType checks if a content type is one of the gRPC content types, e.g.
+// application/grpc or application/grpc+proto.
+func isGRPCContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return contentType == "application/grpc" ||
+		strings.HasPrefix(contentType, "application/grpc+") ||
+		strings.HasPrefix(contentType, "application/grpc;")
+}
+
+// grpcCallFromPath returns the service and method named by the path of a gRPC call, which has the
+// form /<fully-qualified service>/<method>.  Service and method names are protobuf identifiers, so
+// paths with a query, fragment or comma are not gRPC calls.
+func grpcCallFromPath(path string) (service, method string, ok bool) {
+	if !strings.HasPrefix(path, "/") || strings.ContainsAny(path, ",?#") {
+		return "", "", false
+	}
+	service, method, found := strings.Cut(path[1:], "/")
+	if !found || service == "" || method == "" || strings.Contains(method, "/") {
+		return "", "", false
+	}
+	return service, method, true
+}
+
 // matchSrcIPSets checks if the source IP is within the IP sets and not in the not IP sets. It
 // returns true if the IP sets match, false otherwise.
 func matchSrcIPSets(r *proto.Rule, req *requestCache) bool {
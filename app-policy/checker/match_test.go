@@ -132,6 +132,9 @@ func TestMatchHTTPPaths(t *testing.T) {
 		{"exact path with fragment", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: "/foo"}}}, "/foo#xyz", true},
 		{"prefix path with query fail", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: "/foobar"}}}, "/foo?bar", false},
 		{"prefix path with fragment fail", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: "/foobar"}}}, "/foo#bar", false},
+		{"regex", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/users/[0-9]+"}}}, "/users/42?x=1", true},
+		{"regex must match whole path", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/users/[0-9]+"}}}, "/users/42/posts", false},
+		{"invalid regex fail", []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/users/[0-9"}}}, "/users/4", false},
 	}
 
 	for _, tc := range testCases {
@@ -142,6 +145,137 @@ func TestMatchHTTPPaths(t *testing.T) {
 	}
 }
 
+func TestMatchHTTPHosts(t *testing.T) {
+	testCases := []struct {
+		title   string
+		hosts   []string
+		reqHost string
+		result  bool
+	}{
+		{"empty", nil, "api.example.com", true},
+		{"exact", []string{"api.example.com"}, "api.example.com", true},
+		{"exact ignores case and port", []string{"api.example.com"}, "API.example.com:8080", true},
+		{"exact fail", []string{"api.example.com"}, "www.example.com", false},
+		{"wildcard", []string{"*.example.com"}, "a.b.example.com", true},
+		{"wildcard does not match the domain itself", []string{"*.example.com"}, "example.com", false},
+		{"multiple", []string{"www.example.com", "api.example.com"}, "api.example.com", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHosts(tc.hosts, &tc.reqHost)).To(Equal(tc.result))
+		})
+	}
+}
+
+func TestMatchHTTPHeaders(t *testing.T) {
+	exact := func(name, v string) *proto.HTTPMatch_KeyValueMatch {
+		return &proto.HTTPMatch_KeyValueMatch{Name: name, ValueMatch: &proto.HTTPMatch_KeyValueMatch_Exact{Exact: v}}
+	}
+	prefix := func(name, v string) *proto.HTTPMatch_KeyValueMatch {
+		return &proto.HTTPMatch_KeyValueMatch{Name: name, ValueMatch: &proto.HTTPMatch_KeyValueMatch_Prefix{Prefix: v}}
+	}
+	regex := func(name, v string) *proto.HTTPMatch_KeyValueMatch {
+		return &proto.HTTPMatch_KeyValueMatch{Name: name, ValueMatch: &proto.HTTPMatch_KeyValueMatch_Regex{Regex: v}}
+	}
+	present := func(name string, v bool) *proto.HTTPMatch_KeyValueMatch {
+		return &proto.HTTPMatch_KeyValueMatch{Name: name, ValueMatch: &proto.HTTPMatch_KeyValueMatch_Present{Present: v}}
+	}
+	headers := map[string]string{"x-user": "admin-bob", "x-version": "2"}
+
+	testCases := []struct {
+		title   string
+		matches []*proto.HTTPMatch_KeyValueMatch
+		result  bool
+	}{
+		{"empty", nil, true},
+		{"exact", []*proto.HTTPMatch_KeyValueMatch{exact("x-version", "2")}, true},
+		{"exact fail", []*proto.HTTPMatch_KeyValueMatch{exact("x-version", "3")}, false},
+		{"name is case-insensitive", []*proto.HTTPMatch_KeyValueMatch{exact("X-Version", "2")}, true},
+		{"prefix", []*proto.HTTPMatch_KeyValueMatch{prefix("x-user", "admin-")}, true},
+		{"regex", []*proto.HTTPMatch_KeyValueMatch{regex("x-user", "admin-[a-z]+")}, true},
+		{"regex must match whole value", []*proto.HTTPMatch_KeyValueMatch{regex("x-user", "admin")}, false},
+		{"present", []*proto.HTTPMatch_KeyValueMatch{present("x-user", true)}, true},
+		{"absent", []*proto.HTTPMatch_KeyValueMatch{present("x-debug", false)}, true},
+		{"absent fail", []*proto.HTTPMatch_KeyValueMatch{present("x-user", false)}, false},
+		{"missing header fail", []*proto.HTTPMatch_KeyValueMatch{prefix("x-debug", "")}, false},
+		{"all must match", []*proto.HTTPMatch_KeyValueMatch{exact("x-version", "2"), exact("x-user", "alice")}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPHeaders(tc.matches, headers)).To(Equal(tc.result))
+		})
+	}
+}
+
+func TestMatchHTTPQueryParams(t *testing.T) {
+	testCases := []struct {
+		title   string
+		matches []*proto.HTTPMatch_KeyValueMatch
+		reqPath string
+		result  bool
+	}{
+		{"empty", nil, "/foo?a=1", true},
+		{"exact", []*proto.HTTPMatch_KeyValueMatch{{Name: "a", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Exact{Exact: "1"}}}, "/foo?a=1&b=2", true},
+		{"exact with repeated parameter", []*proto.HTTPMatch_KeyValueMatch{{Name: "a", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Exact{Exact: "2"}}}, "/foo?a=1&a=2", true},
+		{"exact fail", []*proto.HTTPMatch_KeyValueMatch{{Name: "a", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Exact{Exact: "2"}}}, "/foo?a=1", false},
+		{"ignores fragment", []*proto.HTTPMatch_KeyValueMatch{{Name: "a", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Exact{Exact: "1"}}}, "/foo?a=1#frag", true},
+		{"present without value", []*proto.HTTPMatch_KeyValueMatch{{Name: "debug", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Present{Present: true}}}, "/foo?debug", true},
+		{"absent without query", []*proto.HTTPMatch_KeyValueMatch{{Name: "debug", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Present{Present: false}}}, "/foo", true},
+		{"present fail", []*proto.HTTPMatch_KeyValueMatch{{Name: "debug", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Present{Present: true}}}, "/foo?a=1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchHTTPQueryParams(tc.matches, &tc.reqPath)).To(Equal(tc.result))
+		})
+	}
+}
+
+func TestMatchGRPC(t *testing.T) {
+	grpcHeaders := map[string]string{"content-type": "application/grpc+proto"}
+	greeter := &proto.GRPCMatch{Services: []string{"helloworld.Greeter"}}
+	sayHello := &proto.GRPCMatch{Services: []string{"helloworld.Greeter"}, Methods: []string{"SayHello"}}
+
+	testCases := []struct {
+		title   string
+		rule    *proto.GRPCMatch
+		reqPath string
+		headers map[string]string
+		result  bool
+	}{
+		{"no match", nil, "/helloworld.Greeter/SayHello", grpcHeaders, true},
+		{"empty match", &proto.GRPCMatch{}, "/helloworld.Greeter/SayHello", grpcHeaders, true},
+		{"service", greeter, "/helloworld.Greeter/SayGoodbye", grpcHeaders, true},
+		{"service fail", greeter, "/helloworld.Farewell/SayGoodbye", grpcHeaders, false},
+		{"service and method", sayHello, "/helloworld.Greeter/SayHello", grpcHeaders, true},
+		{"method fail", sayHello, "/helloworld.Greeter/SayGoodbye", grpcHeaders, false},
+		{"method only", &proto.GRPCMatch{Methods: []string{"Check"}}, "/grpc.health.v1.Health/Check", grpcHeaders, true},
+		{"not a gRPC call", greeter, "/helloworld.Greeter/SayHello", map[string]string{"content-type": "application/json"}, false},
+		{"missing content type", greeter, "/helloworld.Greeter/SayHello", map[string]string{}, false},
+		{"no method in path", greeter, "/helloworld.Greeter", grpcHeaders, false},
+		{"nested path", greeter, "/helloworld.Greeter/SayHello/extra", grpcHeaders, false},
+		{"query in path", greeter, "/helloworld.Greeter/SayHello?x=1", grpcHeaders, false},
+		{"nil headers", sayHello, "/helloworld.Greeter/SayHello", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchGRPC(tc.rule, &tc.reqPath, tc.headers)).To(Equal(tc.result))
+		})
+	}
+
+	t.Run("nil path", func(t *testing.T) {
+		RegisterTestingT(t)
+		Expect(matchGRPC(sayHello, nil, nil)).To(BeTrue())
+	})
+}
+
 // An omitted HTTP Match clause always matches.
 func TestMatchHTTPNil(t *testing.T) {
 	RegisterTestingT(t)
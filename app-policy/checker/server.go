@@ -38,6 +38,7 @@ type authServer struct {
 	Store            policystore.PolicyStoreManager
 	checkProviders   []CheckProvider
 	subscriptionType string
+	statsReporter    StatsReporter
 }
 
 type AuthServerOption func(*authServer)
@@ -61,6 +62,14 @@ func WithSubscriptionType(s string) AuthServerOption {
 	}
 }
 
+// WithStatsReporter configures the server to report the gRPC calls decided by application layer
+// rules, so that they are recorded in flow logs.
+func WithStatsReporter(r StatsReporter) AuthServerOption {
+	return func(as *authServer) {
+		as.statsReporter = r
+	}
+}
+
 func WithRegisteredCheckProvider(c CheckProvider) AuthServerOption {
 	log.Info("registering check provider: ", c.Name())
 	return func(as *authServer) {
@@ -150,6 +159,12 @@ func (as *authServer) Check(ctx context.Context, req *authz.CheckRequest) (*auth
 		if unknownChecks == len(as.checkProviders) {
 			resp.Status.Code = UNKNOWN
 		}
+
+		if as.statsReporter != nil {
+			if stats := l7DataplaneStats(ps, req); stats != nil {
+				as.statsReporter.Report(stats)
+			}
+		}
 	})
 
 	if logCtx.Logger.IsLevelEnabled(log.DebugLevel) {
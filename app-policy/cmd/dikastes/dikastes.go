@@ -104,19 +104,20 @@ func runServer(arguments map[string]interface{}) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Check server
-	gs := grpc.NewServer()
+	// Synchronize the policy store
 	storeManager := policystore.NewPolicyStoreManager()
-	checkServer := checker.NewServer(ctx, storeManager)
+	opts := uds.GetDialOptions()
+	syncClient := syncher.NewClient(dial, storeManager, opts)
+
+	// Check server, which reports the gRPC calls decided by application layer rules to Felix over
+	// the policy sync connection.
+	gs := grpc.NewServer()
+	checkServer := checker.NewServer(ctx, storeManager, checker.WithStatsReporter(syncClient))
 	authz.RegisterAuthorizationServer(gs, checkServer)
 	checkServerV2 := checkServer.V2Compat()
 	authz_v2alpha.RegisterAuthorizationServer(gs, checkServerV2)
 	authz_v2.RegisterAuthorizationServer(gs, checkServerV2)
 
-	// Synchronize the policy store
-	opts := uds.GetDialOptions()
-	syncClient := syncher.NewClient(dial, storeManager, opts)
-
 	// Register the health check service, which reports the syncClient's inSync status.
 	proto.RegisterHealthzServer(gs, health.NewHealthCheckService(syncClient))
 
@@ -31,6 +31,9 @@ const (
 	DefaultSubscriptionType   = "per-pod-policies"
 	DefaultStatsFlushInterval = 5 * time.Second
 	PolicySyncRetryTime       = 1000 * time.Millisecond
+
+	// statsQueueLen is the number of reports that may be queued before further reports are dropped.
+	statsQueueLen = 1000
 )
 
 type SyncClient struct {
@@ -39,6 +42,8 @@ type SyncClient struct {
 	subscriptionType string
 	inSync           bool
 	storeManager     policystore.PolicyStoreManager
+	stats            chan *proto.DataplaneStats
+	statsInterval    time.Duration
 }
 
 type ClientOptions func(*SyncClient)
@@ -68,6 +73,8 @@ func NewClient(
 		storeManager:     policyStoreManager,
 		dialOpts:         dialOpts,
 		subscriptionType: DefaultSubscriptionType,
+		stats:            make(chan *proto.DataplaneStats, statsQueueLen),
+		statsInterval:    DefaultStatsFlushInterval,
 	}
 	for _, opt := range clientOpts {
 		opt(syncClient)
@@ -122,6 +129,12 @@ func (s *SyncClient) syncStore(cxt context.Context, inSync chan<- struct{}, done
 	log.Info("Successfully connected to Policy Sync server")
 	defer conn.Close()
 	client := proto.NewPolicySyncClient(conn)
+
+	// Send stats over the same connection, until it is broken.
+	statsCxt, cancelStats := context.WithCancel(cxt)
+	defer cancelStats()
+	go s.sendStats(statsCxt, client)
+
 	stream, err := client.Sync(cxt, &proto.SyncRequest{})
 	if err != nil {
 		log.Warnf("failed to synchronize with Policy Sync server: %v", err)
@@ -148,6 +161,62 @@ func (s *SyncClient) syncStore(cxt context.Context, inSync chan<- struct{}, done
 	}
 }
 
+// Report queues stats to be sent to the Policy Sync server.  It never blocks; if the queue is full
+// the stats are dropped.
+func (s *SyncClient) Report(d *proto.DataplaneStats) {
+	select {
+	case s.stats <- d:
+	default:
+		log.Debug("Stats queue is full, dropping stats")
+	}
+}
+
+// statsKey identifies identical stats, which are only sent once per flush interval.
+type statsKey struct {
+	srcIP, dstIP     string
+	srcPort, dstPort int32
+	protocol         int32
+	action           proto.Action
+	service, method  string
+}
+
+func keyForStats(d *proto.DataplaneStats) statsKey {
+	return statsKey{
+		srcIP:    d.GetSrcIp(),
+		dstIP:    d.GetDstIp(),
+		srcPort:  d.GetSrcPort(),
+		dstPort:  d.GetDstPort(),
+		protocol: d.GetProtocol().GetNumber(),
+		action:   d.GetAction(),
+		service:  d.GetGrpcCall().GetService(),
+		method:   d.GetGrpcCall().GetMethod(),
+	}
+}
+
+// sendStats sends the queued stats to the Policy Sync server every flush interval, until the
+// context is cancelled.
+func (s *SyncClient) sendStats(cxt context.Context, client proto.PolicySyncClient) {
+	ticker := time.NewTicker(s.statsInterval)
+	defer ticker.Stop()
+	pending := map[statsKey]*proto.DataplaneStats{}
+	for {
+		select {
+		case <-cxt.Done():
+			return
+		case d := <-s.stats:
+			pending[keyForStats(d)] = d
+		case <-ticker.C:
+			for k, d := range pending {
+				if _, err := client.Report(cxt, d); err != nil {
+					log.WithError(err).Warn("Failed to report stats to Policy Sync server")
+					break
+				}
+				delete(pending, k)
+			}
+		}
+	}
+}
+
 // Readiness returns whether the SyncClient is InSync.
 func (s *SyncClient) Readiness() bool {
 	return s.inSync
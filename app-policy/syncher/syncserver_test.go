@@ -134,10 +134,56 @@ func TestSyncServerCancelBeforeInSync(t *testing.T) {
 	Eventually(syncDone).Should(BeClosed())
 }
 
+func TestSyncReportsStats(t *testing.T) {
+	RegisterTestingT(t)
+
+	sCtx, sCancel := context.WithCancel(context.Background())
+	defer sCancel()
+
+	server := newTestSyncServer(sCtx)
+
+	storeManager := policystore.NewPolicyStoreManager()
+	uut := NewClient(server.GetTarget(), storeManager, uds.GetDialOptions())
+	uut.statsInterval = 100 * time.Millisecond
+
+	cCtx, cCancel := context.WithCancel(context.Background())
+	defer cCancel()
+	go uut.Sync(cCtx)
+
+	server.SendInSync()
+	Eventually(uut.Readiness, "2s", "200ms").Should(BeTrue())
+
+	stats := func(method string) *proto.DataplaneStats {
+		return &proto.DataplaneStats{
+			SrcIp:    "10.0.0.1",
+			DstIp:    "10.0.0.2",
+			SrcPort:  40000,
+			DstPort:  8080,
+			Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Number{Number: 6}},
+			GrpcCall: &proto.GRPCCall{Service: "helloworld.Greeter", Method: method},
+		}
+	}
+
+	// Identical stats are only reported once per flush interval.
+	uut.Report(stats("SayHello"))
+	uut.Report(stats("SayHello"))
+	uut.Report(stats("SayGoodbye"))
+
+	var methods []string
+	for i := 0; i < 2; i++ {
+		var d *proto.DataplaneStats
+		Eventually(server.stats, "2s").Should(Receive(&d))
+		methods = append(methods, d.GetGrpcCall().GetMethod())
+	}
+	Expect(methods).To(ConsistOf("SayHello", "SayGoodbye"))
+	Consistently(server.stats, "300ms").ShouldNot(Receive())
+}
+
 type testSyncServer struct {
 	proto.UnimplementedPolicySyncServer
 	context    context.Context
 	updates    chan *proto.ToDataplane
+	stats      chan *proto.DataplaneStats
 	path       string
 	gRPCServer *grpc.Server
 	listener   net.Listener
@@ -148,7 +194,7 @@ type testSyncServer struct {
 func newTestSyncServer(ctx context.Context) *testSyncServer {
 	socketDir := makeTmpListenerDir()
 	socketPath := path.Join(socketDir, ListenerSocket)
-	ss := &testSyncServer{context: ctx, updates: make(chan *proto.ToDataplane), path: socketPath, gRPCServer: grpc.NewServer()}
+	ss := &testSyncServer{context: ctx, updates: make(chan *proto.ToDataplane), stats: make(chan *proto.DataplaneStats, 10), path: socketPath, gRPCServer: grpc.NewServer()}
 	proto.RegisterPolicySyncServer(ss.gRPCServer, ss)
 	ss.listen()
 	return ss
@@ -173,8 +219,9 @@ func (s *testSyncServer) Sync(_ *proto.SyncRequest, stream proto.PolicySync_Sync
 	}
 }
 
-func (s *testSyncServer) Report(_ context.Context, _ *proto.DataplaneStats) (*proto.ReportResult, error) {
-	panic("not implemented")
+func (s *testSyncServer) Report(_ context.Context, d *proto.DataplaneStats) (*proto.ReportResult, error) {
+	s.stats <- d
+	return &proto.ReportResult{Successful: true}, nil
 }
 
 func (s *testSyncServer) SendInSync() {
@@ -42,11 +42,18 @@ func main() {
                  name.
     label        Add or update labels of resources.
     convert      Convert config files between different API versions.
+    validate     Validate resources by file, directory or stdin and check them
+                 against the current cluster state.
+    history      Display the revision history of a policy, tier or network set.
+    rollback     Restore a policy, tier or network set to a previous revision.
+    auth         Inspect tiered policy authorization.
     ipam         IP address management.
     node         Calico node management.
     version      Display the version of this binary.
     datastore    Calico datastore management.
     cluster      Access cluster information.
+    captured-files
+                 Access the files written by PacketCaptures.
 
 Options:
   -h --help                    Show this screen.
@@ -124,6 +131,14 @@ Description:
 			err = commands.Label(args)
 		case "convert":
 			err = commands.Convert(args)
+		case "validate":
+			err = commands.Validate(args)
+		case "auth":
+			err = commands.Auth(args)
+		case "history":
+			err = commands.History(args)
+		case "rollback":
+			err = commands.Rollback(args)
 		case "version":
 			err = commands.Version(args)
 		case "node":
@@ -132,6 +147,8 @@ Description:
 			err = commands.IPAM(args)
 		case "cluster":
 			err = commands.Cluster(args)
+		case "captured-files":
+			err = commands.CapturedFiles(args)
 		case "datastore":
 			err = commands.Datastore(args)
 		default:
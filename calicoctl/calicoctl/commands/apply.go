@@ -53,7 +53,7 @@ Options:
                                [default: ` + constants.DefaultConfigPath + `]
   -n --namespace=<NS>          Namespace of the resource.
                                Only applicable to NetworkPolicy, StagedNetworkPolicy,
-                               StagedKubernetesNetworkPolicy, NetworkSet, and WorkloadEndpoint.
+                               StagedKubernetesNetworkPolicy, NetworkSet, PacketCapture, and WorkloadEndpoint.
                                Uses the default namespace if not specified.
      --context=<context>       The name of the kubeconfig context to use.
      --allow-version-mismatch  Allow client and cluster versions mismatch.
@@ -0,0 +1,70 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/auth"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+)
+
+// Auth includes the subcommands for inspecting authorization.
+func Auth(args []string) error {
+	doc := `Usage:
+  <BINARY_NAME> auth <command> [<args>...]
+
+    can-i            Report the tiered policy permissions of a user.
+
+Options:
+  -h --help      Show this screen.
+
+Description:
+  Commands for inspecting authorization of the Calico API.
+
+  See '<BINARY_NAME> auth <command> --help' to read about a specific subcommand.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parser := &docopt.Parser{
+		HelpHandler:   docopt.PrintHelpAndExit,
+		OptionsFirst:  true,
+		SkipHelpFlags: false,
+	}
+	arguments, err := parser.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if arguments["<command>"] == nil {
+		return nil
+	}
+
+	command := arguments["<command>"].(string)
+	args = append([]string{"auth", command}, arguments["<args>"].([]string)...)
+
+	switch command {
+	case "can-i":
+		return auth.CanI(args)
+	default:
+		fmt.Println(doc)
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docopt/docopt-go"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/go-yaml-wrapper"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/argutils"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// tieredPolicyResources are the policy resource types whose access is controlled per tier.
+var tieredPolicyResources = []struct {
+	resource   string
+	namespaced bool
+}{
+	{"networkpolicies", true},
+	{"stagednetworkpolicies", true},
+	{"globalnetworkpolicies", false},
+	{"stagedglobalnetworkpolicies", false},
+}
+
+var policyVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// TierPermissions is the set of permissions a user has for the policies in a tier.
+type TierPermissions struct {
+	Tier      string                `json:"tier"`
+	CanGet    bool                  `json:"canGet"`
+	Resources []ResourcePermissions `json:"resources"`
+}
+
+// ResourcePermissions is the set of verbs a user is authorized to use for a policy resource type within a tier.
+type ResourcePermissions struct {
+	Resource  string   `json:"resource"`
+	Namespace string   `json:"namespace,omitempty"`
+	Verbs     []string `json:"verbs"`
+}
+
+// CanI reports the tiered policy permissions of the current user, or of the given subject.
+func CanI(args []string) error {
+	doc := `Usage:
+  <BINARY_NAME> auth can-i [--as=<USER>] [--as-group=<GROUP>...] [--tier=<TIER>] [--namespace=<NS>]
+                  [--output=<OUTPUT>] [--config=<CONFIG>] [--allow-version-mismatch]
+
+Examples:
+  # Report the policies the current user can access in each tier.
+  <BINARY_NAME> auth can-i
+
+  # Report the permissions of user "jane" for the "security" tier in namespace "prod".
+  <BINARY_NAME> auth can-i --as=jane --tier=security -n prod
+
+Options:
+  -h --help                    Show this screen.
+     --as=<USER>               The user to report permissions for.  Defaults to the
+                               current user.
+     --as-group=<GROUP>        A group of the user given by --as.  May be repeated.
+     --tier=<TIER>             Only report permissions for this tier.
+  -n --namespace=<NS>          Namespace used to check access to NetworkPolicies and
+                               StagedNetworkPolicies.  Uses the default namespace if not
+                               specified.
+  -o --output=<OUTPUT FORMAT>  Output format. One of: text, yaml or json.
+                               [Default: text]
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The can-i command reports which operations a user is authorized to perform
+  on the Calico policies in each tier.
+
+  Access to the policies in a tier requires both "get" access to the tier and
+  access to the tier-scoped resource type (for example, tier.networkpolicies)
+  with a resource name of "<tier>.*".  This is the same check made by the
+  Calico API server, which also uses it to restrict the results of list and
+  watch requests to the tiers the user has access to.
+
+  Permissions are calculated using Kubernetes SubjectAccessReviews, so this
+  command requires the Kubernetes datastore.  Checking the permissions of
+  another user requires permission to create SubjectAccessReviews.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	output := parsedArgs["--output"].(string)
+	switch output {
+	case "text", "yaml", "yml", "json":
+	default:
+		return fmt.Errorf("unrecognized output format '%s'", output)
+	}
+
+	err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+	if err != nil {
+		return err
+	}
+
+	cf := parsedArgs["--config"].(string)
+	kubeClient, calicoClient, _, err := clientmgr.GetClients(cf)
+	if err != nil {
+		return err
+	}
+	if kubeClient == nil {
+		return fmt.Errorf("tier permissions can only be reported when using the Kubernetes datastore")
+	}
+
+	ctx := context.Background()
+	var tiers []string
+	if tier := argutils.ArgStringOrBlank(parsedArgs, "--tier"); tier != "" {
+		tiers = []string{tier}
+	} else {
+		tierList, err := calicoClient.Tiers().List(ctx, options.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list tiers: %w", err)
+		}
+		for _, t := range tierList.Items {
+			tiers = append(tiers, t.Name)
+		}
+		sort.Strings(tiers)
+	}
+
+	namespace := argutils.ArgStringOrBlank(parsedArgs, "--namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	r := &reviewer{
+		kubeClient: kubeClient,
+		user:       argutils.ArgStringOrBlank(parsedArgs, "--as"),
+		groups:     argutils.ArgStringsOrBlank(parsedArgs, "--as-group"),
+	}
+	if r.user == "" && len(r.groups) > 0 {
+		return fmt.Errorf("--as-group requires --as")
+	}
+
+	var permissions []TierPermissions
+	for _, tier := range tiers {
+		p, err := r.tierPermissions(ctx, tier, namespace)
+		if err != nil {
+			return err
+		}
+		permissions = append(permissions, p)
+	}
+
+	switch output {
+	case "json":
+		b, err := json.MarshalIndent(permissions, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", string(b))
+	case "yaml", "yml":
+		b, err := yaml.Marshal(permissions)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s", string(b))
+	default:
+		printPermissions(permissions)
+	}
+	return nil
+}
+
+// reviewer checks the authorization of a subject using SubjectAccessReviews, or of the current user using
+// SelfSubjectAccessReviews if no subject is specified.
+type reviewer struct {
+	kubeClient kubernetes.Interface
+	user       string
+	groups     []string
+}
+
+// tierPermissions calculates the permissions for the policies in the given tier.
+func (r *reviewer) tierPermissions(ctx context.Context, tier, namespace string) (TierPermissions, error) {
+	p := TierPermissions{Tier: tier}
+	var err error
+	p.CanGet, err = r.allowed(ctx, authzv1.ResourceAttributes{
+		Verb:     "get",
+		Group:    api.GroupName,
+		Resource: "tiers",
+		Name:     tier,
+	})
+	if err != nil {
+		return p, err
+	}
+
+	for _, res := range tieredPolicyResources {
+		rp := ResourcePermissions{Resource: res.resource, Verbs: []string{}}
+		if res.namespaced {
+			rp.Namespace = namespace
+		}
+		if p.CanGet {
+			for _, verb := range policyVerbs {
+				allowed, err := r.allowed(ctx, authzv1.ResourceAttributes{
+					Namespace: rp.Namespace,
+					Verb:      verb,
+					Group:     api.GroupName,
+					Resource:  "tier." + res.resource,
+					Name:      tier + ".*",
+				})
+				if err != nil {
+					return p, err
+				}
+				if allowed {
+					rp.Verbs = append(rp.Verbs, verb)
+				}
+			}
+		}
+		p.Resources = append(p.Resources, rp)
+	}
+	return p, nil
+}
+
+func (r *reviewer) allowed(ctx context.Context, attrs authzv1.ResourceAttributes) (bool, error) {
+	if r.user == "" {
+		review, err := r.kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authzv1.SelfSubjectAccessReview{
+			Spec: authzv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attrs},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return false, fmt.Errorf("failed to check authorization: %w", err)
+		}
+		return review.Status.Allowed, nil
+	}
+
+	review, err := r.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &attrs,
+			User:               r.user,
+			Groups:             r.groups,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to check authorization: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
+
+func printPermissions(permissions []TierPermissions) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "TIER\tRESOURCE\tNAMESPACE\tVERBS")
+	for _, p := range permissions {
+		for _, rp := range p.Resources {
+			verbs := strings.Join(rp.Verbs, ",")
+			if !p.CanGet {
+				verbs = "<none> (cannot get tier)"
+			} else if verbs == "" {
+				verbs = "<none>"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Tier, rp.Resource, rp.Namespace, verbs)
+		}
+	}
+	w.Flush()
+}
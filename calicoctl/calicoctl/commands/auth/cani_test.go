@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// allowRules returns a reactor that allows SubjectAccessReviews for user "jane" matching one of the
+// "<namespace>/<verb>/<resource>/<name>" rules.
+func allowRules(rules ...string) k8stesting.ReactionFunc {
+	allowed := map[string]bool{}
+	for _, r := range rules {
+		allowed[r] = true
+	}
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authzv1.SubjectAccessReview)
+		a := sar.Spec.ResourceAttributes
+		key := a.Namespace + "/" + a.Verb + "/" + a.Resource + "/" + a.Name
+		sar.Status.Allowed = sar.Spec.User == "jane" && allowed[key]
+		return true, sar, nil
+	}
+}
+
+func TestTierPermissions(t *testing.T) {
+	RegisterTestingT(t)
+
+	kc := fake.NewSimpleClientset()
+	kc.PrependReactor("create", "subjectaccessreviews", allowRules(
+		"/get/tiers/security",
+		"prod/get/tier.networkpolicies/security.*",
+		"prod/list/tier.networkpolicies/security.*",
+		"/watch/tier.globalnetworkpolicies/security.*",
+		"prod/list/tier.networkpolicies/platform.*",
+	))
+	r := &reviewer{kubeClient: kc, user: "jane", groups: []string{"dev"}}
+
+	p, err := r.tierPermissions(context.Background(), "security", "prod")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(p).To(Equal(TierPermissions{
+		Tier:   "security",
+		CanGet: true,
+		Resources: []ResourcePermissions{
+			{Resource: "networkpolicies", Namespace: "prod", Verbs: []string{"get", "list"}},
+			{Resource: "stagednetworkpolicies", Namespace: "prod", Verbs: []string{}},
+			{Resource: "globalnetworkpolicies", Verbs: []string{"watch"}},
+			{Resource: "stagedglobalnetworkpolicies", Verbs: []string{}},
+		},
+	}))
+
+	// Access to the policies in a tier requires get access to the tier.
+	p, err = r.tierPermissions(context.Background(), "platform", "prod")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(p.CanGet).To(BeFalse())
+	for _, rp := range p.Resources {
+		Expect(rp.Verbs).To(BeEmpty())
+	}
+
+	r.user = "john"
+	p, err = r.tierPermissions(context.Background(), "security", "prod")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(p.CanGet).To(BeFalse())
+}
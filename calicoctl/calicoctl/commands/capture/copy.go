@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+)
+
+const (
+	calicoNodeSelector  = "k8s-app=calico-node"
+	calicoNodeContainer = "calico-node"
+)
+
+type copyOpts struct {
+	// Only needed for Bind to work.
+	CapturedFiles bool `docopt:"captured-files"`
+	Copy          bool `docopt:"copy"`
+
+	Help                 bool
+	Name                 string `docopt:"<NAME>"`
+	Namespace            string
+	Dest                 string
+	CaptureDir           string
+	Config               string
+	AllowVersionMismatch bool
+}
+
+var usage = `Usage:
+  calicoctl captured-files copy <NAME> [options]
+
+Options:
+  -h --help                    Show this screen.
+  -n --namespace=<NS>          Namespace of the PacketCapture. [default: default]
+  -d --dest=<DEST>             Local directory to copy the files to. [default: .]
+     --capture-dir=<DIR>       Directory that Felix writes capture files to, as
+                               configured by the CaptureDir Felix setting.
+                               [default: /var/log/calico/pcap]
+  -c --config=<CONFIG>         Path to connection configuration file.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+`
+
+var doc = constants.DatastoreIntro + usage + `
+Description:
+  The captured-files copy command copies the pcap files of the named PacketCapture
+  from every calico-node pod that has captured traffic for it.  The files from each
+  node are copied to <DEST>/<NAMESPACE>/<NAME>/<NODE>/.
+
+  This command requires kubectl to be installed and configured to access the cluster.
+`
+
+// Copy copies the capture files of a PacketCapture from the calico-node pods.
+func Copy(args []string) error {
+	return copyTestable(args, fmt.Print, copyFiles)
+}
+
+func copyTestable(args []string, print func(a ...any) (int, error), continuation func(*copyOpts) error) error {
+	parser := &docopt.Parser{HelpHandler: docopt.NoHelpHandler, SkipHelpFlags: true}
+	parsedArgs, err := parser.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'.\n\n%v", strings.Join(args, " "), usage)
+	}
+
+	var opts copyOpts
+	err = parsedArgs.Bind(&opts)
+	if err != nil {
+		return fmt.Errorf("error understanding options: %w", err)
+	}
+
+	if opts.Help {
+		_, _ = print(doc)
+		return nil
+	}
+
+	return continuation(&opts)
+}
+
+func copyFiles(opts *copyOpts) error {
+	if err := common.CheckVersionMismatch(opts.Config, opts.AllowVersionMismatch); err != nil {
+		return err
+	}
+	if err := common.KubectlExists(); err != nil {
+		return fmt.Errorf("missing dependency: %s", err)
+	}
+
+	kubeClient, _, _, err := clientmgr.GetClients(opts.Config)
+	if err != nil {
+		return err
+	}
+	if kubeClient == nil {
+		return fmt.Errorf("captured-files copy is only supported for Kubernetes clusters")
+	}
+
+	pods, err := kubeClient.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{LabelSelector: calicoNodeSelector})
+	if err != nil {
+		return fmt.Errorf("failed to list calico-node pods: %w", err)
+	}
+
+	remoteDir := path.Join(opts.CaptureDir, opts.Namespace, opts.Name)
+	localDir := filepath.Join(opts.Dest, opts.Namespace, opts.Name)
+	copied := 0
+	for _, pod := range pods.Items {
+		logCxt := log.WithFields(log.Fields{"pod": pod.Name, "node": pod.Spec.NodeName})
+
+		// Check whether this node has any files for the capture.
+		out, err := common.Exec([]string{
+			"kubectl", "exec", "-n", pod.Namespace, pod.Name, "-c", calicoNodeContainer, "--",
+			"ls", remoteDir,
+		})
+		if err != nil || out == nil || strings.TrimSpace(out.String()) == "" {
+			logCxt.Debug("No capture files on node.")
+			continue
+		}
+
+		nodeDir := filepath.Join(localDir, pod.Spec.NodeName)
+		if err := os.MkdirAll(nodeDir, 0o755); err != nil {
+			return err
+		}
+		fmt.Printf("Copying capture files from node %s...\n", pod.Spec.NodeName)
+		for _, file := range strings.Fields(out.String()) {
+			src := fmt.Sprintf("%s/%s:%s", pod.Namespace, pod.Name, path.Join(remoteDir, file))
+			if out, err := common.Exec([]string{
+				"kubectl", "cp", "-c", calicoNodeContainer, src, filepath.Join(nodeDir, file),
+			}); err != nil {
+				fmt.Printf("Failed to copy %s from node %s: %v\n", file, pod.Spec.NodeName, err)
+				if out != nil {
+					fmt.Printf("\tcmd output:\n\t\t%s", out.String())
+				}
+				continue
+			}
+			copied++
+		}
+	}
+
+	if copied == 0 {
+		return fmt.Errorf("no capture files found for PacketCapture %s/%s", opts.Namespace, opts.Name)
+	}
+	fmt.Printf("Copied %d file(s) to %s\n", copied, localDir)
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCopy(t *testing.T) {
+	RegisterTestingT(t)
+	test := func(invocation string, expectedErr error, expectedOutput string, expectedOpts *copyOpts) {
+		output := ""
+		opts := (*copyOpts)(nil)
+		err := copyTestable(
+			strings.Split(invocation, " "),
+			func(a ...any) (int, error) {
+				output = fmt.Sprint(a...)
+				return 0, nil
+			}, func(o *copyOpts) error {
+				opts = o
+				return nil
+			})
+		if expectedErr == nil {
+			Expect(err).To(BeNil())
+		} else {
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(Equal(expectedErr.Error()))
+		}
+		Expect(output).To(Equal(expectedOutput))
+		if expectedOpts != nil {
+			expectedOpts.CapturedFiles = true
+			expectedOpts.Copy = true
+		}
+		Expect(opts).To(Equal(expectedOpts))
+	}
+	test("captured-files copy mycapture",
+		nil,
+		"",
+		&copyOpts{
+			Name:       "mycapture",
+			Namespace:  "default",
+			Dest:       ".",
+			CaptureDir: "/var/log/calico/pcap",
+			Config:     "/etc/calico/calicoctl.cfg",
+		})
+	test("captured-files copy mycapture -n ns1 --dest /tmp/out --capture-dir /pcap --allow-version-mismatch",
+		nil,
+		"",
+		&copyOpts{
+			Name:                 "mycapture",
+			Namespace:            "ns1",
+			Dest:                 "/tmp/out",
+			CaptureDir:           "/pcap",
+			Config:               "/etc/calico/calicoctl.cfg",
+			AllowVersionMismatch: true,
+		})
+	test("captured-files copy mycapture --help",
+		nil,
+		doc,
+		nil)
+	test("captured-files copy",
+		errors.New("Invalid option: 'calicoctl captured-files copy'.\n\n"+usage),
+		"",
+		nil)
+}
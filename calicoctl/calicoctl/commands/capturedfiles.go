@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/capture"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+)
+
+// CapturedFiles includes the subcommands for accessing the files written by PacketCaptures.
+func CapturedFiles(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  calicoctl captured-files <command> [<args>...]
+
+    copy             Copy the files of a PacketCapture from every node to a local directory.
+
+Options:
+  -h --help      Show this screen.
+
+Description:
+  Commands for accessing the pcap files written by PacketCaptures.
+
+  See 'calicoctl captured-files <command> --help' to read about a specific subcommand.`
+
+	var parser = &docopt.Parser{
+		HelpHandler:   docopt.PrintHelpAndExit,
+		OptionsFirst:  true,
+		SkipHelpFlags: false,
+	}
+	arguments, err := parser.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if arguments["<command>"] == nil {
+		return nil
+	}
+
+	command := arguments["<command>"].(string)
+	args = append([]string{"captured-files", command}, arguments["<args>"].([]string)...)
+
+	switch command {
+	case "copy":
+		return capture.Copy(args)
+	default:
+		fmt.Println(doc)
+	}
+
+	return nil
+}
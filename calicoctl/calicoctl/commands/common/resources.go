@@ -32,6 +32,7 @@ import (
 	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
 	calicoErrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
 	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	validator "github.com/projectcalico/calico/libcalico-go/lib/validator/v3"
 )
 
 type action int
@@ -43,6 +44,7 @@ const (
 	ActionDelete
 	ActionGetOrList
 	ActionPatch
+	ActionValidate
 )
 
 // Convert loaded resources to a slice of resources for easier processing.
@@ -115,7 +117,7 @@ type fileError struct {
 }
 
 // ExecuteConfigCommand is main function called by all of the resource management commands
-// in calicoctl (apply, create, replace, get, delete and patch).  This provides common function
+// in calicoctl (apply, create, replace, get, delete, patch and validate).  This provides common function
 // for all these commands:
 //   - Load resources from file (or if not specified determine the resource from
 //     the command line options).
@@ -266,7 +268,7 @@ func ExecuteConfigCommand(args map[string]interface{}, action action) CommandRes
 		res, err := ExecuteResourceAction(args, cclient, r, action)
 		if err != nil {
 			switch action {
-			case ActionApply, ActionCreate, ActionDelete, ActionGetOrList:
+			case ActionApply, ActionCreate, ActionDelete, ActionGetOrList, ActionValidate:
 				results.ResErrs = append(results.ResErrs, err)
 				continue
 			default:
@@ -323,6 +325,14 @@ func ExecuteResourceAction(args map[string]interface{}, client client.Interface,
 	case ActionPatch:
 		patch := args["--patch"].(string)
 		resOut, err = rm.Patch(ctx, client, resource, patch)
+	case ActionValidate:
+		// Validation doesn't touch the datastore, the resource is returned as-is so that
+		// the caller can carry out further checks.
+		if err = validator.Validate(resource); err != nil {
+			err = fmt.Errorf("%s %q: %w", resource.GetObjectKind().GroupVersionKind().Kind, resource.GetObjectMeta().GetName(), err)
+		} else {
+			resOut = resource
+		}
 	}
 
 	// Skip over some errors depending on command line options.
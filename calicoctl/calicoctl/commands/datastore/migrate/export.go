@@ -47,6 +47,7 @@ var title = cases.Title(language.English)
 // are processed.
 var allV3Resources []string = []string{
 	"ippools",
+	"ippoolmigrations",
 	"bgppeers",
 	"tiers", // Must come before policies since policies reference tiers.
 	"globalnetworkpolicies",
@@ -58,6 +59,7 @@ var allV3Resources []string = []string{
 	"stagednetworkpolicies",
 	"stagedkubernetesnetworkpolicies",
 	"networksets",
+	"packetcaptures",
 	"nodes", // Must be before resources that reference nodes.
 	"bgpconfigurations",
 	"felixconfigurations",
@@ -67,6 +69,7 @@ var allV3Resources []string = []string{
 
 var resourceDisplayMap map[string]string = map[string]string{
 	"ippools":                         "IPPools",
+	"ippoolmigrations":                "IPPoolMigrations",
 	"bgpconfigurations":               "BGPConfigurations",
 	"bgppeers":                        "BGPPeers",
 	"felixconfigurations":             "FelixConfigurations",
@@ -79,6 +82,7 @@ var resourceDisplayMap map[string]string = map[string]string{
 	"stagednetworkpolicies":           "StagedNetworkPolicies",
 	"stagedkubernetesnetworkpolicies": "StagedKubernetesNetworkPolicyPolicies",
 	"networksets":                     "NetworkSets",
+	"packetcaptures":                  "PacketCaptures",
 	"nodes":                           "Nodes",
 	"ipreservations":                  "IPReservations",
 	"bgpfilters":                      "BGPFilters",
@@ -88,6 +92,7 @@ var resourceDisplayMap map[string]string = map[string]string{
 var namespacedResources map[string]struct{} = map[string]struct{}{
 	"networkpolicies": {},
 	"networksets":     {},
+	"packetcaptures":  {},
 }
 
 func Export(args []string) error {
@@ -180,7 +185,7 @@ Description:
 		}
 
 		// Add options for pulling resources from all namespaces for namespaced resources.
-		if r == "networksets" || r == "networkpolicies" {
+		if _, ok := namespacedResources[r]; ok {
 			mockArgs["--all-namespaces"] = true
 		}
 
@@ -250,7 +255,9 @@ Description:
 						return fmt.Errorf("Unable to convert Calico gloabal network policy for inspection")
 					}
 					if strings.HasPrefix(metaObj.GetObjectMeta().GetName(), names.K8sAdminNetworkPolicyNamePrefix) ||
-						strings.HasPrefix(metaObj.GetObjectMeta().GetName(), names.K8sBaselineAdminNetworkPolicyNamePrefix) {
+						strings.HasPrefix(metaObj.GetObjectMeta().GetName(), names.K8sBaselineAdminNetworkPolicyNamePrefix) ||
+						strings.HasPrefix(metaObj.GetObjectMeta().GetName(), names.K8sAdminClusterNetworkPolicyNamePrefix) ||
+						strings.HasPrefix(metaObj.GetObjectMeta().GetName(), names.K8sBaselineClusterNetworkPolicyNamePrefix) {
 						continue
 					}
 					filtered = append(filtered, obj)
@@ -243,6 +243,16 @@ func (c *MockIPAMClient) NetworkSets() client.NetworkSetInterface {
 	return nil
 }
 
+func (c *MockIPAMClient) PacketCaptures() client.PacketCaptureInterface {
+	// DO NOTHING
+	return nil
+}
+
+func (c *MockIPAMClient) IPPoolMigrations() client.IPPoolMigrationInterface {
+	// DO NOTHING
+	return nil
+}
+
 func (c *MockIPAMClient) HostEndpoints() client.HostEndpointInterface {
 	// DO NOTHING
 	return nil
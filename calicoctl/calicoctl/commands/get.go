@@ -31,7 +31,7 @@ import (
 
 func Get(args []string) error {
 	doc := constants.DatastoreIntro + `Usage:
-  <BINARY_NAME> get ( (<KIND> [<NAME>...]) |
+  <BINARY_NAME> get ( (<KIND> [<NAME>...] [--selecting-endpoint=<ENDPOINT> | --selected-by=<POLICY>]) |
                 --filename=<FILENAME> [--recursive] [--skip-empty] )
                 [--output=<OUTPUT>] [--config=<CONFIG>] [--namespace=<NS>] [--all-namespaces] [--export] [--context=<context>] [--allow-version-mismatch]
 
@@ -42,6 +42,12 @@ Examples:
   # List specific policies in YAML format
   <BINARY_NAME> get -o yaml policy my-policy-1 my-policy-2
 
+  # List the policies of all kinds that apply to pod my-pod in namespace my-ns.
+  <BINARY_NAME> get policies --selecting-endpoint my-ns/my-pod
+
+  # List the endpoints that global network policy my-policy applies to.
+  <BINARY_NAME> get endpoints --selected-by globalnetworkpolicy/my-policy
+
 Options:
   -h --help                    Show this screen.
   -f --filename=<FILENAME>     Filename to use to get the resource.  If set to
@@ -59,9 +65,15 @@ Options:
                                [default: ` + constants.DefaultConfigPath + `]
   -n --namespace=<NS>          Namespace of the resource.
                                Only applicable to NetworkPolicy, StagedNetworkPolicy, StagedKubernetesNetworkPolicy,
-                               NetworkSet, and WorkloadEndpoint.
+                               NetworkSet, PacketCapture, and WorkloadEndpoint.
                                Uses the default namespace if not specified.
   -A --all-namespaces          If present, list the requested object(s) across all namespaces.
+     --selecting-endpoint=<ENDPOINT>
+                               Only list the policies whose selector matches the
+                               endpoint, given as <NAMESPACE>/<POD> for the workload
+                               endpoints of a pod, or <NAME> for a host endpoint.
+     --selected-by=<POLICY>    Only list the endpoints or network sets matched by the
+                               policy, given as <KIND>/[<NAMESPACE>/]<NAME>.
      --export                  If present, returns the requested object(s) stripped of
                                cluster-specific information. This flag will be ignored
                                if <NAME> is not specified.
@@ -80,6 +92,16 @@ Description:
 
   Attempting to get resources that do not exist will simply return no results.
 
+  The --selecting-endpoint and --selected-by options evaluate policy selectors
+  against the current contents of the datastore in the same way as Felix,
+  including the labels that endpoints inherit from their namespace and service
+  account.  With --selecting-endpoint, the resource type "policies" lists the
+  matching policies of every kind.  With --selected-by, the resource type
+  "endpoints" lists both workload and host endpoints; endpoints are matched by
+  the policy's selector and network sets by the selectors in its rules.
+  Namespaced resources are listed across all namespaces unless --namespace is
+  specified.
+
   When getting resources by type, only a single type may be specified at a
   time.  The name and other identifiers (hostname, scope) are optional, and are
   wildcarded when omitted. Thus if you specify no identifiers at all (other
@@ -136,7 +158,8 @@ Description:
 	}
 
 	printNamespace := false
-	if argutils.ArgBoolOrFalse(parsedArgs, "--all-namespaces") || argutils.ArgStringOrBlank(parsedArgs, "--namespace") != "" {
+	if parsedArgs["--selecting-endpoint"] != nil || parsedArgs["--selected-by"] != nil ||
+		argutils.ArgBoolOrFalse(parsedArgs, "--all-namespaces") || argutils.ArgStringOrBlank(parsedArgs, "--namespace") != "" {
 		printNamespace = true
 	}
 
@@ -187,7 +210,12 @@ Description:
 		return fmt.Errorf("unrecognized output format '%s'", output)
 	}
 
-	results := common.ExecuteConfigCommand(parsedArgs, common.ActionGetOrList)
+	var results common.CommandResults
+	if parsedArgs["--selecting-endpoint"] != nil || parsedArgs["--selected-by"] != nil {
+		results = getSelected(parsedArgs)
+	} else {
+		results = common.ExecuteConfigCommand(parsedArgs, common.ActionGetOrList)
+	}
 
 	log.Infof("results: %+v", results)
 
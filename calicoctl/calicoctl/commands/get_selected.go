@@ -0,0 +1,278 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/argutils"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/resourcemgr"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/policyindex"
+)
+
+var (
+	// allPolicyKinds is the expansion of the "policies" resource type for --selecting-endpoint.
+	allPolicyKinds = []string{"networkpolicies", "globalnetworkpolicies", "stagednetworkpolicies", "stagedglobalnetworkpolicies"}
+	// allEndpointKinds is the expansion of the "endpoints" resource type for --selected-by.
+	allEndpointKinds = []string{"workloadendpoints", "hostendpoints"}
+)
+
+// getSelected handles "get" with --selecting-endpoint or --selected-by.  It lists the requested
+// resources as usual and then removes the resources that don't match, using a policy index built
+// from the current contents of the datastore.
+func getSelected(args map[string]interface{}) common.CommandResults {
+	kind := strings.ToLower(argutils.ArgStringOrBlank(args, "<KIND>"))
+	endpointArg := argutils.ArgStringOrBlank(args, "--selecting-endpoint")
+	policyArg := argutils.ArgStringOrBlank(args, "--selected-by")
+
+	kinds := []string{kind}
+	switch {
+	case endpointArg != "" && (kind == "policy" || kind == "policies" || kind == "pol" || kind == "pols"):
+		kinds = allPolicyKinds
+	case policyArg != "" && (kind == "endpoint" || kind == "endpoints" || kind == "ep" || kind == "eps"):
+		kinds = allEndpointKinds
+	}
+
+	// Namespaced resources are listed in the endpoint's namespace, or in all namespaces, unless
+	// the namespace is specified explicitly.
+	endpointNamespace, endpointName, isWorkload := strings.Cut(endpointArg, "/")
+	if !isWorkload {
+		endpointName, endpointNamespace = endpointNamespace, ""
+	}
+	namespaceSpecified := argutils.ArgStringOrBlank(args, "--namespace") != "" || argutils.ArgBoolOrFalse(args, "--all-namespaces")
+
+	var results common.CommandResults
+	for _, k := range kinds {
+		kindArgs := make(map[string]interface{}, len(args))
+		for key, v := range args {
+			kindArgs[key] = v
+		}
+		kindArgs["<KIND>"] = k
+
+		namespaced, err := isNamespacedKind(k)
+		if err != nil {
+			return common.CommandResults{Err: err}
+		}
+		if !namespaced {
+			kindArgs["--namespace"] = nil
+			kindArgs["--all-namespaces"] = false
+		} else if !namespaceSpecified {
+			if endpointNamespace != "" {
+				kindArgs["--namespace"] = endpointNamespace
+			} else {
+				kindArgs["--all-namespaces"] = true
+			}
+		}
+
+		r := common.ExecuteConfigCommand(kindArgs, common.ActionGetOrList)
+		if r.Err != nil {
+			return r
+		}
+		results.Client = r.Client
+		results.NumResources += r.NumResources
+		results.NumHandled += r.NumHandled
+		results.Resources = append(results.Resources, r.Resources...)
+		results.ResErrs = append(results.ResErrs, r.ResErrs...)
+	}
+	if len(kinds) == 1 {
+		results.SingleKind = kinds[0]
+	}
+
+	ctx := context.Background()
+	idx, err := policyindex.Load(ctx, results.Client)
+	if err != nil {
+		return common.CommandResults{Err: err}
+	}
+
+	// Work out the set of resources to keep.
+	keep := map[policyindex.Resource]bool{}
+	if endpointArg != "" {
+		endpoints, err := lookupEndpoints(ctx, results.Client, endpointNamespace, endpointName, isWorkload)
+		if err != nil {
+			return common.CommandResults{Err: err}
+		}
+		for _, ep := range endpoints {
+			for _, m := range idx.PoliciesSelecting(ep) {
+				if m.Field == policyindex.FieldSelector {
+					keep[m.Policy] = true
+				}
+			}
+		}
+	} else {
+		policy, err := parsePolicyRef(policyArg, argutils.ArgStringOrBlank(args, "--namespace"))
+		if err != nil {
+			return common.CommandResults{Err: err}
+		}
+		if policy, err = resolvePolicy(ctx, results.Client, policy); err != nil {
+			return common.CommandResults{Err: err}
+		}
+		for _, m := range idx.SelectedBy(policy) {
+			// Endpoints are selected by the policy's selector, whereas network sets can only be
+			// selected by the policy's rules.
+			if m.Field == policyindex.FieldSelector || !m.Resource.IsEndpoint() {
+				keep[m.Resource] = true
+			}
+		}
+	}
+
+	// Resources are returned as lists, or individually if names were specified.
+	var filtered []runtime.Object
+	results.NumHandled = 0
+	for _, obj := range results.Resources {
+		if !meta.IsListType(obj) {
+			if keep[resourceOf(obj.GetObjectKind().GroupVersionKind().Kind, obj)] {
+				filtered = append(filtered, obj)
+				results.NumHandled++
+			}
+			continue
+		}
+		n, err := filterList(obj, keep)
+		if err != nil {
+			return common.CommandResults{Err: err}
+		}
+		filtered = append(filtered, obj)
+		results.NumHandled += n
+	}
+	results.Resources = filtered
+	return results
+}
+
+func resourceOf(kind string, obj runtime.Object) policyindex.Resource {
+	res := policyindex.Resource{Kind: kind}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		res.Namespace = accessor.GetNamespace()
+		res.Name = accessor.GetName()
+	}
+	return res
+}
+
+// isNamespacedKind returns true if the given resource type (or alias) is namespaced.
+func isNamespacedKind(kind string) (bool, error) {
+	res, err := resourcemgr.GetResourcesFromArgs(map[string]interface{}{"<KIND>": kind, "<NAME>": []string{""}})
+	if err != nil {
+		return false, err
+	}
+	return resourcemgr.GetResourceManager(res[0]).IsNamespaced(), nil
+}
+
+// lookupEndpoints returns the workload endpoints of the given pod, or the named host endpoint.
+func lookupEndpoints(ctx context.Context, c client.Interface, namespace, name string, isWorkload bool) ([]policyindex.Resource, error) {
+	if !isWorkload {
+		if _, err := c.HostEndpoints().Get(ctx, name, options.GetOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to get host endpoint %s: %w", name, err)
+		}
+		return []policyindex.Resource{{Kind: apiv3.KindHostEndpoint, Name: name}}, nil
+	}
+
+	weps, err := c.WorkloadEndpoints().List(ctx, options.ListOptions{Namespace: namespace})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workload endpoints: %w", err)
+	}
+	var endpoints []policyindex.Resource
+	for _, wep := range weps.Items {
+		if wep.Spec.Pod == name || wep.Name == name {
+			endpoints = append(endpoints, policyindex.Resource{
+				Kind:      libapiv3.KindWorkloadEndpoint,
+				Namespace: wep.Namespace,
+				Name:      wep.Name,
+			})
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no workload endpoints found for pod %s/%s", namespace, name)
+	}
+	return endpoints, nil
+}
+
+// parsePolicyRef parses a policy given as <KIND>/[<NAMESPACE>/]<NAME>.  Namespaced policies
+// default to the given namespace, or to "default".
+func parsePolicyRef(ref, namespace string) (policyindex.Resource, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return policyindex.Resource{}, fmt.Errorf("invalid policy %q, expected <KIND>/[<NAMESPACE>/]<NAME>", ref)
+	}
+	res, err := resourcemgr.GetResourcesFromArgs(map[string]interface{}{"<KIND>": parts[0], "<NAME>": []string{""}})
+	if err != nil {
+		return policyindex.Resource{}, err
+	}
+	policy := policyindex.Resource{
+		Kind: res[0].GetObjectKind().GroupVersionKind().Kind,
+		Name: parts[len(parts)-1],
+	}
+	switch policy.Kind {
+	case apiv3.KindNetworkPolicy, apiv3.KindStagedNetworkPolicy:
+		policy.Namespace = "default"
+		if len(parts) == 3 {
+			policy.Namespace = parts[1]
+		} else if namespace != "" {
+			policy.Namespace = namespace
+		}
+	case apiv3.KindGlobalNetworkPolicy, apiv3.KindStagedGlobalNetworkPolicy:
+		if len(parts) == 3 {
+			return policyindex.Resource{}, fmt.Errorf("%s is not namespaced", policy.Kind)
+		}
+	default:
+		return policyindex.Resource{}, fmt.Errorf("resource type '%s' is not a policy", parts[0])
+	}
+	return policy, nil
+}
+
+// resolvePolicy looks up the policy in the datastore, returning it with the name used by the
+// client, which may differ from the name given by the user in its tier prefix.
+func resolvePolicy(ctx context.Context, c client.Interface, policy policyindex.Resource) (policyindex.Resource, error) {
+	var obj runtime.Object
+	var err error
+	switch policy.Kind {
+	case apiv3.KindNetworkPolicy:
+		obj, err = c.NetworkPolicies().Get(ctx, policy.Namespace, policy.Name, options.GetOptions{})
+	case apiv3.KindGlobalNetworkPolicy:
+		obj, err = c.GlobalNetworkPolicies().Get(ctx, policy.Name, options.GetOptions{})
+	case apiv3.KindStagedNetworkPolicy:
+		obj, err = c.StagedNetworkPolicies().Get(ctx, policy.Namespace, policy.Name, options.GetOptions{})
+	case apiv3.KindStagedGlobalNetworkPolicy:
+		obj, err = c.StagedGlobalNetworkPolicies().Get(ctx, policy.Name, options.GetOptions{})
+	}
+	if err != nil {
+		return policyindex.Resource{}, fmt.Errorf("failed to get %s: %w", policy, err)
+	}
+	return resourceOf(policy.Kind, obj), nil
+}
+
+// filterList removes the items in a list resource that are not in keep, returning the number
+// of items that remain.
+func filterList(list runtime.Object, keep map[policyindex.Resource]bool) (int, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, err
+	}
+	kind := strings.TrimSuffix(list.GetObjectKind().GroupVersionKind().Kind, "List")
+	var kept []runtime.Object
+	for _, item := range items {
+		if keep[resourceOf(kind, item)] {
+			kept = append(kept, item)
+		}
+	}
+	return len(kept), meta.SetList(list, kept)
+}
@@ -0,0 +1,275 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docopt/docopt-go"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/go-yaml-wrapper"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/argutils"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/resourcemgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+)
+
+// historyResources maps the kinds that have a revision history to their resource names in the
+// projectcalico.org/v3 API.
+var historyResources = map[string]string{
+	api.KindTier:                          "tiers",
+	api.KindNetworkPolicy:                 "networkpolicies",
+	api.KindGlobalNetworkPolicy:           "globalnetworkpolicies",
+	api.KindStagedNetworkPolicy:           "stagednetworkpolicies",
+	api.KindStagedGlobalNetworkPolicy:     "stagedglobalnetworkpolicies",
+	api.KindStagedKubernetesNetworkPolicy: "stagedkubernetesnetworkpolicies",
+	api.KindNetworkSet:                    "networksets",
+	api.KindGlobalNetworkSet:              "globalnetworksets",
+}
+
+const historyDescription = `  Revision history is recorded by the Calico API server for tiers, network
+  policies, staged network policies and network sets.  The API server keeps a
+  bounded number of revisions for each resource in memory, so the history is
+  reset when the API server restarts, and only contains the changes that were
+  made through the Calico API server.`
+
+func History(args []string) error {
+	doc := `Usage:
+  <BINARY_NAME> history <KIND> <NAME> [--revision=<REVISION>] [--output=<OUTPUT>]
+                  [--config=<CONFIG>] [--namespace=<NS>] [--context=<context>] [--allow-version-mismatch]
+
+Examples:
+  # List the changes made to the NetworkPolicy "allow-web" in namespace "prod".
+  <BINARY_NAME> history networkpolicy allow-web -n prod
+
+  # Show the change and the resulting spec of revision 3 of a GlobalNetworkSet.
+  <BINARY_NAME> history globalnetworkset blocklist --revision=3
+
+Options:
+  -h --help                    Show this screen.
+  -r --revision=<REVISION>     Show the details of a single revision.
+  -o --output=<OUTPUT FORMAT>  Output format. One of: text, yaml or json.
+                               [Default: text]
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+  -n --namespace=<NS>          Namespace of the resource.
+                               Only applicable to NetworkPolicy, StagedNetworkPolicy,
+                               StagedKubernetesNetworkPolicy and NetworkSet.
+                               Uses the default namespace if not specified.
+     --context=<context>       The name of the kubeconfig context to use.
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The history command displays the changes made to a resource, including the
+  user that made each change, when it was made, and what was changed.  Use
+  '<BINARY_NAME> rollback' to restore the spec from a previous revision.
+
+` + historyDescription + `
+
+  This command requires the Calico API server and the Kubernetes datastore.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+	if context := parsedArgs["--context"]; context != nil {
+		os.Setenv("K8S_CURRENT_CONTEXT", context.(string))
+	}
+
+	output := parsedArgs["--output"].(string)
+	switch output {
+	case "text", "yaml", "yml", "json":
+	default:
+		return fmt.Errorf("unrecognized output format '%s'", output)
+	}
+
+	ref, err := newHistoryRef(parsedArgs)
+	if err != nil {
+		return err
+	}
+	history, err := ref.history(context.Background())
+	if err != nil {
+		return err
+	}
+
+	var result interface{} = history
+	if r := argutils.ArgStringOrBlank(parsedArgs, "--revision"); r != "" {
+		rev, err := ref.revision(history, r)
+		if err != nil {
+			return err
+		}
+		result = rev
+	}
+
+	switch output {
+	case "json":
+		b, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", string(b))
+	case "yaml", "yml":
+		b, err := yaml.Marshal(result)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s", string(b))
+	default:
+		if rev, ok := result.(*api.Revision); ok {
+			return printRevision(rev)
+		}
+		printHistory(history)
+	}
+	return nil
+}
+
+// historyRef identifies a resource that has a revision history in the Calico API server.
+type historyRef struct {
+	kubeClient *kubernetes.Clientset
+	kind       string
+	resource   string
+	namespace  string
+	name       string
+}
+
+// newHistoryRef validates the <KIND>, <NAME> and --namespace arguments and connects to the
+// cluster.
+func newHistoryRef(parsedArgs map[string]interface{}) (*historyRef, error) {
+	res, err := resourcemgr.GetResourcesFromArgs(parsedArgs)
+	if err != nil {
+		return nil, err
+	}
+	kind := res[0].GetObjectKind().GroupVersionKind().Kind
+	resource, ok := historyResources[kind]
+	if !ok {
+		return nil, fmt.Errorf("resource type '%s' does not have a revision history", parsedArgs["<KIND>"])
+	}
+	namespace := res[0].GetObjectMeta().GetNamespace()
+	if resourcemgr.GetResourceManager(res[0]).IsNamespaced() && namespace == "" {
+		namespace = "default"
+	}
+
+	if err := common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"]); err != nil {
+		return nil, err
+	}
+	cf := parsedArgs["--config"].(string)
+	kubeClient, _, _, err := clientmgr.GetClients(cf)
+	if err != nil {
+		return nil, err
+	}
+	if kubeClient == nil {
+		return nil, fmt.Errorf("revision history is only available when using the Kubernetes datastore with the Calico API server")
+	}
+
+	return &historyRef{
+		kubeClient: kubeClient,
+		kind:       kind,
+		resource:   resource,
+		namespace:  namespace,
+		name:       res[0].GetObjectMeta().GetName(),
+	}, nil
+}
+
+// collectionPath returns the API server path of the collection containing the resource.
+func (h *historyRef) collectionPath() string {
+	path := "/apis/" + api.GroupVersionCurrent
+	if h.namespace != "" {
+		path += "/namespaces/" + h.namespace
+	}
+	return path + "/" + h.resource
+}
+
+// path returns the API server path of the resource.
+func (h *historyRef) path() string {
+	return h.collectionPath() + "/" + h.name
+}
+
+// history queries the history subresource of the resource.
+func (h *historyRef) history(ctx context.Context) (*api.RevisionHistory, error) {
+	b, err := h.kubeClient.RESTClient().Get().AbsPath(h.path(), "history").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the revision history of %s %s: %w", h.kind, h.name, err)
+	}
+	history := api.NewRevisionHistory()
+	if err := json.Unmarshal(b, history); err != nil {
+		return nil, fmt.Errorf("failed to parse the revision history of %s %s: %w", h.kind, h.name, err)
+	}
+	return history, nil
+}
+
+// revision returns the revision with the given number from the history.
+func (h *historyRef) revision(history *api.RevisionHistory, revision string) (*api.Revision, error) {
+	n, err := strconv.ParseInt(revision, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid revision '%s'", revision)
+	}
+	for i := range history.Items {
+		if history.Items[i].Revision == n {
+			return &history.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("revision %d of %s %s was not found", n, h.kind, h.name)
+}
+
+func printHistory(history *api.RevisionHistory) {
+	if len(history.Items) == 0 {
+		fmt.Println("No revisions found")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 5, 1, 3, ' ', 0)
+	fmt.Fprintln(w, "REVISION\tOPERATION\tUSER\tTIMESTAMP")
+	for _, r := range history.Items {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", r.Revision, r.Operation, r.User, r.Timestamp.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	w.Flush()
+}
+
+func printRevision(r *api.Revision) error {
+	fmt.Printf("Revision:  %d\n", r.Revision)
+	fmt.Printf("Operation: %s\n", r.Operation)
+	fmt.Printf("User:      %s\n", r.User)
+	fmt.Printf("Timestamp: %s\n", r.Timestamp.UTC().Format("2006-01-02T15:04:05Z"))
+	if r.Diff != "" {
+		fmt.Printf("Diff (-old +new):\n%s", r.Diff)
+	}
+	var spec interface{}
+	if err := json.Unmarshal(r.Spec.Raw, &spec); err != nil {
+		return fmt.Errorf("failed to parse the spec of revision %d: %w", r.Revision, err)
+	}
+	b, err := yaml.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Spec:\n%s", string(b))
+	return nil
+}
@@ -55,7 +55,7 @@ Options:
                                [default: ` + constants.DefaultConfigPath + `]
   -n --namespace=<NS>          Namespace of the resource.
                                Only applicable to NetworkPolicy, StagedNetworkPolicy, StagedKubernetesNetworkPolicy,
-                               NetworkSet, and WorkloadEndpoint.
+                               NetworkSet, PacketCapture, and WorkloadEndpoint.
                                Uses the default namespace if not specified.
      --overwrite               If true, overwrite the value when the key is already
                                present in labels. Otherwise reports error when the
@@ -21,6 +21,7 @@ import (
 	gonet "net"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -42,6 +43,7 @@ const (
 	ETCD_CERT_NODE_FILE                 = "/etc/calico/certs/cert.crt"
 	ETCD_CA_CERT_NODE_FILE              = "/etc/calico/certs/ca_cert.crt"
 	FELIX_CONFIG_NODE_FILE              = "/etc/calico/felix.cfg"
+	FILE_DATASTORE_NODE_DIR             = "/var/lib/calico/datastore"
 	AUTODETECTION_METHOD_FIRST          = "first-found"
 	AUTODETECTION_METHOD_CAN_REACH      = "can-reach="
 	AUTODETECTION_METHOD_INTERFACE      = "interface="
@@ -230,7 +232,7 @@ Description:
 	if err != nil {
 		return fmt.Errorf("Error executing command: invalid config file")
 	}
-	if cfg.Spec.DatastoreType != apiconfig.EtcdV3 {
+	if cfg.Spec.DatastoreType != apiconfig.EtcdV3 && cfg.Spec.DatastoreType != apiconfig.File {
 		return fmt.Errorf("Error executing command: unsupported backend specified in config")
 	}
 	etcdcfg := cfg.Spec.EtcdConfig
@@ -282,18 +284,30 @@ Description:
 		vols = append(vols, vol{hostPath: felixConfig, containerPath: FELIX_CONFIG_NODE_FILE})
 	}
 
-	envs["ETCD_ENDPOINTS"] = etcdcfg.EtcdEndpoints
-	envs["ETCD_DISCOVERY_SRV"] = etcdcfg.EtcdDiscoverySrv
-	if etcdcfg.EtcdCACertFile != "" {
-		envs["ETCD_CA_CERT_FILE"] = ETCD_CA_CERT_NODE_FILE
-		vols = append(vols, vol{hostPath: etcdcfg.EtcdCACertFile, containerPath: ETCD_CA_CERT_NODE_FILE})
+	if cfg.Spec.DatastoreType == apiconfig.File {
+		// Share the datastore directory with the node so that it sees the resources
+		// that calicoctl writes.
+		dir, err := filepath.Abs(cfg.Spec.FileDatastoreDir)
+		if err != nil || cfg.Spec.FileDatastoreDir == "" {
+			return fmt.Errorf("Error executing command: invalid file datastore directory")
+		}
+		envs["DATASTORE_TYPE"] = string(apiconfig.File)
+		envs["FILE_DATASTORE_DIR"] = FILE_DATASTORE_NODE_DIR
+		vols = append(vols, vol{hostPath: dir, containerPath: FILE_DATASTORE_NODE_DIR})
+	} else {
+		envs["ETCD_ENDPOINTS"] = etcdcfg.EtcdEndpoints
+		envs["ETCD_DISCOVERY_SRV"] = etcdcfg.EtcdDiscoverySrv
+		if etcdcfg.EtcdCACertFile != "" {
+			envs["ETCD_CA_CERT_FILE"] = ETCD_CA_CERT_NODE_FILE
+			vols = append(vols, vol{hostPath: etcdcfg.EtcdCACertFile, containerPath: ETCD_CA_CERT_NODE_FILE})
 
-	}
-	if etcdcfg.EtcdKeyFile != "" && etcdcfg.EtcdCertFile != "" {
-		envs["ETCD_KEY_FILE"] = ETCD_KEY_NODE_FILE
-		vols = append(vols, vol{hostPath: etcdcfg.EtcdKeyFile, containerPath: ETCD_KEY_NODE_FILE})
-		envs["ETCD_CERT_FILE"] = ETCD_CERT_NODE_FILE
-		vols = append(vols, vol{hostPath: etcdcfg.EtcdCertFile, containerPath: ETCD_CERT_NODE_FILE})
+		}
+		if etcdcfg.EtcdKeyFile != "" && etcdcfg.EtcdCertFile != "" {
+			envs["ETCD_KEY_FILE"] = ETCD_KEY_NODE_FILE
+			vols = append(vols, vol{hostPath: etcdcfg.EtcdKeyFile, containerPath: ETCD_KEY_NODE_FILE})
+			envs["ETCD_CERT_FILE"] = ETCD_CERT_NODE_FILE
+			vols = append(vols, vol{hostPath: etcdcfg.EtcdCertFile, containerPath: ETCD_CERT_NODE_FILE})
+		}
 	}
 
 	// Create the Docker command to execute (or display).  Start with the
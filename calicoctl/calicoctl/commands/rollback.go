@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+)
+
+func Rollback(args []string) error {
+	doc := `Usage:
+  <BINARY_NAME> rollback <KIND> <NAME> --to-revision=<REVISION> [--config=<CONFIG>] [--namespace=<NS>]
+                  [--context=<context>] [--allow-version-mismatch]
+
+Examples:
+  # Restore the NetworkPolicy "allow-web" in namespace "prod" to revision 2.
+  <BINARY_NAME> rollback networkpolicy allow-web -n prod --to-revision=2
+
+Options:
+  -h --help                    Show this screen.
+     --to-revision=<REVISION>  The revision to restore.
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+  -n --namespace=<NS>          Namespace of the resource.
+                               Only applicable to NetworkPolicy, StagedNetworkPolicy,
+                               StagedKubernetesNetworkPolicy and NetworkSet.
+                               Uses the default namespace if not specified.
+     --context=<context>       The name of the kubeconfig context to use.
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The rollback command restores the spec of a resource to the spec recorded in
+  a previous revision.  The metadata of the resource, including its labels, is
+  not changed.  If the resource has been deleted, it is recreated.  The
+  rollback is itself recorded as a new revision.
+
+  Use '<BINARY_NAME> history' to list the revisions of a resource.
+
+` + historyDescription + `
+
+  This command requires the Calico API server and the Kubernetes datastore.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+	if context := parsedArgs["--context"]; context != nil {
+		os.Setenv("K8S_CURRENT_CONTEXT", context.(string))
+	}
+
+	ref, err := newHistoryRef(parsedArgs)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	history, err := ref.history(ctx)
+	if err != nil {
+		return err
+	}
+	rev, err := ref.revision(history, parsedArgs["--to-revision"].(string))
+	if err != nil {
+		return err
+	}
+	var spec interface{}
+	if err := json.Unmarshal(rev.Spec.Raw, &spec); err != nil {
+		return fmt.Errorf("failed to parse the spec of revision %d: %w", rev.Revision, err)
+	}
+
+	// Replace the spec of the current resource, leaving the rest of the resource unchanged so
+	// that the update is rejected if the resource is modified concurrently.
+	rc := ref.kubeClient.RESTClient()
+	b, err := rc.Get().AbsPath(ref.path()).DoRaw(ctx)
+	switch {
+	case err == nil:
+		var obj map[string]interface{}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return fmt.Errorf("failed to parse %s %s: %w", ref.kind, ref.name, err)
+		}
+		obj["spec"] = spec
+		if b, err = json.Marshal(obj); err != nil {
+			return err
+		}
+		if err := rc.Put().AbsPath(ref.path()).Body(b).Do(ctx).Error(); err != nil {
+			return fmt.Errorf("failed to update %s %s: %w", ref.kind, ref.name, err)
+		}
+	case kerrors.IsNotFound(err):
+		metadata := map[string]interface{}{"name": ref.name}
+		if ref.namespace != "" {
+			metadata["namespace"] = ref.namespace
+		}
+		b, err = json.Marshal(map[string]interface{}{
+			"apiVersion": api.GroupVersionCurrent,
+			"kind":       ref.kind,
+			"metadata":   metadata,
+			"spec":       spec,
+		})
+		if err != nil {
+			return err
+		}
+		if err := rc.Post().AbsPath(ref.collectionPath()).Body(b).Do(ctx).Error(); err != nil {
+			return fmt.Errorf("failed to recreate %s %s: %w", ref.kind, ref.name, err)
+		}
+	default:
+		return fmt.Errorf("failed to get %s %s: %w", ref.kind, ref.name, err)
+	}
+
+	fmt.Printf("Successfully rolled back %s %s to revision %d\n", ref.kind, ref.name, rev.Revision)
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docopt/docopt-go"
+	"github.com/projectcalico/go-yaml-wrapper"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	"github.com/projectcalico/calico/libcalico-go/lib/lint"
+)
+
+// validateResult holds the lint warnings for a single resource.
+type validateResult struct {
+	Kind      string         `json:"kind"`
+	Namespace string         `json:"namespace,omitempty"`
+	Name      string         `json:"name"`
+	Warnings  []lint.Warning `json:"warnings"`
+}
+
+func Validate(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> validate --filename=<FILENAME> [--recursive] [--skip-empty] [--output=<OUTPUT>]
+                  [--config=<CONFIG>] [--namespace=<NS>] [--context=<context>] [--allow-version-mismatch]
+
+Examples:
+  # Validate the policies in policy.yaml against the current cluster state.
+  <BINARY_NAME> validate -f ./policy.yaml
+
+  # Validate a policy based on the JSON passed into stdin, printing the results as JSON.
+  cat policy.json | <BINARY_NAME> validate -f - -o json
+
+Options:
+  -h --help                    Show this screen.
+  -f --filename=<FILENAME>     Filename to use to validate the resource.  If set to
+                               "-" loads from stdin. If filename is a directory, this command is
+                               invoked for each .json .yaml and .yml file within that directory.
+  -R --recursive               Process the filename specified in -f or --filename recursively.
+     --skip-empty              Do not error if any files or directory specified using -f or --filename contain no
+                               data.
+  -o --output=<OUTPUT FORMAT>  Output format. One of: text, yaml or json.
+                               [Default: text]
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+  -n --namespace=<NS>          Namespace of the resource.
+                               Only applicable to NetworkPolicy, StagedNetworkPolicy,
+                               StagedKubernetesNetworkPolicy, NetworkSet, PacketCapture, and WorkloadEndpoint.
+                               Uses the default namespace if not specified.
+     --context=<context>       The name of the kubeconfig context to use.
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The validate command checks a set of resources by filename or stdin without
+  writing them to the datastore.  JSON and YAML formats are accepted.
+
+  Each resource is first checked for validity, exactly as it would be by the
+  create and apply commands.  Policies are then linted against the current
+  contents of the datastore, reporting problems such as:
+
+  -  a selector that does not match any existing endpoint
+  -  a selector that requires a label that is not used by any resource, which
+     is usually a typo
+  -  a rule that references a named port or service account that does not exist
+  -  rules that are never evaluated because a policy with higher precedence
+     allows or denies all of the same traffic
+
+  Lint warnings are advisory and do not cause the command to fail.  The command
+  fails if any resource is invalid.
+
+  Valid resource types are:
+
+<RESOURCE_LIST>
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	// Replace <RESOURCE_LIST> with the list of resource types.
+	doc = strings.Replace(doc, "<RESOURCE_LIST>", util.Resources(), 1)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+	if context := parsedArgs["--context"]; context != nil {
+		os.Setenv("K8S_CURRENT_CONTEXT", context.(string))
+	}
+
+	output := parsedArgs["--output"].(string)
+	switch output {
+	case "text", "yaml", "yml", "json":
+	default:
+		return fmt.Errorf("unrecognized output format '%s'", output)
+	}
+
+	results := common.ExecuteConfigCommand(parsedArgs, common.ActionValidate)
+	log.Infof("results: %+v", results)
+
+	if results.FileInvalid {
+		return fmt.Errorf("Failed to execute command: %v", results.Err)
+	} else if results.Err != nil {
+		return results.Err
+	} else if results.NumResources == 0 {
+		fmt.Println("No resources specified")
+		return nil
+	}
+
+	// Lint each of the valid resources against the current datastore state.
+	linter := lint.NewLinter(results.Client)
+	var validated []validateResult
+	for _, r := range results.Resources {
+		warnings, err := linter.Lint(context.Background(), r)
+		if err != nil {
+			return fmt.Errorf("Failed to check resources against the datastore: %w", err)
+		}
+		res := validateResult{
+			Kind:     r.GetObjectKind().GroupVersionKind().Kind,
+			Warnings: warnings,
+		}
+		if accessor, err := meta.Accessor(r); err == nil {
+			res.Namespace = accessor.GetNamespace()
+			res.Name = accessor.GetName()
+		}
+		if res.Warnings == nil {
+			res.Warnings = []lint.Warning{}
+		}
+		validated = append(validated, res)
+	}
+
+	switch output {
+	case "json":
+		b, err := json.MarshalIndent(validated, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", string(b))
+	case "yaml", "yml":
+		b, err := yaml.Marshal(validated)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s", string(b))
+	default:
+		printValidateResults(validated)
+	}
+
+	if len(results.ResErrs) > 0 {
+		return fmt.Errorf("%d out of %d resource(s) failed validation: %v", len(results.ResErrs), results.NumResources, results.ResErrs)
+	}
+	return nil
+}
+
+func printValidateResults(results []validateResult) {
+	numWarnings := 0
+	for _, r := range results {
+		if len(r.Warnings) == 0 {
+			continue
+		}
+		id := r.Name
+		if r.Namespace != "" {
+			id = r.Namespace + "/" + r.Name
+		}
+		fmt.Printf("%s %s:\n", r.Kind, id)
+		for _, w := range r.Warnings {
+			fmt.Printf("  %s\n", w)
+			numWarnings++
+		}
+	}
+	fmt.Printf("Validated %d resource(s) with %d warning(s)\n", len(results), numWarnings)
+}
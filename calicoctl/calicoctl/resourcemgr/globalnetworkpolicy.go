@@ -87,7 +87,9 @@ func init() {
 
 func policyIsANP(r *api.GlobalNetworkPolicy) bool {
 	return strings.HasPrefix(r.Name, names.K8sAdminNetworkPolicyNamePrefix) ||
-		strings.HasPrefix(r.Name, names.K8sBaselineAdminNetworkPolicyNamePrefix)
+		strings.HasPrefix(r.Name, names.K8sBaselineAdminNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(r.Name, names.K8sAdminClusterNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(r.Name, names.K8sBaselineClusterNetworkPolicyNamePrefix)
 }
 
 // newGlobalNetworkPolicyList creates a new (zeroed) GlobalNetworkPolicyList struct with the TypeMetadata initialised to the current
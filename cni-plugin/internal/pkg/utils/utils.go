@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/containernetworking/cni/pkg/invoke"
 	"github.com/containernetworking/cni/pkg/skel"
 	cnitypes "github.com/containernetworking/cni/pkg/types"
 	cniv1 "github.com/containernetworking/cni/pkg/types/100"
@@ -38,12 +39,17 @@ import (
 	"github.com/projectcalico/calico/cni-plugin/pkg/types"
 	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
 	api "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	k8sconversion "github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
 	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
 	"github.com/projectcalico/calico/libcalico-go/lib/names"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 	"github.com/projectcalico/calico/libcalico-go/lib/options"
 )
 
+// ErrPluginNotAvailable is the well-known CNI error code that a plugin returns from STATUS to
+// indicate that it can't currently service ADD requests.
+const ErrPluginNotAvailable uint = 50
+
 // DetermineNodename gets the node name, in order of priority:
 // 1. Nodename field in NetConf
 // 2. Nodename from the file /var/lib/calico/nodename
@@ -191,28 +197,8 @@ func DeleteIPAM(conf types.NetConf, args *skel.CmdArgs, logger *logrus.Entry) er
 
 	var ae *azure.AzureEndpoint
 	if conf.IPAM.Type == "host-local" {
-		// We need to replace "usePodCidr" with a valid, but dummy podCidr string with "host-local" IPAM.
-		// host-local IPAM releases the IP by ContainerID, so podCidr isn't really used to release the IP.
-		// It just needs a valid CIDR, but it doesn't have to be the CIDR associated with the host.
-		dummyPodCidrv4 := "0.0.0.0/0"
-		dummyPodCidrv6 := "::/0"
-		var stdinData map[string]interface{}
-		err := json.Unmarshal(args.StdinData, &stdinData)
-		if err != nil {
-			return err
-		}
-
-		logger.WithFields(logrus.Fields{"podCidrv4": dummyPodCidrv4,
-			"podCidrv6": dummyPodCidrv6}).Info("Using dummy podCidrs to release the IPs")
-		getDummyPodCIDR := func() (string, string, error) {
-			return dummyPodCidrv4, dummyPodCidrv6, nil
-		}
-		err = ReplaceHostLocalIPAMPodCIDRs(logger, stdinData, getDummyPodCIDR)
-		if err != nil {
-			return err
-		}
-
-		args.StdinData, err = json.Marshal(stdinData)
+		var err error
+		args.StdinData, err = replaceHostLocalIPAMPodCIDRsWithDummy(logger, args.StdinData)
 		if err != nil {
 			return err
 		}
@@ -255,6 +241,69 @@ func DeleteIPAM(conf types.NetConf, args *skel.CmdArgs, logger *logrus.Entry) er
 	return err
 }
 
+// GCIPAM delegates a GC call to the configured IPAM plugin so that it can release any addresses
+// that belong to attachments that are no longer in the list of valid attachments.
+func GCIPAM(conf types.NetConf, args *skel.CmdArgs, logger *logrus.Entry) error {
+	logger.WithField("type", conf.IPAM.Type).Info("Calico CNI delegating GC to IPAM plugin")
+	return delegateIPAM(conf, args, logger, invoke.DelegateGC)
+}
+
+// StatusIPAM delegates a STATUS call to the configured IPAM plugin.
+func StatusIPAM(conf types.NetConf, args *skel.CmdArgs, logger *logrus.Entry) error {
+	logger.WithField("type", conf.IPAM.Type).Debug("Calico CNI delegating STATUS to IPAM plugin")
+	return delegateIPAM(conf, args, logger, invoke.DelegateStatus)
+}
+
+type delegateFn func(ctx context.Context, plugin string, netconf []byte, exec invoke.Exec) error
+
+func delegateIPAM(conf types.NetConf, args *skel.CmdArgs, logger *logrus.Entry, delegate delegateFn) error {
+	stdinData := args.StdinData
+	switch conf.IPAM.Type {
+	case "host-local":
+		var err error
+		stdinData, err = replaceHostLocalIPAMPodCIDRsWithDummy(logger, stdinData)
+		if err != nil {
+			return err
+		}
+	case "azure-vnet-ipam":
+		// The azure-vnet-ipam plugin doesn't support the CNI 1.1 verbs, and it needs per-endpoint
+		// data to release an address, which we don't have for attachments that the runtime has
+		// already forgotten about.
+		logger.Info("Azure IPAM doesn't support this operation, skipping")
+		return nil
+	}
+
+	err := delegate(context.Background(), conf.IPAM.Type, stdinData, nil)
+	if err != nil {
+		logger.Error(err)
+	}
+	return err
+}
+
+// replaceHostLocalIPAMPodCIDRsWithDummy replaces "usePodCidr" with a valid, but dummy podCidr string in
+// host-local IPAM config.  host-local IPAM releases IPs by ContainerID, so podCidr isn't really used when
+// tearing down.  It just needs a valid CIDR, but it doesn't have to be the CIDR associated with the host.
+func replaceHostLocalIPAMPodCIDRsWithDummy(logger *logrus.Entry, data []byte) ([]byte, error) {
+	dummyPodCidrv4 := "0.0.0.0/0"
+	dummyPodCidrv6 := "::/0"
+	var stdinData map[string]interface{}
+	err := json.Unmarshal(data, &stdinData)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithFields(logrus.Fields{"podCidrv4": dummyPodCidrv4,
+		"podCidrv6": dummyPodCidrv6}).Info("Using dummy podCidrs to release the IPs")
+	getDummyPodCIDR := func() (string, string, error) {
+		return dummyPodCidrv4, dummyPodCidrv6, nil
+	}
+	err = ReplaceHostLocalIPAMPodCIDRs(logger, stdinData, getDummyPodCIDR)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(stdinData)
+}
+
 // ReplaceHostLocalIPAMPodCIDRs extracts the host-local IPAM config section and replaces our special-case "usePodCidr"
 // subnet value with pod CIDR retrieved by the passed-in getPodCIDR function.  Typically, the passed-in function
 // would access the datastore to retrieve the podCIDR. However, for tear-down we use a dummy value that returns
@@ -568,6 +617,24 @@ type WEPIdentifiers struct {
 	names.WorkloadEndpointIdentifiers
 }
 
+// PrimaryInterface returns the pod-side name of the interface of the pod's primary network.
+func PrimaryInterface(conf types.NetConf) string {
+	if conf.PrimaryInterface != "" {
+		return conf.PrimaryInterface
+	}
+	return k8sconversion.PrimaryInterfaceName
+}
+
+// K8sEndpointForInterface returns the WorkloadEndpoint Endpoint for the given pod-side interface.
+// The pod's primary interface always maps to the primary WorkloadEndpoint, whatever it is called,
+// so that it matches the WorkloadEndpoint that is derived from the pod.
+func K8sEndpointForInterface(conf types.NetConf, iface string) string {
+	if k8sconversion.IsSecondaryInterface(iface, PrimaryInterface(conf)) {
+		return iface
+	}
+	return k8sconversion.PrimaryInterfaceName
+}
+
 // GetIdentifiers takes CNI command arguments, and extracts identifiers i.e. pod name, pod namespace,
 // container ID, endpoint(container interface name) and orchestratorID based on the orchestrator.
 func GetIdentifiers(args *skel.CmdArgs, nodename string) (*WEPIdentifiers, error) {
@@ -620,6 +687,49 @@ func GetHandleID(netName, containerID, workload string) string {
 	return handleID
 }
 
+// StaleHandles returns the IPAM handles for the given network that have addresses assigned to this
+// node but don't correspond to any of the valid attachments supplied by the runtime on a GC call.
+func StaleHandles(ctx context.Context, c client.Interface, conf types.NetConf, nodename string) ([]string, error) {
+	valid := map[string]bool{}
+	for _, a := range conf.ValidAttachments {
+		valid[GetHandleID(conf.Name, a.ContainerID, "")] = true
+	}
+
+	handles, err := c.IPAM().HandlesByNode(ctx, nodename)
+	if err != nil {
+		return nil, err
+	}
+
+	var stale []string
+	prefix := conf.Name + "."
+	for _, handleID := range handles {
+		if !strings.HasPrefix(handleID, prefix) || valid[handleID] {
+			continue
+		}
+		stale = append(stale, handleID)
+	}
+	logrus.WithFields(logrus.Fields{
+		"Network": conf.Name,
+		"Node":    nodename,
+		"Stale":   stale,
+	}).Debug("Found stale IPAM handles")
+	return stale, nil
+}
+
+// CheckDatastoreReady returns an error if the datastore can't be reached, or if Calico isn't
+// currently ready to process requests (for example, because an upgrade is in progress).
+func CheckDatastoreReady(ctx context.Context, c client.Interface) error {
+	ci, err := c.ClusterInformation().Get(ctx, "default", options.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting ClusterInformation: %v", err)
+	}
+	if ci.Spec.DatastoreReady == nil || !*ci.Spec.DatastoreReady {
+		logrus.Info("Upgrade may be in progress, ready flag is not set")
+		return fmt.Errorf("Calico is currently not ready to process requests")
+	}
+	return nil
+}
+
 func CreateClient(conf types.NetConf) (client.Interface, error) {
 	if err := ValidateNetworkName(conf.Name); err != nil {
 		return nil, err
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/projectcalico/calico/cni-plugin/internal/pkg/utils"
+	"github.com/projectcalico/calico/cni-plugin/pkg/types"
 )
 
 var _ = Describe("utils", func() {
@@ -35,4 +36,15 @@ var _ = Describe("utils", func() {
 		table.Entry("mix of special chars",
 			"some_val-with.lots*of^weird#characters", "some_val-with.lots-of-weird-characters"),
 	)
+
+	table.DescribeTable("K8s endpoint for interface", func(primary, iface, endpoint string) {
+		conf := types.NetConf{PrimaryInterface: primary}
+		Expect(utils.K8sEndpointForInterface(conf, iface)).To(Equal(endpoint))
+	},
+		table.Entry("default primary", "", "eth0", "eth0"),
+		table.Entry("default secondary", "", "net1", "net1"),
+		table.Entry("configured primary", "net0", "net0", "eth0"),
+		table.Entry("configured secondary", "net0", "net1", "net1"),
+		table.Entry("eth0 is never secondary", "net0", "eth0", "eth0"),
+	)
 })
@@ -16,6 +16,7 @@ package linux
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -247,7 +248,14 @@ func (d *linuxDataplane) DoWorkloadNetnsSetUp(
 				},
 			)
 
-			if err != nil {
+			// If the pod has more than one Calico interface then the route to the dummy next hop
+			// already exists via the first interface.  In that case, we tell the kernel that the
+			// next hop is directly reachable through this interface instead.
+			onLink := false
+			if errors.Is(err, syscall.EEXIST) {
+				d.logger.Info("Route to dummy next hop already exists, using on-link routes for this interface")
+				onLink = true
+			} else if err != nil {
 				return fmt.Errorf("failed to add route inside the container: %v", err)
 			}
 
@@ -257,7 +265,17 @@ func (d *linuxDataplane) DoWorkloadNetnsSetUp(
 					continue
 				}
 				d.logger.WithField("route", r).Debug("Adding IPv4 route")
-				if err = ip.AddRoute(r, gw, contVeth); err != nil {
+				if onLink {
+					err = netlink.RouteAdd(&netlink.Route{
+						LinkIndex: contVeth.Attrs().Index,
+						Dst:       r,
+						Gw:        gw,
+						Flags:     int(netlink.FLAG_ONLINK),
+					})
+				} else {
+					err = ip.AddRoute(r, gw, contVeth)
+				}
+				if err != nil {
 					return fmt.Errorf("failed to add IPv4 route for %v via %v: %v", r, gw, err)
 				}
 			}
@@ -102,13 +102,15 @@ func Main(version string) {
 	}
 
 	funcs := skel.CNIFuncs{
-		Add:   cmdAdd,
-		Check: nil,
-		Del:   cmdDel,
+		Add:    cmdAdd,
+		Check:  nil,
+		Del:    cmdDel,
+		GC:     cmdGC,
+		Status: cmdStatus,
 	}
 
 	skel.PluginMainFuncs(funcs,
-		cniSpecVersion.PluginSupports("0.1.0", "0.2.0", "0.3.0", "0.3.1", "0.4.0", "1.0.0"),
+		cniSpecVersion.PluginSupports("0.1.0", "0.2.0", "0.3.0", "0.3.1", "0.4.0", "1.0.0", "1.1.0"),
 		"Calico CNI IPAM "+version)
 }
 
@@ -450,3 +452,77 @@ func cmdDel(args *skel.CmdArgs) error {
 
 	return nil
 }
+
+// cmdGC releases the addresses of any attachments for this network on this node that aren't in the
+// runtime's list of valid attachments.
+func cmdGC(args *skel.CmdArgs) error {
+	conf := types.NetConf{}
+	if err := json.Unmarshal(args.StdinData, &conf); err != nil {
+		return fmt.Errorf("failed to load netconf: %v", err)
+	}
+
+	utils.ConfigureLogging(conf)
+
+	calicoClient, err := utils.CreateClient(conf)
+	if err != nil {
+		return err
+	}
+
+	nodename := utils.DetermineNodename(conf)
+	logger := logrus.WithFields(logrus.Fields{"Network": conf.Name, "Node": nodename})
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 90*time.Second)
+	defer cancel()
+
+	// Hold the host-wide lock while we work out which handles are stale, so that we don't race with an
+	// ADD that has assigned an address but not yet returned to the runtime.
+	unlock := acquireIPAMLockBestEffort(conf.IPAMLockFile)
+	defer unlock()
+
+	handles, err := utils.StaleHandles(ctx, calicoClient, conf, nodename)
+	if err != nil {
+		logger.WithError(err).Error("Failed to list IPAM handles")
+		return err
+	}
+
+	var released int
+	for _, handleID := range handles {
+		hLogger := logger.WithField("HandleID", handleID)
+		if err := calicoClient.IPAM().ReleaseByHandle(ctx, handleID); err != nil {
+			if _, ok := err.(errors.ErrorResourceDoesNotExist); !ok {
+				hLogger.WithError(err).Error("Failed to release address")
+				return err
+			}
+			hLogger.Debug("Asked to release address but it doesn't exist. Ignoring")
+			continue
+		}
+		hLogger.Info("Released address for stale attachment")
+		released++
+	}
+	logger.WithField("released", released).Info("IPAM GC complete")
+	return nil
+}
+
+// cmdStatus reports whether the datastore is reachable, since we can't assign addresses otherwise.
+func cmdStatus(args *skel.CmdArgs) error {
+	conf := types.NetConf{}
+	if err := json.Unmarshal(args.StdinData, &conf); err != nil {
+		return fmt.Errorf("failed to load netconf: %v", err)
+	}
+
+	utils.ConfigureLogging(conf)
+
+	calicoClient, err := utils.CreateClient(conf)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := utils.CheckDatastoreReady(ctx, calicoClient); err != nil {
+		logrus.WithError(err).Warn("Calico IPAM is not available")
+		return cnitypes.NewError(utils.ErrPluginNotAvailable, "Calico IPAM is not available", err.Error())
+	}
+	return nil
+}
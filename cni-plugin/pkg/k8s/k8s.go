@@ -29,8 +29,10 @@ import (
 	cnitypes "github.com/containernetworking/cni/pkg/types"
 	cniv1 "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/plugins/pkg/ipam"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -73,6 +75,17 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 
 	logger.Info("Extracted identifiers for CmdAddK8s")
 
+	// Interfaces other than the pod's primary interface (e.g. those attached by Multus) each get their
+	// own WorkloadEndpoint, which is recorded in a per-interface annotation on the pod.
+	secondary := k8sconversion.IsSecondaryEndpoint(epIDs.Endpoint)
+	if secondary {
+		annotKey := k8sconversion.AnnotationSecondaryEndpointPrefix + epIDs.Endpoint
+		if errs := k8svalidation.IsQualifiedName(annotKey); len(errs) != 0 {
+			return nil, fmt.Errorf("interface name %q is not supported for secondary interfaces: %s",
+				epIDs.Endpoint, strings.Join(errs, "; "))
+		}
+	}
+
 	result, err = utils.CheckForSpuriousDockerAdd(args, conf, epIDs, endpoint, logger)
 	if result != nil || err != nil {
 		return result, err
@@ -168,8 +181,12 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 	}
 
 	// Determine which routes to program within the container. If no routes were provided in the CNI config,
-	// then use the Calico default routes. If routes were provided then program those instead.
-	if len(routes) == 0 {
+	// then use the Calico default routes. If routes were provided then program those instead.  Secondary
+	// interfaces mustn't take over the pod's default route so, for those, we route the IP pools of the
+	// interface's addresses instead, once we know what they are.
+	if len(routes) == 0 && secondary {
+		logger.Debug("No routes specified in CNI configuration, will route IP pools for secondary interface.")
+	} else if len(routes) == 0 {
 		logger.Debug("No routes specified in CNI configuration, using defaults.")
 		routes = utils.DefaultRoutes
 	} else {
@@ -209,8 +226,15 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 		logger.WithField("ports", ports).Debug("Fetched K8s ports")
 		logger.WithField("profiles", profiles).Debug("Generated profiles")
 
+		if secondary {
+			// The pod's annotations (ipAddrs, floatingIPs, hwAddr etc.) configure its primary interface.
+			// Secondary interfaces are configured solely by their network's CNI configuration.
+			logger.Debug("Ignoring pod annotations for secondary interface")
+			annot = map[string]string{}
+		}
+
 		// Check for calico IPAM specific annotations and set them if needed.
-		if conf.IPAM.Type == "calico-ipam" {
+		if conf.IPAM.Type == "calico-ipam" && !secondary {
 
 			var v4pools, v6pools, ipFamilies string
 
@@ -306,6 +330,13 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 		}
 	}
 
+	if secondary {
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[api.LabelNetwork] = conf.Name
+	}
+
 	ipAddrsNoIpam := annot["cni.projectcalico.org/ipAddrsNoIpam"]
 	ipAddrs := annot["cni.projectcalico.org/ipAddrs"]
 
@@ -418,6 +449,14 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 	logger.WithField("endpoint", endpoint).Info("Populated endpoint")
 	logger.Infof("Calico CNI using IPs: %s", endpoint.Spec.IPNetworks)
 
+	if secondary && len(routes) == 0 {
+		if routes, err = secondaryInterfaceRoutes(ctx, calicoClient, result); err != nil {
+			utils.ReleaseIPAllocation(logger, conf, args)
+			return nil, err
+		}
+		logger.WithField("routes", routes).Info("Using IP pool routes for secondary interface.")
+	}
+
 	// releaseIPAM cleans up any IPAM allocations on failure.
 	releaseIPAM := func() {
 		logger.WithField("endpointIPs", endpoint.Spec.IPNetworks).Info("Releasing IPAM allocation(s) after failure")
@@ -425,7 +464,7 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 	}
 
 	// Whether the endpoint existed or not, the veth needs (re)creating.
-	desiredVethName := k8sconversion.NewConverter().VethNameForWorkload(epIDs.Namespace, epIDs.Pod)
+	desiredVethName := k8sconversion.NewConverter().VethNameForWorkloadEndpoint(epIDs.Namespace, epIDs.Pod, epIDs.Endpoint)
 	hostVethName, contVethMac, err := d.DoNetworking(
 		ctx, calicoClient, args, result, desiredVethName, routes, endpoint, annot)
 	if err != nil {
@@ -563,6 +602,41 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 	return result, nil
 }
 
+// secondaryInterfaceRoutes returns the routes to program for a secondary interface: the CIDRs of the IP
+// pools that its addresses were allocated from.  Addresses that don't belong to an IP pool (for example,
+// when using host-local IPAM) fall back to the subnet of the address itself.
+func secondaryInterfaceRoutes(ctx context.Context, calicoClient calicoclient.Interface, result *cniv1.Result) ([]*net.IPNet, error) {
+	pools, err := calicoClient.IPPools().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP pools: %w", err)
+	}
+
+	var routes []*net.IPNet
+	seen := map[string]bool{}
+	for _, ipc := range result.IPs {
+		var route *net.IPNet
+		for _, pool := range pools.Items {
+			_, cidr, err := net.ParseCIDR(pool.Spec.CIDR)
+			if err == nil && cidr.Contains(ipc.Address.IP) {
+				route = cidr
+				break
+			}
+		}
+		if route == nil {
+			ones, bits := ipc.Address.Mask.Size()
+			if ones == bits {
+				continue
+			}
+			route = &net.IPNet{IP: ipc.Address.IP.Mask(ipc.Address.Mask), Mask: ipc.Address.Mask}
+		}
+		if !seen[route.String()] {
+			seen[route.String()] = true
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
 // CmdDelK8s performs CNI DEL processing when running under Kubernetes. In Kubernetes, we identify workload endpoints based on their
 // pod name and namespace rather than container ID, so we may receive multiple DEL calls for the same pod, but with different container IDs.
 // As such, we must only delete the workload endpoint when the provided CNI_CONTAINERID matches the value on the WorkloadEndpoint. If they do not match,
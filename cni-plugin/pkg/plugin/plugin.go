@@ -21,11 +21,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"runtime"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/containernetworking/cni/pkg/skel"
@@ -45,10 +47,13 @@ import (
 	"github.com/projectcalico/calico/cni-plugin/pkg/k8s"
 	"github.com/projectcalico/calico/cni-plugin/pkg/types"
 	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	k8sconversion "github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/resources"
 	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
 	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	calicoipam "github.com/projectcalico/calico/libcalico-go/lib/ipam"
 	"github.com/projectcalico/calico/libcalico-go/lib/logutils"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
 	"github.com/projectcalico/calico/libcalico-go/lib/options"
 	"github.com/projectcalico/calico/libcalico-go/lib/winutils"
 )
@@ -80,13 +85,8 @@ func testConnection() error {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), testConnectionTimeout)
 	defer cancel()
-	ci, err := calicoClient.ClusterInformation().Get(ctx, "default", options.GetOptions{})
-	if err != nil {
-		return fmt.Errorf("error getting ClusterInformation: %v", err)
-	}
-	if !*ci.Spec.DatastoreReady {
-		logrus.Info("Upgrade may be in progress, ready flag is not set")
-		return fmt.Errorf("Calico is currently not ready to process requests")
+	if err := utils.CheckDatastoreReady(ctx, calicoClient); err != nil {
+		return err
 	}
 
 	// If we have a kubeconfig, test connection to the APIServer
@@ -173,7 +173,7 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 		conf.CNIVersion = "0.2.0"
 	}
 
-	if version.Compare(conf.CNIVersion, "1.0.0", ">") {
+	if version.Compare(conf.CNIVersion, "1.1.0", ">") {
 		return fmt.Errorf("unsupported CNI version %s", conf.CNIVersion)
 	}
 
@@ -306,16 +306,18 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 	// 4. Pod name (only for k8s)
 	// Note we don't use the interface name (endpoint) for this match.
 	// If we find a match from the returned list then we've found the workload endpoint,
-	// and we reuse that even if it has a different interface name, because
-	// we only support one interface per pod right now.
+	// and we reuse that even if it has a different interface name.  The exception is
+	// Kubernetes secondary interfaces (e.g. attached by Multus): each of those gets its own
+	// WorkloadEndpoint, so we only match an endpoint for a secondary interface if the interface
+	// name is exactly the same, and the primary interface never matches a secondary endpoint.
 	// For example, you have a WEP for a k8s pod "mypod-1", and IfName "eth0" on node "node1", that will result in
 	// a WEP name "node1-k8s-mypod--1-eth0" in the datastore, now you're trying to schedule another pod "mypod",
 	// IfName "eth0" and node "node1", so we do a prefix list to get all the endpoints for that workload, with
 	// the prefix "node1-k8s-mypod-". Now this search would return any existing endpoints for "mypod", but it will also
 	// list "node1-k8s-mypod--1-eth0" which is not the same WorkloadEndpoint, so to avoid that, we go through the
 	// list of returned WEPs from the prefix list and call NameMatches() based on all the
-	// identifiers (pod name, containerID, node name, orchestrator), but omit the IfName (Endpoint field) since we can
-	// only have one interface per pod right now, and NameMatches() will return true if the WEP matches the identifiers.
+	// identifiers (pod name, containerID, node name, orchestrator), but omit the IfName (Endpoint field) for the
+	// reason above, and NameMatches() will return true if the WEP matches the identifiers.
 	// It is possible that none of the WEPs in the list match the identifiers, which means we don't already have an
 	// existing WEP to reuse. See `names.WorkloadEndpointIdentifiers` GoDoc comments for more details.
 	if len(endpoints.Items) > 0 {
@@ -330,6 +332,13 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 				return
 			}
 
+			if match && wepIDs.Orchestrator == api.OrchestratorKubernetes {
+				ifaceEndpoint := utils.K8sEndpointForInterface(conf, args.IfName)
+				if k8sconversion.IsSecondaryEndpoint(ifaceEndpoint) || k8sconversion.IsSecondaryEndpoint(ep.Spec.Endpoint) {
+					match = ep.Spec.Endpoint == ifaceEndpoint
+				}
+			}
+
 			if match {
 				logger.Debugf("Found a match for WorkloadEndpoint: %v", ep)
 				endpoint = &ep
@@ -347,6 +356,9 @@ func cmdAdd(args *skel.CmdArgs) (err error) {
 	// the WEP name with the IfName passed in so we can create the WorkloadEndpoint later in the process.
 	if endpoint == nil {
 		wepIDs.Endpoint = args.IfName
+		if wepIDs.Orchestrator == api.OrchestratorKubernetes {
+			wepIDs.Endpoint = utils.K8sEndpointForInterface(conf, args.IfName)
+		}
 		wepIDs.WEPName, err = wepIDs.CalculateWorkloadEndpointName(false)
 		if err != nil {
 			err = fmt.Errorf("error constructing WorkloadEndpoint name: %s", err)
@@ -628,6 +640,9 @@ func cmdDel(args *skel.CmdArgs) (err error) {
 	if err != nil {
 		return
 	}
+	if epIDs.Orchestrator == api.OrchestratorKubernetes {
+		epIDs.Endpoint = utils.K8sEndpointForInterface(conf, args.IfName)
+	}
 	logger := logrus.WithFields(logrus.Fields{"ContainerID": epIDs.ContainerID})
 
 	var calicoClient clientv3.Interface
@@ -701,6 +716,190 @@ func cmdDel(args *skel.CmdArgs) (err error) {
 	return
 }
 
+// cmdGC cleans up after any attachments for this network that the runtime no longer knows about.  It
+// removes the WorkloadEndpoints of any stale attachments from this node and then delegates to the IPAM
+// plugin to release their addresses.
+func cmdGC(args *skel.CmdArgs) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("Calico CNI panicked during GC: %s\nStack trace:\n%s", e, string(debug.Stack()))
+		}
+		if err != nil {
+			logrus.WithError(err).Error("Final result of CNI GC was an error.")
+		}
+	}()
+
+	conf := types.NetConf{}
+	if err = json.Unmarshal(args.StdinData, &conf); err != nil {
+		err = fmt.Errorf("failed to load netconf: %v", err)
+		return
+	}
+
+	utils.ConfigureLogging(conf)
+	logger := logrus.WithField("Network", conf.Name)
+	logger.WithField("ValidAttachments", len(conf.ValidAttachments)).Info("Calico CNI running GC")
+
+	// We can only map stale attachments back to WorkloadEndpoints via their Calico IPAM handles.
+	if conf.IPAM.Type == "calico-ipam" {
+		if err = gcWorkloadEndpoints(conf, logger); err != nil {
+			return
+		}
+	}
+
+	err = utils.GCIPAM(conf, args, logger)
+	return
+}
+
+func gcWorkloadEndpoints(conf types.NetConf, logger *logrus.Entry) error {
+	calicoClient, err := utils.CreateClient(conf)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+	if err := utils.CheckDatastoreReady(ctx, calicoClient); err != nil {
+		return err
+	}
+
+	nodename := utils.DetermineNodename(conf)
+	handles, err := utils.StaleHandles(ctx, calicoClient, conf, nodename)
+	if err != nil {
+		return fmt.Errorf("error listing IPAM handles for node %s: %v", nodename, err)
+	}
+
+	for _, handleID := range handles {
+		containerID := strings.TrimPrefix(handleID, conf.Name+".")
+		hLogger := logger.WithFields(logrus.Fields{"HandleID": handleID, "ContainerID": containerID})
+		if err := deleteStaleWorkloadEndpoint(ctx, calicoClient, nodename, handleID, containerID, hLogger); err != nil {
+			// Carry on; the IPAM plugin will still release the addresses.
+			hLogger.WithError(err).Warn("Failed to clean up WorkloadEndpoint for stale attachment")
+		}
+	}
+	return nil
+}
+
+// deleteStaleWorkloadEndpoint finds the Kubernetes WorkloadEndpoint that was using the given IPAM handle and
+// deletes it, as long as it still belongs to the stale container.
+func deleteStaleWorkloadEndpoint(
+	ctx context.Context,
+	c clientv3.Interface,
+	nodename, handleID, containerID string,
+	logger *logrus.Entry,
+) error {
+	ips, err := c.IPAM().IPsByHandle(ctx, handleID)
+	if err != nil || len(ips) == 0 {
+		return err
+	}
+	attrs, _, err := c.IPAM().GetAssignmentAttributes(ctx, ips[0])
+	if err != nil {
+		return err
+	}
+	pod, namespace := attrs[calicoipam.AttributePod], attrs[calicoipam.AttributeNamespace]
+	if pod == "" || namespace == "" {
+		logger.Debug("Stale attachment isn't a Kubernetes pod, no WorkloadEndpoint to clean up")
+		return nil
+	}
+
+	// The pod may have more than one Calico interface, so find the endpoint that holds the stale
+	// address rather than assuming it's the primary interface.
+	wepIDs := names.WorkloadEndpointIdentifiers{
+		Node:         nodename,
+		Orchestrator: api.OrchestratorKubernetes,
+		Pod:          pod,
+	}
+	wepPrefix, err := wepIDs.CalculateWorkloadEndpointName(true)
+	if err != nil {
+		return err
+	}
+	ctx = resources.ContextWithWorkloadEndpointListMode(ctx, resources.WorkloadEndpointListModeForceGet)
+	weps, err := c.WorkloadEndpoints().List(ctx, options.ListOptions{
+		Name:      wepPrefix,
+		Namespace: namespace,
+		Prefix:    true,
+	})
+	if err != nil {
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+			return nil
+		}
+		return err
+	}
+
+	for _, wep := range weps.Items {
+		if match, err := wepIDs.NameMatches(wep.Name); err != nil || !match {
+			continue
+		}
+		if !wepHasIP(&wep, ips[0].IP) {
+			continue
+		}
+		if wep.Spec.ContainerID != containerID {
+			// The pod has since been given a new sandbox, leave its endpoint alone.
+			logger.WithField("WorkloadEndpoint", wep.Name).Debug("WorkloadEndpoint belongs to a different container")
+			return nil
+		}
+
+		logger.WithField("WorkloadEndpoint", wep.Name).Info("Deleting WorkloadEndpoint for stale attachment")
+		_, err = c.WorkloadEndpoints().Delete(ctx, namespace, wep.Name, options.DeleteOptions{
+			ResourceVersion: wep.ResourceVersion,
+			UID:             &wep.UID,
+		})
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// wepHasIP returns true if the given IP is one of the WorkloadEndpoint's addresses.
+func wepHasIP(wep *libapi.WorkloadEndpoint, ip net.IP) bool {
+	for _, ipNet := range wep.Spec.IPNetworks {
+		_, cidr, err := net.ParseCIDR(ipNet)
+		if err == nil && cidr.IP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// cmdStatus reports whether the plugin is able to service ADD requests.  We report not-ready if
+// calico/node hasn't started on this node or if the datastore isn't reachable and ready.
+func cmdStatus(args *skel.CmdArgs) error {
+	conf := types.NetConf{}
+	if err := json.Unmarshal(args.StdinData, &conf); err != nil {
+		return fmt.Errorf("failed to load netconf: %v", err)
+	}
+
+	utils.ConfigureLogging(conf)
+	logger := logrus.WithField("Network", conf.Name)
+
+	if err := checkStatus(conf); err != nil {
+		logger.WithError(err).Warn("Calico CNI is not available")
+		return cnitypes.NewError(utils.ErrPluginNotAvailable, "Calico CNI is not available", err.Error())
+	}
+	return utils.StatusIPAM(conf, args, logger)
+}
+
+func checkStatus(conf types.NetConf) error {
+	nodeNameFile := "/var/lib/calico/nodename"
+	if conf.NodenameFile != "" {
+		nodeNameFile = conf.NodenameFile
+	}
+	if !conf.NodenameFileOptional {
+		if _, err := os.Stat(nodeNameFile); err != nil {
+			return fmt.Errorf("%s: check that the calico/node container is running and has mounted /var/lib/calico/", err)
+		}
+	}
+
+	calicoClient, err := utils.CreateClient(conf)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testConnectionTimeout)
+	defer cancel()
+	return utils.CheckDatastoreReady(ctx, calicoClient)
+}
+
 func cmdDummyCheck(args *skel.CmdArgs) (err error) {
 	fmt.Println("OK")
 	return nil
@@ -764,11 +963,13 @@ func Main(version string) {
 	}
 
 	funcs := skel.CNIFuncs{
-		Add:   cmdAdd,
-		Del:   cmdDel,
-		Check: cmdDummyCheck,
+		Add:    cmdAdd,
+		Del:    cmdDel,
+		Check:  cmdDummyCheck,
+		GC:     cmdGC,
+		Status: cmdStatus,
 	}
 	skel.PluginMainFuncs(funcs,
-		cniSpecVersion.PluginSupports("0.1.0", "0.2.0", "0.3.0", "0.3.1", "0.4.0", "1.0.0"),
+		cniSpecVersion.PluginSupports("0.1.0", "0.2.0", "0.3.0", "0.3.1", "0.4.0", "1.0.0", "1.1.0"),
 		"Calico CNI plugin "+version)
 }
@@ -104,6 +104,11 @@ type NetConf struct {
 	IncludeDefaultRoutes bool                   `json:"include_default_routes,omitempty"`
 	DataplaneOptions     map[string]interface{} `json:"dataplane_options,omitempty"`
 
+	// PrimaryInterface is the pod-side name of the interface of the pod's primary network.  Calico
+	// attachments to other interfaces, for example those added by Multus, are treated as secondary.
+	// Default: eth0.
+	PrimaryInterface string `json:"primary_interface,omitempty"`
+
 	// Windows-specific configuration.
 	// WindowsPodDeletionTimestampTimeout defines number of seconds before a pod deletion timestamp timeout and
 	// should be removed from registry. Default: 600 seconds
@@ -141,6 +146,10 @@ type NetConf struct {
 	// Default: /var/run/calico/endpoint-status
 	EndpointStatusDir string `json:"endpoint_status_dir,omitempty"`
 
+	// ValidAttachments is only supplied on a GC call.  It lists the attachments that the
+	// runtime still knows about; any others belonging to this network may be cleaned up.
+	ValidAttachments []types.GCAttachment `json:"cni.dev/valid-attachments,omitempty"`
+
 	// Options below here are deprecated.
 	EtcdAuthority string `json:"etcd_authority"`
 	Hostname      string `json:"hostname"`
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/containernetworking/cni/pkg/types"
 	"github.com/google/uuid"
@@ -482,4 +483,101 @@ var _ = Describe("Calico IPAM Tests", func() {
 			})
 		})
 	})
+
+	Describe("Run IPAM GC", func() {
+		var staleCID string
+		netconf := func(validCIDs ...string) string {
+			attachments := ""
+			for i, c := range validCIDs {
+				if i > 0 {
+					attachments += ","
+				}
+				attachments += fmt.Sprintf(`{"containerID": "%s", "ifname": "eth0"}`, c)
+			}
+			return fmt.Sprintf(`
+                    {
+                      "cniVersion": "1.1.0",
+                      "name": "net1",
+                      "type": "calico",
+                      "etcd_endpoints": "http://%s:2379",
+                      "kubernetes": {
+                        "kubeconfig": "/home/user/certs/kubeconfig"
+                      },
+                      "datastore_type": "%s",
+                      "ipam": {
+                        "type": "%s"
+                      },
+                      "cni.dev/valid-attachments": [%s]
+                    }`, os.Getenv("ETCD_IP"), os.Getenv("DATASTORE_TYPE"), plugin, attachments)
+		}
+
+		BeforeEach(func() {
+			staleCID = uuid.NewString()
+			hostname, err := names.Hostname()
+			Expect(err).NotTo(HaveOccurred())
+
+			for ip, c := range map[string]string{
+				"192.168.123.1": cid,
+				"192.168.123.2": staleCID,
+			} {
+				handleID := fmt.Sprintf("net1.%s", c)
+				err := calicoClient.IPAM().AssignIP(context.Background(), ipam.AssignIPArgs{
+					IP:       cnet.MustParseIP(ip),
+					HandleID: &handleID,
+					Attrs:    map[string]string{ipam.AttributeNode: hostname},
+					Hostname: hostname,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		It("should release addresses for attachments that are no longer valid", func() {
+			_, e, rc := testutils.RunIPAMPlugin(netconf(cid), "GC", "", "", "1.1.0")
+			Expect(e).To(Equal(types.Error{}))
+			Expect(rc).To(Equal(0))
+
+			ctx := context.Background()
+			ips, err := calicoClient.IPAM().IPsByHandle(ctx, fmt.Sprintf("net1.%s", cid))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ips).To(HaveLen(1))
+
+			_, err = calicoClient.IPAM().IPsByHandle(ctx, fmt.Sprintf("net1.%s", staleCID))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not release addresses belonging to other networks", func() {
+			_, e, rc := testutils.RunIPAMPlugin(strings.Replace(netconf(), `"net1"`, `"net2"`, 1), "GC", "", "", "1.1.0")
+			Expect(e).To(Equal(types.Error{}))
+			Expect(rc).To(Equal(0))
+
+			ctx := context.Background()
+			for _, c := range []string{cid, staleCID} {
+				ips, err := calicoClient.IPAM().IPsByHandle(ctx, fmt.Sprintf("net1.%s", c))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ips).To(HaveLen(1))
+			}
+		})
+	})
+
+	Describe("Run IPAM STATUS", func() {
+		It("should report that the plugin is available", func() {
+			netconf := fmt.Sprintf(`
+                    {
+                      "cniVersion": "1.1.0",
+                      "name": "net1",
+                      "type": "calico",
+                      "etcd_endpoints": "http://%s:2379",
+                      "kubernetes": {
+                        "kubeconfig": "/home/user/certs/kubeconfig"
+                      },
+                      "datastore_type": "%s",
+                      "ipam": {
+                        "type": "%s"
+                      }
+                    }`, os.Getenv("ETCD_IP"), os.Getenv("DATASTORE_TYPE"), plugin)
+			_, e, rc := testutils.RunIPAMPlugin(netconf, "STATUS", "", "", "1.1.0")
+			Expect(e).To(Equal(types.Error{}))
+			Expect(rc).To(Equal(0))
+		})
+	})
 })
@@ -0,0 +1,173 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package calico
+
+import (
+	"encoding/json"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+// bfdTimers holds the settings of BIRD's BFD protocol.  BIRD applies them to all of a node's BFD
+// sessions, so they are computed per node rather than per peering.  Zero values mean that the
+// BIRD default is used.
+type bfdTimers struct {
+	MinRxMillis int64 `json:"min_rx_ms,omitempty"`
+	MinTxMillis int64 `json:"min_tx_ms,omitempty"`
+	Multiplier  int32 `json:"multiplier,omitempty"`
+}
+
+// bfdTimersFromConfig returns the timers set in a BFD configuration, or nil if none are set.
+func bfdTimersFromConfig(cfg *apiv3.BFDConfiguration) *bfdTimers {
+	if cfg == nil {
+		return nil
+	}
+	t := &bfdTimers{}
+	if cfg.MinRxInterval != nil {
+		t.MinRxMillis = cfg.MinRxInterval.Milliseconds()
+	}
+	if cfg.MinTxInterval != nil {
+		t.MinTxMillis = cfg.MinTxInterval.Milliseconds()
+	}
+	if cfg.Multiplier != nil {
+		t.Multiplier = *cfg.Multiplier
+	}
+	if *t == (bfdTimers{}) {
+		return nil
+	}
+	return t
+}
+
+// override returns a copy of t with the fields that are set in o replaced.
+func (t bfdTimers) override(o *bfdTimers) bfdTimers {
+	if o == nil {
+		return t
+	}
+	if o.MinRxMillis != 0 {
+		t.MinRxMillis = o.MinRxMillis
+	}
+	if o.MinTxMillis != 0 {
+		t.MinTxMillis = o.MinTxMillis
+	}
+	if o.Multiplier != 0 {
+		t.Multiplier = o.Multiplier
+	}
+	return t
+}
+
+// merge returns a copy of t that also satisfies the timers requested by a peering, by taking the
+// shortest intervals and the smallest multiplier.
+func (t bfdTimers) merge(o *bfdTimers) bfdTimers {
+	if o == nil {
+		return t
+	}
+	if o.MinRxMillis != 0 && (t.MinRxMillis == 0 || o.MinRxMillis < t.MinRxMillis) {
+		t.MinRxMillis = o.MinRxMillis
+	}
+	if o.MinTxMillis != 0 && (t.MinTxMillis == 0 || o.MinTxMillis < t.MinTxMillis) {
+		t.MinTxMillis = o.MinTxMillis
+	}
+	if o.Multiplier != 0 && (t.Multiplier == 0 || o.Multiplier < t.Multiplier) {
+		t.Multiplier = o.Multiplier
+	}
+	return t
+}
+
+// globalBFDConfig returns the BFD configuration of the default BGPConfiguration.
+func (c *client) globalBFDConfig() *apiv3.BFDConfiguration {
+	if c.globalBGPConfig == nil {
+		return nil
+	}
+	return c.globalBGPConfig.Spec.BFD
+}
+
+// bfdEnabledForPeer returns whether BFD should be used for the peerings generated by a BGPPeer.
+func (c *client) bfdEnabledForPeer(v3res *apiv3.BGPPeer) bool {
+	if v3res.Spec.BFD != nil && v3res.Spec.BFD.Enabled != nil {
+		return *v3res.Spec.BFD.Enabled
+	}
+	if cfg := c.globalBFDConfig(); cfg != nil && cfg.Enabled != nil {
+		return *cfg.Enabled
+	}
+	return false
+}
+
+// bfdTimerTracker accumulates the BFD timers requested by the peerings of each node.
+type bfdTimerTracker struct {
+	inUse  bool
+	global *bfdTimers
+	nodes  map[string]*bfdTimers
+}
+
+func newBFDTimerTracker() *bfdTimerTracker {
+	return &bfdTimerTracker{nodes: map[string]*bfdTimers{}}
+}
+
+// add records that a peering uses BFD with the given timers.
+func (b *bfdTimerTracker) add(key model.Key, timers *bfdTimers) {
+	b.inUse = true
+	if timers == nil {
+		return
+	}
+	switch k := key.(type) {
+	case model.GlobalBGPPeerKey:
+		merged := bfdTimers{}.merge(b.global).merge(timers)
+		b.global = &merged
+	case model.NodeBGPPeerKey:
+		merged := bfdTimers{}.merge(b.nodes[k.Nodename]).merge(timers)
+		b.nodes[k.Nodename] = &merged
+	}
+}
+
+// emitBFDTimers adds the v1 BFD keys to the given map of v1 peering keys and values.  The global key holds
+// the BFD timers for nodes without their own timers; a node key is only emitted for nodes whose
+// node-specific BGPConfiguration or peerings set BFD timers.
+func (c *client) emitBFDTimers(b *bfdTimerTracker, peersV1 map[string]string) {
+	globalCfg := c.globalBFDConfig()
+	if !b.inUse && (globalCfg == nil || globalCfg.Enabled == nil || !*globalCfg.Enabled) {
+		return
+	}
+
+	emit := func(key model.Key, timers bfdTimers) {
+		k, err := model.KeyToDefaultPath(key)
+		if err != nil {
+			log.WithError(err).Errorf("Unable to create path from Key %v", key)
+			return
+		}
+		value, err := json.Marshal(timers)
+		if err != nil {
+			log.WithError(err).Errorf("Unable to serialize BFD timers %v", timers)
+			return
+		}
+		peersV1[k] = string(value)
+	}
+
+	global := bfdTimers{}.override(bfdTimersFromConfig(globalCfg))
+	emit(model.GlobalBGPConfigKey{Name: "bfd"}, global.merge(b.global))
+
+	nodes := map[string]struct{}{}
+	for nodeName := range b.nodes {
+		nodes[nodeName] = struct{}{}
+	}
+	for nodeName := range c.nodeBFDConfigs {
+		nodes[nodeName] = struct{}{}
+	}
+	for nodeName := range nodes {
+		node := global.override(bfdTimersFromConfig(c.nodeBFDConfigs[nodeName]))
+		emit(model.NodeBGPConfigKey{Nodename: nodeName, Name: "bfd"}, node.merge(b.global).merge(b.nodes[nodeName]))
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package calico
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+var _ = Describe("BFD timers", func() {
+	var c *client
+
+	duration := func(d time.Duration) *metav1.Duration {
+		return &metav1.Duration{Duration: d}
+	}
+	enabled := true
+	multiplier := int32(3)
+
+	BeforeEach(func() {
+		c = &client{
+			globalBGPConfig: &apiv3.BGPConfiguration{
+				Spec: apiv3.BGPConfigurationSpec{
+					BFD: &apiv3.BFDConfiguration{
+						Enabled:       &enabled,
+						MinRxInterval: duration(300 * time.Millisecond),
+						MinTxInterval: duration(300 * time.Millisecond),
+						Multiplier:    &multiplier,
+					},
+				},
+			},
+			nodeBFDConfigs: map[string]*apiv3.BFDConfiguration{},
+		}
+	})
+
+	It("should enable BFD for peers from the global configuration unless the peer disables it", func() {
+		disabled := false
+		Expect(c.bfdEnabledForPeer(&apiv3.BGPPeer{})).To(BeTrue())
+		Expect(c.bfdEnabledForPeer(&apiv3.BGPPeer{
+			Spec: apiv3.BGPPeerSpec{BFD: &apiv3.BFDConfiguration{Enabled: &disabled}},
+		})).To(BeFalse())
+
+		c.globalBGPConfig = nil
+		Expect(c.bfdEnabledForPeer(&apiv3.BGPPeer{})).To(BeFalse())
+	})
+
+	It("should emit only the global timers when no node overrides them", func() {
+		peers := map[string]string{}
+		c.emitBFDTimers(newBFDTimerTracker(), peers)
+		Expect(peers).To(Equal(map[string]string{
+			"/calico/bgp/v1/global/bfd": `{"min_rx_ms":300,"min_tx_ms":300,"multiplier":3}`,
+		}))
+	})
+
+	It("should merge peer timers into the node configuration", func() {
+		nodeMultiplier := int32(5)
+		c.nodeBFDConfigs["node-1"] = &apiv3.BFDConfiguration{Multiplier: &nodeMultiplier}
+
+		b := newBFDTimerTracker()
+		b.add(model.GlobalBGPPeerKey{}, &bfdTimers{MinTxMillis: 100})
+		b.add(model.NodeBGPPeerKey{Nodename: "node-2"}, &bfdTimers{MinRxMillis: 500, Multiplier: 2})
+
+		peers := map[string]string{}
+		c.emitBFDTimers(b, peers)
+		Expect(peers).To(Equal(map[string]string{
+			"/calico/bgp/v1/global/bfd":      `{"min_rx_ms":300,"min_tx_ms":100,"multiplier":3}`,
+			"/calico/bgp/v1/host/node-1/bfd": `{"min_rx_ms":300,"min_tx_ms":100,"multiplier":5}`,
+			"/calico/bgp/v1/host/node-2/bfd": `{"min_rx_ms":300,"min_tx_ms":100,"multiplier":2}`,
+		}))
+	})
+
+	It("should not emit BFD keys when BFD is not in use", func() {
+		c.globalBGPConfig = nil
+		peers := map[string]string{}
+		c.emitBFDTimers(newBFDTimerTracker(), peers)
+		Expect(peers).To(BeEmpty())
+	})
+})
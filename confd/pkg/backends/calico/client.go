@@ -109,31 +109,45 @@ func NewCalicoClient(confdConfig *config.Config) (*client, error) {
 	// not.  If it is we need to monitor all node configuration.  If it is not enabled then we
 	// only need to monitor our own node.  If this setting changes, we terminate confd (so that
 	// when restarted it will start watching the correct resources).
-	cc, err := clientv3.New(*clientCfg)
-	if err != nil {
-		log.Errorf("Failed to create main Calico client: %v", err)
-		return nil, err
-	}
-	cfg, err := cc.BGPConfigurations().Get(
-		context.Background(),
-		globalConfigName,
-		options.GetOptions{},
-	)
-	if _, ok := err.(lerr.ErrorResourceDoesNotExist); err != nil && !ok {
-		// Failed to get the BGP configuration (and not because it doesn't exist).
-		// Exit.
-		log.Errorf("Failed to query current BGP settings: %v", err)
-		return nil, err
+	//
+	// When rendering from a snapshot, we don't connect to the datastore at all; the BGP
+	// configuration and the syncer's KVs all come from the snapshot.
+	var bc api.Client
+	var cfg *apiv3.BGPConfiguration
+	var snapshotUpdates []api.Update
+	if confdConfig.Snapshot != "" {
+		if snapshotUpdates, err = loadSnapshot(confdConfig.Snapshot); err != nil {
+			log.Errorf("Failed to load snapshot: %v", err)
+			return nil, err
+		}
+		cfg = bgpConfigFromSnapshot(snapshotUpdates)
+	} else {
+		cc, err := clientv3.New(*clientCfg)
+		if err != nil {
+			log.Errorf("Failed to create main Calico client: %v", err)
+			return nil, err
+		}
+		cfg, err = cc.BGPConfigurations().Get(
+			context.Background(),
+			globalConfigName,
+			options.GetOptions{},
+		)
+		if _, ok := err.(lerr.ErrorResourceDoesNotExist); err != nil && !ok {
+			// Failed to get the BGP configuration (and not because it doesn't exist).
+			// Exit.
+			log.Errorf("Failed to query current BGP settings: %v", err)
+			return nil, err
+		}
+
+		// We know the v2 client implements the backendClientAccessor interface.  Use it to
+		// get the backend client.
+		bc = cc.(backendClientAccessor).Backend()
 	}
 	nodeMeshEnabled := true
 	if cfg != nil && cfg.Spec.NodeToNodeMeshEnabled != nil {
 		nodeMeshEnabled = *cfg.Spec.NodeToNodeMeshEnabled
 	}
 
-	// We know the v2 client implements the backendClientAccessor interface.  Use it to
-	// get the backend client.
-	bc := cc.(backendClientAccessor).Backend()
-
 	// Create the client.  Initialize the cache revision to 1 so that the watcher
 	// code can handle the first iteration by always rendering.
 	c := &client{
@@ -147,6 +161,7 @@ func NewCalicoClient(confdConfig *config.Config) (*client, error) {
 		bgpPeers:                make(map[string]*apiv3.BGPPeer),
 		sourceReady:             make(map[string]bool),
 		nodeListenPorts:         make(map[string]uint16),
+		nodeBFDConfigs:          make(map[string]*apiv3.BFDConfiguration),
 		globalBGPConfig:         cfg,
 		nodeIPs:                 make(map[string]struct{}),
 		programmedRouteRefCount: make(map[string]int),
@@ -240,7 +255,11 @@ func NewCalicoClient(confdConfig *config.Config) (*client, error) {
 	// confd process.
 	c.nodeLogKey = fmt.Sprintf("/calico/bgp/v1/host/%s/loglevel", template.NodeName)
 	c.nodeV1Processor = updateprocessors.NewBGPNodeUpdateProcessor(clientCfg.Spec.K8sUsePodCIDR)
-	if syncclientutils.MustStartSyncerClientIfTyphaConfigured(
+	if confdConfig.Snapshot != "" {
+		log.WithField("snapshot", confdConfig.Snapshot).Info("Using snapshot in place of syncer")
+		c.syncer = &snapshotSyncer{updates: snapshotUpdates, callbacks: c}
+		c.syncer.Start()
+	} else if syncclientutils.MustStartSyncerClientIfTyphaConfigured(
 		&confdConfig.Typha, syncproto.SyncerTypeBGP,
 		buildinfo.Version, template.NodeName, fmt.Sprintf("confd %s", buildinfo.Version),
 		c,
@@ -253,7 +272,11 @@ func NewCalicoClient(confdConfig *config.Config) (*client, error) {
 		c.syncer.Start()
 	}
 
-	if len(clusterCIDRs) != 0 || len(externalCIDRs) != 0 || len(lbCIDRs) != 0 {
+	if confdConfig.Snapshot != "" {
+		// The route generator watches Services and Endpoints, which aren't in the snapshot.
+		log.Info("Service advertisement routes are not rendered from a snapshot")
+		c.OnSyncChange(SourceRouteGenerator, true)
+	} else if len(clusterCIDRs) != 0 || len(externalCIDRs) != 0 || len(lbCIDRs) != 0 {
 		// Create and start route generator, if configured to do so. This can either be through
 		// environment variable, or the data store via BGPConfiguration.
 		// We only turn it on if configured to do so, to avoid needing to watch services / endpoints.
@@ -313,6 +336,7 @@ type client struct {
 	bgpPeers         map[string]*apiv3.BGPPeer
 	globalListenPort uint16
 	nodeListenPorts  map[string]uint16
+	nodeBFDConfigs   map[string]*apiv3.BFDConfiguration
 	nodeIPs          map[string]struct{}
 
 	// The route generator
@@ -489,6 +513,12 @@ type bgpPeer struct {
 	Filters         []string             `json:"filters"`
 	PassiveMode     bool                 `json:"passive_mode"`
 	LocalBGPPeer    bool                 `json:"local_bgp_peer"`
+	LLGRStaleTime   string               `json:"llgr_stale_time"`
+	BFD             bool                 `json:"bfd"`
+
+	// BFD timers requested by this peering.  These are applied per node, so are not part of
+	// the peering's v1 value.
+	bfdTimers *bfdTimers
 }
 
 type bgpPrefix struct {
@@ -515,6 +545,9 @@ func (c *client) updatePeersV1() {
 	// value form as c.peeringCache.
 	peersV1 := make(map[string]string)
 
+	// The BFD timers requested by the peerings that use BFD.
+	bfd := newBFDTimerTracker()
+
 	// Common subroutine for emitting both global and node-specific peerings.
 	emit := func(key model.Key, peer *bgpPeer) {
 		log.WithFields(log.Fields{"key": key, "peer": peer}).Debug("Maybe emit peering")
@@ -554,6 +587,9 @@ func (c *client) updatePeersV1() {
 			return
 		}
 		peersV1[k] = string(value)
+		if peer.BFD {
+			bfd.add(key, peer.bfdTimers)
+		}
 	}
 
 	// Loop through v3 BGPPeers twice, first to emit global peerings, then for
@@ -748,6 +784,9 @@ func (c *client) updatePeersV1() {
 		}
 	}
 
+	// Add the per-node BFD timers, which depend on the peerings.
+	c.emitBFDTimers(bfd, peersV1)
+
 	// Now reconcile against the cache.
 	for k, value := range c.peeringCache {
 		newValue, ok := peersV1[k]
@@ -1177,11 +1216,17 @@ func (c *client) updateBGPConfigCache(resName string, v3res *apiv3.BGPConfigurat
 		c.getNodeToNodeMeshKVPair(v3res, model.GlobalBGPConfigKey{})
 		c.getLogSeverityKVPair(v3res, model.GlobalBGPConfigKey{})
 		c.getNodeMeshRestartTimeKVPair(v3res, model.GlobalBGPConfigKey{})
+		c.getNodeMeshLongLivedStaleTimeKVPair(v3res, model.GlobalBGPConfigKey{})
+		c.getNodeMeshBFDKVPair(v3res, model.GlobalBGPConfigKey{})
 		c.getNodeMeshPasswordKVPair(v3res, model.GlobalBGPConfigKey{})
 		c.getIgnoredInterfacesKVPair(v3res, model.GlobalBGPConfigKey{})
 
 		// Cache the updated BGP configuration
 		c.globalBGPConfig = v3res
+
+		// BFD for explicit peerings defaults to the global setting.
+		*updatePeersV1 = true
+		*updateReasons = append(*updateReasons, "Global BGP configuration updated.")
 	} else if strings.HasPrefix(resName, perNodeConfigNamePrefix) {
 		// The name of a configuration resource has a strict format.  It is either "default"
 		// for the global default values, or "node.<nodename>" for the node specific vales.
@@ -1189,6 +1234,15 @@ func (c *client) updateBGPConfigCache(resName string, v3res *apiv3.BGPConfigurat
 		c.getPrefixAdvertisementsKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName})
 		c.getListenPortKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName}, updatePeersV1, updateReasons)
 		c.getLogSeverityKVPair(v3res, model.NodeBGPConfigKey{Nodename: nodeName})
+
+		// Node-specific BFD timers are emitted along with the peerings.
+		if v3res != nil && v3res.Spec.BFD != nil {
+			c.nodeBFDConfigs[nodeName] = v3res.Spec.BFD
+		} else {
+			delete(c.nodeBFDConfigs, nodeName)
+		}
+		*updatePeersV1 = true
+		*updateReasons = append(*updateReasons, "Node BGP configuration updated.")
 	} else {
 		log.Warningf("Bad value for BGPConfiguration resource name: %s.", resName)
 	}
@@ -1439,6 +1493,27 @@ func (c *client) getNodeMeshRestartTimeKVPair(v3res *apiv3.BGPConfiguration, key
 	}
 }
 
+func (c *client) getNodeMeshLongLivedStaleTimeKVPair(v3res *apiv3.BGPConfiguration, key interface{}) {
+	meshStaleTimeKey := getBGPConfigKey("node_mesh_llgr_stale_time", key)
+
+	if v3res != nil && v3res.Spec.NodeMeshLongLivedStaleTime != nil {
+		staleTime := *v3res.Spec.NodeMeshLongLivedStaleTime
+		c.updateCache(api.UpdateTypeKVUpdated, getKVPair(meshStaleTimeKey, fmt.Sprintf("%v", int(math.Round(staleTime.Duration.Seconds())))))
+	} else {
+		c.updateCache(api.UpdateTypeKVDeleted, getKVPair(meshStaleTimeKey))
+	}
+}
+
+func (c *client) getNodeMeshBFDKVPair(v3res *apiv3.BGPConfiguration, key interface{}) {
+	meshBFDKey := getBGPConfigKey("node_mesh_bfd", key)
+
+	if v3res != nil && v3res.Spec.BFD != nil && v3res.Spec.BFD.Enabled != nil && *v3res.Spec.BFD.Enabled {
+		c.updateCache(api.UpdateTypeKVUpdated, getKVPair(meshBFDKey, "true"))
+	} else {
+		c.updateCache(api.UpdateTypeKVDeleted, getKVPair(meshBFDKey))
+	}
+}
+
 func (c *client) getNodeMeshPasswordKVPair(v3res *apiv3.BGPConfiguration, key interface{}) {
 	meshPasswordKey := getBGPConfigKey("node_mesh_password", key)
 
@@ -1929,6 +2004,13 @@ func (c *client) setPeerConfigFieldsFromV3Resource(peers []*bgpPeer, v3res *apiv
 		if v3res.Spec.MaxRestartTime != nil {
 			peer.RestartTime = fmt.Sprintf("%v", int(math.Round(v3res.Spec.MaxRestartTime.Duration.Seconds())))
 		}
+		if v3res.Spec.LongLivedStaleTime != nil {
+			peer.LLGRStaleTime = fmt.Sprintf("%v", int(math.Round(v3res.Spec.LongLivedStaleTime.Duration.Seconds())))
+		}
+		peer.BFD = c.bfdEnabledForPeer(v3res)
+		if peer.BFD {
+			peer.bfdTimers = bfdTimersFromConfig(v3res.Spec.BFD)
+		}
 	}
 }
 
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package calico
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+// loadSnapshot reads a snapshot of the BGP syncer's KVs from the given file, in the format that
+// is written by "typha-client snapshot --type=bgp": a YAML or JSON map from the default path of
+// each key to its value.  It returns the KVs as updates, in key order.
+func loadSnapshot(filename string) ([]api.Update, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", filename, err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(jsonData, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", filename, err)
+	}
+
+	paths := make([]string, 0, len(raw))
+	for p := range raw {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var updates []api.Update
+	for _, p := range paths {
+		key := model.KeyFromDefaultPath(p)
+		if key == nil {
+			log.WithField("key", p).Warn("Ignoring unknown key in snapshot")
+			continue
+		}
+		value, err := parseSnapshotValue(key, raw[p])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse value of %s in snapshot: %w", p, err)
+		}
+		updates = append(updates, api.Update{
+			KVPair:     model.KVPair{Key: key, Value: value},
+			UpdateType: api.UpdateTypeKVNew,
+		})
+	}
+	return updates, nil
+}
+
+// parseSnapshotValue parses a value from a snapshot.  Most values are JSON objects, but values
+// that are not serialised as JSON, such as IP addresses, are written to the snapshot as strings.
+func parseSnapshotValue(key model.Key, raw json.RawMessage) (interface{}, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if value, err := model.ParseValue(key, []byte(s)); err == nil && value != nil {
+			return value, nil
+		}
+	}
+	return model.ParseValue(key, raw)
+}
+
+// bgpConfigFromSnapshot returns the default BGPConfiguration from the given snapshot updates, or
+// nil if there isn't one.
+func bgpConfigFromSnapshot(updates []api.Update) *apiv3.BGPConfiguration {
+	for _, u := range updates {
+		if k, ok := u.Key.(model.ResourceKey); ok && k.Kind == apiv3.KindBGPConfiguration && k.Name == globalConfigName {
+			cfg, _ := u.Value.(*apiv3.BGPConfiguration)
+			return cfg
+		}
+	}
+	return nil
+}
+
+// snapshotSyncer is an api.Syncer that replays the KVs from a snapshot in place of the BGP syncer,
+// so that the templates can be rendered without access to the datastore.
+type snapshotSyncer struct {
+	updates   []api.Update
+	callbacks api.SyncerCallbacks
+}
+
+func (s *snapshotSyncer) Start() {
+	go func() {
+		s.callbacks.OnStatusUpdated(api.ResyncInProgress)
+		s.callbacks.OnUpdates(s.updates)
+		s.callbacks.OnStatusUpdated(api.InSync)
+	}()
+}
+
+func (s *snapshotSyncer) Stop() {}
@@ -0,0 +1,138 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package calico
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+const testSnapshot = `
+/calico/resources/v3/projectcalico.org/bgpconfigurations/default:
+  apiVersion: projectcalico.org/v3
+  kind: BGPConfiguration
+  metadata:
+    name: default
+  spec:
+    asNumber: 65001
+    nodeToNodeMeshEnabled: false
+/calico/resources/v3/projectcalico.org/nodes/node1:
+  apiVersion: projectcalico.org/v3
+  kind: Node
+  metadata:
+    name: node1
+  spec:
+    bgp:
+      ipv4Address: 10.0.0.1/24
+/calico/ipam/v2/host/node1/ipv4/block/192.168.0.0-26:
+  state: confirmed
+/calico/unknown/key/format: foo
+`
+
+// recordingCallbacks is a SyncerCallbacks that records the statuses and updates that it receives.
+type recordingCallbacks struct {
+	lock     sync.Mutex
+	statuses []string
+	updates  []api.Update
+}
+
+func (r *recordingCallbacks) OnStatusUpdated(status api.SyncStatus) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.statuses = append(r.statuses, status.String())
+}
+
+func (r *recordingCallbacks) OnUpdates(updates []api.Update) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.updates = append(r.updates, updates...)
+}
+
+func (r *recordingCallbacks) getStatuses() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]string(nil), r.statuses...)
+}
+
+func (r *recordingCallbacks) getUpdates() []api.Update {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]api.Update(nil), r.updates...)
+}
+
+var _ = Describe("BGP syncer snapshots", func() {
+	var dir, filename string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "confd-snapshot")
+		Expect(err).NotTo(HaveOccurred())
+		filename = filepath.Join(dir, "snapshot.yaml")
+		Expect(os.WriteFile(filename, []byte(testSnapshot), 0644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should load the KVs from a snapshot", func() {
+		updates, err := loadSnapshot(filename)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updates).To(HaveLen(3))
+
+		Expect(updates[0].Key).To(Equal(model.BlockAffinityKey{
+			Host:         "node1",
+			CIDR:         cnet.MustParseCIDR("192.168.0.0/26"),
+			AffinityType: "host",
+		}))
+		Expect(updates[1].Key).To(Equal(model.ResourceKey{Kind: apiv3.KindBGPConfiguration, Name: "default"}))
+		Expect(updates[2].Key).To(Equal(model.ResourceKey{Kind: libapiv3.KindNode, Name: "node1"}))
+		Expect(updates[2].Value.(*libapiv3.Node).Spec.BGP.IPv4Address).To(Equal("10.0.0.1/24"))
+
+		cfg := bgpConfigFromSnapshot(updates)
+		Expect(cfg).NotTo(BeNil())
+		Expect(*cfg.Spec.NodeToNodeMeshEnabled).To(BeFalse())
+		Expect(cfg.Spec.ASNumber.String()).To(Equal("65001"))
+	})
+
+	It("should return nil if the snapshot has no BGP configuration", func() {
+		Expect(bgpConfigFromSnapshot(nil)).To(BeNil())
+	})
+
+	It("should fail on a value that doesn't match its key", func() {
+		Expect(os.WriteFile(filename, []byte("/calico/resources/v3/projectcalico.org/nodes/node1: [1, 2]\n"), 0644)).To(Succeed())
+		_, err := loadSnapshot(filename)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should replay the snapshot and then report in sync", func() {
+		updates, err := loadSnapshot(filename)
+		Expect(err).NotTo(HaveOccurred())
+		rec := &recordingCallbacks{}
+		s := &snapshotSyncer{updates: updates, callbacks: rec}
+		s.Start()
+		Eventually(rec.getStatuses).Should(Equal([]string{"resync", "in-sync"}))
+		Expect(rec.getUpdates()).To(HaveLen(3))
+	})
+})
@@ -37,6 +37,7 @@ type Config struct {
 	CalicoConfig   string `toml:"calicoconfig"`
 	Onetime        bool   `toml:"onetime"`
 	KeepStageFile  bool   `toml:"keep-stage-file"`
+	Snapshot       string `toml:"snapshot"`
 	Typha          syncclientutils.TyphaConfig
 	TemplateConfig template.Config
 }
@@ -0,0 +1,78 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// RenderResult is the result of rendering a template resource in a dry run.
+type RenderResult struct {
+	Dest     string
+	Rendered []byte
+	// Current is the current contents of Dest, or nil if it does not exist.
+	Current  []byte
+	CheckCmd string
+	// CheckOutput and CheckErr are the output and the error from the check command, if there is
+	// one.
+	CheckOutput []byte
+	CheckErr    error
+}
+
+// Render renders all of the template resources without touching their destination files.  The
+// rendered files are written to a temporary directory under the base names of their
+// destinations, so that relative includes between them resolve, and each check command is run
+// against its rendered file in that directory.
+func Render(config Config) ([]*RenderResult, error) {
+	ts, err := getTemplateResources(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := setClientPrefixes(config, ts); err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "confd-render")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.WithError(err).WithField("dir", dir).Error("error removing render directory")
+		}
+	}()
+
+	results := make([]*RenderResult, len(ts))
+	for i, t := range ts {
+		if err := t.setVars(); err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := t.render(&buf); err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", t.Dest, err)
+		}
+		r := &RenderResult{Dest: t.Dest, Rendered: buf.Bytes(), CheckCmd: t.CheckCmd}
+		if r.Current, err = os.ReadFile(t.Dest); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			r.Current = nil
+		}
+		if err := os.WriteFile(filepath.Join(dir, filepath.Base(t.Dest)), r.Rendered, 0644); err != nil {
+			return nil, err
+		}
+		results[i] = r
+	}
+
+	// Only run the checks once all of the files are rendered, since they may include each other.
+	for i, t := range ts {
+		if t.CheckCmd == "" || config.SyncOnly {
+			continue
+		}
+		results[i].CheckOutput, results[i].CheckErr = t.runCheck(filepath.Join(dir, filepath.Base(t.Dest)), dir)
+	}
+	return results, nil
+}
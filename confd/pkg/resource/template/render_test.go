@@ -0,0 +1,95 @@
+package template
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type fakeStoreClient map[string]string
+
+func (f fakeStoreClient) SetPrefixes(keys []string) error {
+	return nil
+}
+
+func (f fakeStoreClient) GetValues(keys []string) (map[string]string, error) {
+	return f, nil
+}
+
+func (f fakeStoreClient) WatchPrefix(prefix string, keys []string, waitIndex uint64, stopChan chan bool) (string, error) {
+	return "", nil
+}
+
+func (f fakeStoreClient) GetCurrentRevision() uint64 {
+	return 1
+}
+
+func writeTestFile(t *testing.T, name, contents string) {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_Render(t *testing.T) {
+	confDir := t.TempDir()
+	destDir := t.TempDir()
+	writeTestFile(t, filepath.Join(confDir, "conf.d", "main.toml"), fmt.Sprintf(`[template]
+src = "main.cfg.template"
+dest = "%s/main.cfg"
+keys = ["/global"]
+check_cmd = "grep -q 'include \"extra.cfg\"' {{.src}} && grep -q extra extra.cfg"
+`, destDir))
+	writeTestFile(t, filepath.Join(confDir, "conf.d", "extra.toml"), fmt.Sprintf(`[template]
+src = "extra.cfg.template"
+dest = "%s/extra.cfg"
+keys = ["/global"]
+check_cmd = "false"
+`, destDir))
+	writeTestFile(t, filepath.Join(confDir, "templates", "main.cfg.template"),
+		"as {{getv \"/global/as_num\"}};\ninclude \"extra.cfg\";\n")
+	writeTestFile(t, filepath.Join(confDir, "templates", "extra.cfg.template"), "# extra\n")
+	writeTestFile(t, filepath.Join(destDir, "main.cfg"), "as 64512;\n")
+
+	results, err := Render(Config{
+		ConfDir:     confDir,
+		ConfigDir:   filepath.Join(confDir, "conf.d"),
+		TemplateDir: filepath.Join(confDir, "templates"),
+		StoreClient: fakeStoreClient{"/global/as_num": "65001"},
+	})
+	if err != nil {
+		t.Fatalf("Render() returned error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Render() returned %d results, want 2", len(results))
+	}
+	byDest := map[string]*RenderResult{}
+	for _, r := range results {
+		byDest[filepath.Base(r.Dest)] = r
+	}
+
+	main := byDest["main.cfg"]
+	if string(main.Rendered) != "as 65001;\ninclude \"extra.cfg\";\n" {
+		t.Errorf("Unexpected rendered main.cfg: %q", main.Rendered)
+	}
+	if string(main.Current) != "as 64512;\n" {
+		t.Errorf("Unexpected current main.cfg: %q", main.Current)
+	}
+	if main.CheckErr != nil {
+		t.Errorf("Check of main.cfg failed: %v: %s", main.CheckErr, main.CheckOutput)
+	}
+
+	extra := byDest["extra.cfg"]
+	if extra.Current != nil {
+		t.Errorf("Expected no current extra.cfg, got %q", extra.Current)
+	}
+	if extra.CheckErr == nil {
+		t.Error("Expected check of extra.cfg to fail")
+	}
+	if _, err := os.Stat(extra.Dest); !os.IsNotExist(err) {
+		t.Errorf("Render() should not write the destination file, stat returned %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path"
@@ -165,20 +166,13 @@ func (t *TemplateResource) createStageFile() error {
 		return errors.New("Missing template: " + t.Src)
 	}
 
-	log.Debug("Compiling source template " + t.Src)
-
-	tmpl, err := template.New(filepath.Base(t.Src)).Funcs(t.funcMap).ParseFiles(t.Src)
-	if err != nil {
-		return fmt.Errorf("Unable to process template %s, %s", t.Src, err)
-	}
-
 	// create TempFile in Dest directory to avoid cross-filesystem issues
 	temp, err := os.CreateTemp(filepath.Dir(t.Dest), "."+filepath.Base(t.Dest))
 	if err != nil {
 		return err
 	}
 
-	if err = tmpl.Execute(temp, nil); err != nil {
+	if err = t.render(temp); err != nil {
 		// The key error to return is the failure to execute.
 		// to preserve that error ignore the errors in close and clean
 		temp.Close()           // nolint:errcheck
@@ -211,6 +205,18 @@ func (t *TemplateResource) createStageFile() error {
 	return nil
 }
 
+// render compiles the src template and executes it with the current vars, writing the result
+// to w.
+func (t *TemplateResource) render(w io.Writer) error {
+	log.Debug("Compiling source template " + t.Src)
+
+	tmpl, err := template.New(filepath.Base(t.Src)).Funcs(t.funcMap).ParseFiles(t.Src)
+	if err != nil {
+		return fmt.Errorf("Unable to process template %s, %s", t.Src, err)
+	}
+	return tmpl.Execute(w, nil)
+}
+
 // sync compares the staged and dest config files and attempts to sync them
 // if they differ. sync will run a config check command if set before
 // overwriting the target config file. Finally, sync will run a reload command
@@ -301,25 +307,33 @@ func (t *TemplateResource) sync(key string) error {
 // file.
 // It returns nil if the check command returns 0 and there are no other errors.
 func (t *TemplateResource) check() error {
+	_, err := t.runCheck(t.StageFile.Name(), "")
+	return err
+}
+
+// runCheck executes the check command against the given file, in the given working directory
+// (or the current directory if dir is empty), and returns its combined output.
+func (t *TemplateResource) runCheck(src, dir string) ([]byte, error) {
 	var cmdBuffer bytes.Buffer
 	data := make(map[string]string)
-	data["src"] = t.StageFile.Name()
+	data["src"] = src
 	tmpl, err := template.New("checkcmd").Parse(t.CheckCmd)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err := tmpl.Execute(&cmdBuffer, data); err != nil {
-		return err
+		return nil, err
 	}
 	log.Debug("Running checkcmd: " + cmdBuffer.String())
 	c := exec.Command(t.shellCmd, "-c", cmdBuffer.String())
+	c.Dir = dir
 	output, err := c.CombinedOutput()
 	if err != nil {
 		log.Errorf("Error from checkcmd %q: %q", cmdBuffer.String(), string(output))
-		return err
+		return output, err
 	}
 	log.Debug(fmt.Sprintf("Output from checkcmd: %q", string(output)))
-	return nil
+	return output, nil
 }
 
 // reload executes the reload command.
@@ -0,0 +1,89 @@
+package run
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/confd/pkg/backends/calico"
+	"github.com/projectcalico/calico/confd/pkg/config"
+	"github.com/projectcalico/calico/confd/pkg/resource/template"
+)
+
+// Render renders the templates once for the given node, from the datastore or, if one is
+// configured, from a snapshot, without writing them to their destinations.  For each template it
+// writes the rendered file, a diff against the current file and the result of the template's
+// check command to out.  It returns an error if rendering or any of the checks fail.
+func Render(config *config.Config, nodeName string, out io.Writer) error {
+	if nodeName != "" {
+		// The templates look up the node name from the environment as well.
+		template.NodeName = nodeName
+		if err := os.Setenv("NODENAME", nodeName); err != nil {
+			return err
+		}
+	}
+	log.WithField("node", template.NodeName).Info("Rendering confd templates")
+	storeClient, err := calico.NewCalicoClient(config)
+	if err != nil {
+		return err
+	}
+
+	results, err := template.Render(template.Config{
+		ConfDir:     config.ConfDir,
+		ConfigDir:   filepath.Join(config.ConfDir, "conf.d"),
+		Prefix:      config.Prefix,
+		SyncOnly:    config.SyncOnly,
+		TemplateDir: filepath.Join(config.ConfDir, "templates"),
+		StoreClient: storeClient,
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		_, _ = fmt.Fprintf(out, "=== %s\n", r.Dest)
+		_, _ = out.Write(r.Rendered)
+
+		_, _ = fmt.Fprintf(out, "=== diff %s\n", r.Dest)
+		if string(r.Rendered) == string(r.Current) {
+			_, _ = fmt.Fprintln(out, "No changes")
+		} else {
+			current := r.Dest
+			if r.Current == nil {
+				current = "/dev/null"
+			}
+			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(string(r.Current)),
+				B:        difflib.SplitLines(string(r.Rendered)),
+				FromFile: current,
+				ToFile:   r.Dest + " (rendered)",
+				Context:  3,
+			})
+			if err != nil {
+				return err
+			}
+			_, _ = io.WriteString(out, diff)
+		}
+
+		if r.CheckCmd == "" {
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "=== check %s\n", r.Dest)
+		_, _ = out.Write(r.CheckOutput)
+		if r.CheckErr != nil {
+			_, _ = fmt.Fprintf(out, "Check failed: %v\n", r.CheckErr)
+			failed++
+		} else {
+			_, _ = fmt.Fprintln(out, "Check passed")
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d rendered file(s) failed their check", failed)
+	}
+	return nil
+}
@@ -582,6 +582,8 @@ execute_tests_daemon() {
         run_individual_test 'mesh/static-routes-exclude-node'
         run_individual_test 'mesh/communities'
         run_individual_test 'mesh/restart-time'
+        run_individual_test 'mesh/graceful-shutdown'
+        run_individual_test 'mesh/bfd'
     done
 
     # Turn the node-mesh off.
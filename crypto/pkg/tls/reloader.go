@@ -0,0 +1,322 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultReloadInterval is how often a CertReloader checks its files for changes.  Certificates are
+// rotated well before they expire, so there's no need to notice a change immediately.
+const defaultReloadInterval = 30 * time.Second
+
+var (
+	gaugeCertExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "calico_tls_certificate_expiry_timestamp_seconds",
+		Help: "Expiry time of a loaded TLS certificate, in seconds since the epoch.  For a CA bundle, " +
+			"this is the expiry time of the first certificate in the bundle to expire.",
+	}, []string{"name", "file"})
+	counterCertReloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "calico_tls_certificate_reloads_total",
+		Help: "Number of times that changed TLS certificate files have been reloaded, by result.",
+	}, []string{"name", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(gaugeCertExpiry, counterCertReloads)
+}
+
+// CertReloader loads a certificate and key, and a CA bundle, from files and reloads them when the
+// files change, so that the certificates can be rotated without restarting the component that
+// uses them.  Either the certificate and key or the CA bundle may be omitted.
+//
+// The files are polled rather than watched because Kubernetes updates projected secrets by
+// swapping symlinks, which file watching libraries don't cope with well.  If the changed files
+// can't be loaded, for example because the certificate has been updated but the key has not yet,
+// the previous certificates remain in use until the next poll.
+type CertReloader struct {
+	name                      string
+	certFile, keyFile, caFile string
+	interval                  time.Duration
+
+	lock                   sync.RWMutex
+	certPEM, keyPEM, caPEM []byte
+	cert                   *tls.Certificate
+	caPool                 *x509.CertPool
+}
+
+type CertReloaderOption func(*CertReloader)
+
+// WithReloadInterval sets how often the files are checked for changes.
+func WithReloadInterval(interval time.Duration) CertReloaderOption {
+	return func(r *CertReloader) {
+		r.interval = interval
+	}
+}
+
+// NewCertReloader creates a CertReloader and loads the given files, returning an error if they
+// can't be loaded.  The name identifies the certificates in logs and metrics.
+func NewCertReloader(name, certFile, keyFile, caFile string, opts ...CertReloaderOption) (*CertReloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("certificate and key files must be specified together")
+	}
+	if certFile == "" && caFile == "" {
+		return nil, errors.New("no certificate or CA files specified")
+	}
+	r := &CertReloader{
+		name:     name,
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		interval: defaultReloadInterval,
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Run polls the files for changes until the context is done.
+func (r *CertReloader) Run(ctx context.Context) {
+	logCxt := log.WithField("name", r.name)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		changed, err := r.reload()
+		if err != nil {
+			logCxt.WithError(err).Warn("Failed to reload changed TLS certificates, will retry")
+			counterCertReloads.WithLabelValues(r.name, "error").Inc()
+		} else if changed {
+			logCxt.Info("Reloaded changed TLS certificates")
+			counterCertReloads.WithLabelValues(r.name, "success").Inc()
+		}
+	}
+}
+
+// reload reads the files and, if they have changed since they were last loaded, parses them and
+// replaces the current certificates.  It returns true if the certificates were replaced.
+func (r *CertReloader) reload() (bool, error) {
+	var certPEM, keyPEM, caPEM []byte
+	var err error
+	if r.certFile != "" {
+		if certPEM, err = os.ReadFile(r.certFile); err != nil {
+			return false, fmt.Errorf("failed to read certificate file: %w", err)
+		}
+		if keyPEM, err = os.ReadFile(r.keyFile); err != nil {
+			return false, fmt.Errorf("failed to read key file: %w", err)
+		}
+	}
+	if r.caFile != "" {
+		if caPEM, err = os.ReadFile(r.caFile); err != nil {
+			return false, fmt.Errorf("failed to read CA file: %w", err)
+		}
+	}
+
+	r.lock.RLock()
+	unchanged := (r.cert != nil || r.caPool != nil) &&
+		bytes.Equal(certPEM, r.certPEM) && bytes.Equal(keyPEM, r.keyPEM) && bytes.Equal(caPEM, r.caPEM)
+	r.lock.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		c, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return false, fmt.Errorf("failed to load x509 key pair from %s and %s: %w", r.certFile, r.keyFile, err)
+		}
+		if c.Leaf == nil {
+			if c.Leaf, err = x509.ParseCertificate(c.Certificate[0]); err != nil {
+				return false, fmt.Errorf("failed to parse certificate %s: %w", r.certFile, err)
+			}
+		}
+		cert = &c
+	}
+	var caPool *x509.CertPool
+	if r.caFile != "" {
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return false, fmt.Errorf("failed to add CA data from %s to pool", r.caFile)
+		}
+	}
+
+	r.lock.Lock()
+	r.certPEM, r.keyPEM, r.caPEM = certPEM, keyPEM, caPEM
+	r.cert, r.caPool = cert, caPool
+	r.lock.Unlock()
+
+	if cert != nil {
+		gaugeCertExpiry.WithLabelValues(r.name, r.certFile).Set(float64(cert.Leaf.NotAfter.Unix()))
+	}
+	if expiry, ok := earliestExpiry(caPEM); ok {
+		gaugeCertExpiry.WithLabelValues(r.name, r.caFile).Set(float64(expiry.Unix()))
+	}
+	return true, nil
+}
+
+// earliestExpiry returns the earliest expiry time of the certificates in the given PEM data.
+func earliestExpiry(data []byte) (time.Time, bool) {
+	var expiry time.Time
+	found := false
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return expiry, found
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if !found || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+			found = true
+		}
+	}
+}
+
+// Certificate returns the current certificate, or nil if the reloader has no certificate file.
+func (r *CertReloader) Certificate() *tls.Certificate {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.cert
+}
+
+// CAPool returns the current CA bundle, or nil if the reloader has no CA file.
+func (r *CertReloader) CAPool() *x509.CertPool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.caPool
+}
+
+// GetCertificate returns the current certificate.  It is intended for use as the GetCertificate
+// function of a server's tls.Config.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if cert := r.Certificate(); cert != nil {
+		return cert, nil
+	}
+	return nil, errors.New("no certificate loaded")
+}
+
+// GetClientCertificate returns the current certificate.  It is intended for use as the
+// GetClientCertificate function of a client's tls.Config.
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if cert := r.Certificate(); cert != nil {
+		return cert, nil
+	}
+	return nil, errors.New("no certificate loaded")
+}
+
+// ConfigureServer arranges for a server's tls.Config to present the current certificate and, if
+// the reloader has a CA file, to verify client certificates against the current CA bundle.
+func (r *CertReloader) ConfigureServer(cfg *tls.Config) {
+	if r.certFile != "" {
+		// GetCertificate is only used if there are no static certificates.
+		cfg.Certificates = nil
+		cfg.GetCertificate = r.GetCertificate
+	}
+	if r.caFile != "" {
+		// ClientCAs is read once per handshake from the config, so give each handshake a copy
+		// of the config with the current CA bundle.
+		cfg.ClientCAs = r.CAPool()
+		cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := cfg.Clone()
+			c.ClientCAs = r.CAPool()
+			return c, nil
+		}
+	}
+}
+
+// ConfigureClient arranges for a client's tls.Config to present the current certificate and, if the
+// reloader has a CA file, to verify the server's certificate against the current CA bundle and
+// the config's ServerName, which must be set first.  If the config already skips verification,
+// because the caller verifies the server's certificate itself, the caller should verify it
+// against CAPool().
+func (r *CertReloader) ConfigureClient(cfg *tls.Config) {
+	if r.certFile != "" {
+		cfg.Certificates = nil
+		cfg.GetClientCertificate = r.GetClientCertificate
+	}
+	if r.caFile == "" || cfg.InsecureSkipVerify {
+		return
+	}
+
+	// crypto/tls has no hook for changing RootCAs per connection, so disable its verification
+	// and do the equivalent verification ourselves.
+	serverName := cfg.ServerName
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+		if serverName == "" {
+			return errors.New("no server name to verify the server certificate against")
+		}
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("server did not present a certificate")
+		}
+		opts := x509.VerifyOptions{
+			DNSName:       serverName,
+			Roots:         r.CAPool(),
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, cert := range cs.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := cs.PeerCertificates[0].Verify(opts)
+		return err
+	}
+}
+
+// NewReloadingMutualTLSConfig is like NewMutualTLSConfig, but the returned tls.Config picks up
+// changes to the cert, key and CA files until the context is done.  The name identifies the
+// certificates in logs and metrics.
+func NewReloadingMutualTLSConfig(ctx context.Context, name, cert, key, ca string) (*tls.Config, error) {
+	tlsCfg, err := NewTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TLS Config: %w", err)
+	}
+	tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+
+	r, err := NewCertReloader(name, cert, key, ca)
+	if err != nil {
+		return nil, err
+	}
+	r.ConfigureServer(tlsCfg)
+	go r.Run(ctx)
+
+	return tlsCfg, nil
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+var testSerial int64
+
+func newTestCA() *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	testSerial++
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(testSerial),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns the PEM encoded certificate and key for a new certificate for the given DNS name.
+func (ca *testCA) issue(dnsName string, notAfter time.Time) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	testSerial++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(testSerial),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(path string, data []byte) {
+	Expect(os.WriteFile(path, data, 0600)).To(Succeed())
+}
+
+func TestCertReloaderReload(t *testing.T) {
+	RegisterTestingT(t)
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	ca := newTestCA()
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	certPEM, keyPEM := ca.issue("server", expiry)
+	writeFile(certFile, certPEM)
+	writeFile(keyFile, keyPEM)
+	writeFile(caFile, ca.pem)
+
+	r, err := NewCertReloader("test", certFile, keyFile, caFile)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := r.GetCertificate(nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cert.Leaf.NotAfter).To(BeTemporally("==", expiry))
+	Expect(r.CAPool()).NotTo(BeNil())
+
+	// Nothing changed, so nothing is reloaded.
+	changed, err := r.reload()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(changed).To(BeFalse())
+
+	// A certificate whose key hasn't been updated yet fails to load, and the previous certificate
+	// stays in use.
+	newExpiry := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	newCertPEM, newKeyPEM := ca.issue("server", newExpiry)
+	writeFile(certFile, newCertPEM)
+	_, err = r.reload()
+	Expect(err).To(HaveOccurred())
+	cert, err = r.GetClientCertificate(nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cert.Leaf.NotAfter).To(BeTemporally("==", expiry))
+
+	// Once the key is updated too, the new certificate is loaded.
+	writeFile(keyFile, newKeyPEM)
+	changed, err = r.reload()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(changed).To(BeTrue())
+	cert, err = r.GetCertificate(nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cert.Leaf.NotAfter).To(BeTemporally("==", newExpiry))
+
+	// Missing and mismatched files are rejected up front.
+	_, err = NewCertReloader("test", certFile, "", caFile)
+	Expect(err).To(HaveOccurred())
+	_, err = NewCertReloader("test", certFile, keyFile, filepath.Join(dir, "missing.crt"))
+	Expect(err).To(HaveOccurred())
+}
+
+// handshake runs a TLS handshake between the given server and client configs.
+func handshake(serverCfg, clientCfg *tls.Config) (serverErr, clientErr error) {
+	sc, cc := net.Pipe()
+	defer sc.Close()
+	defer cc.Close()
+	done := make(chan error, 1)
+	go func() {
+		s := tls.Server(sc, serverCfg)
+		err := s.Handshake()
+		if err != nil {
+			// Unblock the client.
+			_ = sc.Close()
+		}
+		done <- err
+	}()
+	c := tls.Client(cc, clientCfg)
+	clientErr = c.Handshake()
+	if clientErr != nil {
+		_ = cc.Close()
+	}
+	serverErr = <-done
+	return
+}
+
+func TestCertReloaderRotatesCA(t *testing.T) {
+	RegisterTestingT(t)
+	dir := t.TempDir()
+	serverCert, serverKey := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+	clientCert, clientKey := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	caFile := filepath.Join(dir, "ca.crt")
+
+	issueAll := func(ca *testCA) {
+		certPEM, keyPEM := ca.issue("server", time.Now().Add(time.Hour))
+		writeFile(serverCert, certPEM)
+		writeFile(serverKey, keyPEM)
+		certPEM, keyPEM = ca.issue("client", time.Now().Add(time.Hour))
+		writeFile(clientCert, certPEM)
+		writeFile(clientKey, keyPEM)
+	}
+	oldCA := newTestCA()
+	issueAll(oldCA)
+	writeFile(caFile, oldCA.pem)
+
+	serverCerts, err := NewCertReloader("server", serverCert, serverKey, caFile)
+	Expect(err).NotTo(HaveOccurred())
+	serverCfg, err := NewTLSConfig()
+	Expect(err).NotTo(HaveOccurred())
+	serverCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	serverCerts.ConfigureServer(serverCfg)
+
+	clientCerts, err := NewCertReloader("client", clientCert, clientKey, caFile)
+	Expect(err).NotTo(HaveOccurred())
+	clientCfg, err := NewTLSConfig()
+	Expect(err).NotTo(HaveOccurred())
+	clientCfg.ServerName = "server"
+	clientCerts.ConfigureClient(clientCfg)
+
+	serverErr, clientErr := handshake(serverCfg, clientCfg)
+	Expect(serverErr).NotTo(HaveOccurred())
+	Expect(clientErr).NotTo(HaveOccurred())
+
+	// Rotate to a new CA, with new certificates issued by it.  Until the server has reloaded, the
+	// client doesn't trust its certificate.
+	newCA := newTestCA()
+	issueAll(newCA)
+	writeFile(caFile, newCA.pem)
+	_, err = clientCerts.reload()
+	Expect(err).NotTo(HaveOccurred())
+	_, clientErr = handshake(serverCfg, clientCfg)
+	Expect(clientErr).To(HaveOccurred())
+
+	_, err = serverCerts.reload()
+	Expect(err).NotTo(HaveOccurred())
+	serverErr, clientErr = handshake(serverCfg, clientCfg)
+	Expect(serverErr).NotTo(HaveOccurred())
+	Expect(clientErr).NotTo(HaveOccurred())
+
+	// The client checks the server name.
+	clientCfg, err = NewTLSConfig()
+	Expect(err).NotTo(HaveOccurred())
+	clientCfg.ServerName = "other"
+	clientCerts.ConfigureClient(clientCfg)
+	_, clientErr = handshake(serverCfg, clientCfg)
+	Expect(clientErr).To(HaveOccurred())
+}
@@ -0,0 +1,425 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autohep creates and maintains HostEndpoints for the interfaces of Felix's own host, from
+// the HostEndpointTemplates in the FelixConfiguration.  It fills the same role as the host endpoint
+// controller in kube-controllers, for hosts that are not Kubernetes nodes.
+package autohep
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/projectcalico/calico/felix/dispatcher"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+const (
+	// CreatedByLabelKey and CreatedByLabelValue mark the HostEndpoints that Felix owns.  Felix never
+	// touches HostEndpoints without this label.
+	CreatedByLabelKey   = "projectcalico.org/created-by"
+	CreatedByLabelValue = "calico-felix"
+
+	defaultInterval = 10 * time.Second
+)
+
+// ErrNotInSync is returned by Reconcile until the Controller has received the current HostEndpoints
+// from Felix's syncer.
+var ErrNotInSync = errors.New("generated HostEndpoint cache is not in sync with the datastore")
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// Interface is a network interface of the host, along with its addresses.
+type Interface struct {
+	Name  string
+	Addrs []net.IP
+}
+
+// HostEndpointClient is the subset of the HostEndpoint client that the Controller uses.  The
+// Controller never lists HostEndpoints; it learns about them from syncer updates instead, and
+// only gets a HostEndpoint when it needs to update it.
+type HostEndpointClient interface {
+	Get(ctx context.Context, name string, opts options.GetOptions) (*apiv3.HostEndpoint, error)
+	Create(ctx context.Context, res *apiv3.HostEndpoint, opts options.SetOptions) (*apiv3.HostEndpoint, error)
+	Update(ctx context.Context, res *apiv3.HostEndpoint, opts options.SetOptions) (*apiv3.HostEndpoint, error)
+	Delete(ctx context.Context, name string, opts options.DeleteOptions) (*apiv3.HostEndpoint, error)
+}
+
+type template struct {
+	apiv3.HostEndpointTemplate
+	ifaceRegexp *regexp.Regexp
+}
+
+// Controller periodically reconciles the HostEndpoints that it owns for its host with the host's
+// interfaces.  It keeps track of those HostEndpoints from the updates that Felix's syncer already
+// sends to the calculation graph, so it doesn't need its own watch on the datastore.
+type Controller struct {
+	hostname       string
+	templates      []template
+	client         HostEndpointClient
+	listInterfaces func() ([]Interface, error)
+	interval       time.Duration
+
+	lock   sync.Mutex
+	inSync bool
+	owned  map[string]*apiv3.HostEndpoint
+}
+
+type Option func(*Controller)
+
+// WithInterfaceLister overrides the function used to list the host's interfaces.
+func WithInterfaceLister(f func() ([]Interface, error)) Option {
+	return func(c *Controller) {
+		c.listInterfaces = f
+	}
+}
+
+// WithInterval sets the interval at which the controller rescans the host's interfaces.
+func WithInterval(interval time.Duration) Option {
+	return func(c *Controller) {
+		c.interval = interval
+	}
+}
+
+// New creates a Controller for the given host.  The templates' interface patterns must already
+// have been validated.
+func New(hostname string, templates []apiv3.HostEndpointTemplate, client HostEndpointClient, opts ...Option) *Controller {
+	c := &Controller{
+		hostname:       hostname,
+		client:         client,
+		listInterfaces: ListInterfaces,
+		interval:       defaultInterval,
+		owned:          map[string]*apiv3.HostEndpoint{},
+	}
+	for _, t := range templates {
+		c.templates = append(c.templates, template{
+			HostEndpointTemplate: t,
+			ifaceRegexp:          regexp.MustCompile("^(?:" + t.InterfacePattern + ")$"),
+		})
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+// RegisterWith registers the Controller for HostEndpoint updates and sync status with the given
+// dispatcher, typically the calculation graph's.  The dispatcher must have sent InSync for
+// Reconcile to succeed.
+func (c *Controller) RegisterWith(disp *dispatcher.Dispatcher) {
+	disp.Register(model.HostEndpointKey{}, c.OnUpdate)
+	disp.RegisterStatusHandler(c.OnStatusUpdated)
+}
+
+// OnStatusUpdated records when the syncer is in sync.
+func (c *Controller) OnStatusUpdated(status bapi.SyncStatus) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if status == bapi.InSync && !c.inSync {
+		log.WithField("owned", len(c.owned)).Info("Generated HostEndpoint cache is in sync")
+		c.inSync = true
+	}
+}
+
+// OnUpdate handles a HostEndpoint update from the syncer.  Only the HostEndpoints that this
+// Controller owns are kept.
+func (c *Controller) OnUpdate(update bapi.Update) (filterOut bool) {
+	key, ok := update.Key.(model.HostEndpointKey)
+	if !ok || key.Hostname != c.hostname {
+		return
+	}
+	var hep *apiv3.HostEndpoint
+	if v, ok := update.Value.(*model.HostEndpoint); ok && v != nil {
+		hep = hostEndpointFromModel(key, v, update.Revision)
+	}
+	c.setOwned(key.EndpointID, hep)
+	return
+}
+
+// hostEndpointFromModel rebuilds the fields of a HostEndpoint that the Controller compares from
+// the model used by the syncer.  The result doesn't have all of the resource's metadata so it must
+// not be written back as is.
+func hostEndpointFromModel(key model.HostEndpointKey, v *model.HostEndpoint, revision string) *apiv3.HostEndpoint {
+	var ips []string
+	for _, ip := range v.ExpectedIPv4Addrs {
+		ips = append(ips, ip.String())
+	}
+	for _, ip := range v.ExpectedIPv6Addrs {
+		ips = append(ips, ip.String())
+	}
+	sort.Strings(ips)
+	hep := &apiv3.HostEndpoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            key.EndpointID,
+			ResourceVersion: revision,
+		},
+		Spec: apiv3.HostEndpointSpec{
+			Node:          key.Hostname,
+			InterfaceName: v.Name,
+			ExpectedIPs:   ips,
+			Profiles:      v.ProfileIDs,
+		},
+	}
+	if v.Labels.Len() > 0 {
+		hep.Labels = v.Labels.RecomputeOriginalMap()
+	}
+	return hep
+}
+
+// setOwned records the latest version of the named HostEndpoint, or its deletion if hep is nil.
+func (c *Controller) setOwned(name string, hep *apiv3.HostEndpoint) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.setOwnedLocked(name, hep)
+}
+
+func (c *Controller) setOwnedLocked(name string, hep *apiv3.HostEndpoint) {
+	if hep == nil || hep.Labels[CreatedByLabelKey] != CreatedByLabelValue || hep.Spec.Node != c.hostname {
+		delete(c.owned, name)
+		return
+	}
+	c.owned[name] = hep
+}
+
+// ownedHostEndpoints returns a copy of the HostEndpoints that this Controller owns.
+func (c *Controller) ownedHostEndpoints() (map[string]*apiv3.HostEndpoint, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.inSync {
+		return nil, ErrNotInSync
+	}
+	owned := make(map[string]*apiv3.HostEndpoint, len(c.owned))
+	for name, hep := range c.owned {
+		owned[name] = hep.DeepCopy()
+	}
+	return owned, nil
+}
+
+// Run reconciles the HostEndpoints until the context is canceled.  If there are no templates, it
+// returns after the first successful reconciliation, which removes any HostEndpoints left over
+// from a previous configuration; that is a no-op if the syncer didn't report any.
+func (c *Controller) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		if err := c.Reconcile(ctx); errors.Is(err, ErrNotInSync) {
+			log.Debug("Waiting for the generated HostEndpoint cache to sync.")
+		} else if err != nil {
+			log.WithError(err).Warn("Failed to reconcile generated HostEndpoints, will retry.")
+		} else if len(c.templates) == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile creates, updates and deletes the generated HostEndpoints so that they match the
+// host's current interfaces.
+func (c *Controller) Reconcile(ctx context.Context) error {
+	current, err := c.ownedHostEndpoints()
+	if err != nil {
+		return err
+	}
+
+	var desired map[string]*apiv3.HostEndpoint
+	if len(c.templates) > 0 {
+		ifaces, err := c.listInterfaces()
+		if err != nil {
+			return fmt.Errorf("failed to list interfaces: %w", err)
+		}
+		desired = c.desiredHostEndpoints(ifaces)
+	} else if len(current) == 0 {
+		log.Debug("No templates and no generated HostEndpoints, skipping cleanup.")
+		return nil
+	}
+
+	var lastErr error
+	for name, hep := range current {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		log.WithFields(log.Fields{"name": name, "iface": hep.Spec.InterfaceName}).Info(
+			"Deleting generated HostEndpoint.")
+		if _, err := c.client.Delete(ctx, name, options.DeleteOptions{ResourceVersion: hep.ResourceVersion}); err != nil {
+			if _, ok := err.(cerrors.ErrorResourceDoesNotExist); !ok {
+				log.WithError(err).WithField("name", name).Warn("Failed to delete generated HostEndpoint.")
+				lastErr = err
+				continue
+			}
+		}
+		// Record our own writes rather than waiting for the syncer, so that the next
+		// reconciliation doesn't repeat them.
+		c.setOwned(name, nil)
+	}
+
+	for name, hep := range desired {
+		logCxt := log.WithFields(log.Fields{"name": name, "iface": hep.Spec.InterfaceName})
+		if cur, ok := current[name]; ok {
+			if !needsUpdate(cur, hep) {
+				continue
+			}
+			logCxt.Info("Updating generated HostEndpoint.")
+			// The syncer's copy doesn't have all of the metadata that an update needs.
+			existing, err := c.client.Get(ctx, name, options.GetOptions{})
+			if err != nil {
+				logCxt.WithError(err).Warn("Failed to get generated HostEndpoint for update.")
+				lastErr = err
+				continue
+			}
+			labels := hep.Labels
+			hep.ObjectMeta = existing.ObjectMeta
+			hep.Labels = labels
+			updated, err := c.client.Update(ctx, hep, options.SetOptions{})
+			if err != nil {
+				logCxt.WithError(err).Warn("Failed to update generated HostEndpoint.")
+				lastErr = err
+				continue
+			}
+			c.setOwned(name, updated)
+			continue
+		}
+		logCxt.Info("Creating generated HostEndpoint.")
+		created, err := c.client.Create(ctx, hep, options.SetOptions{})
+		if err != nil {
+			if _, ok := err.(cerrors.ErrorResourceAlreadyExists); ok {
+				// A HostEndpoint that we don't own has the same name; leave it alone.
+				logCxt.Warn("A HostEndpoint with the generated name already exists and wasn't created by Felix, skipping.")
+				continue
+			}
+			logCxt.WithError(err).Warn("Failed to create generated HostEndpoint.")
+			lastErr = err
+			continue
+		}
+		c.setOwned(name, created)
+	}
+	return lastErr
+}
+
+func (c *Controller) desiredHostEndpoints(ifaces []Interface) map[string]*apiv3.HostEndpoint {
+	sort.Slice(ifaces, func(i, j int) bool { return ifaces[i].Name < ifaces[j].Name })
+	heps := map[string]*apiv3.HostEndpoint{}
+	for _, iface := range ifaces {
+		// Only the first matching template applies, since Felix only uses one HostEndpoint per
+		// interface.
+		for _, t := range c.templates {
+			if !t.ifaceRegexp.MatchString(iface.Name) {
+				continue
+			}
+			name := c.hostEndpointName(t.GenerateName, iface.Name)
+			var ips []string
+			for _, ip := range iface.Addrs {
+				ips = append(ips, ip.String())
+			}
+			sort.Strings(ips)
+			heps[name] = &apiv3.HostEndpoint{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       apiv3.KindHostEndpoint,
+					APIVersion: apiv3.GroupVersionCurrent,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: c.labels(t.Labels),
+				},
+				Spec: apiv3.HostEndpointSpec{
+					Node:          c.hostname,
+					InterfaceName: iface.Name,
+					ExpectedIPs:   ips,
+					Profiles:      t.Profiles,
+				},
+			}
+			break
+		}
+	}
+	return heps
+}
+
+func (c *Controller) labels(templateLabels map[string]string) map[string]string {
+	labels := map[string]string{}
+	for k, v := range templateLabels {
+		labels[k] = v
+	}
+	labels[CreatedByLabelKey] = CreatedByLabelValue
+	return labels
+}
+
+// hostEndpointName returns the name of the HostEndpoint for the given template and interface.
+// Characters that aren't allowed in names are replaced by dashes, and overlong names are replaced
+// by a hash.
+func (c *Controller) hostEndpointName(generateName, ifaceName string) string {
+	name := fmt.Sprintf("%s-%s-%s", c.hostname, generateName, ifaceName)
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(
+		base64.RawURLEncoding.EncodeToString(hash[:]))) + "-auto-hep"
+}
+
+func needsUpdate(current, expected *apiv3.HostEndpoint) bool {
+	return !reflect.DeepEqual(current.Labels, expected.Labels) ||
+		current.Spec.InterfaceName != expected.Spec.InterfaceName ||
+		!slices.Equal(current.Spec.ExpectedIPs, expected.Spec.ExpectedIPs) ||
+		!slices.Equal(current.Spec.Profiles, expected.Spec.Profiles)
+}
+
+// ListInterfaces returns the host's interfaces along with their global unicast addresses.
+func ListInterfaces() ([]Interface, error) {
+	netIfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	var ifaces []Interface
+	for _, ni := range netIfaces {
+		addrs, err := ni.Addrs()
+		if err != nil {
+			// The interface may have gone away since we listed it.
+			log.WithError(err).WithField("iface", ni.Name).Debug("Failed to get interface addresses, skipping.")
+			continue
+		}
+		iface := Interface{Name: ni.Name}
+		for _, a := range addrs {
+			ipNet, ok := a.(*net.IPNet)
+			if !ok || !ipNet.IP.IsGlobalUnicast() {
+				continue
+			}
+			iface.Addrs = append(iface.Addrs, ipNet.IP)
+		}
+		ifaces = append(ifaces, iface)
+	}
+	return ifaces, nil
+}
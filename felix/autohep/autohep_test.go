@@ -0,0 +1,347 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autohep_test
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/autohep"
+	"github.com/projectcalico/calico/felix/dispatcher"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// fakeClient is an in-memory HostEndpoint client that also acts as Felix's syncer, sending the
+// converted HostEndpoints to the controllers' dispatchers.
+type fakeClient struct {
+	heps    map[string]apiv3.HostEndpoint
+	nextRev int
+	writes  int
+	gets    int
+
+	watchers []watcher
+	// muted stops the client from sending updates, as if the syncer were lagging behind.
+	muted bool
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{heps: map[string]apiv3.HostEndpoint{}}
+}
+
+// watcher is a controller's dispatcher, along with the update processor that converts the
+// HostEndpoints that it is sent.
+type watcher struct {
+	disp      *dispatcher.Dispatcher
+	processor watchersyncer.SyncerUpdateProcessor
+}
+
+func (f *fakeClient) Get(ctx context.Context, name string, opts options.GetOptions) (*apiv3.HostEndpoint, error) {
+	f.gets++
+	cur, ok := f.heps[name]
+	if !ok {
+		return nil, cerrors.ErrorResourceDoesNotExist{Identifier: name}
+	}
+	return cur.DeepCopy(), nil
+}
+
+func (f *fakeClient) Create(ctx context.Context, res *apiv3.HostEndpoint, opts options.SetOptions) (*apiv3.HostEndpoint, error) {
+	f.writes++
+	if _, ok := f.heps[res.Name]; ok {
+		return nil, cerrors.ErrorResourceAlreadyExists{Identifier: res.Name}
+	}
+	return f.store(res), nil
+}
+
+func (f *fakeClient) Update(ctx context.Context, res *apiv3.HostEndpoint, opts options.SetOptions) (*apiv3.HostEndpoint, error) {
+	f.writes++
+	cur, ok := f.heps[res.Name]
+	if !ok {
+		return nil, cerrors.ErrorResourceDoesNotExist{Identifier: res.Name}
+	}
+	if cur.ResourceVersion != res.ResourceVersion {
+		return nil, cerrors.ErrorResourceUpdateConflict{Identifier: res.Name}
+	}
+	return f.store(res), nil
+}
+
+func (f *fakeClient) Delete(ctx context.Context, name string, opts options.DeleteOptions) (*apiv3.HostEndpoint, error) {
+	f.writes++
+	cur, ok := f.heps[name]
+	if !ok {
+		return nil, cerrors.ErrorResourceDoesNotExist{Identifier: name}
+	}
+	delete(f.heps, name)
+	f.notify(name, nil)
+	return &cur, nil
+}
+
+func (f *fakeClient) store(res *apiv3.HostEndpoint) *apiv3.HostEndpoint {
+	f.nextRev++
+	hep := *res.DeepCopy()
+	hep.ResourceVersion = strconv.Itoa(f.nextRev)
+	f.heps[hep.Name] = hep
+	f.notify(hep.Name, &hep)
+	return &hep
+}
+
+// watch registers c with a new dispatcher, sends it the current HostEndpoints followed by
+// in-sync, and then sends it every subsequent change.
+func (f *fakeClient) watch(c *autohep.Controller) {
+	w := watcher{
+		disp:      dispatcher.NewDispatcher(),
+		processor: updateprocessors.NewHostEndpointUpdateProcessor(),
+	}
+	c.RegisterWith(w.disp)
+	for _, hep := range f.heps {
+		w.send(hep.Name, &hep)
+	}
+	w.disp.OnStatusUpdated(bapi.InSync)
+	f.watchers = append(f.watchers, w)
+}
+
+func (f *fakeClient) notify(name string, hep *apiv3.HostEndpoint) {
+	if f.muted {
+		return
+	}
+	for _, w := range f.watchers {
+		w.send(name, hep)
+	}
+}
+
+// send converts the HostEndpoint to the model that Felix's syncer uses and dispatches it.
+func (w watcher) send(name string, hep *apiv3.HostEndpoint) {
+	kvp := &model.KVPair{Key: model.ResourceKey{Kind: apiv3.KindHostEndpoint, Name: name}}
+	if hep != nil {
+		kvp.Value = hep.DeepCopy()
+		kvp.Revision = hep.ResourceVersion
+	}
+	kvps, err := w.processor.Process(kvp)
+	Expect(err).NotTo(HaveOccurred())
+	for _, kvp := range kvps {
+		u := bapi.Update{KVPair: *kvp, UpdateType: bapi.UpdateTypeKVUpdated}
+		if kvp.Value == nil {
+			u.UpdateType = bapi.UpdateTypeKVDeleted
+		}
+		w.disp.OnUpdates([]bapi.Update{u})
+	}
+}
+
+func (f *fakeClient) names() []string {
+	var names []string
+	for name := range f.heps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var _ = Describe("HostEndpoint generation", func() {
+	var (
+		client    *fakeClient
+		ifaces    []autohep.Interface
+		templates []apiv3.HostEndpointTemplate
+	)
+
+	newController := func(opts ...autohep.Option) *autohep.Controller {
+		opts = append(opts, autohep.WithInterfaceLister(func() ([]autohep.Interface, error) {
+			return append([]autohep.Interface(nil), ifaces...), nil
+		}))
+		c := autohep.New("host1", templates, client, opts...)
+		client.watch(c)
+		return c
+	}
+
+	BeforeEach(func() {
+		client = newFakeClient()
+		ifaces = []autohep.Interface{
+			{Name: "eth0", Addrs: []net.IP{net.ParseIP("10.0.0.2"), net.ParseIP("10.0.0.1")}},
+			{Name: "eth1", Addrs: []net.IP{net.ParseIP("fd00::1")}},
+			{Name: "lo"},
+			{Name: "bond0.100"},
+		}
+		templates = []apiv3.HostEndpointTemplate{
+			{
+				GenerateName:     "uplink",
+				InterfacePattern: "eth[0-9]+",
+				Labels:           map[string]string{"role": "uplink"},
+				Profiles:         []string{"allow-egress"},
+			},
+			{
+				GenerateName:     "all",
+				InterfacePattern: "eth.*|bond.*",
+			},
+		}
+	})
+
+	It("should create a HostEndpoint for each matching interface", func() {
+		Expect(newController().Reconcile(context.Background())).To(Succeed())
+		Expect(client.names()).To(Equal([]string{"host1-all-bond0.100", "host1-uplink-eth0", "host1-uplink-eth1"}))
+
+		hep := client.heps["host1-uplink-eth0"]
+		Expect(hep.Labels).To(Equal(map[string]string{
+			"role":                    "uplink",
+			autohep.CreatedByLabelKey: autohep.CreatedByLabelValue,
+		}))
+		Expect(hep.Spec).To(Equal(apiv3.HostEndpointSpec{
+			Node:          "host1",
+			InterfaceName: "eth0",
+			ExpectedIPs:   []string{"10.0.0.1", "10.0.0.2"},
+			Profiles:      []string{"allow-egress"},
+		}))
+		Expect(client.heps["host1-all-bond0.100"].Spec.InterfaceName).To(Equal("bond0.100"))
+	})
+
+	It("should not rewrite HostEndpoints that are up to date", func() {
+		c := newController()
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		rev := client.nextRev
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.nextRev).To(Equal(rev))
+	})
+
+	It("should not repeat its own writes before the syncer reports them", func() {
+		c := newController()
+		client.muted = true
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		ifaces = ifaces[:1]
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.writes).To(Equal(5))
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.writes).To(Equal(5))
+		Expect(client.names()).To(Equal([]string{"host1-uplink-eth0"}))
+	})
+
+	It("should not reconcile until the syncer is in sync", func() {
+		c := autohep.New("host1", templates, client, autohep.WithInterfaceLister(func() ([]autohep.Interface, error) {
+			return ifaces, nil
+		}))
+		Expect(c.Reconcile(context.Background())).To(MatchError(autohep.ErrNotInSync))
+		Expect(client.heps).To(BeEmpty())
+
+		client.watch(c)
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.heps).To(HaveLen(3))
+	})
+
+	It("should pick up changes to its HostEndpoints from the syncer", func() {
+		c := newController()
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		hep := client.heps["host1-uplink-eth0"]
+		hep.Spec.Profiles = nil
+		client.store(&hep)
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.heps["host1-uplink-eth0"].Spec.Profiles).To(Equal([]string{"allow-egress"}))
+	})
+
+	It("should update HostEndpoints when the addresses change", func() {
+		c := newController()
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		ifaces[1].Addrs = append(ifaces[1].Addrs, net.ParseIP("fd00::2"))
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.heps["host1-uplink-eth1"].Spec.ExpectedIPs).To(Equal([]string{"fd00::1", "fd00::2"}))
+		Expect(client.heps["host1-uplink-eth1"].Labels).To(HaveKeyWithValue("role", "uplink"))
+		Expect(client.heps["host1-uplink-eth1"].ResourceVersion).NotTo(BeEmpty())
+		Expect(client.gets).To(Equal(1))
+	})
+
+	It("should delete HostEndpoints when their interface goes away", func() {
+		c := newController()
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		ifaces = ifaces[:1]
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(client.names()).To(Equal([]string{"host1-uplink-eth0"}))
+	})
+
+	It("should delete its HostEndpoints when there are no templates", func() {
+		Expect(newController().Reconcile(context.Background())).To(Succeed())
+		templates = nil
+		Expect(newController().Reconcile(context.Background())).To(Succeed())
+		Expect(client.heps).To(BeEmpty())
+	})
+
+	It("should leave other HostEndpoints alone", func() {
+		mine := &apiv3.HostEndpoint{}
+		mine.Name = "host1-uplink-eth0"
+		mine.Spec.Node = "host1"
+		client.store(mine)
+		otherHost := &apiv3.HostEndpoint{}
+		otherHost.Name = "host2-uplink-eth0"
+		otherHost.Labels = map[string]string{autohep.CreatedByLabelKey: autohep.CreatedByLabelValue}
+		otherHost.Spec.Node = "host2"
+		client.store(otherHost)
+
+		Expect(newController().Reconcile(context.Background())).To(Succeed())
+		Expect(client.names()).To(Equal([]string{
+			"host1-all-bond0.100", "host1-uplink-eth0", "host1-uplink-eth1", "host2-uplink-eth0",
+		}))
+		Expect(client.heps["host1-uplink-eth0"].Labels).To(BeEmpty())
+	})
+
+	It("should sanitise and shorten names", func() {
+		ifaces = []autohep.Interface{{Name: "eth0_" + strings.Repeat("x", 300)}}
+		templates[0].InterfacePattern = "eth0.*"
+		Expect(newController().Reconcile(context.Background())).To(Succeed())
+		names := client.names()
+		Expect(names).To(HaveLen(1))
+		Expect(len(names[0])).To(BeNumerically("<=", 253))
+		Expect(names[0]).To(HaveSuffix("-auto-hep"))
+
+		ifaces = []autohep.Interface{{Name: "eth0_1"}}
+		client = newFakeClient()
+		Expect(newController().Reconcile(context.Background())).To(Succeed())
+		Expect(client.names()).To(Equal([]string{"host1-uplink-eth0-1"}))
+	})
+
+	It("should skip the cleanup if there are no templates and no generated HostEndpoints", func() {
+		other := &apiv3.HostEndpoint{}
+		other.Name = "host1-eth0"
+		other.Spec.Node = "host1"
+		client.store(other)
+		templates = nil
+		listed := false
+		c := autohep.New("host1", templates, client, autohep.WithInterfaceLister(func() ([]autohep.Interface, error) {
+			listed = true
+			return ifaces, nil
+		}))
+		client.watch(c)
+		Expect(c.Reconcile(context.Background())).To(Succeed())
+		Expect(listed).To(BeFalse())
+		Expect(client.writes).To(BeZero())
+		Expect(client.gets).To(BeZero())
+	})
+
+	It("should return from Run after cleaning up if there are no templates", func() {
+		templates = nil
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			newController(autohep.WithInterval(time.Millisecond)).Run(context.Background())
+		}()
+		Eventually(done).Should(BeClosed())
+	})
+})
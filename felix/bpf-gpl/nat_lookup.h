@@ -14,6 +14,52 @@
 #include "routes.h"
 #include "nat_types.h"
 
+#if !(CALI_F_XDP) && !(CALI_F_CGROUP)
+static CALI_BPF_INLINE __u32 nat_maglev_mix(__u32 h, __u32 v)
+{
+	v *= 0xcc9e2d51;
+	v = (v << 15) | (v >> 17);
+	v *= 0x1b873593;
+	h ^= v;
+	h = (h << 13) | (h >> 19);
+	return h * 5 + 0xe6546b64;
+}
+
+/* nat_maglev_hash hashes the connection's 5-tuple.  It must not depend on
+ * anything local to the node so that every node that sees the connection
+ * picks the same Maglev bucket.
+ */
+static CALI_BPF_INLINE __u32 nat_maglev_hash(ipv46_addr_t *ip_src, ipv46_addr_t *ip_dst,
+					      __u8 ip_proto, __u16 sport, __u16 dport)
+{
+	__u32 h = 0;
+
+#ifdef IPVER6
+	h = nat_maglev_mix(h, ip_src->a);
+	h = nat_maglev_mix(h, ip_src->b);
+	h = nat_maglev_mix(h, ip_src->c);
+	h = nat_maglev_mix(h, ip_src->d);
+	h = nat_maglev_mix(h, ip_dst->a);
+	h = nat_maglev_mix(h, ip_dst->b);
+	h = nat_maglev_mix(h, ip_dst->c);
+	h = nat_maglev_mix(h, ip_dst->d);
+#else
+	h = nat_maglev_mix(h, *ip_src);
+	h = nat_maglev_mix(h, *ip_dst);
+#endif
+	h = nat_maglev_mix(h, ((__u32)sport << 16) | dport);
+	h = nat_maglev_mix(h, ip_proto);
+
+	h ^= h >> 16;
+	h *= 0x85ebca6b;
+	h ^= h >> 13;
+	h *= 0xc2b2ae35;
+	h ^= h >> 16;
+
+	return h;
+}
+#endif
+
 static CALI_BPF_INLINE struct calico_nat_dest* calico_nat_lookup(ipv46_addr_t *ip_src,
 								 ipv46_addr_t *ip_dst,
 								 __u8 ip_proto,
@@ -186,6 +232,25 @@ static CALI_BPF_INLINE struct calico_nat_dest* calico_nat_lookup(ipv46_addr_t *i
 
 skip_affinity:
 	nat_lv2_key.id = nat_lv1_val->id;
+
+#if !(CALI_F_XDP) && !(CALI_F_CGROUP)
+	/* Maglev only applies when we choose from all the backends. When the
+	 * choice is restricted to the local backends, there is no other node
+	 * that could pick a backend for the same connection.
+	 */
+	if ((nat_lv1_val->flags & NAT_FLG_MAGLEV) && count == nat_lv1_val->count) {
+		nat_lv2_key.ordinal = nat_maglev_hash(ip_src, ip_dst, ip_proto, ctx->state->sport, dport);
+		nat_lv2_key.ordinal %= NAT_MAGLEV_LUT_SIZE;
+
+		CALI_DEBUG("NAT: maglev lookup id=%d bucket=%d", nat_lv2_key.id, nat_lv2_key.ordinal);
+
+		if ((nat_lv2_val = cali_nat_mgl_lookup_elem(&nat_lv2_key))) {
+			goto backend_selected;
+		}
+		CALI_DEBUG("NAT: maglev miss, falling back to random backend");
+	}
+#endif
+
 	nat_lv2_key.ordinal = bpf_get_prandom_u32();
 	nat_lv2_key.ordinal %= count;
 
@@ -197,6 +262,9 @@ skip_affinity:
 		return NULL;
 	}
 
+#if !(CALI_F_XDP) && !(CALI_F_CGROUP)
+backend_selected:
+#endif
 	CALI_DEBUG("NAT: backend selected " IP_FMT ":%d", debug_ip(nat_lv2_val->addr), nat_lv2_val->port);
 
 	if (nat_lv1_val->affinity_timeo != 0 || affinity_always_timeo) {
@@ -60,6 +60,7 @@ struct calico_nat_value {
 #define NAT_FLG_EXTERNAL_LOCAL	0x1
 #define NAT_FLG_INTERNAL_LOCAL	0x2
 #define NAT_FLG_NAT_EXCLUDE	0x4
+#define NAT_FLG_MAGLEV		0x8
 
 #ifdef IPVER6
 CALI_MAP_NAMED(cali_v6_nat_fe, cali_nat_fe, 3,
@@ -93,6 +94,22 @@ CALI_MAP_NAMED(cali_v4_nat_be, cali_nat_be,,
 		struct calico_nat_secondary_key, struct calico_nat_dest,
 		256*1024, BPF_F_NO_PREALLOC)
 
+/* Map: NAT Maglev lookup tables.  ID and bucket -> dest and port.  Services that
+ * have NAT_FLG_MAGLEV set own NAT_MAGLEV_LUT_SIZE buckets in this map so that all
+ * nodes pick the same backend for the same connection.  Must be kept in sync with
+ * MaglevLUTSize in felix/bpf/nat.
+ */
+#define NAT_MAGLEV_LUT_SIZE	1021
+
+#ifdef IPVER6
+CALI_MAP_NAMED(cali_v6_nat_mgl, cali_nat_mgl,,
+#else
+CALI_MAP_NAMED(cali_v4_nat_mgl, cali_nat_mgl,,
+#endif
+		BPF_MAP_TYPE_HASH,
+		struct calico_nat_secondary_key, struct calico_nat_dest,
+		256*1024, BPF_F_NO_PREALLOC)
+
 struct calico_nat_affinity_key {
 	struct calico_nat nat_key;
 	ipv46_addr_t client_ip;
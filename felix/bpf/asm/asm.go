@@ -133,6 +133,10 @@ const (
 	StoreReg32 OpCode = OpClassStoreReg | MemOpModeMem | MemOpSize32
 	StoreReg64 OpCode = OpClassStoreReg | MemOpModeMem | MemOpSize64
 
+	// AtomicAdd64 adds the source register to the memory location; the immediate selects the
+	// atomic operation, which is zero for a plain add.
+	AtomicAdd64 OpCode = OpClassStoreReg | MemOpModeXADD | MemOpSize64
+
 	// TODO: check these opcodes, should they be OpClassStoreMem with an immediate source instead?
 	StoreImm8  OpCode = OpClassStoreImm | MemOpModeImm | MemOpSize8
 	StoreImm16 OpCode = OpClassStoreImm | MemOpModeImm | MemOpSize16
@@ -487,6 +491,11 @@ func (b *Block) Store64(dst Reg, ptrReg Reg, fo FieldOffset) {
 	b.add(StoreReg64, dst, ptrReg, fo.Offset, 0, annotation)
 }
 
+// AtomicAdd64 atomically adds src to the 64-bit value at ptrReg + fo.
+func (b *Block) AtomicAdd64(ptrReg Reg, src Reg, fo FieldOffset) {
+	b.add(AtomicAdd64, ptrReg, src, fo.Offset, 0, "")
+}
+
 func (b *Block) LoadStack8(dst Reg, fo FieldOffset) {
 	b.Load8(dst, R10, fo)
 }
@@ -535,6 +544,14 @@ func (b *Block) Add64(dst, src Reg) {
 	b.add(Add64, dst, src, 0, 0, "")
 }
 
+func (b *Block) Sub64(dst, src Reg) {
+	b.add(Sub64, dst, src, 0, 0, "")
+}
+
+func (b *Block) Mul64(dst, src Reg) {
+	b.add(Mul64, dst, src, 0, 0, "")
+}
+
 func (b *Block) AddImm64(dst Reg, imm int32) {
 	b.add(AddImm64, dst, 0, 0, imm, "")
 }
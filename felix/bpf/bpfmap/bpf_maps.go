@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/projectcalico/calico/felix/bpf/arp"
+	"github.com/projectcalico/calico/felix/bpf/connlimit"
 	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/counters"
 	"github.com/projectcalico/calico/felix/bpf/failsafes"
@@ -31,6 +32,7 @@ import (
 	"github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/nat"
 	"github.com/projectcalico/calico/felix/bpf/profiling"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
 	"github.com/projectcalico/calico/felix/bpf/routes"
 	"github.com/projectcalico/calico/felix/bpf/state"
 )
@@ -42,6 +44,7 @@ type IPMaps struct {
 	FrontendMap  maps.Map
 	BackendMap   maps.Map
 	AffinityMap  maps.Map
+	MaglevMap    maps.Map
 	RouteMap     maps.Map
 	CtMap        maps.Map
 	SrMsgMap     maps.Map
@@ -58,6 +61,9 @@ type CommonMaps struct {
 	XDPProgramsMap  maps.Map
 	XDPJumpMap      maps.MapWithDeleteIfExists
 	ProfilingMap    maps.Map
+	RateLimitMap    maps.Map
+	ConnLimitMap    maps.Map
+	ConnFlowMap     maps.Map
 }
 
 type Maps struct {
@@ -90,6 +96,9 @@ func getCommonMaps() *CommonMaps {
 		XDPProgramsMap:  hook.NewXDPProgramsMap(),
 		XDPJumpMap:      jump.XDPMap().(maps.MapWithDeleteIfExists),
 		ProfilingMap:    profiling.Map(),
+		RateLimitMap:    ratelimit.Map(),
+		ConnLimitMap:    connlimit.CounterMap(),
+		ConnFlowMap:     connlimit.FlowMap(),
 	}
 }
 
@@ -115,6 +124,7 @@ func getIPMaps(ipFamily int) *IPMaps {
 		FrontendMap:  getmapWithExistsCheck(nat.FrontendMap, nat.FrontendMapV6),
 		BackendMap:   getmapWithExistsCheck(nat.BackendMap, nat.BackendMapV6),
 		AffinityMap:  getmap(nat.AffinityMap, nat.AffinityMapV6),
+		MaglevMap:    getmapWithExistsCheck(nat.MaglevMap, nat.MaglevMapV6),
 		RouteMap:     getmap(routes.Map, routes.MapV6),
 		CtMap:        getmap(conntrack.Map, conntrack.MapV6),
 		SrMsgMap:     getmap(nat.SendRecvMsgMap, nat.SendRecvMsgMapV6),
@@ -172,6 +182,9 @@ func (c *CommonMaps) slice() []maps.Map {
 		c.XDPProgramsMap,
 		c.XDPJumpMap,
 		c.ProfilingMap,
+		c.RateLimitMap,
+		c.ConnLimitMap,
+		c.ConnFlowMap,
 	}
 }
 
@@ -183,6 +196,7 @@ func (i *IPMaps) slice() []maps.Map {
 		i.FrontendMap,
 		i.BackendMap,
 		i.AffinityMap,
+		i.MaglevMap,
 		i.RouteMap,
 		i.CtMap,
 		i.SrMsgMap,
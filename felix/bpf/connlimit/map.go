@@ -0,0 +1,109 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connlimit
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
+)
+
+// The connection limit maps track the connections allowed by the policy rules that limit the
+// number of concurrent connections.  When a rule allows a new connection, the policy program
+// (generated by the polprog package) records the connection in the flow map and increments the
+// rule's counter in the counter map.  The Scanner removes the connections that no longer have a
+// conntrack entry from the flow map and decrements their counters.
+//
+// WARNING: must be kept in sync with the definitions in bpf/polprog/pol_prog_builder.go.
+//
+// The counter map has the same key as the rate limit map, see ratelimit.Key.
+//
+// Counter value:
+// uint64 count            8
+//
+// Flow key:
+// uint8 src_addr[16] BE   16  (IPv4 addresses are IPv4-mapped)
+// uint8 dst_addr[16] BE   +16 = 32
+// uint16 src_port HE      +2 = 34
+// uint16 dst_port HE      +2 = 36
+// uint8 proto             +1 = 37
+// uint8 pad[3]            +3 = 40
+//
+// Flow value: the counter key.
+const (
+	CounterKeySize   = ratelimit.KeySize
+	CounterValueSize = 8
+	FlowKeySize      = 40
+	FlowValueSize    = CounterKeySize
+)
+
+var CounterMapParameters = maps.MapParameters{
+	Type:       "hash",
+	KeySize:    CounterKeySize,
+	ValueSize:  CounterValueSize,
+	MaxEntries: 65536,
+	Name:       "cali_connlim",
+}
+
+var FlowMapParameters = maps.MapParameters{
+	Type:       "hash",
+	KeySize:    FlowKeySize,
+	ValueSize:  FlowValueSize,
+	MaxEntries: 65536,
+	Name:       "cali_connflow",
+}
+
+func CounterMap() maps.Map {
+	return maps.NewPinnedMap(CounterMapParameters)
+}
+
+func FlowMap() maps.Map {
+	return maps.NewPinnedMap(FlowMapParameters)
+}
+
+type CounterKey = ratelimit.Key
+
+func CounterValueFromBytes(b []byte) uint64 {
+	return binary.LittleEndian.Uint64(b)
+}
+
+func CounterValueAsBytes(count uint64) []byte {
+	b := make([]byte, CounterValueSize)
+	binary.LittleEndian.PutUint64(b, count)
+	return b
+}
+
+type FlowKey [FlowKeySize]byte
+
+func NewFlowKey(proto uint8, srcAddr net.IP, srcPort uint16, dstAddr net.IP, dstPort uint16) FlowKey {
+	var k FlowKey
+	copy(k[0:16], srcAddr.To16())
+	copy(k[16:32], dstAddr.To16())
+	binary.LittleEndian.PutUint16(k[32:34], srcPort)
+	binary.LittleEndian.PutUint16(k[34:36], dstPort)
+	k[36] = proto
+	return k
+}
+
+func (k FlowKey) AsBytes() []byte {
+	return k[:]
+}
+
+// IsIPv4 returns true if the flow's addresses are IPv4-mapped.
+func (k FlowKey) IsIPv4() bool {
+	return net.IP(k[0:16]).To4() != nil
+}
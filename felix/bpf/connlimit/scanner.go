@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connlimit
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/bpf/conntrack"
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+// Scanner is a conntrack EntryScannerSynced that ends the counted connections that no longer have
+// a conntrack entry.  At the start of each iteration it takes a snapshot of the flow map.  The
+// flows that match a conntrack entry are removed from the snapshot and, at the end of the
+// iteration, the remaining ones are removed from the flow map and their counters are decremented.
+//
+// Connections that are allowed during an iteration are not in its snapshot, so they are never
+// ended before their conntrack entry has been created.  The counters are decremented without
+// any locking so, if the policy programs count a new connection at the same time, the count may
+// be off by one until the connection ends.
+type Scanner struct {
+	ipv4     bool
+	counters maps.Map
+	flows    maps.Map
+
+	// pending holds the flows in the snapshot that have not been matched to a conntrack entry.
+	pending map[FlowKey]CounterKey
+}
+
+func NewScanner(counters, flows maps.Map, ipVersion int) *Scanner {
+	return &Scanner{
+		ipv4:     ipVersion == 4,
+		counters: counters,
+		flows:    flows,
+	}
+}
+
+// IterationStart satisfies conntrack.EntryScannerSynced.
+func (s *Scanner) IterationStart() {
+	s.pending = map[FlowKey]CounterKey{}
+	err := s.flows.Iter(func(k, v []byte) maps.IteratorAction {
+		var flow FlowKey
+		copy(flow[:], k)
+		if flow.IsIPv4() == s.ipv4 {
+			var ck CounterKey
+			copy(ck[:], v)
+			s.pending[flow] = ck
+		}
+		return maps.IterNone
+	})
+	if err != nil {
+		log.WithError(err).Warn("Failed to iterate over connection limit flows.")
+		s.pending = nil
+	}
+}
+
+// Check satisfies conntrack.EntryScanner.  A counted flow may match either direction of the
+// conntrack entry.
+func (s *Scanner) Check(k conntrack.KeyInterface, _ conntrack.ValueInterface, _ conntrack.EntryGet) conntrack.ScanVerdict {
+	if len(s.pending) == 0 {
+		return conntrack.ScanVerdictOK
+	}
+	delete(s.pending, NewFlowKey(k.Proto(), k.AddrA(), k.PortA(), k.AddrB(), k.PortB()))
+	delete(s.pending, NewFlowKey(k.Proto(), k.AddrB(), k.PortB(), k.AddrA(), k.PortA()))
+	return conntrack.ScanVerdictOK
+}
+
+// IterationEnd satisfies conntrack.EntryScannerSynced.
+func (s *Scanner) IterationEnd() {
+	for flow, ck := range s.pending {
+		if err := s.flows.Delete(flow.AsBytes()); err != nil && !maps.IsNotExists(err) {
+			log.WithError(err).Warn("Failed to delete connection limit flow.")
+			continue
+		}
+		s.decrement(ck)
+	}
+	s.pending = nil
+}
+
+func (s *Scanner) decrement(ck CounterKey) {
+	v, err := s.counters.Get(ck.AsBytes())
+	if err != nil {
+		if !maps.IsNotExists(err) {
+			log.WithError(err).Warn("Failed to read connection limit counter.")
+		}
+		return
+	}
+	if count := CounterValueFromBytes(v); count > 1 {
+		err = s.counters.Update(ck.AsBytes(), CounterValueAsBytes(count-1))
+	} else {
+		err = s.counters.Delete(ck.AsBytes())
+	}
+	if err != nil && !maps.IsNotExists(err) {
+		log.WithError(err).Warn("Failed to update connection limit counter.")
+	}
+}
+
+var _ conntrack.EntryScannerSynced = (*Scanner)(nil)
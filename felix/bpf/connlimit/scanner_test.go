@@ -0,0 +1,82 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connlimit
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/bpf/conntrack"
+	"github.com/projectcalico/calico/felix/bpf/mock"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
+)
+
+func TestScanner(t *testing.T) {
+	RegisterTestingT(t)
+
+	counters := mock.NewMockMap(CounterMapParameters)
+	flows := mock.NewMockMap(FlowMapParameters)
+
+	client := net.ParseIP("10.0.0.1")
+	server := net.ParseIP("10.0.0.2")
+	ck := ratelimit.NewKey(1, client)
+	ckV6 := ratelimit.NewKey(2, net.ParseIP("fd00::1"))
+
+	flowA := NewFlowKey(6, client, 1000, server, 80)
+	flowB := NewFlowKey(6, client, 1001, server, 80)
+	flowV6 := NewFlowKey(6, net.ParseIP("fd00::1"), 1000, net.ParseIP("fd00::2"), 80)
+	Expect(flows.Update(flowA.AsBytes(), ck.AsBytes())).To(Succeed())
+	Expect(flows.Update(flowB.AsBytes(), ck.AsBytes())).To(Succeed())
+	Expect(flows.Update(flowV6.AsBytes(), ckV6.AsBytes())).To(Succeed())
+	Expect(counters.Update(ck.AsBytes(), CounterValueAsBytes(2))).To(Succeed())
+	Expect(counters.Update(ckV6.AsBytes(), CounterValueAsBytes(1))).To(Succeed())
+
+	count := func(k CounterKey) uint64 {
+		v, err := counters.Get(k.AsBytes())
+		if err != nil {
+			return 0
+		}
+		return CounterValueFromBytes(v)
+	}
+
+	s := NewScanner(counters, flows, 4)
+
+	// Only flow A still has a conntrack entry, which is keyed in the opposite direction.
+	s.IterationStart()
+	s.Check(conntrack.NewKey(6, server, 80, client, 1000), nil, nil)
+	s.Check(conntrack.NewKey(17, client, 1001, server, 80), nil, nil)
+	s.IterationEnd()
+	Expect(flows.ContainsKey(flowA.AsBytes())).To(BeTrue())
+	Expect(flows.ContainsKey(flowB.AsBytes())).To(BeFalse())
+	Expect(count(ck)).To(Equal(uint64(1)))
+
+	// The IPv6 flow is left to the IPv6 scanner.
+	Expect(flows.ContainsKey(flowV6.AsBytes())).To(BeTrue())
+	Expect(count(ckV6)).To(Equal(uint64(1)))
+
+	// Once flow A's conntrack entry has gone, its counter is removed.
+	s.IterationStart()
+	s.IterationEnd()
+	Expect(flows.ContainsKey(flowA.AsBytes())).To(BeFalse())
+	Expect(counters.ContainsKey(ck.AsBytes())).To(BeFalse())
+
+	s = NewScanner(counters, flows, 6)
+	s.IterationStart()
+	s.IterationEnd()
+	Expect(flows.IsEmpty()).To(BeTrue())
+	Expect(counters.IsEmpty()).To(BeTrue())
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/projectcalico/calico/felix/idalloc"
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
@@ -380,6 +381,32 @@ func (m *bpfIPSets) ApplyUpdates(_ ipsets.UpdateListener) {
 	bpfIPSetsGauge.Set(float64(len(m.ipSets)))
 }
 
+// PendingChanges returns the BPF map entries that would be added and removed on the next ApplyUpdates.
+func (m *bpfIPSets) PendingChanges() []pendingchanges.Layer {
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindBPFMap,
+		Name:          m.bpfMap.GetName(),
+		IPVersion:     uint8(m.IPVersionConfig.Family.Version()),
+		ResyncPending: m.resyncScheduled,
+	}
+	m.dirtyIPSetIDs.Iter(func(setID uint64) error {
+		ipSet := m.getExistingIPSet(setID)
+		if ipSet == nil {
+			return nil
+		}
+		ipSet.PendingRemoves.Iter(func(entry IPSetEntryInterface) error {
+			layer.Changes = append(layer.Changes, fmt.Sprintf("delete %v", entry))
+			return nil
+		})
+		ipSet.PendingAdds.Iter(func(entry IPSetEntryInterface) error {
+			layer.Changes = append(layer.Changes, fmt.Sprintf("update %v", entry))
+			return nil
+		})
+		return nil
+	})
+	return []pendingchanges.Layer{layer}
+}
+
 // ApplyDeletions tries to delete any IP sets that are no longer needed.
 // Failures are ignored, deletions will be retried the next time we do a resync.
 func (m *bpfIPSets) ApplyDeletions() bool {
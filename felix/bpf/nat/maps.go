@@ -31,24 +31,28 @@ func init() {
 	maps.SetSize(FrontendMapParameters.VersionedName(), FrontendMapParameters.MaxEntries)
 	maps.SetSize(BackendMapParameters.VersionedName(), BackendMapParameters.MaxEntries)
 	maps.SetSize(AffinityMapParameters.VersionedName(), AffinityMapParameters.MaxEntries)
+	maps.SetSize(MaglevMapParameters.VersionedName(), MaglevMapParameters.MaxEntries)
 	maps.SetSize(SendRecvMsgMapParameters.VersionedName(), SendRecvMsgMapParameters.MaxEntries)
 	maps.SetSize(CTNATsMapParameters.VersionedName(), CTNATsMapParameters.MaxEntries)
 
 	maps.SetSize(FrontendMapV6Parameters.VersionedName(), FrontendMapV6Parameters.MaxEntries)
 	maps.SetSize(BackendMapV6Parameters.VersionedName(), BackendMapV6Parameters.MaxEntries)
 	maps.SetSize(AffinityMapV6Parameters.VersionedName(), AffinityMapV6Parameters.MaxEntries)
+	maps.SetSize(MaglevMapV6Parameters.VersionedName(), MaglevMapV6Parameters.MaxEntries)
 	maps.SetSize(SendRecvMsgMapV6Parameters.VersionedName(), SendRecvMsgMapV6Parameters.MaxEntries)
 	maps.SetSize(CTNATsMapV6Parameters.VersionedName(), CTNATsMapV6Parameters.MaxEntries)
 }
 
-func SetMapSizes(fsize, bsize, asize int) {
+func SetMapSizes(fsize, bsize, asize, msize int) {
 	maps.SetSize(FrontendMapParameters.VersionedName(), fsize)
 	maps.SetSize(BackendMapParameters.VersionedName(), bsize)
 	maps.SetSize(AffinityMapParameters.VersionedName(), asize)
+	maps.SetSize(MaglevMapParameters.VersionedName(), msize)
 
 	maps.SetSize(FrontendMapV6Parameters.VersionedName(), fsize)
 	maps.SetSize(BackendMapV6Parameters.VersionedName(), bsize)
 	maps.SetSize(AffinityMapV6Parameters.VersionedName(), asize)
+	maps.SetSize(MaglevMapV6Parameters.VersionedName(), msize)
 }
 
 //	struct calico_nat_v4_key {
@@ -202,12 +206,14 @@ const (
 	NATFlgExternalLocal = 0x1
 	NATFlgInternalLocal = 0x2
 	NATFlgExclude       = 0x4
+	NATFlgMaglev        = 0x8
 )
 
 var flgTostr = map[int]string{
 	NATFlgExternalLocal: "external-local",
 	NATFlgInternalLocal: "internal-local",
 	NATFlgExclude:       "nat-exclude",
+	NATFlgMaglev:        "maglev",
 }
 
 type FrontendValue [frontendValueSize]byte
@@ -390,6 +396,27 @@ func BackendMap() maps.MapWithExistsCheck {
 	return maps.NewPinnedMap(BackendMapParameters)
 }
 
+// MaglevLUTSize is the number of buckets in the Maglev lookup table of each service that
+// uses Maglev backend selection.  It must be a prime and must match NAT_MAGLEV_LUT_SIZE in
+// bpf-gpl/nat_types.h.
+const MaglevLUTSize = 1021
+
+// MaglevMapParameters describe the map that holds the Maglev lookup tables.  The keys are
+// the same as the keys of the BackendMap, with the ordinal being the bucket in the lookup
+// table, and the values are the backends.
+var MaglevMapParameters = maps.MapParameters{
+	Type:       "hash",
+	KeySize:    backendKeySize,
+	ValueSize:  backendValueSize,
+	MaxEntries: 256 * 1024,
+	Name:       "cali_v4_nat_mgl",
+	Flags:      unix.BPF_F_NO_PREALLOC,
+}
+
+func MaglevMap() maps.MapWithExistsCheck {
+	return maps.NewPinnedMap(MaglevMapParameters)
+}
+
 // NATMapMem represents FrontendMap loaded into memory
 type MapMem map[FrontendKey]FrontendValue
 
@@ -245,6 +245,19 @@ func BackendMapV6() maps.MapWithExistsCheck {
 	return maps.NewPinnedMap(BackendMapV6Parameters)
 }
 
+var MaglevMapV6Parameters = maps.MapParameters{
+	Type:       "hash",
+	KeySize:    backendKeyV6Size,
+	ValueSize:  backendValueV6Size,
+	MaxEntries: 256 * 1024,
+	Name:       "cali_v6_nat_mgl",
+	Flags:      unix.BPF_F_NO_PREALLOC,
+}
+
+func MaglevMapV6() maps.MapWithExistsCheck {
+	return maps.NewPinnedMap(MaglevMapV6Parameters)
+}
+
 // NATMapMem represents FrontendMap loaded into memory
 type MapMemV6 map[FrontendKeyV6]FrontendValueV6
 
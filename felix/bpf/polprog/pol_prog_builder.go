@@ -23,8 +23,10 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	. "github.com/projectcalico/calico/felix/bpf/asm"
+	"github.com/projectcalico/calico/felix/bpf/connlimit"
 	"github.com/projectcalico/calico/felix/bpf/ipsets"
 	"github.com/projectcalico/calico/felix/bpf/maps"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
 	"github.com/projectcalico/calico/felix/bpf/state"
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/proto"
@@ -49,6 +51,9 @@ type Builder struct {
 
 	ipSetMapFD         maps.FD
 	stateMapFD         maps.FD
+	rateLimitMapFD     maps.FD
+	connLimitMapFD     maps.FD
+	connLimitFlowMapFD maps.FD
 	staticJumpMapFD    maps.FD
 	policyJumpMapFD    maps.FD
 	policyMapIndex     int
@@ -117,6 +122,12 @@ var (
 	// port+proto+pad, the dst key is also aligned in the same way. <sweat :-)>
 	offSrcIPSetKey = nextOffset(ipsets.IPSetEntryV6Size, 4)
 	offDstIPSetKey = nextOffset(ipsets.IPSetEntryV6Size, 4)
+	// The limit keys and values are 64-bit aligned for 64-bit stores.  The rate and connection
+	// limits share the same key.
+	offLimitKey         = nextOffset(ratelimit.KeySize, 8)
+	offRateLimitValue   = nextOffset(ratelimit.ValueSize, 8)
+	offConnLimitValue   = nextOffset(connlimit.CounterValueSize, 8)
+	offConnLimitFlowKey = nextOffset(connlimit.FlowKeySize, 8)
 
 	// Offsets within the cal_tc_state struct.
 	// WARNING: must be kept in sync with the definitions in bpf-gpl/types.h.
@@ -159,6 +170,23 @@ var (
 	ipsKeyProto  int16 = 18
 	ipsKeyPad    int16 = 19
 
+	// Offsets within the rate limit map's key and value.
+	// WARNING: must be kept in sync with the definitions in bpf/ratelimit/map.go.
+	rlKeyRuleID int16 = 0
+	rlKeyAddr   int16 = 8
+	rlValTokens       = FieldOffset{Offset: 0, Field: "rlimit->tokens"}
+	rlValLastNs       = FieldOffset{Offset: 8, Field: "rlimit->last_ns"}
+
+	// Offsets within the connection limit maps' flow key and counter value.
+	// WARNING: must be kept in sync with the definitions in bpf/connlimit/map.go.
+	clFlowSrcAddr int16 = 0
+	clFlowDstAddr int16 = 16
+	clFlowSrcPort int16 = 32
+	clFlowDstPort int16 = 34
+	clFlowProto   int16 = 36
+	clFlowPad     int16 = 37
+	clValCount          = FieldOffset{Offset: 0, Field: "connlimit->count"}
+
 	// Bits in the state flags field.
 	FlagDestIsHost uint64 = 1 << 2
 	FlagSrcIsHost  uint64 = 1 << 3
@@ -750,6 +778,9 @@ func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
 		// If all the match criteria are met, we fall through to the end of the rule
 		// so all that's left to do is to jump to the relevant action.
 		// TODO log and log-and-xxx actions
+		if limit := rule.GetLimit(); limit != nil && strings.ToLower(rule.Action) == "allow" {
+			p.writeRuleLimit(rule, limit)
+		}
 		if p.flowLogsEnabled || p.policyDebugEnabled {
 			p.writeRecordRuleHit(rule, actionLabel)
 		}
@@ -759,6 +790,254 @@ func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
 	p.b.LabelNextInsn(p.endOfRuleLabel())
 }
 
+// writeRuleLimit emits the checks for the rule's rate and connection limits.  Limits are
+// enforced with conntrack, so XDP programs, which enforce untracked policy, don't support them.
+func (p *Builder) writeRuleLimit(rule Rule, limit *proto.RuleLimit) {
+	if p.xdp {
+		log.WithField("rule", rule.RuleId).Debug("Limits are not supported in XDP programs, ignoring.")
+		return
+	}
+	if limit.NewConnectionsPerSecond > 0 {
+		p.writeRateLimit(rule, limit)
+	}
+	if limit.MaxConnections > 0 {
+		p.writeConnLimit(rule, limit)
+	}
+}
+
+// writeLimitKey stores the key for the rule's limit maps on the stack: the rule ID followed by
+// the source IP, or zeros for a per-rule limit.
+func (p *Builder) writeLimitKey(rule Rule, limit *proto.RuleLimit) {
+	p.b.LoadImm64(R1, int64(rule.MatchID))
+	p.b.StoreStack64(R1, offLimitKey+rlKeyRuleID)
+	if limit.PerRule {
+		p.b.MovImm64(R1, 0)
+		p.b.StoreStack64(R1, offLimitKey+rlKeyAddr)
+		p.b.StoreStack64(R1, offLimitKey+rlKeyAddr+8)
+		return
+	}
+	p.writeStackIPv6Addr(offLimitKey+rlKeyAddr, stateOffIPSrc)
+}
+
+// writeStackIPv6Addr copies an IP address from the state to the stack as an IPv6 address.  IPv4
+// addresses are stored as IPv4-mapped IPv6 addresses, ::ffff:a.b.c.d.
+func (p *Builder) writeStackIPv6Addr(offset int16, addr FieldOffset) {
+	if p.forIPv6 {
+		p.b.Load64(R1, R9, addr)
+		p.b.StoreStack64(R1, offset)
+		addr.Offset += 8
+		p.b.Load64(R1, R9, addr)
+		p.b.StoreStack64(R1, offset+8)
+		return
+	}
+	p.b.MovImm64(R1, 0)
+	p.b.StoreStack64(R1, offset)
+	p.b.MovImm32(R1, int32(bits.ReverseBytes32(0x0000ffff)))
+	p.b.StoreStack32(R1, offset+8)
+	p.b.Load32(R1, R9, addr)
+	p.b.StoreStack32(R1, offset+12)
+}
+
+// writeRateLimit emits a token bucket that drops new connections that are over the rule's rate
+// limit.  Since the policy program only sees the first packet of each connection, each packet
+// uses one token.  The buckets are kept in the rate limit map, keyed on the rule and, unless the
+// limit is per-rule, the source IP.
+//
+// The bucket is updated without any locking so, when packets for the same bucket are processed
+// on several CPUs at once, the limit is approximate.
+func (p *Builder) writeRateLimit(rule Rule, limit *proto.RuleLimit) {
+	if p.rateLimitMapFD == 0 {
+		log.WithField("rule", rule.RuleId).Warn("No rate limit map, ignoring rule's rate limit.")
+		return
+	}
+
+	rate := uint64(limit.NewConnectionsPerSecond)
+	capacity := uint64(limit.Burst) * ratelimit.TokenScale
+	if capacity < ratelimit.TokenScale {
+		capacity = ratelimit.TokenScale
+	}
+	// Cap the elapsed time at the time it takes to fill the bucket so that the refill can't
+	// overflow.
+	maxElapsed := capacity/rate + 1
+
+	p.b.AddCommentF("Rate limit: %d new connections per second, burst %d", rate, capacity/ratelimit.TokenScale)
+
+	p.writeLimitKey(rule, limit)
+
+	// R7 = now.
+	p.b.Call(HelperKtimeGetNs)
+	p.b.Mov64(R7, R0)
+
+	p.b.LoadMapFD(R1, uint32(p.rateLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offLimitKey))
+	p.b.Call(HelperMapLookupElem)
+	haveBucketLabel := p.freshPerRuleLabel()
+	p.b.JumpNEImm64(R0, 0, haveBucketLabel)
+
+	// No bucket yet; create a full one, less the token for this connection.
+	p.b.AddComment("New rate limit bucket")
+	p.b.LoadImm64(R1, int64(capacity-ratelimit.TokenScale))
+	p.b.StoreStack64(R1, offRateLimitValue+rlValTokens.Offset)
+	p.b.StoreStack64(R7, offRateLimitValue+rlValLastNs.Offset)
+	p.b.LoadMapFD(R1, uint32(p.rateLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offLimitKey))
+	p.b.Mov64(R3, R10)
+	p.b.AddImm64(R3, int32(offRateLimitValue))
+	p.b.MovImm64(R4, 0) // BPF_ANY
+	p.b.Call(HelperMapUpdateElem)
+	allowedLabel := p.freshPerRuleLabel()
+	p.b.Jump(allowedLabel)
+
+	// R8 = bucket, R1 = tokens, R2 = last time.
+	p.b.LabelNextInsn(haveBucketLabel)
+	p.b.Mov64(R8, R0)
+	p.b.Load64(R1, R8, rlValTokens)
+	p.b.Load64(R2, R8, rlValLastNs)
+
+	// R3 = elapsed time, capped at maxElapsed.  Another CPU may have stored a later time.
+	notLaterLabel := p.freshPerRuleLabel()
+	p.b.JumpLE64(R2, R7, notLaterLabel)
+	p.b.Mov64(R2, R7)
+	p.b.LabelNextInsn(notLaterLabel)
+	p.b.Mov64(R3, R7)
+	p.b.Sub64(R3, R2)
+	p.b.LoadImm64(R4, int64(maxElapsed))
+	elapsedOKLabel := p.freshPerRuleLabel()
+	p.b.JumpLE64(R3, R4, elapsedOKLabel)
+	p.b.Mov64(R3, R4)
+	p.b.LabelNextInsn(elapsedOKLabel)
+
+	// Refill the bucket: tokens = min(tokens + elapsed * rate, capacity).
+	p.b.LoadImm64(R4, int64(rate))
+	p.b.Mul64(R3, R4)
+	p.b.Add64(R1, R3)
+	p.b.LoadImm64(R4, int64(capacity))
+	notFullLabel := p.freshPerRuleLabel()
+	p.b.JumpLE64(R1, R4, notFullLabel)
+	p.b.Mov64(R1, R4)
+	p.b.LabelNextInsn(notFullLabel)
+	p.b.Store64(R8, R7, rlValLastNs)
+
+	// Take a token if there is one, otherwise drop the connection.
+	p.b.LoadImm64(R4, ratelimit.TokenScale)
+	overLimitLabel := p.freshPerRuleLabel()
+	p.b.JumpLT64(R1, R4, overLimitLabel)
+	p.b.Sub64(R1, R4)
+	p.b.Store64(R8, R1, rlValTokens)
+	p.b.Jump(allowedLabel)
+
+	p.b.LabelNextInsn(overLimitLabel)
+	p.b.AddComment("Over the rate limit")
+	p.b.Store64(R8, R1, rlValTokens)
+	p.b.Jump("deny")
+
+	p.b.LabelNextInsn(allowedLabel)
+}
+
+// writeConnLimit emits a check that drops new connections once the rule has allowed its maximum
+// number of concurrent connections.  Each connection that the rule allows is recorded in the
+// connection limit flow map and counted in the counter map, which has the same key as the rate
+// limit map.  A connection is only counted the first time it is seen, so that retransmissions
+// of its first packet are not dropped.  The conntrack scanner in the connlimit package removes
+// the connection and decrements the count once the connection's conntrack entry has gone.
+//
+// The count is checked and incremented separately so, when new connections for the same key are
+// processed on several CPUs at once, the limit is approximate.
+func (p *Builder) writeConnLimit(rule Rule, limit *proto.RuleLimit) {
+	if p.connLimitMapFD == 0 || p.connLimitFlowMapFD == 0 {
+		log.WithField("rule", rule.RuleId).Warn("No connection limit maps, ignoring rule's connection limit.")
+		return
+	}
+
+	p.b.AddCommentF("Connection limit: %d concurrent connections", limit.MaxConnections)
+	p.writeLimitKey(rule, limit)
+
+	// Build the flow key from the post-NAT 5-tuple.
+	p.writeStackIPv6Addr(offConnLimitFlowKey+clFlowSrcAddr, stateOffIPSrc)
+	p.writeStackIPv6Addr(offConnLimitFlowKey+clFlowDstAddr, stateOffPostNATIPDst)
+	p.b.Load16(R1, R9, stateOffSrcPort)
+	p.b.StoreStack16(R1, offConnLimitFlowKey+clFlowSrcPort)
+	p.b.Load16(R1, R9, stateOffPostNATDstPort)
+	p.b.StoreStack16(R1, offConnLimitFlowKey+clFlowDstPort)
+	p.b.Load8(R1, R9, stateOffIPProto)
+	p.b.StoreStack8(R1, offConnLimitFlowKey+clFlowProto)
+	p.b.MovImm64(R1, 0)
+	p.b.StoreStack8(R1, offConnLimitFlowKey+clFlowPad)
+	p.b.StoreStack16(R1, offConnLimitFlowKey+clFlowPad+1)
+
+	// If the connection has already been counted, allow it.
+	allowedLabel := p.freshPerRuleLabel()
+	p.b.LoadMapFD(R1, uint32(p.connLimitFlowMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offConnLimitFlowKey))
+	p.b.Call(HelperMapLookupElem)
+	p.b.JumpNEImm64(R0, 0, allowedLabel)
+
+	// Drop the connection if the count has reached the limit.
+	p.b.LoadMapFD(R1, uint32(p.connLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offLimitKey))
+	p.b.Call(HelperMapLookupElem)
+	underLimitLabel := p.freshPerRuleLabel()
+	p.b.JumpEqImm64(R0, 0, underLimitLabel)
+	p.b.Load64(R1, R0, clValCount)
+	p.b.LoadImm64(R2, int64(limit.MaxConnections))
+	overLimitLabel := p.freshPerRuleLabel()
+	p.b.JumpGE64(R1, R2, overLimitLabel)
+
+	// Record the connection.  If that fails, the flow map is full; allow the connection without
+	// counting it, since an uncounted connection can't hold the count up forever.
+	p.b.LabelNextInsn(underLimitLabel)
+	p.b.LoadMapFD(R1, uint32(p.connLimitFlowMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offConnLimitFlowKey))
+	p.b.Mov64(R3, R10)
+	p.b.AddImm64(R3, int32(offLimitKey))
+	p.b.MovImm64(R4, 1) // BPF_NOEXIST
+	p.b.Call(HelperMapUpdateElem)
+	p.b.JumpNEImm64(R0, 0, allowedLabel)
+
+	// Count the connection, creating the counter for the first connection.
+	p.writeConnLimitIncrement(allowedLabel)
+	p.b.AddComment("New connection limit counter")
+	p.b.MovImm64(R1, 1)
+	p.b.StoreStack64(R1, offConnLimitValue)
+	p.b.LoadMapFD(R1, uint32(p.connLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offLimitKey))
+	p.b.Mov64(R3, R10)
+	p.b.AddImm64(R3, int32(offConnLimitValue))
+	p.b.MovImm64(R4, 1) // BPF_NOEXIST
+	p.b.Call(HelperMapUpdateElem)
+	p.b.JumpEqImm64(R0, 0, allowedLabel)
+	// Another CPU created the counter first.
+	p.writeConnLimitIncrement(allowedLabel)
+	p.b.Jump(allowedLabel)
+
+	p.b.LabelNextInsn(overLimitLabel)
+	p.b.AddComment("Over the connection limit")
+	p.b.Jump("deny")
+
+	p.b.LabelNextInsn(allowedLabel)
+}
+
+// writeConnLimitIncrement emits an atomic increment of the counter for the key on the stack,
+// followed by a jump to doneLabel.  If there is no counter, it falls through.
+func (p *Builder) writeConnLimitIncrement(doneLabel string) {
+	p.b.LoadMapFD(R1, uint32(p.connLimitMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offLimitKey))
+	p.b.Call(HelperMapLookupElem)
+	noCounterLabel := p.freshPerRuleLabel()
+	p.b.JumpEqImm64(R0, 0, noCounterLabel)
+	p.b.MovImm64(R1, 1)
+	p.b.AtomicAdd64(R0, R1, clValCount)
+	p.b.Jump(doneLabel)
+	p.b.LabelNextInsn(noCounterLabel)
+}
+
 func (p *Builder) writeProtoMatch(negate bool, protocol *proto.Protocol) {
 	if negate {
 		p.b.AddCommentF("If protocol == %s, skip to next rule", protocolToName(protocol))
@@ -1265,6 +1544,23 @@ func WithPolicyMapIndexAndStride(entryPointIdx, stride int) Option {
 	}
 }
 
+// WithConnLimitMaps provides the maps that hold the state for the rules' connection limits.
+// Without them, connection limits are ignored.
+func WithConnLimitMaps(counterFD, flowFD maps.FD) Option {
+	return func(b *Builder) {
+		b.connLimitMapFD = counterFD
+		b.connLimitFlowMapFD = flowFD
+	}
+}
+
+// WithRateLimitMap provides the map that holds the state for the rules' rate limits.  Without it,
+// rate limits are ignored.
+func WithRateLimitMap(fd maps.FD) Option {
+	return func(b *Builder) {
+		b.rateLimitMapFD = fd
+	}
+}
+
 func WithIPv6() Option {
 	return func(p *Builder) {
 		p.forIPv6 = true
@@ -16,6 +16,7 @@ package polprog
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -208,3 +209,57 @@ func TestProgramSplitting(t *testing.T) {
 	Expect(len(progs)).To(BeNumerically(">=", 6))
 	Expect(len(progs)).To(BeNumerically("<=", 8))
 }
+
+func TestRuleLimits(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+
+	limitComments := func(rule *proto.Rule, opts ...Option) []string {
+		opts = append(opts, WithAllowDenyJumps(666, 777), WithPolicyDebugEnabled())
+		pg := NewBuilder(alloc, 1, 2, 3, 4, opts...)
+		insns, err := pg.Instructions(Rules{
+			Tiers: []Tier{{
+				Policies: []Policy{{
+					Rules: []Rule{{Rule: rule}},
+				}},
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var rlComments []string
+		_, comments := aggregateCommentsAndLabels(&insns[0])
+		for _, c := range comments {
+			if strings.Contains(c, "limit") && !strings.HasPrefix(c, "Start of rule") {
+				rlComments = append(rlComments, c)
+			}
+		}
+		return rlComments
+	}
+
+	limit := &proto.RuleLimit{NewConnectionsPerSecond: 10, Burst: 20}
+	expected := []string{"Rate limit: 10 new connections per second, burst 20", "New rate limit bucket", "Over the rate limit"}
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: limit}, WithRateLimitMap(5))).To(Equal(expected))
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: limit}, WithRateLimitMap(5), WithIPv6())).To(Equal(expected))
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: &proto.RuleLimit{
+		NewConnectionsPerSecond: 10, Burst: 20, PerRule: true,
+	}}, WithRateLimitMap(5))).To(Equal(expected))
+
+	// Rate limits need the map, and only apply to allow rules.
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: limit})).To(BeEmpty())
+	Expect(limitComments(&proto.Rule{Action: "Deny", Limit: limit}, WithRateLimitMap(5))).To(BeEmpty())
+
+	connLimit := &proto.RuleLimit{MaxConnections: 10}
+	expected = []string{"Connection limit: 10 concurrent connections", "New connection limit counter", "Over the connection limit"}
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: connLimit}, WithConnLimitMaps(6, 7))).To(Equal(expected))
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: connLimit}, WithConnLimitMaps(6, 7), WithIPv6())).To(Equal(expected))
+
+	// Connection limits need the maps.
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: connLimit}, WithRateLimitMap(5))).To(BeEmpty())
+
+	// Both limits may apply to the same rule; the rate limit is checked first.
+	Expect(limitComments(&proto.Rule{Action: "Allow", Limit: &proto.RuleLimit{
+		NewConnectionsPerSecond: 10, Burst: 20, MaxConnections: 10,
+	}}, WithRateLimitMap(5), WithConnLimitMaps(6, 7))).To(Equal([]string{
+		"Rate limit: 10 new connections per second, burst 20", "New rate limit bucket", "Over the rate limit",
+		"Connection limit: 10 concurrent connections", "New connection limit counter", "Over the connection limit",
+	}))
+}
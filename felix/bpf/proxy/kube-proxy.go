@@ -46,6 +46,7 @@ type KubeProxy struct {
 	frontendMap maps.MapWithExistsCheck
 	backendMap  maps.MapWithExistsCheck
 	affinityMap maps.Map
+	maglevMap   maps.MapWithExistsCheck
 	ctMap       maps.Map
 	rt          *RTCache
 	opts        []Option
@@ -53,6 +54,9 @@ type KubeProxy struct {
 	excludedCIDRs *ip.CIDRTrie
 
 	dsrEnabled bool
+
+	maglevMaxServices int
+	maglevDefault     bool
 }
 
 // StartKubeProxy start a new kube-proxy if there was no error
@@ -74,6 +78,10 @@ func StartKubeProxy(k8s kubernetes.Interface, hostname string,
 		exiting:       make(chan struct{}),
 	}
 
+	if m, ok := bpfMaps.MaglevMap.(maps.MapWithExistsCheck); ok {
+		kp.maglevMap = m
+	}
+
 	for _, o := range opts {
 		if err := o(kp); err != nil {
 			return nil, errors.WithMessage(err, "applying option to kube-proxy")
@@ -132,7 +140,7 @@ func (kp *KubeProxy) run(hostIPs []net.IP) error {
 	}
 
 	syncer, err := NewSyncer(kp.ipFamily, withLocalNP, kp.frontendMap, kp.backendMap, kp.affinityMap,
-		kp.rt, kp.excludedCIDRs)
+		kp.rt, kp.excludedCIDRs, kp.maglevOpts())
 	if err != nil {
 		return errors.WithMessage(err, "new bpf syncer")
 	}
@@ -154,7 +162,8 @@ func (kp *KubeProxy) start() error {
 		withLocalNP = append(withLocalNP, podNPIPV6)
 	}
 
-	syncer, err := NewSyncer(kp.ipFamily, withLocalNP, kp.frontendMap, kp.backendMap, kp.affinityMap, kp.rt, kp.excludedCIDRs)
+	syncer, err := NewSyncer(kp.ipFamily, withLocalNP, kp.frontendMap, kp.backendMap, kp.affinityMap, kp.rt,
+		kp.excludedCIDRs, kp.maglevOpts())
 	if err != nil {
 		return errors.WithMessage(err, "new bpf syncer")
 	}
@@ -201,6 +210,17 @@ func (kp *KubeProxy) start() error {
 	return nil
 }
 
+func (kp *KubeProxy) maglevOpts() *MaglevOpts {
+	if kp.maglevMap == nil || kp.maglevMaxServices == 0 {
+		return nil
+	}
+	return &MaglevOpts{
+		Map:         kp.maglevMap,
+		MaxServices: kp.maglevMaxServices,
+		Default:     kp.maglevDefault,
+	}
+}
+
 // OnHostIPsUpdate should be used by an external user to update the proxy's list
 // of host IPs
 func (kp *KubeProxy) OnHostIPsUpdate(IPs []net.IP) {
@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"hash/fnv"
+)
+
+// MaglevLUT builds a Maglev lookup table of the given size for the given backends as described
+// in "Maglev: A Fast and Reliable Software Network Load Balancer" (Eisenbud et al., NSDI 2016).
+// Each element of the returned table is an index into backends.  The table depends only on the
+// backend names and their order, so every node that knows the same set of backends computes
+// the same table, and adding or removing a backend only remaps a small share of the buckets.
+// The size must be a prime for the table to be fully populated.  Returns nil if there are no
+// backends.
+func MaglevLUT(backends []string, size int) []int {
+	n := len(backends)
+	if n == 0 || size <= 1 {
+		return nil
+	}
+
+	offsets := make([]int, n)
+	skips := make([]int, n)
+	for i, b := range backends {
+		offsets[i] = int(maglevHash(b, 0xd4) % uint64(size))
+		skips[i] = int(maglevHash(b, 0x37)%uint64(size-1)) + 1
+	}
+
+	lut := make([]int, size)
+	for i := range lut {
+		lut[i] = -1
+	}
+	next := make([]int, n)
+
+	filled := 0
+	for {
+		for i := 0; i < n; i++ {
+			// Find the next preferred bucket of this backend that's still free.
+			c := (offsets[i] + next[i]*skips[i]) % size
+			for lut[c] >= 0 {
+				next[i]++
+				c = (offsets[i] + next[i]*skips[i]) % size
+			}
+			lut[c] = i
+			next[i]++
+			filled++
+			if filled == size {
+				return lut
+			}
+		}
+	}
+}
+
+func maglevHash(s string, seed byte) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte{seed})
+	_, _ = h.Write([]byte(s))
+	return h.Sum64()
+}
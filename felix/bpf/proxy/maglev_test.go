@@ -0,0 +1,225 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sp "k8s.io/kubernetes/pkg/proxy"
+
+	"github.com/projectcalico/calico/felix/bpf/nat"
+	proxy "github.com/projectcalico/calico/felix/bpf/proxy"
+)
+
+func maglevBackends(n int) []string {
+	backends := make([]string, n)
+	for i := range backends {
+		backends[i] = fmt.Sprintf("10.65.%d.%d:8080", i/256, i%256)
+	}
+	return backends
+}
+
+func TestMaglevLUTIsFullAndBalanced(t *testing.T) {
+	RegisterTestingT(t)
+
+	backends := maglevBackends(10)
+	lut := proxy.MaglevLUT(backends, nat.MaglevLUTSize)
+	Expect(lut).To(HaveLen(nat.MaglevLUTSize))
+
+	counts := make([]int, len(backends))
+	for _, idx := range lut {
+		Expect(idx).To(BeNumerically(">=", 0))
+		Expect(idx).To(BeNumerically("<", len(backends)))
+		counts[idx]++
+	}
+	// Maglev guarantees that the shares differ by at most one bucket.
+	for _, c := range counts {
+		Expect(c).To(BeNumerically("~", nat.MaglevLUTSize/len(backends), 1))
+	}
+
+	Expect(proxy.MaglevLUT(backends, nat.MaglevLUTSize)).To(Equal(lut), "Table should be deterministic")
+}
+
+func TestMaglevLUTMinimalDisruption(t *testing.T) {
+	RegisterTestingT(t)
+
+	backends := maglevBackends(20)
+	before := proxy.MaglevLUT(backends, nat.MaglevLUTSize)
+
+	// Remove one backend, the buckets of the others should mostly stay put.
+	removed := 7
+	after := proxy.MaglevLUT(append(backends[:removed:removed], backends[removed+1:]...), nat.MaglevLUTSize)
+
+	moved := 0
+	for bucket := range before {
+		if before[bucket] == removed {
+			continue
+		}
+		if backends[before[bucket]] != backendsWithout(backends, removed)[after[bucket]] {
+			moved++
+		}
+	}
+	Expect(moved).To(BeNumerically("<", nat.MaglevLUTSize/10))
+}
+
+func backendsWithout(backends []string, i int) []string {
+	return append(backends[:i:i], backends[i+1:]...)
+}
+
+func TestMaglevLUTNoBackends(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(proxy.MaglevLUT(nil, nat.MaglevLUTSize)).To(BeNil())
+}
+
+var _ = Describe("BPF Syncer Maglev", func() {
+	var (
+		svcs   *mockNATMap
+		eps    *mockNATBackendMap
+		maglev *mockNATBackendMap
+		s      *proxy.Syncer
+	)
+
+	svcKey := k8sp.ServicePortName{
+		NamespacedName: types.NamespacedName{
+			Namespace: "default",
+			Name:      "maglev-service",
+		},
+	}
+	svcIP := net.IPv4(10, 0, 0, 1)
+	svcNATKey := nat.NewNATKey(svcIP, 1234, proxy.ProtoV1ToIntPanic(v1.ProtocolTCP))
+
+	makeState := func(selection string, numEps int) proxy.DPSyncerState {
+		var opts []proxy.K8sServicePortOption
+		if selection != "" {
+			opts = append(opts, proxy.K8sSvcWithBackendSelection(selection))
+		}
+		var endpoints []k8sp.Endpoint
+		for i := 0; i < numEps; i++ {
+			endpoints = append(endpoints, proxy.NewEndpointInfo(fmt.Sprintf("10.1.0.%d", i+1), 5555,
+				proxy.EndpointInfoOptIsReady(true)))
+		}
+		return proxy.DPSyncerState{
+			SvcMap: k8sp.ServicePortMap{
+				svcKey: proxy.NewK8sServicePort(svcIP, 1234, v1.ProtocolTCP, opts...),
+			},
+			EpsMap: k8sp.EndpointsMap{
+				svcKey: endpoints,
+			},
+		}
+	}
+
+	newSyncer := func(maxServices int, byDefault bool) {
+		svcs = newMockNATMap()
+		eps = newMockNATBackendMap()
+		maglev = newMockNATBackendMap()
+		s, _ = proxy.NewSyncer(4, []net.IP{net.IPv4(192, 168, 0, 1)}, svcs, eps, newMockAffinityMap(),
+			proxy.NewRTCache(), nil, &proxy.MaglevOpts{
+				Map:         maglev,
+				MaxServices: maxServices,
+				Default:     byDefault,
+			})
+	}
+
+	It("should program a lookup table for a service that requests Maglev", func() {
+		newSyncer(10, false)
+		Expect(s.Apply(makeState(proxy.BackendSelectionMaglev, 3))).To(Succeed())
+
+		val, ok := svcs.m[svcNATKey]
+		Expect(ok).To(BeTrue())
+		Expect(val.Flags() & nat.NATFlgMaglev).NotTo(BeZero())
+
+		Expect(maglev.m).To(HaveLen(nat.MaglevLUTSize))
+		backends := map[nat.BackendValue]int{}
+		for k, v := range maglev.m {
+			Expect(k.ID()).To(Equal(val.ID()))
+			backends[v]++
+		}
+		Expect(backends).To(HaveLen(3))
+		Expect(backends).To(HaveKey(nat.NewNATBackendValue(net.IPv4(10, 1, 0, 1), 5555)))
+
+		By("removing the lookup table when the service stops using Maglev")
+		Expect(s.Apply(makeState(proxy.BackendSelectionRandom, 3))).To(Succeed())
+		val = svcs.m[svcNATKey]
+		Expect(val.Flags() & nat.NATFlgMaglev).To(BeZero())
+		Expect(maglev.m).To(BeEmpty())
+	})
+
+	It("should use Maglev by default when configured to", func() {
+		newSyncer(10, true)
+		Expect(s.Apply(makeState("", 2))).To(Succeed())
+		Expect(svcs.m[svcNATKey].Flags() & nat.NATFlgMaglev).NotTo(BeZero())
+		Expect(maglev.m).To(HaveLen(nat.MaglevLUTSize))
+
+		By("letting the service opt out")
+		Expect(s.Apply(makeState(proxy.BackendSelectionRandom, 2))).To(Succeed())
+		Expect(svcs.m[svcNATKey].Flags() & nat.NATFlgMaglev).To(BeZero())
+		Expect(maglev.m).To(BeEmpty())
+	})
+
+	It("should not program a lookup table for a service without backends", func() {
+		newSyncer(10, true)
+		Expect(s.Apply(makeState("", 0))).To(Succeed())
+		Expect(svcs.m[svcNATKey].Flags() & nat.NATFlgMaglev).To(BeZero())
+		Expect(maglev.m).To(BeEmpty())
+	})
+
+	It("should fall back to random selection when there is no room for the lookup table", func() {
+		newSyncer(1, true)
+		state := makeState("", 2)
+		svcKey2 := k8sp.ServicePortName{
+			NamespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "second-service",
+			},
+		}
+		state.SvcMap[svcKey2] = proxy.NewK8sServicePort(net.IPv4(10, 0, 0, 2), 1234, v1.ProtocolTCP)
+		state.EpsMap[svcKey2] = []k8sp.Endpoint{
+			proxy.NewEndpointInfo("10.1.1.1", 5555, proxy.EndpointInfoOptIsReady(true)),
+		}
+		Expect(s.Apply(state)).To(Succeed())
+
+		maglevSvcs := 0
+		for _, v := range svcs.m {
+			if v.Flags()&nat.NATFlgMaglev != 0 {
+				maglevSvcs++
+			}
+		}
+		Expect(maglevSvcs).To(Equal(1))
+		Expect(maglev.m).To(HaveLen(nat.MaglevLUTSize))
+	})
+
+	It("should compute the same table regardless of which backends are local", func() {
+		newSyncer(10, true)
+		Expect(s.Apply(makeState("", 3))).To(Succeed())
+		remote := map[nat.BackendKey]nat.BackendValue{}
+		for k, v := range maglev.m {
+			remote[k] = v
+		}
+
+		newSyncer(10, true)
+		state := makeState("", 3)
+		state.EpsMap[svcKey][2] = proxy.NewEndpointInfo("10.1.0.3", 5555,
+			proxy.EndpointInfoOptIsReady(true), proxy.EndpointInfoOptIsLocal(true))
+		Expect(s.Apply(state)).To(Succeed())
+		Expect(maglev.m).To(Equal(remote))
+	})
+})
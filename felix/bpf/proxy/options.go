@@ -71,6 +71,16 @@ func WithDSREnabled() Option {
 	})
 }
 
+// WithMaglev enables Maglev backend selection for up to maxServices services.  If
+// byDefault is set, services use Maglev unless they opt out through an annotation.
+func WithMaglev(maxServices int, byDefault bool) Option {
+	return makeKubeProxyOption(func(kp *KubeProxy) error {
+		kp.maglevMaxServices = maxServices
+		kp.maglevDefault = byDefault
+		return nil
+	})
+}
+
 // WithTopologyNodeZone sets the topology node zone
 func WithTopologyNodeZone(nodeZone string) Option {
 	return makeOption(func(p *proxy) error {
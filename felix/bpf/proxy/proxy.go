@@ -388,16 +388,24 @@ const (
 	ReapTerminatingUDPImmediatelly = "TerminatingImmediately"
 
 	ExcludeServiceAnnotation = "projectcalico.org/natExcludeService"
+
+	// BackendSelectionAnnotation selects how a backend is picked for new connections to the
+	// service, overriding the BPFServiceBackendSelection configuration.
+	BackendSelectionAnnotation = "projectcalico.org/backendSelection"
+	BackendSelectionRandom     = "Random"
+	BackendSelectionMaglev     = "Maglev"
 )
 
 type ServiceAnnotations interface {
 	ReapTerminatingUDP() bool
 	ExcludeService() bool
+	BackendSelection() string
 }
 
 type servicePortAnnotations struct {
 	reapTerminatingUDP bool
 	excludeService     bool
+	backendSelection   string
 }
 
 func (s *servicePortAnnotations) ReapTerminatingUDP() bool {
@@ -408,6 +416,12 @@ func (s *servicePortAnnotations) ExcludeService() bool {
 	return s.excludeService
 }
 
+// BackendSelection returns the backend selection algorithm requested by the service's
+// annotation, or an empty string if the service doesn't request one.
+func (s *servicePortAnnotations) BackendSelection() string {
+	return s.backendSelection
+}
+
 type servicePort struct {
 	k8sp.ServicePort
 	servicePortAnnotations
@@ -423,6 +437,21 @@ func makeServiceInfo(_ *v1.ServicePort, s *v1.Service, baseSvc *k8sp.BaseService
 		goto out
 	}
 
+	if v, ok := s.ObjectMeta.Annotations[BackendSelectionAnnotation]; ok {
+		switch {
+		case strings.EqualFold(v, BackendSelectionMaglev):
+			svc.backendSelection = BackendSelectionMaglev
+		case strings.EqualFold(v, BackendSelectionRandom):
+			svc.backendSelection = BackendSelectionRandom
+		default:
+			log.WithFields(log.Fields{
+				"service":    s.Name,
+				"namespace":  s.Namespace,
+				"annotation": v,
+			}).Warn("Unknown backend selection requested, ignoring annotation.")
+		}
+	}
+
 	if baseSvc.Protocol() == v1.ProtocolUDP {
 		if v, ok := s.ObjectMeta.Annotations[ReapTerminatingUDPAnnotation]; ok && strings.EqualFold(v, ReapTerminatingUDPImmediatelly) {
 			svc.reapTerminatingUDP = true
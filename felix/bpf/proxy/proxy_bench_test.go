@@ -47,6 +47,7 @@ func benchmarkProxyUpdates(b *testing.B, svcN, epsN int) {
 			&mock.DummyMap{},
 			proxy.NewRTCache(),
 			nil,
+			nil,
 		)
 		Expect(err).ShouldNot(HaveOccurred())
 
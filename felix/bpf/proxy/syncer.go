@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -51,6 +52,7 @@ type svcInfo struct {
 	id         uint32
 	count      int
 	localCount int
+	maglev     bool
 	svc        Service
 }
 
@@ -109,6 +111,17 @@ type stickyFrontend struct {
 	timeo time.Duration
 }
 
+// MaglevOpts configures Maglev backend selection in the Syncer.
+type MaglevOpts struct {
+	// Map holds the lookup tables of all the services that use Maglev.
+	Map maps.MapWithExistsCheck
+	// MaxServices is the number of lookup tables that fit in the Map.
+	MaxServices int
+	// Default makes the services that do not select an algorithm through the
+	// BackendSelectionAnnotation use Maglev.
+	Default bool
+}
+
 // Syncer is an implementation of DPSyncer interface. It is not thread safe and
 // should be called only once at a time
 type Syncer struct {
@@ -118,6 +131,12 @@ type Syncer struct {
 	bpfEps  *cachingmap.CachingMap[nat.BackendKey, nat.BackendValueInterface]
 	bpfAff  maps.Map
 
+	// bpfMaglev is nil if Maglev is not available.
+	bpfMaglev         *cachingmap.CachingMap[nat.BackendKey, nat.BackendValueInterface]
+	maglevMaxServices int
+	maglevDefault     bool
+	maglevServices    int
+
 	nextSvcID uint32
 
 	nodePortIPs []net.IP
@@ -214,6 +233,7 @@ func NewSyncer(family int, nodePortIPs []net.IP,
 	frontendMap maps.MapWithExistsCheck, backendMap maps.MapWithExistsCheck,
 	affmap maps.Map, rt Routes,
 	excludedCIDRs *ip.CIDRTrie,
+	maglev *MaglevOpts,
 ) (*Syncer, error) {
 
 	s := &Syncer{
@@ -260,6 +280,19 @@ func NewSyncer(family int, nodePortIPs []net.IP,
 		return nil, fmt.Errorf("unknwn family %d", family)
 	}
 
+	if maglev != nil && maglev.Map != nil {
+		backendValueFromBytes := nat.BackendValueFromBytes
+		if family == 6 {
+			backendValueFromBytes = nat.BackendValueV6FromBytes
+		}
+		s.bpfMaglev = cachingmap.New[nat.BackendKey, nat.BackendValueInterface](maglev.Map.GetName(),
+			maps.NewTypedMap[nat.BackendKey, nat.BackendValueInterface](
+				maglev.Map, nat.BackendKeyFromBytes, backendValueFromBytes,
+			))
+		s.maglevMaxServices = maglev.MaxServices
+		s.maglevDefault = maglev.Default
+	}
+
 	return s, nil
 }
 
@@ -272,6 +305,12 @@ func (s *Syncer) loadOrigs() error {
 	if err != nil {
 		return err
 	}
+	if s.bpfMaglev != nil {
+		err = s.bpfMaglev.LoadCacheFromDataplane()
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -344,6 +383,7 @@ func (s *Syncer) startupBuildPrev(state DPSyncerState) error {
 			id:         id,
 			count:      count,
 			localCount: int(svcv.LocalCount()),
+			maglev:     svcv.Flags()&nat.NATFlgMaglev != 0,
 			svc:        state.SvcMap[svckey.sname].(Service),
 		}
 
@@ -404,7 +444,7 @@ func (s *Syncer) applySvc(skey svcKey, sinfo Service, eps []k8sp.Endpoint) error
 	} else {
 		id = s.newSvcID()
 	}
-	count, local, err := s.updateService(skey, sinfo, id, eps)
+	count, local, maglev, err := s.updateService(skey, sinfo, id, eps)
 	if err != nil {
 		return err
 	}
@@ -413,6 +453,7 @@ func (s *Syncer) applySvc(skey svcKey, sinfo Service, eps []k8sp.Endpoint) error
 		id:         id,
 		count:      count,
 		localCount: local,
+		maglev:     maglev,
 		svc:        sinfo,
 	}
 
@@ -539,6 +580,9 @@ func (s *Syncer) applyDerived(
 
 	skey = getSvcKey(sname, getSvcKeyExtra(t, sinfo.ClusterIP()))
 	flags := uint32(0)
+	if svc.maglev {
+		flags |= nat.NATFlgMaglev
+	}
 
 	switch t {
 	case svcTypeNodePort, svcTypeLoadBalancer, svcTypeNodePortRemote:
@@ -554,6 +598,7 @@ func (s *Syncer) applyDerived(
 		id:         svc.id,
 		count:      count,
 		localCount: local,
+		maglev:     svc.maglev,
 		svc:        sinfo,
 	}
 
@@ -593,6 +638,10 @@ func (s *Syncer) apply(state DPSyncerState) error {
 	// let CachingMap calculate deltas...
 	s.bpfSvcs.Desired().DeleteAll()
 	s.bpfEps.Desired().DeleteAll()
+	if s.bpfMaglev != nil {
+		s.bpfMaglev.Desired().DeleteAll()
+	}
+	s.maglevServices = 0
 
 	// insert or update existing services
 	for sname, sinfo := range state.SvcMap {
@@ -681,6 +730,12 @@ func (s *Syncer) apply(state DPSyncerState) error {
 	if err != nil {
 		return err
 	}
+	if s.bpfMaglev != nil {
+		err = s.bpfMaglev.ApplyUpdatesOnly()
+		if err != nil {
+			return err
+		}
+	}
 	// Update the frontends, after this is done we should be handling packets correctly.
 	err = s.bpfSvcs.ApplyUpdatesOnly()
 	if err != nil {
@@ -691,6 +746,12 @@ func (s *Syncer) apply(state DPSyncerState) error {
 	if err != nil {
 		return err
 	}
+	if s.bpfMaglev != nil {
+		err = s.bpfMaglev.ApplyDeletionsOnly()
+		if err != nil {
+			return err
+		}
+	}
 
 	log.Info("new state written")
 
@@ -745,8 +806,9 @@ func (s *Syncer) Apply(state DPSyncerState) error {
 	return s.cleanupSticky()
 }
 
-func (s *Syncer) updateService(skey svcKey, sinfo Service, id uint32, eps []k8sp.Endpoint) (int, int, error) {
+func (s *Syncer) updateService(skey svcKey, sinfo Service, id uint32, eps []k8sp.Endpoint) (int, int, bool, error) {
 	cpEps := make([]k8sp.Endpoint, 0, len(eps))
+	var readyEps []k8sp.Endpoint
 
 	cnt := 0
 	local := 0
@@ -765,8 +827,9 @@ func (s *Syncer) updateService(skey svcKey, sinfo Service, id uint32, eps []k8sp
 		// eps could contain Ready and Terminating pods but only write Ready pods to backend.
 		if ep.IsReady() {
 			if err := s.writeSvcBackend(id, uint32(cnt), ep); err != nil {
-				return 0, 0, err
+				return 0, 0, false, err
 			}
+			readyEps = append(readyEps, ep)
 			cnt++
 			local++
 		}
@@ -782,8 +845,9 @@ func (s *Syncer) updateService(skey svcKey, sinfo Service, id uint32, eps []k8sp
 		// eps could contain Ready and Terminating pods but only write Ready pods to backend.
 		if ep.IsReady() {
 			if err := s.writeSvcBackend(id, uint32(cnt), ep); err != nil {
-				return 0, 0, err
+				return 0, 0, false, err
 			}
+			readyEps = append(readyEps, ep)
 			cnt++
 		}
 
@@ -795,8 +859,14 @@ func (s *Syncer) updateService(skey svcKey, sinfo Service, id uint32, eps []k8sp
 		flags |= nat.NATFlgInternalLocal
 	}
 
+	maglev := s.useMaglev(skey, sinfo, cnt)
+	if maglev {
+		s.writeSvcMaglevLUT(id, readyEps)
+		flags |= nat.NATFlgMaglev
+	}
+
 	if err := s.writeSvc(sinfo, id, cnt, local, flags); err != nil {
-		return 0, 0, err
+		return 0, 0, false, err
 	}
 
 	// svcTypeNodePortRemote is semi-primary service - it has a different set of
@@ -811,7 +881,56 @@ func (s *Syncer) updateService(skey svcKey, sinfo Service, id uint32, eps []k8sp
 		s.newEpsMap[skey.sname] = cpEps
 	}
 
-	return cnt, local, nil
+	return cnt, local, maglev, nil
+}
+
+// useMaglev decides whether the service should use Maglev backend selection and, if so,
+// reserves room for its lookup table.
+func (s *Syncer) useMaglev(skey svcKey, sinfo Service, count int) bool {
+	if s.bpfMaglev == nil || count == 0 {
+		return false
+	}
+
+	switch sinfo.BackendSelection() {
+	case BackendSelectionMaglev:
+	case BackendSelectionRandom:
+		return false
+	default:
+		if !s.maglevDefault {
+			return false
+		}
+	}
+
+	if s.maglevServices >= s.maglevMaxServices {
+		log.WithField("service", skey).Warnf("No room for another Maglev lookup table (limit is %d services), "+
+			"service will use random backend selection.", s.maglevMaxServices)
+		return false
+	}
+	s.maglevServices++
+
+	return true
+}
+
+// writeSvcMaglevLUT writes the Maglev lookup table of the service with the given ID.  The
+// backends are sorted so that the table doesn't depend on the order in which this node
+// learned about them, nor on which of them are local to this node.
+func (s *Syncer) writeSvcMaglevLUT(svcID uint32, eps []k8sp.Endpoint) {
+	eps = slices.Clone(eps)
+	slices.SortFunc(eps, func(a, b k8sp.Endpoint) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	names := make([]string, len(eps))
+	for i, ep := range eps {
+		names[i] = ep.String()
+	}
+
+	for bucket, idx := range MaglevLUT(names, nat.MaglevLUTSize) {
+		ep := eps[idx]
+		port := ep.Port() // it is error free by this point
+		val := s.newBackendValue(net.ParseIP(ep.IP()), uint16(port))
+		s.bpfMaglev.Desired().Set(nat.NewNATBackendKey(svcID, uint32(bucket)), val)
+	}
 }
 
 func (s *Syncer) writeSvcBackend(svcID uint32, idx uint32, ep k8sp.Endpoint) error {
@@ -1552,6 +1671,14 @@ func K8sSvcWithHintsAnnotation(hintsAnnotation string) K8sServicePortOption {
 	}
 }
 
+// K8sSvcWithBackendSelection sets the backend selection algorithm as if it was
+// requested by the BackendSelectionAnnotation.
+func K8sSvcWithBackendSelection(selection string) K8sServicePortOption {
+	return func(s interface{}) {
+		s.(*servicePort).backendSelection = selection
+	}
+}
+
 func K8sSvcWithReapTerminatingUDP() K8sServicePortOption {
 	return func(s interface{}) {
 		s.(*servicePort).reapTerminatingUDP = true
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/projectcalico/calico/felix/bpf/maps"
+)
+
+// The rate limit map holds the token buckets for the policy rules that limit the rate of new
+// connections.  It is only accessed by the policy programs, which are generated by the polprog
+// package.
+//
+// WARNING: must be kept in sync with the definitions in bpf/polprog/pol_prog_builder.go.
+//
+// Key:
+// uint64 rule_id HE    8
+// uint8 addr[16] BE    +16 = 24  (IPv4 addresses are IPv4-mapped, all zeros for per-rule limits)
+//
+// Value:
+// uint64 tokens        8  (in units of 1/TokenScale connections)
+// uint64 last_ns       +8 = 16
+const (
+	KeySize   = 24
+	ValueSize = 16
+
+	// TokenScale is the number of token units that one connection uses.  Working in nanoseconds
+	// means that the refill for an interval is just the elapsed time multiplied by the rate.
+	TokenScale = 1_000_000_000
+)
+
+var MapParameters = maps.MapParameters{
+	Type:       "lru_hash",
+	KeySize:    KeySize,
+	ValueSize:  ValueSize,
+	MaxEntries: 65536,
+	Name:       "cali_rlimit",
+}
+
+func Map() maps.Map {
+	return maps.NewPinnedMap(MapParameters)
+}
+
+type Key [KeySize]byte
+
+func NewKey(ruleID uint64, addr net.IP) Key {
+	var k Key
+	binary.LittleEndian.PutUint64(k[0:8], ruleID)
+	if addr != nil {
+		copy(k[8:24], addr.To16())
+	}
+	return k
+}
+
+func (k Key) AsBytes() []byte {
+	return k[:]
+}
+
+type Value struct {
+	Tokens uint64
+	LastNs uint64
+}
+
+func ValueFromBytes(b []byte) Value {
+	return Value{
+		Tokens: binary.LittleEndian.Uint64(b[0:8]),
+		LastNs: binary.LittleEndian.Uint64(b[8:16]),
+	}
+}
@@ -581,6 +581,7 @@ var (
 
 	natMap, natBEMap, ctMap, rtMap, ipsMap, testStateMap, affinityMap, arpMap, fsafeMap     maps.Map
 	natMapV6, natBEMapV6, ctMapV6, rtMapV6, ipsMapV6, affinityMapV6, arpMapV6, fsafeMapV6   maps.Map
+	maglevMap, maglevMapV6                                                                  maps.Map
 	stateMap, countersMap, ifstateMap, progMap, progMapXDP, policyJumpMap, policyJumpMapXDP maps.Map
 	perfMap                                                                                 maps.Map
 	profilingMap                                                                            maps.Map
@@ -603,6 +604,8 @@ func initMapsOnce() {
 		testStateMap = state.MapForTest()
 		affinityMap = nat.AffinityMap()
 		affinityMapV6 = nat.AffinityMapV6()
+		maglevMap = nat.MaglevMap()
+		maglevMapV6 = nat.MaglevMapV6()
 		arpMap = arp.Map()
 		arpMapV6 = arp.MapV6()
 		fsafeMap = failsafes.Map()
@@ -616,7 +619,7 @@ func initMapsOnce() {
 		perfMap = perf.Map("perf_evnt", 512)
 
 		allMaps = []maps.Map{natMap, natBEMap, natMapV6, natBEMapV6, ctMap, ctMapV6, rtMap, rtMapV6, ipsMap, ipsMapV6,
-			stateMap, testStateMap, affinityMap, affinityMapV6, arpMap, arpMapV6, fsafeMap, fsafeMapV6,
+			stateMap, testStateMap, affinityMap, affinityMapV6, maglevMap, maglevMapV6, arpMap, arpMapV6, fsafeMap, fsafeMapV6,
 			countersMap, ifstateMap, profilingMap,
 			policyJumpMap, policyJumpMapXDP}
 		for _, m := range allMaps {
@@ -28,8 +28,10 @@ import (
 	conntrack3 "github.com/projectcalico/calico/felix/bpf/conntrack/v3"
 	v3 "github.com/projectcalico/calico/felix/bpf/conntrack/v3"
 	"github.com/projectcalico/calico/felix/bpf/counters"
+	"github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/nat"
 	"github.com/projectcalico/calico/felix/bpf/polprog"
+	"github.com/projectcalico/calico/felix/bpf/proxy"
 	"github.com/projectcalico/calico/felix/bpf/routes"
 	tcdefs "github.com/projectcalico/calico/felix/bpf/tc/defs"
 	"github.com/projectcalico/calico/felix/ip"
@@ -1868,6 +1870,140 @@ func TestNATSYNRetryGoesToSameBackend(t *testing.T) {
 	})
 }
 
+func TestNATMaglev(t *testing.T) {
+	RegisterTestingT(t)
+
+	cleanUpMaps()
+	defer cleanUpMaps()
+
+	tcpSyn := &layers.TCP{
+		SrcPort:    54321,
+		DstPort:    7890,
+		SYN:        true,
+		DataOffset: 5,
+	}
+
+	_, ipv4, _, _, _, err := testPacketV4(nil, nil, tcpSyn, nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	natIPs := []net.IP{net.IPv4(192, 0, 0, 1), net.IPv4(192, 0, 0, 2), net.IPv4(192, 0, 0, 3)}
+	natPort := uint16(666)
+	names := make([]string, len(natIPs))
+	for i, natIP := range natIPs {
+		names[i] = fmt.Sprintf("%s:%d", natIP, natPort)
+	}
+
+	// setBackends programs the service with the first count backends.
+	setBackends := func(count int) {
+		err := natMap.Update(
+			nat.NewNATKey(ipv4.DstIP, uint16(tcpSyn.DstPort), uint8(ipv4.Protocol)).AsBytes(),
+			nat.NewNATValueWithFlags(0, uint32(count), 0, 0, nat.NATFlgMaglev).AsBytes(),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		for i, natIP := range natIPs {
+			k := nat.NewNATBackendKey(0, uint32(i)).AsBytes()
+			if i < count {
+				err = natBEMap.Update(k, nat.NewNATBackendValue(natIP, natPort).AsBytes())
+			} else {
+				err = natBEMap.Delete(k)
+				if maps.IsNotExists(err) {
+					err = nil
+				}
+			}
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	// setLUT fills every bucket of the service's Maglev lookup table.
+	setLUT := func(ipForBucket func(bucket int) net.IP) {
+		for b := 0; b < nat.MaglevLUTSize; b++ {
+			err := maglevMap.Update(
+				nat.NewNATBackendKey(0, uint32(b)).AsBytes(),
+				nat.NewNATBackendValue(ipForBucket(b), natPort).AsBytes(),
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+	}
+
+	// Insert a reverse route for the source workload.
+	rtKey := routes.NewKey(srcV4CIDR).AsBytes()
+	rtVal := routes.NewValueWithIfIndex(routes.FlagsLocalWorkload|routes.FlagInIPAMPool, 1).AsBytes()
+	defer resetRTMap(rtMap)
+	err = rtMap.Update(rtKey, rtVal)
+	Expect(err).NotTo(HaveOccurred())
+
+	const numFlows = 64
+	srcPort := func(flow int) uint16 {
+		return 40000 + uint16(flow)
+	}
+
+	skbMark = 0
+	runBpfTest(t, "calico_from_workload_ep", rulesDefaultAllow, func(bpfrun bpfProgRunFn) {
+		// backendOf sends a SYN from the given source port and returns the backend that it was
+		// NATed to.  Conntrack is cleaned first so that the backend is selected again.
+		backendOf := func(sport uint16) net.IP {
+			resetCTMap(ctMap)
+			tcpSyn.SrcPort = layers.TCPPort(sport)
+			_, _, _, _, synPkt, err := testPacketV4(nil, nil, tcpSyn, nil)
+			Expect(err).NotTo(HaveOccurred())
+			res, err := bpfrun(synPkt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Retval).To(Equal(resTC_ACT_REDIRECT))
+			pktR := gopacket.NewPacket(res.dataOut, layers.LayerTypeEthernet, gopacket.Default)
+			return pktR.Layer(layers.LayerTypeIPv4).(*layers.IPv4).DstIP
+		}
+
+		// First, find out which bucket each flow hashes to by giving every bucket its own
+		// backend address.
+		setBackends(len(natIPs))
+		setLUT(func(b int) net.IP {
+			return net.IPv4(10, 200, byte(b>>8), byte(b))
+		})
+		buckets := make([]int, numFlows)
+		seenBuckets := map[int]bool{}
+		for f := 0; f < numFlows; f++ {
+			ip := backendOf(srcPort(f)).To4()
+			Expect(ip[:2]).To(Equal([]byte{10, 200}), "flow should have been NATed via the Maglev table")
+			buckets[f] = int(ip[2])<<8 | int(ip[3])
+			Expect(buckets[f]).To(BeNumerically("<", nat.MaglevLUTSize))
+			seenBuckets[buckets[f]] = true
+
+			Expect(backendOf(srcPort(f)).To4()).To(Equal(ip), "the same flow should always hash to the same bucket")
+		}
+		Expect(len(seenBuckets)).To(BeNumerically(">", numFlows/2), "flows should be spread across the buckets")
+
+		// Program the table that the syncer would program for all the backends.
+		lut := proxy.MaglevLUT(names, nat.MaglevLUTSize)
+		setLUT(func(b int) net.IP { return natIPs[lut[b]] })
+		for f := 0; f < numFlows; f++ {
+			Expect(backendOf(srcPort(f))).To(Equal(natIPs[lut[buckets[f]]]))
+		}
+
+		// Remove the last backend.  Its flows must move to the remaining backends, and almost
+		// all of the other flows must stay where they were.
+		setBackends(len(natIPs) - 1)
+		newLUT := proxy.MaglevLUT(names[:len(natIPs)-1], nat.MaglevLUTSize)
+		setLUT(func(b int) net.IP { return natIPs[newLUT[b]] })
+
+		kept, moved := 0, 0
+		for f := 0; f < numFlows; f++ {
+			ip := backendOf(srcPort(f))
+			Expect(ip).To(Equal(natIPs[newLUT[buckets[f]]]))
+			Expect(ip).NotTo(Equal(natIPs[len(natIPs)-1]))
+			if lut[buckets[f]] == len(natIPs)-1 {
+				continue
+			}
+			if ip.Equal(natIPs[lut[buckets[f]]]) {
+				kept++
+			} else {
+				moved++
+			}
+		}
+		Expect(kept).To(BeNumerically(">", 0))
+		Expect(moved).To(BeNumerically("<=", (kept+moved)/10), "removing a backend should only move a few of the other flows")
+	})
+}
+
 func TestNATAffinity(t *testing.T) {
 	RegisterTestingT(t)
 
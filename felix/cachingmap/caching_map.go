@@ -20,6 +20,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/projectcalico/calico/felix/deltatracker"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 )
 
 // DataplaneMap is an interface of the underlying map that is being cached by the
@@ -171,6 +172,25 @@ func (c *CachingMap[K, V]) ApplyDeletionsOnly() error {
 	return nil
 }
 
+// PendingChanges returns the updates and deletions that the next ApplyAllChanges would make to the dataplane map.
+// If the cache of the dataplane hasn't been loaded yet, the changes are calculated as if the map were empty.
+func (c *CachingMap[K, V]) PendingChanges() []pendingchanges.Layer {
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindBPFMap,
+		Name:          c.name,
+		ResyncPending: !c.cacheLoaded,
+	}
+	c.deltaTracker.PendingDeletions().Iter(func(k K) deltatracker.IterAction {
+		layer.Changes = append(layer.Changes, fmt.Sprintf("delete %v", k))
+		return deltatracker.IterActionNoOp
+	})
+	c.deltaTracker.PendingUpdates().Iter(func(k K, v V) deltatracker.IterAction {
+		layer.Changes = append(layer.Changes, fmt.Sprintf("update %v: %v", k, v))
+		return deltatracker.IterActionNoOp
+	})
+	return []pendingchanges.Layer{layer}
+}
+
 type ErrSlice []error
 
 func (e ErrSlice) Error() string {
@@ -327,6 +327,39 @@ func TestCachingMap_Resync(t *testing.T) {
 	Expect(mockMap.OpCount()).To(Equal(preApplyOpCount))
 }
 
+// TestCachingMap_PendingChanges verifies that the pending changes match the changes that are then applied.
+func TestCachingMap_PendingChanges(t *testing.T) {
+	mockMap, cm := setupCachingMapTest(t)
+	mockMap.Contents = map[string]string{
+		"1, 1": "1, 2, 4, 3",
+		"1, 2": "1, 2, 3, 4",
+		"1, 3": "1, 2, 4, 4",
+	}
+
+	cm.Desired().Set("1, 1", "1, 2, 4, 3")
+	cm.Desired().Set("1, 2", "1, 2, 3, 6")
+	cm.Desired().Set("1, 4", "1, 2, 3, 5")
+	layers := cm.PendingChanges()
+	Expect(layers).To(HaveLen(1))
+	Expect(layers[0].Name).To(Equal("mock-map"))
+	Expect(layers[0].ResyncPending).To(BeTrue())
+
+	err := cm.LoadCacheFromDataplane()
+	Expect(err).NotTo(HaveOccurred())
+	layers = cm.PendingChanges()
+	Expect(layers[0].ResyncPending).To(BeFalse())
+	Expect(layers[0].Changes).To(ConsistOf(
+		"delete 1, 3",
+		"update 1, 2: 1, 2, 3, 6",
+		"update 1, 4: 1, 2, 3, 5",
+	))
+	Expect(mockMap.OpCount()).To(Equal(1), "Calculating pending changes shouldn't touch the map")
+
+	err = cm.ApplyAllChanges()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cm.PendingChanges()[0].Changes).To(BeEmpty())
+}
+
 func setupCachingMapTest(t *testing.T) (*Map, *CachingMap[string, string]) {
 	RegisterTestingT(t)
 	mockMap := newMockMap()
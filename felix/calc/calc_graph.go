@@ -99,6 +99,11 @@ type vxlanCallbacks interface {
 	OnVTEPRemove(node string)
 }
 
+type packetCaptureCallbacks interface {
+	OnPacketCaptureUpdate(update *proto.PacketCaptureUpdate)
+	OnPacketCaptureRemove(id types.PacketCaptureID)
+}
+
 type PipelineCallbacks interface {
 	ipSetUpdateCallbacks
 	rulesUpdateCallbacks
@@ -108,6 +113,7 @@ type PipelineCallbacks interface {
 	passthruCallbacks
 	routeCallbacks
 	vxlanCallbacks
+	packetCaptureCallbacks
 }
 
 type CalcGraph struct {
@@ -381,6 +387,11 @@ func NewCalculationGraph(
 	activeBGPPeerCalc.RegisterWith(localEndpointDispatcher, allUpdDispatcher)
 	activeBGPPeerCalc.OnEndpointBGPPeerDataUpdate = polResolver.OnEndpointBGPPeerDataUpdate
 
+	// Create and hook up the packet capture calculator, which matches PacketCaptures
+	// against local endpoints.
+	packetCaptureCalc := NewPacketCaptureCalculator(callbacks)
+	packetCaptureCalc.RegisterWith(localEndpointDispatcher, allUpdDispatcher)
+
 	// Register for host IP updates.
 	//
 	//        ...
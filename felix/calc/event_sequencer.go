@@ -17,6 +17,7 @@ package calc
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	log "github.com/sirupsen/logrus"
@@ -90,6 +91,8 @@ type EventSequencer struct {
 	pendingGlobalBGPConfig       *proto.GlobalBGPConfigUpdate
 	pendingServiceUpdates        map[serviceID]*proto.ServiceUpdate
 	pendingServiceDeletes        set.Set[serviceID]
+	pendingPacketCaptureUpdates  map[types.PacketCaptureID]*proto.PacketCaptureUpdate
+	pendingPacketCaptureDeletes  set.Set[types.PacketCaptureID]
 
 	// Sets to record what we've sent downstream. Updated whenever we flush.
 	sentIPSets          set.Set[string]
@@ -107,6 +110,7 @@ type EventSequencer struct {
 	sentWireguard       set.Set[string]
 	sentWireguardV6     set.Set[string]
 	sentServices        set.Set[serviceID]
+	sentPacketCaptures  set.Set[types.PacketCaptureID]
 
 	Callback EventHandler
 }
@@ -167,6 +171,8 @@ func NewEventSequencer(conf configInterface) *EventSequencer {
 		pendingWireguardDeletes:      set.New[string](),
 		pendingServiceUpdates:        map[serviceID]*proto.ServiceUpdate{},
 		pendingServiceDeletes:        set.New[serviceID](),
+		pendingPacketCaptureUpdates:  map[types.PacketCaptureID]*proto.PacketCaptureUpdate{},
+		pendingPacketCaptureDeletes:  set.New[types.PacketCaptureID](),
 
 		// Sets to record what we've sent downstream. Updated whenever we flush.
 		sentIPSets:          set.New[string](),
@@ -184,6 +190,7 @@ func NewEventSequencer(conf configInterface) *EventSequencer {
 		sentWireguard:       set.New[string](),
 		sentWireguardV6:     set.New[string](),
 		sentServices:        set.New[serviceID](),
+		sentPacketCaptures:  set.New[types.PacketCaptureID](),
 	}
 	return buf
 }
@@ -730,9 +737,12 @@ func (buf *EventSequencer) flushHostWireguardUpdates() {
 			}
 			log.WithField("ipv4Str", ipv4Str).Debug("Sending IPv4 wireguard endpoint update")
 			buf.Callback(&proto.WireguardEndpointUpdate{
-				Hostname:          nodename,
-				PublicKey:         wg.PublicKey,
-				InterfaceIpv4Addr: ipv4Str,
+				Hostname:               nodename,
+				PublicKey:              wg.PublicKey,
+				InterfaceIpv4Addr:      ipv4Str,
+				NextPublicKey:          wg.NextPublicKey,
+				PublicKeyTimestamp:     unixOrZero(wg.PublicKeyTimestamp),
+				AcceptedNextPublicKeys: wg.AcceptedNextPublicKeys,
 			})
 			buf.sentWireguard.Add(nodename)
 		} else if buf.sentWireguard.Contains(nodename) {
@@ -749,9 +759,12 @@ func (buf *EventSequencer) flushHostWireguardUpdates() {
 			}
 			log.WithField("ipv6Str", ipv6Str).Debug("Sending IPv6 wireguard endpoint update")
 			buf.Callback(&proto.WireguardEndpointV6Update{
-				Hostname:          nodename,
-				PublicKeyV6:       wg.PublicKeyV6,
-				InterfaceIpv6Addr: ipv6Str,
+				Hostname:                 nodename,
+				PublicKeyV6:              wg.PublicKeyV6,
+				InterfaceIpv6Addr:        ipv6Str,
+				NextPublicKeyV6:          wg.NextPublicKeyV6,
+				PublicKeyTimestamp:       unixOrZero(wg.PublicKeyTimestampV6),
+				AcceptedNextPublicKeysV6: wg.AcceptedNextPublicKeysV6,
 			})
 			buf.sentWireguardV6.Add(nodename)
 		} else if buf.sentWireguardV6.Contains(nodename) {
@@ -767,6 +780,14 @@ func (buf *EventSequencer) flushHostWireguardUpdates() {
 	log.Debug("Done flushing wireguard updates")
 }
 
+// unixOrZero converts a timestamp to seconds since the epoch, mapping the zero time to 0.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
 func (buf *EventSequencer) OnIPPoolRemove(key model.IPPoolKey) {
 	log.WithField("key", key).Debug("IPPool removed")
 	cidr := ip.CIDRFromCalicoNet(key.CIDR)
@@ -894,6 +915,9 @@ func (buf *EventSequencer) Flush() {
 	}
 
 	buf.flushServices()
+
+	// Flush PacketCaptures after the endpoints that they refer to.
+	buf.flushPacketCaptures()
 }
 
 func (buf *EventSequencer) flushRemovedIPSets() {
@@ -996,6 +1020,44 @@ func (buf *EventSequencer) OnNamespaceRemove(id types.NamespaceID) {
 	}
 }
 
+func (buf *EventSequencer) OnPacketCaptureUpdate(update *proto.PacketCaptureUpdate) {
+	// We trust the caller not to send us an update with nil ID, so safe to dereference.
+	id := types.ProtoToPacketCaptureID(update.GetId())
+	log.WithFields(log.Fields{
+		"key":       id,
+		"endpoints": update.GetEndpoints(),
+	}).Debug("PacketCapture update")
+	buf.pendingPacketCaptureDeletes.Discard(id)
+	buf.pendingPacketCaptureUpdates[id] = update
+}
+
+func (buf *EventSequencer) OnPacketCaptureRemove(id types.PacketCaptureID) {
+	log.WithFields(log.Fields{
+		"key": id,
+	}).Debug("PacketCapture removed")
+	delete(buf.pendingPacketCaptureUpdates, id)
+	if buf.sentPacketCaptures.Contains(id) {
+		buf.pendingPacketCaptureDeletes.Add(id)
+	}
+}
+
+func (buf *EventSequencer) flushPacketCaptures() {
+	// Order doesn't matter, but send removes first to reduce max occupancy
+	buf.pendingPacketCaptureDeletes.Iter(func(id types.PacketCaptureID) error {
+		msg := proto.PacketCaptureRemove{Id: types.PacketCaptureIDToProto(id)}
+		buf.Callback(&msg)
+		buf.sentPacketCaptures.Discard(id)
+		return nil
+	})
+	buf.pendingPacketCaptureDeletes.Clear()
+	for id, msg := range buf.pendingPacketCaptureUpdates {
+		buf.Callback(msg)
+		buf.sentPacketCaptures.Add(id)
+	}
+	buf.pendingPacketCaptureUpdates = make(map[types.PacketCaptureID]*proto.PacketCaptureUpdate)
+	log.Debug("Done flushing PacketCaptures")
+}
+
 func (buf *EventSequencer) OnWireguardUpdate(nodename string, wg *model.Wireguard) {
 	log.WithFields(log.Fields{
 		"nodename": nodename,
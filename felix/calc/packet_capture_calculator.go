@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/dispatcher"
+	"github.com/projectcalico/calico/felix/labelindex"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/types"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	sel "github.com/projectcalico/calico/libcalico-go/lib/selector"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+// PacketCaptureCalculator matches PacketCapture resources against local workload endpoints.
+// For each capture that selects at least one local endpoint, it emits a PacketCaptureUpdate
+// that lists the selected endpoints; once a capture selects no local endpoints (or is
+// deleted), it emits a PacketCaptureRemove.
+type PacketCaptureCalculator struct {
+	// All PacketCaptures, indexed by namespace/name.
+	allCaptures map[types.PacketCaptureID]*v3.PacketCapture
+
+	// Label index, matching PacketCaptures against local endpoints.
+	labelIndex *labelindex.InheritIndex
+
+	// Local endpoints that each PacketCapture currently selects.
+	endpointsByCapture map[types.PacketCaptureID]set.Set[model.WorkloadEndpointKey]
+
+	// PacketCaptures that need to be re-sent to the dataplane at the end of the
+	// current update.
+	dirtyCaptures set.Set[types.PacketCaptureID]
+
+	callbacks packetCaptureCallbacks
+}
+
+func NewPacketCaptureCalculator(callbacks packetCaptureCallbacks) *PacketCaptureCalculator {
+	pcc := &PacketCaptureCalculator{
+		allCaptures:        map[types.PacketCaptureID]*v3.PacketCapture{},
+		endpointsByCapture: map[types.PacketCaptureID]set.Set[model.WorkloadEndpointKey]{},
+		dirtyCaptures:      set.New[types.PacketCaptureID](),
+		callbacks:          callbacks,
+	}
+	pcc.labelIndex = labelindex.NewInheritIndex(pcc.onMatchStarted, pcc.onMatchStopped)
+	return pcc
+}
+
+func (pcc *PacketCaptureCalculator) RegisterWith(localEndpointDispatcher, allUpdDispatcher *dispatcher.Dispatcher) {
+	// It needs local workload endpoints.
+	localEndpointDispatcher.Register(model.WorkloadEndpointKey{}, pcc.OnUpdate)
+	// It also needs PacketCaptures and Profiles (for namespace labels).
+	allUpdDispatcher.Register(model.ResourceKey{}, pcc.OnUpdate)
+}
+
+func (pcc *PacketCaptureCalculator) OnUpdate(update api.Update) (_ bool) {
+	switch id := update.Key.(type) {
+	case model.WorkloadEndpointKey:
+		// Delegate to the label index.  It will call us back when the match status changes.
+		pcc.labelIndex.OnUpdate(update)
+	case model.ResourceKey:
+		switch id.Kind {
+		case v3.KindPacketCapture:
+			captureID := types.PacketCaptureID{Namespace: id.Namespace, Name: id.Name}
+			if update.Value != nil {
+				logrus.WithField("id", captureID).Debug("Updating PacketCapture")
+				capture := update.Value.(*v3.PacketCapture)
+				pcc.allCaptures[captureID] = capture
+				pcc.labelIndex.UpdateSelector(captureID, captureSelector(capture))
+				// Always re-send the capture, in case the filter or limits changed.
+				pcc.dirtyCaptures.Add(captureID)
+			} else {
+				logrus.WithField("id", captureID).Debug("Deleting PacketCapture")
+				pcc.labelIndex.DeleteSelector(captureID)
+				delete(pcc.allCaptures, captureID)
+			}
+		case v3.KindProfile:
+			pcc.labelIndex.OnUpdate(update)
+		default:
+			// Ignore other kinds of v3 resource.
+		}
+	default:
+		logrus.Infof("Ignoring unexpected update: %v %#v",
+			reflect.TypeOf(update.Key), update)
+	}
+
+	pcc.flushDirtyCaptures()
+	return
+}
+
+// captureSelector returns the selector for the given PacketCapture, limited to endpoints in
+// the PacketCapture's namespace.
+func captureSelector(capture *v3.PacketCapture) *sel.Selector {
+	rawSelector := fmt.Sprintf("%s == '%s'", v3.LabelNamespace, capture.Namespace)
+	if capture.Spec.Selector != "" {
+		rawSelector = fmt.Sprintf("(%s) && (%s)", rawSelector, capture.Spec.Selector)
+	}
+	selector, err := sel.Parse(rawSelector)
+	if err != nil {
+		logrus.WithError(err).Errorf("PacketCapture had invalid selector: %q.  Will ignore this PacketCapture.",
+			capture.Spec.Selector)
+		return sel.NoMatch
+	}
+	return selector
+}
+
+func (pcc *PacketCaptureCalculator) onMatchStarted(captureIDIface any, workloadIDIface any) {
+	captureID := captureIDIface.(types.PacketCaptureID)
+	workloadID := workloadIDIface.(model.WorkloadEndpointKey)
+	endpoints := pcc.endpointsByCapture[captureID]
+	if endpoints == nil {
+		endpoints = set.New[model.WorkloadEndpointKey]()
+		pcc.endpointsByCapture[captureID] = endpoints
+	}
+	endpoints.Add(workloadID)
+	pcc.dirtyCaptures.Add(captureID)
+}
+
+func (pcc *PacketCaptureCalculator) onMatchStopped(captureIDIface any, workloadIDIface any) {
+	captureID := captureIDIface.(types.PacketCaptureID)
+	workloadID := workloadIDIface.(model.WorkloadEndpointKey)
+	endpoints := pcc.endpointsByCapture[captureID]
+	if endpoints == nil {
+		return
+	}
+	endpoints.Discard(workloadID)
+	if endpoints.Len() == 0 {
+		delete(pcc.endpointsByCapture, captureID)
+	}
+	pcc.dirtyCaptures.Add(captureID)
+}
+
+func (pcc *PacketCaptureCalculator) flushDirtyCaptures() {
+	pcc.dirtyCaptures.Iter(func(captureID types.PacketCaptureID) error {
+		capture := pcc.allCaptures[captureID]
+		endpoints := pcc.endpointsByCapture[captureID]
+		if capture == nil || endpoints == nil {
+			pcc.callbacks.OnPacketCaptureRemove(captureID)
+		} else {
+			pcc.callbacks.OnPacketCaptureUpdate(packetCaptureToProto(captureID, capture, endpoints))
+		}
+		return set.RemoveItem
+	})
+}
+
+func packetCaptureToProto(
+	id types.PacketCaptureID,
+	capture *v3.PacketCapture,
+	endpoints set.Set[model.WorkloadEndpointKey],
+) *proto.PacketCaptureUpdate {
+	update := &proto.PacketCaptureUpdate{
+		Id:     types.PacketCaptureIDToProto(id),
+		Filter: capture.Spec.Filter,
+	}
+	if capture.Spec.Duration != nil {
+		update.EndTime = capture.CreationTimestamp.Add(capture.Spec.Duration.Duration).Unix()
+	}
+	if capture.Spec.MaxFileSizeBytes != nil {
+		update.MaxFileSizeBytes = int64(*capture.Spec.MaxFileSizeBytes)
+	}
+	if capture.Spec.MaxFiles != nil {
+		update.MaxFiles = int32(*capture.Spec.MaxFiles)
+	}
+	endpoints.Iter(func(ep model.WorkloadEndpointKey) error {
+		update.Endpoints = append(update.Endpoints, types.WorkloadEndpointIDToProto(types.WorkloadEndpointID{
+			OrchestratorId: ep.OrchestratorID,
+			WorkloadId:     ep.WorkloadID,
+			EndpointId:     ep.EndpointID,
+		}))
+		return nil
+	})
+	// Sort the endpoints so that the dataplane gets a stable order.
+	sort.Slice(update.Endpoints, func(i, j int) bool {
+		a, b := update.Endpoints[i], update.Endpoints[j]
+		if a.OrchestratorId != b.OrchestratorId {
+			return a.OrchestratorId < b.OrchestratorId
+		}
+		if a.WorkloadId != b.WorkloadId {
+			return a.WorkloadId < b.WorkloadId
+		}
+		return a.EndpointId < b.EndpointId
+	})
+	return update
+}
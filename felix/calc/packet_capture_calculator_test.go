@@ -0,0 +1,163 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/types"
+	"github.com/projectcalico/calico/lib/std/uniquelabels"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+type packetCaptureRecorder struct {
+	captures map[types.PacketCaptureID]*proto.PacketCaptureUpdate
+	removes  []types.PacketCaptureID
+}
+
+func (r *packetCaptureRecorder) OnPacketCaptureUpdate(update *proto.PacketCaptureUpdate) {
+	r.captures[types.ProtoToPacketCaptureID(update.Id)] = update
+}
+
+func (r *packetCaptureRecorder) OnPacketCaptureRemove(id types.PacketCaptureID) {
+	delete(r.captures, id)
+	r.removes = append(r.removes, id)
+}
+
+var _ = Describe("PacketCaptureCalculator", func() {
+	var (
+		pcc      *PacketCaptureCalculator
+		recorder *packetCaptureRecorder
+	)
+
+	captureID := types.PacketCaptureID{Namespace: "ns1", Name: "capture"}
+	created := metav1.NewTime(time.Unix(1000, 0))
+
+	updateEndpoint := func(namespace, name string, labels map[string]string) {
+		allLabels := map[string]string{v3.LabelNamespace: namespace}
+		for k, v := range labels {
+			allLabels[k] = v
+		}
+		pcc.OnUpdate(api.Update{
+			KVPair: model.KVPair{
+				Key: model.WorkloadEndpointKey{
+					Hostname:       "my-host",
+					OrchestratorID: "k8s",
+					WorkloadID:     namespace + "/" + name,
+					EndpointID:     "eth0",
+				},
+				Value: &model.WorkloadEndpoint{
+					Name:   "cali-" + name,
+					Labels: uniquelabels.Make(allLabels),
+				},
+			},
+		})
+	}
+
+	updateCapture := func(spec v3.PacketCaptureSpec) {
+		pcc.OnUpdate(api.Update{
+			KVPair: model.KVPair{
+				Key: model.ResourceKey{Kind: v3.KindPacketCapture, Namespace: captureID.Namespace, Name: captureID.Name},
+				Value: &v3.PacketCapture{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         captureID.Namespace,
+						Name:              captureID.Name,
+						CreationTimestamp: created,
+					},
+					Spec: spec,
+				},
+			},
+		})
+	}
+
+	workloadIDs := func() []string {
+		var ids []string
+		for _, ep := range recorder.captures[captureID].GetEndpoints() {
+			ids = append(ids, ep.WorkloadId)
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		recorder = &packetCaptureRecorder{captures: map[types.PacketCaptureID]*proto.PacketCaptureUpdate{}}
+		pcc = NewPacketCaptureCalculator(recorder)
+
+		updateEndpoint("ns1", "red", map[string]string{"color": "red"})
+		updateEndpoint("ns1", "blue", map[string]string{"color": "blue"})
+		updateEndpoint("ns2", "red", map[string]string{"color": "red"})
+	})
+
+	It("should only select endpoints in the capture's namespace", func() {
+		updateCapture(v3.PacketCaptureSpec{Selector: "color == 'red'"})
+		Expect(workloadIDs()).To(Equal([]string{"ns1/red"}))
+	})
+
+	It("should select all endpoints in the namespace with an empty selector", func() {
+		updateCapture(v3.PacketCaptureSpec{})
+		Expect(workloadIDs()).To(Equal([]string{"ns1/blue", "ns1/red"}))
+	})
+
+	It("should pass through the filter and limits", func() {
+		maxFiles := 3
+		maxSize := 1024
+		updateCapture(v3.PacketCaptureSpec{
+			Filter:           "tcp port 80",
+			Duration:         &metav1.Duration{Duration: time.Minute},
+			MaxFiles:         &maxFiles,
+			MaxFileSizeBytes: &maxSize,
+		})
+		update := recorder.captures[captureID]
+		Expect(update).NotTo(BeNil())
+		Expect(update.Filter).To(Equal("tcp port 80"))
+		Expect(update.EndTime).To(Equal(int64(1060)))
+		Expect(update.MaxFiles).To(Equal(int32(3)))
+		Expect(update.MaxFileSizeBytes).To(Equal(int64(1024)))
+	})
+
+	It("should not send a capture that selects no local endpoints", func() {
+		updateCapture(v3.PacketCaptureSpec{Selector: "color == 'green'"})
+		Expect(recorder.captures).To(BeEmpty())
+	})
+
+	It("should track endpoint label changes", func() {
+		updateCapture(v3.PacketCaptureSpec{Selector: "color == 'red'"})
+		updateEndpoint("ns1", "blue", map[string]string{"color": "red"})
+		Expect(workloadIDs()).To(Equal([]string{"ns1/blue", "ns1/red"}))
+
+		updateEndpoint("ns1", "red", map[string]string{"color": "green"})
+		updateEndpoint("ns1", "blue", map[string]string{"color": "green"})
+		Expect(recorder.captures).To(BeEmpty())
+		Expect(recorder.removes).To(ContainElement(captureID))
+	})
+
+	It("should remove the capture when it is deleted", func() {
+		updateCapture(v3.PacketCaptureSpec{})
+		Expect(recorder.captures).To(HaveKey(captureID))
+		pcc.OnUpdate(api.Update{
+			KVPair: model.KVPair{
+				Key: model.ResourceKey{Kind: v3.KindPacketCapture, Namespace: captureID.Namespace, Name: captureID.Name},
+			},
+		})
+		Expect(recorder.captures).To(BeEmpty())
+		Expect(recorder.removes).To(Equal([]types.PacketCaptureID{captureID}))
+	})
+})
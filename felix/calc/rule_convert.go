@@ -18,6 +18,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
 	googleproto "google.golang.org/protobuf/proto"
@@ -31,6 +32,10 @@ const (
 	// collision-resistance.  16 chars gives us 96 bits of entropy, which is fairly collision
 	// resistant.
 	RuleIDLength = 16
+
+	// defaultRuleLimitBurst is the burst that we use for a rule's rate limit if the rule doesn't
+	// specify one.
+	defaultRuleLimitBurst = 5
 )
 
 func parsedRulesToProtoRules(in []*ParsedRule, ruleIDSeed string) (out []*proto.Rule) {
@@ -153,6 +158,9 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 			} else if pathMatch.Prefix != "" {
 				protoMatch := &proto.HTTPMatch_PathMatch_Prefix{Prefix: pathMatch.Prefix}
 				paths = append(paths, &proto.HTTPMatch_PathMatch{PathMatch: protoMatch})
+			} else if pathMatch.Regex != "" {
+				protoMatch := &proto.HTTPMatch_PathMatch_Regex{Regex: pathMatch.Regex}
+				paths = append(paths, &proto.HTTPMatch_PathMatch{PathMatch: protoMatch})
 			} else {
 				log.Error("Ignoring unknown path match type", pathMatch)
 			}
@@ -164,6 +172,62 @@ func parsedRuleToProtoRule(in *ParsedRule) *proto.Rule {
 		if len(in.HTTPMatch.Methods) > 0 {
 			out.HttpMatch.Methods = in.HTTPMatch.Methods
 		}
+		if len(in.HTTPMatch.Hosts) > 0 {
+			out.HttpMatch.Hosts = in.HTTPMatch.Hosts
+		}
+		for _, h := range in.HTTPMatch.Headers {
+			m := keyValueMatchToProto(h.Name, h.Exact, h.Prefix, h.Regex, h.Present)
+			if m == nil {
+				log.Error("Ignoring unknown header match type", h)
+				continue
+			}
+			out.HttpMatch.Headers = append(out.HttpMatch.Headers, m)
+		}
+		for _, q := range in.HTTPMatch.QueryParams {
+			m := keyValueMatchToProto(q.Name, q.Exact, q.Prefix, q.Regex, q.Present)
+			if m == nil {
+				log.Error("Ignoring unknown query parameter match type", q)
+				continue
+			}
+			out.HttpMatch.QueryParams = append(out.HttpMatch.QueryParams, m)
+		}
+		if jwt := in.HTTPMatch.JWT; jwt != nil {
+			out.HttpMatch.Jwt = &proto.HTTPMatch_JWTMatch{
+				Jwks:       jwt.JWKS,
+				Issuer:     jwt.Issuer,
+				Audiences:  jwt.Audiences,
+				Algorithms: jwt.Algorithms,
+			}
+			for _, c := range jwt.Claims {
+				out.HttpMatch.Jwt.Claims = append(out.HttpMatch.Jwt.Claims, &proto.HTTPMatch_JWTMatch_ClaimMatch{
+					Name:   c.Name,
+					Values: c.Values,
+				})
+			}
+		}
+	}
+
+	if in.GRPCMatch != nil {
+		out.GrpcMatch = &proto.GRPCMatch{
+			Services: in.GRPCMatch.Services,
+			Methods:  in.GRPCMatch.Methods,
+		}
+	}
+
+	if in.Limit != nil {
+		out.Limit = &proto.RuleLimit{
+			PerRule: in.Limit.Scope == string(v3.RuleLimitScopePerRule),
+		}
+		if in.Limit.NewConnectionsPerSecond != nil {
+			out.Limit.NewConnectionsPerSecond = *in.Limit.NewConnectionsPerSecond
+			out.Limit.Burst = defaultRuleLimitBurst
+			if in.Limit.Burst != nil {
+				out.Limit.Burst = *in.Limit.Burst
+			}
+		}
+		if in.Limit.MaxConnections != nil {
+			out.Limit.MaxConnections = *in.Limit.MaxConnections
+		}
 	}
 
 	if in.Metadata != nil {
@@ -282,3 +346,22 @@ func portToProtoPort(in numorstring.Port) (out *proto.PortRange) {
 	}
 	return
 }
+
+// keyValueMatchToProto converts a header or query parameter match to its protobuf form.  It returns nil
+// if none of the value matches are set.
+func keyValueMatchToProto(name, exact, prefix, regex string, present *bool) *proto.HTTPMatch_KeyValueMatch {
+	out := &proto.HTTPMatch_KeyValueMatch{Name: name}
+	switch {
+	case exact != "":
+		out.ValueMatch = &proto.HTTPMatch_KeyValueMatch_Exact{Exact: exact}
+	case prefix != "":
+		out.ValueMatch = &proto.HTTPMatch_KeyValueMatch_Prefix{Prefix: prefix}
+	case regex != "":
+		out.ValueMatch = &proto.HTTPMatch_KeyValueMatch_Regex{Regex: regex}
+	case present != nil:
+		out.ValueMatch = &proto.HTTPMatch_KeyValueMatch_Present{Present: *present}
+	default:
+		return nil
+	}
+	return out
+}
@@ -38,6 +38,10 @@ var icmpType11 = 11
 var icmpCode13 = 13
 var proto123 = numorstring.ProtocolFromInt(uint8(123))
 var protoTCP = numorstring.ProtocolFromStringV1("tcp")
+var falseValue = false
+var limit100 = uint32(100)
+var limit20 = uint32(20)
+var limit50 = uint32(50)
 
 var fullyLoadedParsedRule = ParsedRule{
 	Action:    "allow",
@@ -90,7 +94,29 @@ var fullyLoadedParsedRule = ParsedRule{
 	HTTPMatch: &model.HTTPMatch{Methods: []string{"GET", "POST"}, Paths: []v3.HTTPPath{
 		{Exact: "/foo"},
 		{Prefix: "/bar"},
-	}},
+		{Regex: "/baz/[0-9]+"},
+	},
+		Hosts:       []string{"*.example.com"},
+		Headers:     []v3.HTTPHeaderMatch{{Name: "x-user", Prefix: "admin-"}, {Name: "x-debug", Present: &falseValue}},
+		QueryParams: []v3.HTTPQueryParamMatch{{Name: "version", Regex: "v[12]"}},
+		JWT: &v3.HTTPJWTMatch{
+			JWKS:       `{"keys":[]}`,
+			Issuer:     "https://issuer.example.com",
+			Audiences:  []string{"api"},
+			Claims:     []v3.HTTPJWTClaimMatch{{Name: "groups", Values: []string{"admins"}}},
+			Algorithms: []string{"RS256", "ES256"},
+		},
+	},
+	GRPCMatch: &model.GRPCMatch{
+		Services: []string{"helloworld.Greeter"},
+		Methods:  []string{"SayHello"},
+	},
+	Limit: &model.RuleLimit{
+		NewConnectionsPerSecond: &limit100,
+		Burst:                   &limit20,
+		MaxConnections:          &limit50,
+		Scope:                   "PerSource",
+	},
 
 	Metadata: &model.RuleMetadata{Annotations: map[string]string{"key": "value"}},
 }
@@ -157,7 +183,33 @@ var fullyLoadedProtoRule = &proto.Rule{
 	HttpMatch: &proto.HTTPMatch{Methods: []string{"GET", "POST"},
 		Paths: []*proto.HTTPMatch_PathMatch{{PathMatch: &proto.HTTPMatch_PathMatch_Exact{Exact: "/foo"}},
 			{PathMatch: &proto.HTTPMatch_PathMatch_Prefix{Prefix: "/bar"}},
-		}},
+			{PathMatch: &proto.HTTPMatch_PathMatch_Regex{Regex: "/baz/[0-9]+"}},
+		},
+		Hosts: []string{"*.example.com"},
+		Headers: []*proto.HTTPMatch_KeyValueMatch{
+			{Name: "x-user", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Prefix{Prefix: "admin-"}},
+			{Name: "x-debug", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Present{Present: false}},
+		},
+		QueryParams: []*proto.HTTPMatch_KeyValueMatch{
+			{Name: "version", ValueMatch: &proto.HTTPMatch_KeyValueMatch_Regex{Regex: "v[12]"}},
+		},
+		Jwt: &proto.HTTPMatch_JWTMatch{
+			Jwks:       `{"keys":[]}`,
+			Issuer:     "https://issuer.example.com",
+			Audiences:  []string{"api"},
+			Claims:     []*proto.HTTPMatch_JWTMatch_ClaimMatch{{Name: "groups", Values: []string{"admins"}}},
+			Algorithms: []string{"RS256", "ES256"},
+		},
+	},
+	GrpcMatch: &proto.GRPCMatch{
+		Services: []string{"helloworld.Greeter"},
+		Methods:  []string{"SayHello"},
+	},
+	Limit: &proto.RuleLimit{
+		NewConnectionsPerSecond: 100,
+		Burst:                   20,
+		MaxConnections:          50,
+	},
 
 	Metadata: &proto.RuleMetadata{Annotations: map[string]string{"key": "value"}},
 }
@@ -219,6 +271,20 @@ var _ = DescribeTable("ParsedRulesToProtoRules",
 		&proto.Rule{
 			DstIpPortSetIds: []string{"ipPortSetID"},
 		}),
+	Entry("Rate limit with default burst",
+		ParsedRule{
+			Limit: &model.RuleLimit{NewConnectionsPerSecond: &limit100},
+		},
+		&proto.Rule{
+			Limit: &proto.RuleLimit{NewConnectionsPerSecond: 100, Burst: 5},
+		}),
+	Entry("Per-rule connection limit",
+		ParsedRule{
+			Limit: &model.RuleLimit{MaxConnections: &limit50, Scope: "PerRule"},
+		},
+		&proto.Rule{
+			Limit: &proto.RuleLimit{MaxConnections: 50, PerRule: true},
+		}),
 	Entry("fully-loaded rule",
 		fullyLoadedParsedRule,
 		fullyLoadedProtoRule),
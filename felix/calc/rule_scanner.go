@@ -350,9 +350,13 @@ type ParsedRule struct {
 	OriginalDstService                string
 	OriginalDstServiceNamespace       string
 
-	// These fields allow us to pass through the HTTP match criteria from the V3 datamodel. The iptables dataplane
-	// does not implement the match, but other dataplanes such as Dikastes do.
+	// These fields allow us to pass through the HTTP and gRPC match criteria from the V3 datamodel. The iptables
+	// dataplane does not implement the match, but other dataplanes such as Dikastes do.
 	HTTPMatch *model.HTTPMatch
+	GRPCMatch *model.GRPCMatch
+
+	// Limit on the connections that the rule allows, implemented by the dataplane.
+	Limit *model.RuleLimit
 
 	Metadata *model.RuleMetadata
 }
@@ -481,6 +485,8 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		OriginalDstService:                rule.DstService,
 		OriginalDstServiceNamespace:       rule.DstServiceNamespace,
 		HTTPMatch:                         rule.HTTPMatch,
+		GRPCMatch:                         rule.GRPCMatch,
+		Limit:                             rule.Limit,
 
 		// Pass through metadata (used by iptables backend)
 		Metadata: rule.Metadata,
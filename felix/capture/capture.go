@@ -0,0 +1,174 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture implements the per-interface packet captures that back PacketCapture
+// resources.  Packets are read from an AF_PACKET socket bound to the interface, so capture
+// works the same way whether the iptables, nftables or BPF dataplane is in use.
+package capture
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/google/gopacket"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const readTimeout = 500 * time.Millisecond
+
+type Config struct {
+	// Dir is the directory to write the capture files to.
+	Dir string
+	// Filter is a BPF filter expression in tcpdump syntax.  Empty captures all packets.
+	Filter string
+	// MaxFileSizeBytes is the size at which capture files are rotated.
+	MaxFileSizeBytes int64
+	// MaxFiles is the number of capture files to keep.
+	MaxFiles int
+	// EndTime is the time at which the capture stops by itself.  Zero for no limit.
+	EndTime time.Time
+}
+
+// Capture captures the packets on a single interface.
+type Capture struct {
+	iface   string
+	fd      int
+	writer  *RotatingWriter
+	endTime time.Time
+
+	stopC chan struct{}
+	doneC chan struct{}
+	// err records why the capture stopped by itself, if it failed.  Only valid once doneC is
+	// closed.
+	err error
+}
+
+// Start starts capturing on the named interface, writing to files named after the interface.
+func Start(iface string, cfg Config) (*Capture, error) {
+	link, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up interface %s: %w", iface, err)
+	}
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW, int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	if err := configureSocket(fd, link.Index, cfg.Filter); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+
+	c := &Capture{
+		iface:   iface,
+		fd:      fd,
+		writer:  NewRotatingWriter(cfg.Dir, iface, cfg.MaxFileSizeBytes, cfg.MaxFiles),
+		endTime: cfg.EndTime,
+		stopC:   make(chan struct{}),
+		doneC:   make(chan struct{}),
+	}
+	go c.loop()
+	return c, nil
+}
+
+func configureSocket(fd, ifindex int, filter string) error {
+	// Attach the filter before binding so that we never see unfiltered packets.
+	if filter != "" {
+		insns, err := compileFilter(filter)
+		if err != nil {
+			return fmt.Errorf("failed to compile filter %q: %w", filter, err)
+		}
+		prog := unix.SockFprog{Len: uint16(len(insns)), Filter: &insns[0]}
+		if err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &prog); err != nil {
+			return fmt.Errorf("failed to attach filter: %w", err)
+		}
+	}
+	tv := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		return fmt.Errorf("failed to set read timeout: %w", err)
+	}
+	addr := unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: ifindex}
+	if err := unix.Bind(fd, &addr); err != nil {
+		return fmt.Errorf("failed to bind packet socket: %w", err)
+	}
+	return nil
+}
+
+func (c *Capture) loop() {
+	defer close(c.doneC)
+	defer func() {
+		_ = unix.Close(c.fd)
+		if err := c.writer.Close(); err != nil {
+			log.WithError(err).WithField("iface", c.iface).Warn("Failed to close capture file.")
+		}
+	}()
+
+	logCxt := log.WithField("iface", c.iface)
+	buf := make([]byte, snapLen)
+	for {
+		select {
+		case <-c.stopC:
+			return
+		default:
+		}
+		if !c.endTime.IsZero() && time.Now().After(c.endTime) {
+			logCxt.Info("Capture reached its end time.")
+			return
+		}
+
+		n, _, err := unix.Recvfrom(c.fd, buf, unix.MSG_TRUNC)
+		if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+			continue
+		} else if err != nil {
+			logCxt.WithError(err).Error("Failed to read from packet socket; stopping capture.")
+			c.err = fmt.Errorf("failed to read from packet socket: %w", err)
+			return
+		}
+		ci := gopacket.CaptureInfo{
+			Timestamp:     time.Now(),
+			CaptureLength: min(n, len(buf)),
+			Length:        n,
+		}
+		if err := c.writer.WritePacket(ci, buf[:ci.CaptureLength]); err != nil {
+			logCxt.WithError(err).Error("Failed to write captured packet; stopping capture.")
+			c.err = fmt.Errorf("failed to write captured packet: %w", err)
+			return
+		}
+	}
+}
+
+// Stop stops the capture, if it is still running, and waits for its files to be closed.
+func (c *Capture) Stop() {
+	close(c.stopC)
+	<-c.doneC
+}
+
+// Done returns a channel that is closed once the capture has stopped, whether because it was
+// stopped, reached its end time or failed.
+func (c *Capture) Done() <-chan struct{} {
+	return c.doneC
+}
+
+// Err returns the error that made the capture stop by itself, or nil if it didn't fail.  It must
+// only be called once Done is closed.
+func (c *Capture) Err() error {
+	return c.err
+}
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
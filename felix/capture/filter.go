@@ -0,0 +1,37 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package capture
+
+import (
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcap"
+	"golang.org/x/sys/unix"
+)
+
+// compileFilter compiles a tcpdump-style filter expression to classic BPF, for attaching to
+// the packet socket.
+func compileFilter(expression string) ([]unix.SockFilter, error) {
+	insns, err := pcap.CompileBPFFilter(layers.LinkTypeEthernet, snapLen, expression)
+	if err != nil {
+		return nil, err
+	}
+	filter := make([]unix.SockFilter, len(insns))
+	for i, insn := range insns {
+		filter[i] = unix.SockFilter{Code: insn.Code, Jt: insn.Jt, Jf: insn.Jf, K: insn.K}
+	}
+	return filter, nil
+}
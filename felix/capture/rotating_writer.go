@@ -0,0 +1,150 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	log "github.com/sirupsen/logrus"
+)
+
+const snapLen = 65535
+
+// RotatingWriter writes packets to a sequence of pcap files named <dir>/<prefix>_<N>.pcap.
+// Once the current file reaches maxSize bytes, it starts a new file, deleting the oldest file
+// if that would leave more than maxFiles files.  If the directory already holds files from an
+// earlier capture, for example before Felix restarted, numbering continues after them.
+type RotatingWriter struct {
+	dir      string
+	prefix   string
+	maxSize  int64
+	maxFiles int
+
+	// Index of the oldest and of the current file.
+	oldest, current int
+
+	file   *os.File
+	writer *pcapgo.Writer
+	size   int64
+}
+
+func NewRotatingWriter(dir, prefix string, maxSize int64, maxFiles int) *RotatingWriter {
+	return &RotatingWriter{
+		dir:      dir,
+		prefix:   prefix,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+		current:  -1,
+	}
+}
+
+// FileName returns the name of the nth capture file.
+func (w *RotatingWriter) FileName(n int) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%s_%d.pcap", w.prefix, n))
+}
+
+func (w *RotatingWriter) WritePacket(ci gopacket.CaptureInfo, data []byte) error {
+	if w.file == nil || w.size >= w.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	if err := w.writer.WritePacket(ci, data); err != nil {
+		return err
+	}
+	// Each record has a 16-byte header.
+	w.size += int64(16 + len(data))
+	return nil
+}
+
+func (w *RotatingWriter) rotate() error {
+	if err := w.Close(); err != nil {
+		log.WithError(err).Warn("Failed to close capture file.")
+	}
+	if err := os.MkdirAll(w.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create capture directory: %w", err)
+	}
+
+	if w.current < 0 {
+		w.oldest, w.current = w.existingFiles()
+	}
+	w.current++
+	f, err := os.OpenFile(w.FileName(w.current), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create capture file: %w", err)
+	}
+	w.file = f
+	w.writer = pcapgo.NewWriter(f)
+	if err := w.writer.WriteFileHeader(snapLen, layers.LinkTypeEthernet); err != nil {
+		return fmt.Errorf("failed to write capture file header: %w", err)
+	}
+	w.size = 24
+
+	for w.current-w.oldest >= w.maxFiles {
+		name := w.FileName(w.oldest)
+		log.WithField("file", name).Debug("Removing old capture file.")
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).WithField("file", name).Warn("Failed to remove old capture file.")
+		}
+		w.oldest++
+	}
+	return nil
+}
+
+// existingFiles returns the lowest and highest indexes of the capture files already in the
+// directory, or 0 and -1 if there are none.
+func (w *RotatingWriter) existingFiles() (oldest, newest int) {
+	oldest, newest = 0, -1
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		n, ok := strings.CutPrefix(e.Name(), w.prefix+"_")
+		if !ok {
+			continue
+		}
+		n, ok = strings.CutSuffix(n, ".pcap")
+		if !ok {
+			continue
+		}
+		idx, err := strconv.Atoi(n)
+		if err != nil || idx < 0 {
+			continue
+		}
+		if newest < 0 || idx < oldest {
+			oldest = idx
+		}
+		newest = max(newest, idx)
+	}
+	return
+}
+
+func (w *RotatingWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	w.writer = nil
+	return err
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/pcapgo"
+	. "github.com/onsi/gomega"
+)
+
+func writePackets(t *testing.T, w *RotatingWriter, num int, data []byte) {
+	for i := 0; i < num; i++ {
+		ci := gopacket.CaptureInfo{Timestamp: time.Now(), CaptureLength: len(data), Length: len(data)}
+		if err := w.WritePacket(ci, data); err != nil {
+			t.Fatalf("WritePacket failed: %v", err)
+		}
+	}
+}
+
+func TestRotatingWriter_Rotates(t *testing.T) {
+	RegisterTestingT(t)
+	dir := t.TempDir()
+	data := make([]byte, 100)
+
+	// Each packet takes 116 bytes; the header takes 24, so each file holds 3 packets.
+	w := NewRotatingWriter(filepath.Join(dir, "ns", "capture"), "cali1234", 300, 2)
+	writePackets(t, w, 7, data)
+	Expect(w.Close()).To(Succeed())
+
+	entries, err := os.ReadDir(filepath.Join(dir, "ns", "capture"))
+	Expect(err).NotTo(HaveOccurred())
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	Expect(names).To(ConsistOf("cali1234_1.pcap", "cali1234_2.pcap"))
+
+	// The last file should be readable and contain the 7th packet only.
+	f, err := os.Open(w.FileName(2))
+	Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+	r, err := pcapgo.NewReader(f)
+	Expect(err).NotTo(HaveOccurred())
+	pkt, _, err := r.ReadPacketData()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(pkt).To(Equal(data))
+	_, _, err = r.ReadPacketData()
+	Expect(err).To(HaveOccurred())
+}
+
+func TestRotatingWriter_NoFileUntilFirstPacket(t *testing.T) {
+	RegisterTestingT(t)
+	dir := t.TempDir()
+
+	w := NewRotatingWriter(dir, "cali1234", 1000, 2)
+	Expect(w.Close()).To(Succeed())
+	Expect(w.FileName(0)).NotTo(BeAnExistingFile())
+
+	writePackets(t, w, 1, []byte{1, 2, 3})
+	Expect(w.Close()).To(Succeed())
+	Expect(w.FileName(0)).To(BeAnExistingFile())
+}
+
+func TestRotatingWriter_ContinuesAfterExistingFiles(t *testing.T) {
+	RegisterTestingT(t)
+	dir := t.TempDir()
+	data := make([]byte, 100)
+
+	w := NewRotatingWriter(dir, "cali1234", 300, 3)
+	writePackets(t, w, 4, data)
+	Expect(w.Close()).To(Succeed())
+	before, err := os.ReadFile(w.FileName(0))
+	Expect(err).NotTo(HaveOccurred())
+
+	// A new writer, as after a restart, must not overwrite the earlier files.
+	w = NewRotatingWriter(dir, "cali1234", 300, 3)
+	writePackets(t, w, 1, data)
+	Expect(w.Close()).To(Succeed())
+	after, err := os.ReadFile(w.FileName(0))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(after).To(Equal(before))
+	Expect(w.FileName(2)).To(BeAnExistingFile())
+
+	// Rotation still honours the file limit across both writers.
+	writePackets(t, w, 3, data)
+	Expect(w.Close()).To(Succeed())
+	entries, err := os.ReadDir(dir)
+	Expect(err).NotTo(HaveOccurred())
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	Expect(names).To(ConsistOf("cali1234_1.pcap", "cali1234_2.pcap", "cali1234_3.pcap"))
+}
@@ -799,7 +799,16 @@ func (c *collector) convertDataplaneStatsAndApplyUpdate(d *proto.DataplaneStats)
 
 	// Locate the data for this connection, creating if not yet available (it's possible to get an update
 	// from the dataplane before nflogs or conntrack).
-	_ = c.getDataAndUpdateEndpoints(t, false, false)
+	data := c.getDataAndUpdateEndpoints(t, false, false)
+	if data == nil {
+		return
+	}
+
+	// Application layer policy is only applied on ingress, so the gRPC call is reported with the
+	// ingress stats for the connection.
+	if call := d.GetGrpcCall(); call != nil {
+		data.AddGRPCCall(call.Service, call.Method)
+	}
 }
 
 // updatePendingRuleTraces evaluates each flow of epStats against the policies in the PolicyStore
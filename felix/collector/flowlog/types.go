@@ -17,6 +17,7 @@ package flowlog
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -127,6 +128,7 @@ type FlowSpec struct {
 	FlowLabels
 	FlowEnforcedPolicySets
 	FlowPendingPolicySet
+	FlowGRPCCalls
 
 	// Reset aggregated data on the next metric update to ensure we clear out obsolete labels, policies and Domains for
 	// connections that are not actively part of the flow during the export interval.
@@ -136,12 +138,14 @@ type FlowSpec struct {
 func NewFlowSpec(mu *metric.Update, displayDebugTraceLogs bool) *FlowSpec {
 	// NewFlowStatsByProcess potentially needs to update fields in mu *metric.Update hence passing it by pointer
 	// TODO: reconsider/refactor the inner functions called in NewFlowStatsByProcess to avoid above scenario
-	return &FlowSpec{
+	f := &FlowSpec{
 		FlowLabels:             NewFlowLabels(*mu),
 		FlowEnforcedPolicySets: NewFlowEnforcedPolicySets(*mu),
 		FlowPendingPolicySet:   NewFlowPendingPolicySet(*mu),
 		FlowStatsByProcess:     NewFlowStatsByProcess(mu, displayDebugTraceLogs),
 	}
+	f.aggregateFlowGRPCCalls(*mu)
+	return f
 }
 
 func (f *FlowSpec) ContainsActiveRefs(mu *metric.Update) bool {
@@ -158,6 +162,7 @@ func (f *FlowSpec) ToFlowLogs(fm FlowMeta, startTime, endTime time.Time, include
 			StartTime:                startTime,
 			EndTime:                  endTime,
 			FlowProcessReportedStats: stat,
+			GRPCCalls:                f.FlowGRPCCalls.toSlice(),
 		}
 
 		if includeLabels {
@@ -197,10 +202,12 @@ func (f *FlowSpec) AggregateMetricUpdate(mu *metric.Update) {
 		f.FlowPendingPolicySet = nil
 		f.FlowLabels.SrcLabels = uniquelabels.Nil
 		f.FlowLabels.DstLabels = uniquelabels.Nil
+		f.FlowGRPCCalls = nil
 		f.resetAggrData = false
 	}
 	f.aggregateFlowLabels(*mu)
 	f.aggregateFlowEnforcedPolicySets(*mu)
+	f.aggregateFlowGRPCCalls(*mu)
 	f.aggregateFlowStatsByProcess(mu)
 
 	f.replaceFlowPendingPolicySet(*mu)
@@ -345,6 +352,34 @@ func (fpl *FlowPendingPolicySet) replaceFlowPendingPolicySet(mu metric.Update) {
 	*fpl = NewFlowPendingPolicySet(mu)
 }
 
+// FlowGRPCCalls keeps track of the gRPC calls, as "service/method", whose verdict was decided by an
+// application layer policy rule for a flow.
+type FlowGRPCCalls map[string]empty
+
+func (fgc *FlowGRPCCalls) aggregateFlowGRPCCalls(mu metric.Update) {
+	if len(mu.GRPCCalls) == 0 {
+		return
+	}
+	if *fgc == nil {
+		*fgc = make(FlowGRPCCalls)
+	}
+	for _, call := range mu.GRPCCalls {
+		(*fgc)[call] = emptyValue
+	}
+}
+
+func (fgc FlowGRPCCalls) toSlice() []string {
+	if len(fgc) == 0 {
+		return nil
+	}
+	calls := make([]string, 0, len(fgc))
+	for call := range fgc {
+		calls = append(calls, call)
+	}
+	sort.Strings(calls)
+	return calls
+}
+
 // flowReferences are internal only stats used for computing numbers of flows
 type flowReferences struct {
 	// The set of unique flows that were started within the reporting interval. This is added to when a new flow
@@ -574,4 +609,8 @@ type FlowLog struct {
 	FlowProcessReportedStats
 
 	FlowEnforcedPolicySet, FlowPendingPolicySet FlowPolicySet
+
+	// GRPCCalls are the gRPC calls, as "service/method", whose verdict was decided by an
+	// application layer policy rule.
+	GRPCCalls []string
 }
@@ -348,3 +348,28 @@ var _ = Describe("FlowPolicySets", func() {
 		),
 	)
 })
+
+var _ = Describe("FlowGRPCCalls", func() {
+	It("records the union of gRPC calls until the flow is calibrated", func() {
+		ca := NewAggregator()
+
+		mu1 := muWithEndpointMeta
+		mu1.GRPCCalls = []string{"helloworld.Greeter/SayHello"}
+		mu2 := muWithEndpointMeta
+		mu2.GRPCCalls = []string{"helloworld.Greeter/SayGoodbye", "helloworld.Greeter/SayHello"}
+		mu3 := muWithEndpointMeta
+		Expect(ca.FeedUpdate(&mu1)).NotTo(HaveOccurred())
+		Expect(ca.FeedUpdate(&mu2)).NotTo(HaveOccurred())
+		Expect(ca.FeedUpdate(&mu3)).NotTo(HaveOccurred())
+
+		flowlogs := ca.GetAndCalibrate()
+		Expect(flowlogs).To(HaveLen(1))
+		Expect(flowlogs[0].GRPCCalls).To(Equal([]string{"helloworld.Greeter/SayGoodbye", "helloworld.Greeter/SayHello"}))
+
+		// Calls from the previous interval are not carried over.
+		Expect(ca.FeedUpdate(&mu3)).NotTo(HaveOccurred())
+		flowlogs = ca.GetAndCalibrate()
+		Expect(flowlogs).To(HaveLen(1))
+		Expect(flowlogs[0].GRPCCalls).To(BeNil())
+	})
+})
@@ -158,6 +158,7 @@ func ConvertFlowlogToGoldmane(fl *flowlog.FlowLog) *types.Flow {
 
 		SourceLabels: ensureLabels(fl.SrcLabels),
 		DestLabels:   ensureLabels(fl.DstLabels),
+		GrpcCalls:    unique.Make(strings.Join(fl.GRPCCalls, ",")),
 	}
 }
 
@@ -178,6 +179,7 @@ func ConvertGoldmaneToFlowlog(gl *proto.Flow) flowlog.FlowLog {
 	fl.DstLabels = ensureFlowLogLabels(gl.DestLabels)
 	fl.FlowEnforcedPolicySet = toFlowPolicySet(gl.Key.Policies.EnforcedPolicies)
 	fl.FlowPendingPolicySet = toFlowPolicySet(gl.Key.Policies.PendingPolicies)
+	fl.GRPCCalls = gl.GrpcCalls
 
 	fl.SrcMeta = endpoint.Metadata{
 		Namespace:      gl.Key.SourceNamespace,
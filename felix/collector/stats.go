@@ -17,6 +17,7 @@ package collector
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -351,6 +352,10 @@ type Data struct {
 	IngressPendingRuleIDs []*calc.RuleID
 	EgressPendingRuleIDs  []*calc.RuleID
 
+	// The gRPC calls, as "service/method", that Dikastes has reported for the connection since
+	// the stats were last reported.
+	grpcCalls []string
+
 	updatedAt     time.Duration
 	ruleUpdatedAt time.Duration
 
@@ -417,6 +422,7 @@ func (d *Data) setDirtyFlag() {
 
 func (d *Data) ClearConnDirtyFlag() {
 	d.dirty = false
+	d.grpcCalls = nil
 	d.conntrackPktsCtr.ResetDelta()
 	d.conntrackBytesCtr.ResetDelta()
 	d.conntrackPktsCtrReverse.ResetDelta()
@@ -479,6 +485,17 @@ func (d *Data) SetConntrackCounters(packets int, bytes int) {
 	d.touch()
 }
 
+// AddGRPCCall records a gRPC call whose verdict was decided by an application layer policy rule.
+func (d *Data) AddGRPCCall(service, method string) {
+	call := service + "/" + method
+	if slices.Contains(d.grpcCalls, call) {
+		return
+	}
+	d.grpcCalls = append(d.grpcCalls, call)
+	d.setDirtyFlag()
+	d.touch()
+}
+
 // SetExpired flags the connection as expired for later cleanup.
 func (d *Data) SetExpired() {
 	d.Expired = true
@@ -594,6 +611,7 @@ func (d *Data) MetricUpdateIngressConn(ut metric.UpdateType) metric.Update {
 		HasDenyRule:     d.IngressRuleTrace.HasDenyRule(),
 		PendingRuleIDs:  d.IngressPendingRuleIDs,
 		IsConnection:    d.IsConnection,
+		GRPCCalls:       d.grpcCalls,
 		InMetric: metric.Value{
 			DeltaPackets: d.conntrackPktsCtr.Delta(),
 			DeltaBytes:   d.conntrackBytesCtr.Delta(),
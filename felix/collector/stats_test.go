@@ -22,6 +22,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/calc"
 	"github.com/projectcalico/calico/felix/collector"
+	"github.com/projectcalico/calico/felix/collector/types/metric"
 	"github.com/projectcalico/calico/felix/collector/types/tuple"
 	"github.com/projectcalico/calico/felix/rules"
 )
@@ -365,3 +366,31 @@ var _ = Describe("Rule Trace", func() {
 	})
 
 })
+
+var _ = Describe("gRPC calls", func() {
+	var data *collector.Data
+
+	BeforeEach(func() {
+		var src, dst [16]byte
+		copy(src[:], net.ParseIP("127.0.0.1").To16())
+		copy(dst[:], net.ParseIP("127.1.1.1").To16())
+		data = collector.NewData(*tuple.New(src, dst, 6, 12345, 50051), nil, nil)
+		data.SetConntrackCounters(1, 100)
+		data.ClearConnDirtyFlag()
+	})
+
+	It("should report each call once with the ingress stats until the stats are cleared", func() {
+		data.AddGRPCCall("helloworld.Greeter", "SayHello")
+		data.AddGRPCCall("helloworld.Greeter", "SayHello")
+		data.AddGRPCCall("helloworld.Greeter", "SayGoodbye")
+		Expect(data.IsDirty()).To(BeTrue())
+		Expect(data.MetricUpdateIngressConn(metric.UpdateTypeReport).GRPCCalls).To(Equal([]string{
+			"helloworld.Greeter/SayHello",
+			"helloworld.Greeter/SayGoodbye",
+		}))
+		Expect(data.MetricUpdateEgressConn(metric.UpdateTypeReport).GRPCCalls).To(BeNil())
+
+		data.ClearConnDirtyFlag()
+		Expect(data.MetricUpdateIngressConn(metric.UpdateTypeReport).GRPCCalls).To(BeNil())
+	})
+})
@@ -100,6 +100,10 @@ type Update struct {
 	// HTTP Data updates after the connection itself has closed.
 	UnknownRuleID *calc.RuleID
 
+	// The gRPC calls, as "service/method", whose verdict was decided by an application layer
+	// policy rule since the last update.
+	GRPCCalls []string
+
 	// Inbound/Outbound packet/byte counts.
 	InMetric  Value
 	OutMetric Value
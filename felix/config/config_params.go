@@ -164,20 +164,23 @@ type Config struct {
 	UseInternalDataplaneDriver bool          `config:"bool;true"`
 	DataplaneDriver            string        `config:"file(must-exist,executable);calico-iptables-plugin;non-zero,die-on-fail,skip-default-validation"`
 	DataplaneWatchdogTimeout   time.Duration `config:"seconds;90"`
+	DataplaneDryRun            bool          `config:"bool;false"`
 
 	// Wireguard configuration
-	WireguardEnabled               bool          `config:"bool;false"`
-	WireguardEnabledV6             bool          `config:"bool;false"`
-	WireguardListeningPort         int           `config:"int;51820"`
-	WireguardListeningPortV6       int           `config:"int;51821"`
-	WireguardRoutingRulePriority   int           `config:"int;99"`
-	WireguardInterfaceName         string        `config:"iface-param;wireguard.cali;non-zero"`
-	WireguardInterfaceNameV6       string        `config:"iface-param;wg-v6.cali;non-zero"`
-	WireguardMTU                   int           `config:"int;0"`
-	WireguardMTUV6                 int           `config:"int;0"`
-	WireguardHostEncryptionEnabled bool          `config:"bool;false"`
-	WireguardPersistentKeepAlive   time.Duration `config:"seconds;0"`
-	WireguardThreadingEnabled      bool          `config:"bool;false"`
+	WireguardEnabled                bool          `config:"bool;false"`
+	WireguardEnabledV6              bool          `config:"bool;false"`
+	WireguardListeningPort          int           `config:"int;51820"`
+	WireguardListeningPortV6        int           `config:"int;51821"`
+	WireguardRoutingRulePriority    int           `config:"int;99"`
+	WireguardInterfaceName          string        `config:"iface-param;wireguard.cali;non-zero"`
+	WireguardInterfaceNameV6        string        `config:"iface-param;wg-v6.cali;non-zero"`
+	WireguardMTU                    int           `config:"int;0"`
+	WireguardMTUV6                  int           `config:"int;0"`
+	WireguardHostEncryptionEnabled  bool          `config:"bool;false"`
+	WireguardPersistentKeepAlive    time.Duration `config:"seconds;0"`
+	WireguardThreadingEnabled       bool          `config:"bool;false"`
+	WireguardKeyRotationInterval    time.Duration `config:"seconds;0"`
+	WireguardKeyRotationGracePeriod time.Duration `config:"seconds;300"`
 
 	// nftables configuration.
 	NFTablesMode string `config:"oneof(Enabled,Disabled);Disabled"`
@@ -206,6 +209,8 @@ type Config struct {
 	BPFMapSizeNATFrontend              int               `config:"int;65536;non-zero"`
 	BPFMapSizeNATBackend               int               `config:"int;262144;non-zero"`
 	BPFMapSizeNATAffinity              int               `config:"int;65536;non-zero"`
+	BPFServiceBackendSelection         string            `config:"oneof(Random,Maglev);Random;non-zero"`
+	BPFMaglevMaxServices               int               `config:"int;256;non-zero"`
 	BPFMapSizeRoute                    int               `config:"int;262144;non-zero"`
 	BPFMapSizeConntrack                int               `config:"int;512000;non-zero"`
 	BPFMapSizePerCPUConntrack          int               `config:"int;0"`
@@ -233,8 +238,9 @@ type Config struct {
 
 	// DatastoreType controls which datastore driver Felix will use.  Typically, this is detected from the environment
 	// and it does not need to be set manually. (For example, if `KUBECONFIG` is set, the kubernetes datastore driver
-	// will be used by default).
-	DatastoreType string `config:"oneof(kubernetes,etcdv3);etcdv3;non-zero,die-on-fail,local"`
+	// will be used by default).  The `file` datastore driver stores resources in a local directory; it is configured
+	// with the `FILE_DATASTORE_DIR` environment variable.
+	DatastoreType string `config:"oneof(kubernetes,etcdv3,file);etcdv3;non-zero,die-on-fail,local"`
 
 	// FelixHostname is the name of this node, used to identify resources in the datastore that belong to this node.
 	// Auto-detected from the node's hostname if not provided.
@@ -468,6 +474,18 @@ type Config struct {
 	// set. A value of -1 disables the override and uses the runtime default.
 	GoMaxProcs int `config:"int(-1);-1"`
 
+	// CaptureDir is the directory in which Felix writes the pcap files of PacketCaptures.  Files are
+	// written to <CaptureDir>/<namespace>/<name>/.
+	CaptureDir string `config:"file;/var/log/calico/pcap"`
+	// CaptureMaxFileSizeBytes is the default size at which a capture file is rotated.
+	CaptureMaxFileSizeBytes int `config:"int(1);10000000"`
+	// CaptureMaxFiles is the default number of capture files kept per interface.
+	CaptureMaxFiles int `config:"int(1);2"`
+
+	// HostEndpointTemplates are the templates from which Felix generates HostEndpoints for this host's
+	// interfaces.
+	HostEndpointTemplates []v3.HostEndpointTemplate `config:"host-endpoint-templates;;"`
+
 	// Configures MTU auto-detection.
 	MTUIfacePattern *regexp.Regexp `config:"regexp;^((en|wl|ww|sl|ib)[Pcopsvx].*|(eth|wlan|wwan).*)"`
 
@@ -911,6 +929,8 @@ func (config *Config) DatastoreConfig() apiconfig.CalicoAPIConfig {
 			cfg.Spec.DatastoreType = apiconfig.EtcdV3
 		} else if config.DatastoreType == string(apiconfig.Kubernetes) {
 			cfg.Spec.DatastoreType = apiconfig.Kubernetes
+		} else if config.DatastoreType == string(apiconfig.File) {
+			cfg.Spec.DatastoreType = apiconfig.File
 		}
 	}
 
@@ -1153,6 +1173,8 @@ func loadParams() {
 			param = &KeyValueListParam{}
 		case "keydurationlist":
 			param = &KeyDurationListParam{}
+		case "host-endpoint-templates":
+			param = &HostEndpointTemplatesParam{}
 		default:
 			log.Panicf("Unknown type of parameter: %v", kind)
 			panic("Unknown type of parameter") // Unreachable, keep the linter happy.
@@ -515,6 +515,19 @@ var _ = DescribeTable("Config parsing",
 	// Not a required parameter so a bad value is translated to nil:
 	Entry("HealthTimeoutOverrides non-duration", "HealthTimeoutOverrides", "foo=bar", map[string]time.Duration(nil), false),
 
+	Entry("HostEndpointTemplates", "HostEndpointTemplates", "", []v3.HostEndpointTemplate(nil), false),
+	Entry("HostEndpointTemplates good", "HostEndpointTemplates",
+		`[{"generateName":"uplink","interfacePattern":"eth[0-9]+","labels":{"role":"uplink"},"profiles":["allow-all"]}]`,
+		[]v3.HostEndpointTemplate{{
+			GenerateName:     "uplink",
+			InterfacePattern: "eth[0-9]+",
+			Labels:           map[string]string{"role": "uplink"},
+			Profiles:         []string{"allow-all"},
+		}}, false),
+	Entry("HostEndpointTemplates bad JSON", "HostEndpointTemplates", `[{"generateName":`, []v3.HostEndpointTemplate(nil), false),
+	Entry("HostEndpointTemplates bad pattern", "HostEndpointTemplates",
+		`[{"generateName":"uplink","interfacePattern":"eth[0-9"}]`, []v3.HostEndpointTemplate(nil), false),
+
 	Entry("BPFForceTrackPacketsFromIfaces Empty", "BPFForceTrackPacketsFromIfaces", "", []string{"docker+"}),
 	Entry("BPFForceTrackPacketsFromIfaces Single valid entry", "BPFForceTrackPacketsFromIfaces", "docker0", []string{"docker0"}),
 	Entry("BPFForceTrackPacketsFromIfaces Single valid entry", "BPFForceTrackPacketsFromIfaces", "cali-123", []string{"cali-123"}),
@@ -16,6 +16,7 @@ package config
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -31,6 +32,7 @@ import (
 	"time"
 
 	"github.com/kardianos/osext"
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -1028,6 +1030,34 @@ func (p *KeyDurationListParam) SchemaDescription() string {
 		"use Go's standard format (e.g. 1s, 1m, 1h3m2s)"
 }
 
+type HostEndpointTemplatesParam struct {
+	Metadata
+}
+
+func (p *HostEndpointTemplatesParam) Parse(raw string) (result interface{}, err error) {
+	var templates []v3.HostEndpointTemplate
+	if err = json.Unmarshal([]byte(raw), &templates); err != nil {
+		err = p.parseFailed(raw, err.Error())
+		return
+	}
+	for _, t := range templates {
+		if t.GenerateName == "" {
+			err = p.parseFailed(raw, "template has no generateName")
+			return
+		}
+		if _, reErr := regexp.Compile(t.InterfacePattern); reErr != nil {
+			err = p.parseFailed(raw, "invalid interfacePattern: "+reErr.Error())
+			return
+		}
+	}
+	return templates, nil
+}
+
+func (p *HostEndpointTemplatesParam) SchemaDescription() string {
+	return "JSON list of HostEndpoint templates, each with `generateName`, `interfacePattern`, " +
+		"`labels` and `profiles` fields"
+}
+
 type StringSliceParam struct {
 	Metadata
 	ValidationRegex *regexp.Regexp
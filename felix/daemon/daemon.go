@@ -18,12 +18,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
 	"reflect"
 	"runtime"
 	"runtime/debug"
+	"slices"
 	"sync"
 	"syscall"
 	"time"
@@ -31,14 +33,17 @@ import (
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/projectcalico/calico/felix/autohep"
 	"github.com/projectcalico/calico/felix/calc"
 	"github.com/projectcalico/calico/felix/collector"
 	"github.com/projectcalico/calico/felix/config"
 	dp "github.com/projectcalico/calico/felix/dataplane"
 	"github.com/projectcalico/calico/felix/jitter"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/felix/policysync"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/statusrep"
@@ -448,6 +453,11 @@ configRetry:
 		lookupsCache,
 	)
 
+	if src, ok := dpDriver.(pendingchanges.Source); ok && configParams.DebugPort != 0 {
+		// Expose the dataplane's pending changes on the debug server.
+		http.Handle(pendingchanges.HTTPPath, pendingchanges.NewHandler(src))
+	}
+
 	// Defer reporting ready until we've started the dataplane driver.  This
 	// ensures that our overall readiness waits for the dataplane driver to
 	// report ready on its health report.
@@ -669,6 +679,23 @@ configRetry:
 		statsCollector.RegisterWith(asyncCalcGraph.CalcGraph)
 	}
 
+	if datastoreConfig.Spec.DatastoreType == apiconfig.Kubernetes {
+		if len(configParams.HostEndpointTemplates) > 0 {
+			log.Warn("HostEndpointTemplates are not supported with the Kubernetes datastore; use " +
+				"kube-controllers' host endpoint controller instead.")
+		}
+	} else if configParams.IsLeader() {
+		// Always start the controller, even without templates, so that it cleans up any
+		// HostEndpoints that were generated by a previous configuration.  It learns about
+		// the existing HostEndpoints from the calculation graph's input, which already
+		// includes them, so it doesn't need its own watch.
+		log.WithField("templates", len(configParams.HostEndpointTemplates)).Info(
+			"Starting HostEndpoint generation from templates")
+		hepController := autohep.New(configParams.FelixHostname, configParams.HostEndpointTemplates, v3Client.HostEndpoints())
+		hepController.RegisterWith(asyncCalcGraph.CalcGraph.AllUpdDispatcher)
+		go hepController.Run(context.Background())
+	}
+
 	// Create the validator, which sits between the syncer and the
 	// calculation graph.
 	validator := calc.NewValidationFilter(asyncCalcGraph, configParams)
@@ -1196,7 +1223,14 @@ func (fc *DataplaneConnector) handleProcessStatusUpdate(ctx context.Context, msg
 	}
 }
 
-func (fc *DataplaneConnector) reconcileWireguardStatUpdate(dpPubKey string, ipVersion proto.IPVersion) error {
+func (fc *DataplaneConnector) reconcileWireguardStatUpdate(status *proto.WireguardStatusUpdate) error {
+	dpPubKey := status.PublicKey
+	ipVersion := status.IpVersion
+	var dpKeyTimestamp *metav1.Time
+	if status.PublicKeyTimestamp != 0 {
+		dpKeyTimestamp = &metav1.Time{Time: time.Unix(status.PublicKeyTimestamp, 0)}
+	}
+
 	// In case of a recoverable failure (ErrorResourceUpdateConflict), retry update 3 times.
 	for iter := 0; iter < 3; iter++ {
 		// Read node resource from datastore and compare it with the publicKey from dataplane.
@@ -1230,20 +1264,35 @@ func (fc *DataplaneConnector) reconcileWireguardStatUpdate(dpPubKey string, ipVe
 			return err
 		}
 
-		// Check if the public-key needs to be updated.
-		storedPublicKey := node.Status.WireguardPublicKey
-		if ipVersion == proto.IPVersion_IPV6 {
-			storedPublicKey = node.Status.WireguardPublicKeyV6
-		} else if ipVersion != proto.IPVersion_IPV4 {
+		// Check if the public-key or key rotation state needs to be updated.
+		var storedPublicKey, storedNextPublicKey *string
+		var storedKeyTimestamp **metav1.Time
+		var storedAcceptedKeys *[]string
+		switch ipVersion {
+		case proto.IPVersion_IPV4:
+			storedPublicKey = &node.Status.WireguardPublicKey
+			storedNextPublicKey = &node.Status.WireguardNextPublicKey
+			storedKeyTimestamp = &node.Status.WireguardPublicKeyTimestamp
+			storedAcceptedKeys = &node.Status.WireguardAcceptedNextPublicKeys
+		case proto.IPVersion_IPV6:
+			storedPublicKey = &node.Status.WireguardPublicKeyV6
+			storedNextPublicKey = &node.Status.WireguardNextPublicKeyV6
+			storedKeyTimestamp = &node.Status.WireguardPublicKeyTimestampV6
+			storedAcceptedKeys = &node.Status.WireguardAcceptedNextPublicKeysV6
+		default:
 			return fmt.Errorf("Unknown IP version: %d", ipVersion)
 		}
-		if storedPublicKey != dpPubKey {
+		if *storedPublicKey != dpPubKey ||
+			*storedNextPublicKey != status.NextPublicKey ||
+			!wireguardKeyTimestampsEqual(*storedKeyTimestamp, dpKeyTimestamp) ||
+			!slices.Equal(*storedAcceptedKeys, status.AcceptedNextPublicKeys) {
+			oldPublicKey := *storedPublicKey
+			*storedPublicKey = dpPubKey
+			*storedNextPublicKey = status.NextPublicKey
+			*storedKeyTimestamp = dpKeyTimestamp
+			*storedAcceptedKeys = status.AcceptedNextPublicKeys
+
 			updateCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-			if ipVersion == proto.IPVersion_IPV4 {
-				node.Status.WireguardPublicKey = dpPubKey
-			} else if ipVersion == proto.IPVersion_IPV6 {
-				node.Status.WireguardPublicKeyV6 = dpPubKey
-			}
 			_, err := fc.datastorev3.Nodes().Update(updateCtx, node, options.SetOptions{})
 			cancel()
 			if err != nil {
@@ -1257,13 +1306,22 @@ func (fc *DataplaneConnector) reconcileWireguardStatUpdate(dpPubKey string, ipVe
 				log.WithError(err).Info("Failed updating node resource")
 				return err
 			}
-			log.Debugf("Updated IPv%d Wireguard public-key from %s to %s", ipVersion, storedPublicKey, dpPubKey)
+			log.Debugf("Updated IPv%d Wireguard public-key from %s to %s (next key %q)",
+				ipVersion, oldPublicKey, dpPubKey, status.NextPublicKey)
 		}
 		break
 	}
 	return nil
 }
 
+// wireguardKeyTimestampsEqual compares two key timestamps to the resolution that is stored in the datastore.
+func wireguardKeyTimestampsEqual(a, b *metav1.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Unix() == b.Unix()
+}
+
 func (fc *DataplaneConnector) handleWireguardStatUpdateFromDataplane() {
 	var current *proto.WireguardStatusUpdate
 	var ticker *jitter.Ticker
@@ -1282,7 +1340,7 @@ func (fc *DataplaneConnector) handleWireguardStatUpdateFromDataplane() {
 		}
 
 		// Try and reconcile the current wireguard status data.
-		err := fc.reconcileWireguardStatUpdate(current.PublicKey, current.IpVersion)
+		err := fc.reconcileWireguardStatUpdate(current)
 		if err == nil {
 			current = nil
 			retryC = nil
@@ -311,6 +311,9 @@ func StartDataplaneDriver(
 				PersistentKeepAlive: configParams.WireguardPersistentKeepAlive,
 				ThreadedNAPI:        configParams.WireguardThreadingEnabled,
 				RouteSyncDisabled:   configParams.RouteSyncDisabled,
+
+				KeyRotationInterval:    configParams.WireguardKeyRotationInterval,
+				KeyRotationGracePeriod: configParams.WireguardKeyRotationGracePeriod,
 			},
 			IPIPMTU:                        configParams.IpInIpMtu,
 			VXLANMTU:                       configParams.VXLANMTU,
@@ -358,6 +361,7 @@ func StartDataplaneDriver(
 			WatchdogTimeout:                    configParams.DataplaneWatchdogTimeout,
 			DebugSimulateDataplaneHangAfter:    configParams.DebugSimulateDataplaneHangAfter,
 			DebugSimulateDataplaneApplyDelay:   configParams.DebugSimulateDataplaneApplyDelay,
+			DataplaneDryRun:                    configParams.DataplaneDryRun,
 			ExternalNodesCidrs:                 configParams.ExternalNodesCIDRList,
 			SidecarAccelerationEnabled:         configParams.SidecarAccelerationEnabled,
 			BPFEnabled:                         configParams.BPFEnabled,
@@ -382,6 +386,8 @@ func StartDataplaneDriver(
 			BPFMapSizeNATFrontend:              configParams.BPFMapSizeNATFrontend,
 			BPFMapSizeNATBackend:               configParams.BPFMapSizeNATBackend,
 			BPFMapSizeNATAffinity:              configParams.BPFMapSizeNATAffinity,
+			BPFServiceBackendSelection:         configParams.BPFServiceBackendSelection,
+			BPFMaglevMaxServices:               configParams.BPFMaglevMaxServices,
 			BPFMapSizeConntrack:                configParams.BPFMapSizeConntrack,
 			BPFMapSizeConntrackScaling:         configParams.BPFMapSizeConntrackScaling,
 			BPFMapSizePerCPUConntrack:          configParams.BPFMapSizePerCPUConntrack,
@@ -402,6 +408,9 @@ func StartDataplaneDriver(
 			BPFRedirectToPeer:                  configParams.BPFRedirectToPeer,
 			BPFProfiling:                       configParams.BPFProfiling,
 			ServiceLoopPrevention:              configParams.ServiceLoopPrevention,
+			CaptureDir:                         configParams.CaptureDir,
+			CaptureMaxFileSizeBytes:            configParams.CaptureMaxFileSizeBytes,
+			CaptureMaxFiles:                    configParams.CaptureMaxFiles,
 
 			KubeClientSet: k8sClientSet,
 
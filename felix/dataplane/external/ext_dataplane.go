@@ -250,6 +250,10 @@ func WrapPayloadWithEnvelope(msg interface{}, seqNo uint64) (*proto.ToDataplane,
 		envelope.Payload = &proto.ToDataplane_ServiceUpdate{ServiceUpdate: msg}
 	case *proto.ServiceRemove:
 		envelope.Payload = &proto.ToDataplane_ServiceRemove{ServiceRemove: msg}
+	case *proto.PacketCaptureUpdate:
+		envelope.Payload = &proto.ToDataplane_PacketCaptureUpdate{PacketCaptureUpdate: msg}
+	case *proto.PacketCaptureRemove:
+		envelope.Payload = &proto.ToDataplane_PacketCaptureRemove{PacketCaptureRemove: msg}
 
 	default:
 		return nil, fmt.Errorf("Unknown message type: %T", msg)
@@ -74,6 +74,7 @@ import (
 	"github.com/projectcalico/calico/felix/ifacemonitor"
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/routetable"
 	"github.com/projectcalico/calico/felix/rules"
@@ -1593,6 +1594,11 @@ func (m *bpfEndpointManager) loadDefaultPolicies() error {
 	return nil
 }
 
+// PendingChanges returns the pending changes to the interface state map.
+func (m *bpfEndpointManager) PendingChanges() []pendingchanges.Layer {
+	return m.ifStateMap.PendingChanges()
+}
+
 func (m *bpfEndpointManager) CompleteDeferredWork() error {
 	defer func() {
 		log.Debug("CompleteDeferredWork done.")
@@ -3838,6 +3844,13 @@ func (m *bpfEndpointManager) loadPolicyProgram(
 		opts = append(opts, polprog.WithFlowLogs())
 	}
 
+	if m.commonMaps.RateLimitMap != nil {
+		opts = append(opts, polprog.WithRateLimitMap(m.commonMaps.RateLimitMap.MapFD()))
+	}
+	if m.commonMaps.ConnLimitMap != nil && m.commonMaps.ConnFlowMap != nil {
+		opts = append(opts, polprog.WithConnLimitMaps(m.commonMaps.ConnLimitMap.MapFD(), m.commonMaps.ConnFlowMap.MapFD()))
+	}
+
 	pg := polprog.NewBuilder(
 		ipSetIDAlloc,
 		ipsetsMapFD,
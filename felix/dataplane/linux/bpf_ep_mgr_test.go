@@ -35,6 +35,7 @@ import (
 	"github.com/projectcalico/calico/felix/bpf"
 	"github.com/projectcalico/calico/felix/bpf/asm"
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
+	"github.com/projectcalico/calico/felix/bpf/connlimit"
 	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	"github.com/projectcalico/calico/felix/bpf/counters"
 	"github.com/projectcalico/calico/felix/bpf/hook"
@@ -45,6 +46,7 @@ import (
 	bpfmaps "github.com/projectcalico/calico/felix/bpf/maps"
 	"github.com/projectcalico/calico/felix/bpf/mock"
 	"github.com/projectcalico/calico/felix/bpf/polprog"
+	"github.com/projectcalico/calico/felix/bpf/ratelimit"
 	"github.com/projectcalico/calico/felix/bpf/state"
 	"github.com/projectcalico/calico/felix/bpf/tc"
 	"github.com/projectcalico/calico/felix/bpf/xdp"
@@ -407,6 +409,9 @@ var _ = Describe("BPF Endpoint Manager", func() {
 		countersMap = mock.NewMockMap(cparams)
 		commonMaps.CountersMap = countersMap
 		commonMaps.RuleCountersMap = mock.NewMockMap(counters.PolicyMapParameters)
+		commonMaps.RateLimitMap = mock.NewMockMap(ratelimit.MapParameters)
+		commonMaps.ConnLimitMap = mock.NewMockMap(connlimit.CounterMapParameters)
+		commonMaps.ConnFlowMap = mock.NewMockMap(connlimit.FlowMapParameters)
 
 		progsParams := bpfmaps.MapParameters{
 			Type:       "prog_array",
@@ -15,6 +15,7 @@
 package intdataplane
 
 import (
+	"fmt"
 	"net"
 	"sync"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/projectcalico/calico/felix/ifacemonitor"
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/types"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
@@ -414,6 +416,27 @@ func (m *bpfRouteManager) calculateRoute(cidr ip.CIDR) routes.ValueInterface {
 	return route
 }
 
+// PendingChanges returns the route map updates and deletions that the next CompleteDeferredWork would make.
+// It only covers routes whose desired state has already been calculated; CIDRs that have been marked dirty
+// since the last apply are recalculated as part of the next apply.
+func (m *bpfRouteManager) PendingChanges() []pendingchanges.Layer {
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindBPFMap,
+		Name:          m.routeMap.GetName(),
+		IPVersion:     uint8(m.ipFamily),
+		ResyncPending: m.resyncScheduled,
+	}
+	m.dirtyRoutes.Iter(func(key routes.KeyInterface) error {
+		if value, present := m.desiredRoutes[key]; present {
+			layer.Changes = append(layer.Changes, fmt.Sprintf("update %v: %v", key, value))
+		} else {
+			layer.Changes = append(layer.Changes, fmt.Sprintf("delete %v", key))
+		}
+		return nil
+	})
+	return []pendingchanges.Layer{layer}
+}
+
 func (m *bpfRouteManager) applyUpdates() (numDels uint, numAdds uint) {
 	debug := log.GetLevel() >= log.DebugLevel
 
@@ -0,0 +1,260 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/capture"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/types"
+)
+
+// States reported for each interface of a PacketCapture.
+const (
+	captureStateCapturing = "Capturing"
+	captureStateFinished  = "Finished"
+	captureStateFailed    = "Failed"
+)
+
+var gaugePacketCaptureState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "felix_packet_capture_state",
+	Help: "Set to 1 for the current state (Capturing, Finished or Failed) of each interface of each PacketCapture.",
+}, []string{"namespace", "name", "interface", "state"})
+
+func init() {
+	prometheus.MustRegister(gaugePacketCaptureState)
+}
+
+// captureKey identifies a single interface of a PacketCapture.
+type captureKey struct {
+	id    types.PacketCaptureID
+	iface string
+}
+
+type runningCapture interface {
+	Stop()
+	Done() <-chan struct{}
+	Err() error
+}
+
+// The capture manager starts and stops the per-interface packet captures that implement
+// PacketCaptures.  The calculation graph tells it which local workload endpoints each
+// PacketCapture selects; it maps those to interface names using the workload endpoint
+// updates.
+type captureManager struct {
+	dir              string
+	maxFileSizeBytes int64
+	maxFiles         int
+
+	// Latest PacketCaptureUpdate for each active PacketCapture.
+	captures map[types.PacketCaptureID]*proto.PacketCaptureUpdate
+	// Interface name of each local workload endpoint.
+	ifaceByEndpoint map[types.WorkloadEndpointID]string
+
+	running       map[captureKey]runningCapture
+	runningConfig map[captureKey]capture.Config
+	// Configuration of captures that ended by themselves or failed to start.  They aren't
+	// restarted until their configuration changes.
+	finished map[captureKey]capture.Config
+	states   map[captureKey]string
+	dirty    bool
+
+	// Shims for testing.
+	startCapture func(iface string, cfg capture.Config) (runningCapture, error)
+	now          func() time.Time
+}
+
+func newCaptureManager(config Config) *captureManager {
+	return newCaptureManagerWithShims(config, func(iface string, cfg capture.Config) (runningCapture, error) {
+		return capture.Start(iface, cfg)
+	}, time.Now)
+}
+
+func newCaptureManagerWithShims(
+	config Config,
+	startCapture func(iface string, cfg capture.Config) (runningCapture, error),
+	now func() time.Time,
+) *captureManager {
+	return &captureManager{
+		dir:              config.CaptureDir,
+		maxFileSizeBytes: int64(config.CaptureMaxFileSizeBytes),
+		maxFiles:         config.CaptureMaxFiles,
+		captures:         map[types.PacketCaptureID]*proto.PacketCaptureUpdate{},
+		ifaceByEndpoint:  map[types.WorkloadEndpointID]string{},
+		running:          map[captureKey]runningCapture{},
+		runningConfig:    map[captureKey]capture.Config{},
+		finished:         map[captureKey]capture.Config{},
+		states:           map[captureKey]string{},
+		startCapture:     startCapture,
+		now:              now,
+	}
+}
+
+func (m *captureManager) OnUpdate(protoBufMsg interface{}) {
+	switch msg := protoBufMsg.(type) {
+	case *proto.PacketCaptureUpdate:
+		m.captures[types.ProtoToPacketCaptureID(msg.GetId())] = msg
+		m.dirty = true
+	case *proto.PacketCaptureRemove:
+		delete(m.captures, types.ProtoToPacketCaptureID(msg.GetId()))
+		m.dirty = true
+	case *proto.WorkloadEndpointUpdate:
+		id := types.ProtoToWorkloadEndpointID(msg.GetId())
+		if m.ifaceByEndpoint[id] != msg.Endpoint.Name {
+			m.ifaceByEndpoint[id] = msg.Endpoint.Name
+			m.dirty = true
+		}
+	case *proto.WorkloadEndpointRemove:
+		id := types.ProtoToWorkloadEndpointID(msg.GetId())
+		if _, ok := m.ifaceByEndpoint[id]; ok {
+			delete(m.ifaceByEndpoint, id)
+			m.dirty = true
+		}
+	}
+}
+
+func (m *captureManager) CompleteDeferredWork() error {
+	m.reapFinishedCaptures()
+	if !m.dirty {
+		return nil
+	}
+
+	desired := map[captureKey]capture.Config{}
+	now := m.now()
+	for id, c := range m.captures {
+		cfg := capture.Config{
+			Dir:              filepath.Join(m.dir, id.Namespace, id.Name),
+			Filter:           c.Filter,
+			MaxFileSizeBytes: m.maxFileSizeBytes,
+			MaxFiles:         m.maxFiles,
+		}
+		if c.EndTime != 0 {
+			cfg.EndTime = time.Unix(c.EndTime, 0)
+		}
+		if c.MaxFileSizeBytes != 0 {
+			cfg.MaxFileSizeBytes = c.MaxFileSizeBytes
+		}
+		if c.MaxFiles != 0 {
+			cfg.MaxFiles = int(c.MaxFiles)
+		}
+		for _, ep := range c.Endpoints {
+			iface, ok := m.ifaceByEndpoint[types.ProtoToWorkloadEndpointID(ep)]
+			if !ok {
+				// We'll get the endpoint later.
+				continue
+			}
+			desired[captureKey{id: id, iface: iface}] = cfg
+		}
+	}
+
+	// Stop captures that are no longer wanted, or whose configuration has changed.
+	for key, rc := range m.running {
+		if cfg, ok := desired[key]; ok && cfg == m.runningConfig[key] {
+			continue
+		}
+		log.WithFields(log.Fields{"capture": key.id, "iface": key.iface}).Info("Stopping packet capture.")
+		rc.Stop()
+		delete(m.running, key)
+		delete(m.runningConfig, key)
+	}
+	for key, cfg := range m.finished {
+		if desired[key] != cfg {
+			delete(m.finished, key)
+		}
+	}
+	for key := range m.states {
+		if _, ok := desired[key]; !ok {
+			m.setState(key, "")
+		}
+	}
+
+	// Start new captures.
+	for key, cfg := range desired {
+		if _, ok := m.running[key]; ok {
+			continue
+		}
+		if _, ok := m.finished[key]; ok {
+			continue
+		}
+		logCxt := log.WithFields(log.Fields{"capture": key.id, "iface": key.iface})
+		if !cfg.EndTime.IsZero() && !now.Before(cfg.EndTime) {
+			// Capture has already finished.
+			m.finished[key] = cfg
+			m.setState(key, captureStateFinished)
+			continue
+		}
+		logCxt.Info("Starting packet capture.")
+		rc, err := m.startCapture(key.iface, cfg)
+		if err != nil {
+			// Most likely the filter is invalid, in which case retrying won't help.  Wait
+			// for the next change instead.
+			logCxt.WithError(err).Error("Failed to start packet capture.")
+			m.finished[key] = cfg
+			m.setState(key, captureStateFailed)
+			continue
+		}
+		m.running[key] = rc
+		m.runningConfig[key] = cfg
+		m.setState(key, captureStateCapturing)
+	}
+
+	m.dirty = false
+	return nil
+}
+
+// reapFinishedCaptures removes the captures that have stopped by themselves, because they reached
+// their end time or failed, and records their state.
+func (m *captureManager) reapFinishedCaptures() {
+	for key, rc := range m.running {
+		select {
+		case <-rc.Done():
+		default:
+			continue
+		}
+		logCxt := log.WithFields(log.Fields{"capture": key.id, "iface": key.iface})
+		if err := rc.Err(); err != nil {
+			logCxt.WithError(err).Warn("Packet capture failed.")
+			m.setState(key, captureStateFailed)
+		} else {
+			logCxt.Info("Packet capture finished.")
+			m.setState(key, captureStateFinished)
+		}
+		m.finished[key] = m.runningConfig[key]
+		delete(m.running, key)
+		delete(m.runningConfig, key)
+	}
+}
+
+// setState updates the reported state of a capture.  An empty state stops reporting it.
+func (m *captureManager) setState(key captureKey, state string) {
+	old, ok := m.states[key]
+	if ok && old == state {
+		return
+	}
+	if ok {
+		gaugePacketCaptureState.DeleteLabelValues(key.id.Namespace, key.id.Name, key.iface, old)
+	}
+	if state == "" {
+		delete(m.states, key)
+		return
+	}
+	m.states[key] = state
+	gaugePacketCaptureState.WithLabelValues(key.id.Namespace, key.id.Name, key.iface, state).Set(1)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/projectcalico/calico/felix/capture"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+type mockCapture struct {
+	cfg     capture.Config
+	stopped bool
+	doneC   chan struct{}
+	err     error
+}
+
+func (c *mockCapture) Stop() {
+	c.stopped = true
+}
+
+func (c *mockCapture) Done() <-chan struct{} {
+	return c.doneC
+}
+
+func (c *mockCapture) Err() error {
+	return c.err
+}
+
+// finish simulates the capture stopping by itself.
+func (c *mockCapture) finish(err error) {
+	c.err = err
+	close(c.doneC)
+}
+
+func captureState(iface, state string) float64 {
+	return testutil.ToFloat64(gaugePacketCaptureState.WithLabelValues("ns1", "capture", iface, state))
+}
+
+var _ = Describe("Capture manager", func() {
+	var (
+		mgr      *captureManager
+		started  map[string]*mockCapture
+		startErr error
+		now      time.Time
+	)
+
+	ep1 := &proto.WorkloadEndpointID{OrchestratorId: "k8s", WorkloadId: "ns1/pod1", EndpointId: "eth0"}
+	ep2 := &proto.WorkloadEndpointID{OrchestratorId: "k8s", WorkloadId: "ns1/pod2", EndpointId: "eth0"}
+	captureID := &proto.PacketCaptureID{Namespace: "ns1", Name: "capture"}
+
+	BeforeEach(func() {
+		gaugePacketCaptureState.Reset()
+		started = map[string]*mockCapture{}
+		startErr = nil
+		now = time.Unix(1000, 0)
+		mgr = newCaptureManagerWithShims(Config{
+			CaptureDir:              "/var/log/calico/pcap",
+			CaptureMaxFileSizeBytes: 1000,
+			CaptureMaxFiles:         2,
+		}, func(iface string, cfg capture.Config) (runningCapture, error) {
+			if startErr != nil {
+				return nil, startErr
+			}
+			c := &mockCapture{cfg: cfg, doneC: make(chan struct{})}
+			started[iface] = c
+			return c, nil
+		}, func() time.Time {
+			return now
+		})
+
+		mgr.OnUpdate(&proto.WorkloadEndpointUpdate{Id: ep1, Endpoint: &proto.WorkloadEndpoint{Name: "cali1"}})
+		mgr.OnUpdate(&proto.WorkloadEndpointUpdate{Id: ep2, Endpoint: &proto.WorkloadEndpoint{Name: "cali2"}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+	})
+
+	It("should start captures on the selected interfaces with default limits", func() {
+		mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Filter: "tcp", Endpoints: []*proto.WorkloadEndpointID{ep1}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+
+		Expect(started).To(HaveLen(1))
+		Expect(started["cali1"].cfg).To(Equal(capture.Config{
+			Dir:              "/var/log/calico/pcap/ns1/capture",
+			Filter:           "tcp",
+			MaxFileSizeBytes: 1000,
+			MaxFiles:         2,
+		}))
+	})
+
+	It("should use the capture's own limits", func() {
+		mgr.OnUpdate(&proto.PacketCaptureUpdate{
+			Id:               captureID,
+			EndTime:          2000,
+			MaxFileSizeBytes: 50,
+			MaxFiles:         5,
+			Endpoints:        []*proto.WorkloadEndpointID{ep1},
+		})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+
+		Expect(started["cali1"].cfg.EndTime).To(Equal(time.Unix(2000, 0)))
+		Expect(started["cali1"].cfg.MaxFileSizeBytes).To(Equal(int64(50)))
+		Expect(started["cali1"].cfg.MaxFiles).To(Equal(5))
+	})
+
+	It("should not start a capture that has already ended", func() {
+		mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, EndTime: 1000, Endpoints: []*proto.WorkloadEndpointID{ep1}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(started).To(BeEmpty())
+		Expect(captureState("cali1", "Finished")).To(Equal(1.0))
+	})
+
+	It("should tolerate a failure to start a capture", func() {
+		startErr = errors.New("bad filter")
+		mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Filter: "bad", Endpoints: []*proto.WorkloadEndpointID{ep1}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(started).To(BeEmpty())
+		Expect(captureState("cali1", "Failed")).To(Equal(1.0))
+
+		// It is retried when the capture changes.
+		startErr = nil
+		mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Filter: "tcp", Endpoints: []*proto.WorkloadEndpointID{ep1}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(started).To(HaveKey("cali1"))
+		Expect(testutil.CollectAndCount(gaugePacketCaptureState)).To(Equal(1))
+		Expect(captureState("cali1", "Capturing")).To(Equal(1.0))
+	})
+
+	Describe("with a running capture", func() {
+		BeforeEach(func() {
+			mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Endpoints: []*proto.WorkloadEndpointID{ep1, ep2}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(started).To(HaveLen(2))
+		})
+
+		It("should stop the capture of an endpoint that is no longer selected", func() {
+			mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Endpoints: []*proto.WorkloadEndpointID{ep1}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(started["cali1"].stopped).To(BeFalse())
+			Expect(started["cali2"].stopped).To(BeTrue())
+		})
+
+		It("should stop the capture of an endpoint that is removed", func() {
+			mgr.OnUpdate(&proto.WorkloadEndpointRemove{Id: ep2})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(started["cali2"].stopped).To(BeTrue())
+		})
+
+		It("should restart the captures when the filter changes", func() {
+			old := started["cali1"]
+			mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Filter: "udp", Endpoints: []*proto.WorkloadEndpointID{ep1, ep2}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(old.stopped).To(BeTrue())
+			Expect(started["cali1"].stopped).To(BeFalse())
+			Expect(started["cali1"].cfg.Filter).To(Equal("udp"))
+		})
+
+		It("should stop all captures when the capture is removed", func() {
+			mgr.OnUpdate(&proto.PacketCaptureRemove{Id: captureID})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(started["cali1"].stopped).To(BeTrue())
+			Expect(started["cali2"].stopped).To(BeTrue())
+			Expect(testutil.CollectAndCount(gaugePacketCaptureState)).To(Equal(0))
+		})
+
+		It("should reap captures that stop by themselves and report their state", func() {
+			Expect(captureState("cali1", "Capturing")).To(Equal(1.0))
+			cali1, cali2 := started["cali1"], started["cali2"]
+			cali1.finish(nil)
+			cali2.finish(errors.New("interface went away"))
+
+			// Reaping doesn't need any other update.
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(mgr.running).To(BeEmpty())
+			Expect(testutil.CollectAndCount(gaugePacketCaptureState)).To(Equal(2))
+			Expect(captureState("cali1", "Finished")).To(Equal(1.0))
+			Expect(captureState("cali2", "Failed")).To(Equal(1.0))
+
+			// They aren't restarted by unrelated updates, nor stopped a second time.
+			mgr.OnUpdate(&proto.WorkloadEndpointUpdate{Id: ep1, Endpoint: &proto.WorkloadEndpoint{Name: "cali1"}})
+			mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Endpoints: []*proto.WorkloadEndpointID{ep1, ep2}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(started["cali1"]).To(BeIdenticalTo(cali1))
+			Expect(cali1.stopped).To(BeFalse())
+
+			// Their state is dropped once they no longer apply.
+			mgr.OnUpdate(&proto.PacketCaptureUpdate{Id: captureID, Endpoints: []*proto.WorkloadEndpointID{ep1}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(testutil.CollectAndCount(gaugePacketCaptureState)).To(Equal(1))
+		})
+	})
+})
@@ -39,6 +39,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/bpf"
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
+	bpfconnlimit "github.com/projectcalico/calico/felix/bpf/connlimit"
 	"github.com/projectcalico/calico/felix/bpf/conntrack"
 	bpfconntrack "github.com/projectcalico/calico/felix/bpf/conntrack"
 	bpftimeouts "github.com/projectcalico/calico/felix/bpf/conntrack/timeouts"
@@ -75,6 +76,7 @@ import (
 	"github.com/projectcalico/calico/felix/logutils"
 	"github.com/projectcalico/calico/felix/netlinkshim"
 	"github.com/projectcalico/calico/felix/nftables"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/routerule"
 	"github.com/projectcalico/calico/felix/routetable"
@@ -207,6 +209,10 @@ type Config struct {
 	DebugSimulateDataplaneHangAfter  time.Duration
 	DebugSimulateDataplaneApplyDelay time.Duration
 
+	// DataplaneDryRun, if set, causes the iptables/nftables tables and the IP sets to log the changes that
+	// they would make instead of making them.
+	DataplaneDryRun bool
+
 	ExternalNodesCidrs []string
 
 	BPFEnabled                         bool
@@ -240,6 +246,8 @@ type Config struct {
 	BPFMapSizeNATFrontend              int
 	BPFMapSizeNATBackend               int
 	BPFMapSizeNATAffinity              int
+	BPFServiceBackendSelection         string
+	BPFMaglevMaxServices               int
 	BPFMapSizeIPSets                   int
 	BPFMapSizeIfState                  int
 	BPFIpv6Enabled                     bool
@@ -262,6 +270,11 @@ type Config struct {
 
 	ServiceLoopPrevention string
 
+	// Packet capture settings.
+	CaptureDir              string
+	CaptureMaxFileSizeBytes int
+	CaptureMaxFiles         int
+
 	LookPathOverride func(file string) (string, error)
 
 	KubeClientSet *kubernetes.Clientset
@@ -319,6 +332,9 @@ type InternalDataplane struct {
 	fromDataplane           chan interface{}
 	sendDataplaneInSyncOnce sync.Once
 
+	// pendingChangesReqs carries requests for the pending changes of the dataplane layers to the main loop.
+	pendingChangesReqs chan chan []pendingchanges.Layer
+
 	mainRouteTables []routetable.SyncerInterface
 	allTables       []generictables.Table
 	mangleTables    []generictables.Table
@@ -454,16 +470,17 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	}
 
 	dp := &InternalDataplane{
-		toDataplane:    make(chan interface{}, msgPeekLimit),
-		fromDataplane:  make(chan interface{}, 100),
-		ruleRenderer:   ruleRenderer,
-		ifaceMonitor:   ifacemonitor.New(config.IfaceMonitorConfig, featureDetector, config.FatalErrorRestartCallback),
-		ifaceUpdates:   make(chan any, 100),
-		config:         config,
-		applyThrottle:  throttle.New(10),
-		loopSummarizer: logutils.NewSummarizer("dataplane reconciliation loops"),
-		actions:        actionSet,
-		newMatch:       newMatchFn,
+		toDataplane:        make(chan interface{}, msgPeekLimit),
+		fromDataplane:      make(chan interface{}, 100),
+		pendingChangesReqs: make(chan chan []pendingchanges.Layer),
+		ruleRenderer:       ruleRenderer,
+		ifaceMonitor:       ifacemonitor.New(config.IfaceMonitorConfig, featureDetector, config.FatalErrorRestartCallback),
+		ifaceUpdates:       make(chan any, 100),
+		config:             config,
+		applyThrottle:      throttle.New(10),
+		loopSummarizer:     logutils.NewSummarizer("dataplane reconciliation loops"),
+		actions:            actionSet,
+		newMatch:           newMatchFn,
 	}
 	dp.applyThrottle.Refill() // Allow the first apply() immediately.
 	dp.ifaceMonitor.StateCallback = dp.onIfaceStateChange
@@ -492,6 +509,17 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		OpRecorder:       dp.loopSummarizer,
 	}
 
+	if config.DataplaneDryRun {
+		if config.BPFEnabled {
+			log.Warn("Dataplane dry-run mode is not supported in BPF mode, ignoring.")
+		} else {
+			log.Warn("Dataplane dry-run mode enabled, changes to iptables/nftables and IP sets will be logged " +
+				"but not applied.")
+			iptablesOptions.DryRun = true
+			nftablesOptions.DryRun = true
+		}
+	}
+
 	if config.BPFEnabled && config.BPFKubeProxyIptablesCleanupEnabled {
 		// If BPF-mode is enabled, clean up kube-proxy's rules too.
 		if !config.RulesConfig.NFTables {
@@ -598,7 +626,9 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		)
 
 		ipSetsConfigV4 := config.RulesConfig.IPSetConfigV4
-		ipSetsV4 = ipsets.NewIPSets(ipSetsConfigV4, dp.loopSummarizer)
+		ipSetsV4IPT := ipsets.NewIPSets(ipSetsConfigV4, dp.loopSummarizer)
+		ipSetsV4IPT.DryRun = iptablesOptions.DryRun
+		ipSetsV4 = ipSetsV4IPT
 	}
 
 	dp.natTables = append(dp.natTables, natTableV4)
@@ -849,7 +879,8 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	}
 
 	bpfipsets.SetMapSize(config.BPFMapSizeIPSets)
-	bpfnat.SetMapSizes(config.BPFMapSizeNATFrontend, config.BPFMapSizeNATBackend, config.BPFMapSizeNATAffinity)
+	bpfnat.SetMapSizes(config.BPFMapSizeNATFrontend, config.BPFMapSizeNATBackend, config.BPFMapSizeNATAffinity,
+		config.BPFMaglevMaxServices*bpfnat.MaglevLUTSize)
 	bpfroutes.SetMapSize(config.BPFMapSizeRoute)
 	bpfconntrack.SetMapSize(bpfMapSizeConntrack)
 	bpfconntrack.SetCleanupMapSize(config.BPFMapSizeConntrackCleanupQueue)
@@ -893,11 +924,11 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		ipSetIDAllocatorV4 = idalloc.New()
 
 		// Start IPv4 BPF dataplane components
-		conntrackScannerV4 = startBPFDataplaneComponents(proto.IPVersion_IPV4, bpfMaps.V4, ipSetIDAllocatorV4, config, ipsetsManager, dp)
+		conntrackScannerV4 = startBPFDataplaneComponents(proto.IPVersion_IPV4, bpfMaps.V4, bpfMaps.CommonMaps, ipSetIDAllocatorV4, config, ipsetsManager, dp)
 		if config.BPFIpv6Enabled {
 			// Start IPv6 BPF dataplane components
 			ipSetIDAllocatorV6 = idalloc.New()
-			conntrackScannerV6 = startBPFDataplaneComponents(proto.IPVersion_IPV6, bpfMaps.V6, ipSetIDAllocatorV6, config, ipsetsManagerV6, dp)
+			conntrackScannerV6 = startBPFDataplaneComponents(proto.IPVersion_IPV6, bpfMaps.V6, bpfMaps.CommonMaps, ipSetIDAllocatorV6, config, ipsetsManagerV6, dp)
 		}
 
 		workloadIfaceRegex := regexp.MustCompile(strings.Join(interfaceRegexes, "|"))
@@ -1099,12 +1130,8 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	// Add a manager for IPv4 wireguard configuration. This is added irrespective of whether wireguard is actually enabled
 	// because it may need to tidy up some of the routing rules when disabled.
 	cryptoRouteTableWireguard := wireguard.New(config.Hostname, &config.Wireguard, 4, config.NetlinkTimeout,
-		config.DeviceRouteProtocol, func(publicKey wgtypes.Key) error {
-			if publicKey == zeroKey {
-				dp.fromDataplane <- &proto.WireguardStatusUpdate{PublicKey: "", IpVersion: 4}
-			} else {
-				dp.fromDataplane <- &proto.WireguardStatusUpdate{PublicKey: publicKey.String(), IpVersion: 4}
-			}
+		config.DeviceRouteProtocol, func(status wireguard.KeyStatus) error {
+			dp.fromDataplane <- wireguardStatusUpdate(status, 4)
 			return nil
 		},
 		dp.loopSummarizer,
@@ -1114,6 +1141,7 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	dp.RegisterManager(dp.wireguardManager) // IPv4
 
 	dp.RegisterManager(newServiceLoopManager(filterTableV4, ruleRenderer, 4))
+	dp.RegisterManager(newCaptureManager(config))
 
 	if config.IPv6Enabled {
 		ipSetsConfigV6 := config.RulesConfig.IPSetConfigV6
@@ -1150,7 +1178,9 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 				featureDetector,
 				iptablesOptions,
 			)
-			ipSetsV6 = ipsets.NewIPSets(ipSetsConfigV6, dp.loopSummarizer)
+			ipSetsV6IPT := ipsets.NewIPSets(ipSetsConfigV6, dp.loopSummarizer)
+			ipSetsV6IPT.DryRun = iptablesOptions.DryRun
+			ipSetsV6 = ipSetsV6IPT
 		}
 
 		dp.ipSets = append(dp.ipSets, ipSetsV6)
@@ -1255,12 +1285,8 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		// Add a manager for IPv6 wireguard configuration. This is added irrespective of whether wireguard is actually enabled
 		// because it may need to tidy up some of the routing rules when disabled.
 		cryptoRouteTableWireguardV6 := wireguard.New(config.Hostname, &config.Wireguard, 6, config.NetlinkTimeout,
-			config.DeviceRouteProtocol, func(publicKey wgtypes.Key) error {
-				if publicKey == zeroKey {
-					dp.fromDataplane <- &proto.WireguardStatusUpdate{PublicKey: "", IpVersion: 6}
-				} else {
-					dp.fromDataplane <- &proto.WireguardStatusUpdate{PublicKey: publicKey.String(), IpVersion: 6}
-				}
+			config.DeviceRouteProtocol, func(status wireguard.KeyStatus) error {
+				dp.fromDataplane <- wireguardStatusUpdate(status, 6)
 				return nil
 			},
 			dp.loopSummarizer,
@@ -1594,6 +1620,12 @@ type routeRules interface {
 	Apply() error
 }
 
+// routeTableSyncerWithReschedule is implemented by route table syncers that may need to be applied again after a
+// delay, even if there are no other updates.
+type routeTableSyncerWithReschedule interface {
+	RescheduleAfter() time.Duration
+}
+
 func (d *InternalDataplane) routeTableSyncers() []routetable.SyncerInterface {
 	rts := d.mainRouteTables
 	for _, mrts := range d.managersWithRouteTables {
@@ -2125,6 +2157,47 @@ func (d *InternalDataplane) shutdownXDPCompletely() error {
 	return fmt.Errorf("Failed to wipe the XDP state after %v tries over %v seconds: Error %v", maxTries, waitInterval, err)
 }
 
+// PendingChanges returns the changes that each dataplane layer would make on its next apply.  The changes are
+// collected by the main loop, so this blocks until the main loop is free to handle the request.
+func (d *InternalDataplane) PendingChanges(ctx context.Context) ([]pendingchanges.Layer, error) {
+	respC := make(chan []pendingchanges.Layer, 1)
+	select {
+	case d.pendingChangesReqs <- respC:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case layers := <-respC:
+		return layers, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (d *InternalDataplane) pendingChanges() []pendingchanges.Layer {
+	var layers []pendingchanges.Layer
+	// In nftables mode, the root tables also implement the IP sets, only report them once.
+	seen := set.New[pendingchanges.Reporter]()
+	maybeAdd := func(o any) {
+		r, ok := o.(pendingchanges.Reporter)
+		if !ok || seen.Contains(r) {
+			return
+		}
+		seen.Add(r)
+		layers = append(layers, r.PendingChanges()...)
+	}
+	for _, t := range d.allTables {
+		maybeAdd(t)
+	}
+	for _, s := range d.ipSets {
+		maybeAdd(s)
+	}
+	for _, m := range d.allManagers {
+		maybeAdd(m)
+	}
+	return layers
+}
+
 func (d *InternalDataplane) loopUpdatingDataplane() {
 	log.Info("Started internal iptables dataplane driver loop")
 	healthTicks := time.NewTicker(healthInterval).C
@@ -2176,6 +2249,8 @@ func (d *InternalDataplane) loopUpdatingDataplane() {
 			d.dataplaneNeedsSync = true
 			// nil out the channel to record that the timer is now inactive.
 			d.reschedC = nil
+		case respC := <-d.pendingChangesReqs:
+			respC <- d.pendingChanges()
 		case <-throttleC:
 			d.applyThrottle.Refill()
 		case <-healthTicks:
@@ -2633,6 +2708,15 @@ func (d *InternalDataplane) apply() {
 	// Wait for the route updates to finish.
 	routesWG.Wait()
 
+	// Some route table syncers need to be applied again after a delay, even if nothing else changes.
+	for _, r := range d.routeTableSyncers() {
+		if r, ok := r.(routeTableSyncerWithReschedule); ok {
+			if after := r.RescheduleAfter(); after != 0 && (reschedDelay == 0 || after < reschedDelay) {
+				reschedDelay = after
+			}
+		}
+	}
+
 	// Wait for the rule updates to finish.
 	rulesWG.Wait()
 
@@ -2731,6 +2815,7 @@ func (d dummyLock) Unlock() {
 func startBPFDataplaneComponents(
 	ipFamily proto.IPVersion,
 	bpfmaps *bpfmap.IPMaps,
+	commonMaps *bpfmap.CommonMaps,
 	ipSetIDAllocator *idalloc.IDAllocator,
 	config Config,
 	ipSetsMgr *dpsets.IPSetsManager,
@@ -2762,6 +2847,9 @@ func startBPFDataplaneComponents(
 		bpfproxyOpts = append(bpfproxyOpts, bpfproxy.WithExcludedCIDRs(config.BPFExcludeCIDRsFromNAT))
 	}
 
+	bpfproxyOpts = append(bpfproxyOpts, bpfproxy.WithMaglev(config.BPFMaglevMaxServices,
+		config.BPFServiceBackendSelection == bpfproxy.BackendSelectionMaglev))
+
 	if ipFamily == proto.IPVersion_IPV6 {
 		ipSetConfig = config.RulesConfig.IPSetConfigV6
 		ipSetEntry = bpfipsets.IPSetEntryV6FromBytes
@@ -2799,6 +2887,8 @@ func startBPFDataplaneComponents(
 		log.WithError(err).Fatal("Failed to create conntrack liveness scanner.")
 	}
 	conntrackScanner := bpfconntrack.NewScanner(bpfmaps.CtMap, ctKey, ctVal, livenessScanner)
+	// Runs after the liveness scanner so that it only sees the connections that are still live.
+	conntrackScanner.AddUnlocked(bpfconnlimit.NewScanner(commonMaps.ConnLimitMap, commonMaps.ConnFlowMap, int(ipFamily)))
 
 	// Before we start, scan for all finished / timed out connections to
 	// free up the conntrack table asap as it may take time to sync up the
@@ -15,6 +15,8 @@
 package intdataplane
 
 import (
+	"time"
+
 	log "github.com/sirupsen/logrus"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
@@ -158,6 +160,7 @@ func (m *wireguardManager) OnUpdate(protoBufMsg interface{}) {
 			}
 		}
 		m.wireguardRouteTable.EndpointWireguardUpdate(msg.Hostname, key, ifaceAddr)
+		m.updateKeyRotation(logCtx, msg.Hostname, msg.NextPublicKey, msg.PublicKeyTimestamp, msg.AcceptedNextPublicKeys)
 	case *proto.WireguardEndpointRemove:
 		logCtx.WithField("msg", msg).Debug("WireguardEndpointRemove update")
 		if m.ipVersion != 4 {
@@ -187,6 +190,7 @@ func (m *wireguardManager) OnUpdate(protoBufMsg interface{}) {
 			}
 		}
 		m.wireguardRouteTable.EndpointWireguardUpdate(msg.Hostname, key, ifaceAddr)
+		m.updateKeyRotation(logCtx, msg.Hostname, msg.NextPublicKeyV6, msg.PublicKeyTimestamp, msg.AcceptedNextPublicKeysV6)
 	case *proto.WireguardEndpointV6Remove:
 		logCtx.WithField("msg", msg).Debug("WireguardEndpointV6Remove update")
 		if m.ipVersion != 6 {
@@ -197,6 +201,56 @@ func (m *wireguardManager) OnUpdate(protoBufMsg interface{}) {
 	}
 }
 
+// updateKeyRotation passes the key rotation state published by a node through to the wireguard module.  An empty
+// next key indicates that the node is not currently rotating its key.  Accepted keys that cannot be parsed are
+// ignored.
+func (m *wireguardManager) updateKeyRotation(
+	logCtx *log.Entry, hostname, nextPublicKey string, timestamp int64, acceptedNextPublicKeys []string,
+) {
+	var nextKey wgtypes.Key
+	if nextPublicKey != "" {
+		var err error
+		nextKey, err = wgtypes.ParseKey(nextPublicKey)
+		if err != nil {
+			logCtx.WithError(err).Errorf("error parsing next wireguard public key %s for node %s", nextPublicKey, hostname)
+			nextKey = wgtypes.Key{}
+		}
+	}
+	var keyTimestamp time.Time
+	if timestamp != 0 {
+		keyTimestamp = time.Unix(timestamp, 0)
+	}
+	var acceptedKeys []wgtypes.Key
+	for _, k := range acceptedNextPublicKeys {
+		acceptedKey, err := wgtypes.ParseKey(k)
+		if err != nil {
+			logCtx.WithError(err).Errorf("error parsing accepted wireguard public key %s for node %s", k, hostname)
+			continue
+		}
+		acceptedKeys = append(acceptedKeys, acceptedKey)
+	}
+	m.wireguardRouteTable.EndpointWireguardKeyRotationUpdate(hostname, nextKey, keyTimestamp, acceptedKeys)
+}
+
+// wireguardStatusUpdate converts the key status reported by the wireguard module into the status update sent back to
+// the calculation graph.
+func wireguardStatusUpdate(status wireguard.KeyStatus, ipVersion proto.IPVersion) *proto.WireguardStatusUpdate {
+	update := &proto.WireguardStatusUpdate{IpVersion: ipVersion}
+	if status.PublicKey != zeroKey {
+		update.PublicKey = status.PublicKey.String()
+	}
+	if status.NextPublicKey != zeroKey {
+		update.NextPublicKey = status.NextPublicKey.String()
+	}
+	if !status.KeyTimestamp.IsZero() {
+		update.PublicKeyTimestamp = status.KeyTimestamp.Unix()
+	}
+	for _, k := range status.AcceptedNextPublicKeys {
+		update.AcceptedNextPublicKeys = append(update.AcceptedNextPublicKeys, k.String())
+	}
+	return update
+}
+
 func (m *wireguardManager) CompleteDeferredWork() error {
 	// Dataplane programming is handled through the routetable interface.
 	return nil
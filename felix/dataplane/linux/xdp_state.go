@@ -1489,8 +1489,11 @@ func isValidRuleForXDP(rule *proto.Rule) bool {
 		len(rule.NotDstNamedPortIpSetIds) == 0 &&
 		// have no application layer policy stuff
 		rule.HttpMatch == nil &&
+		rule.GrpcMatch == nil &&
 		rule.SrcServiceAccountMatch == nil &&
-		rule.DstServiceAccountMatch == nil
+		rule.DstServiceAccountMatch == nil &&
+		// have no limits, which rely on conntrack
+		rule.Limit == nil
 
 	// Note that XDP doesn't support writing rule.Metadata to the dataplane
 	// (as we do using -m comment in iptables), but the rule still can be
@@ -120,6 +120,8 @@ var knownProtoRuleFields = set.From(
 	"SrcIpSetIds",
 	"SrcServiceAccountMatch",
 	"HttpMatch",
+	"GrpcMatch",
+	"Limit",
 	"Metadata",
 	"DstIpPortSetIds",
 )
@@ -1629,6 +1631,10 @@ var _ = Describe("XDP state", func() {
 							name: "httpMatchDefined",
 							rule: modifiedRule("HttpMatch", &proto.HTTPMatch{}),
 						},
+						{
+							name: "grpcMatchDefined",
+							rule: modifiedRule("GrpcMatch", &proto.GRPCMatch{}),
+						},
 						{
 							name: "srcServiceAccountMatchDefined",
 							rule: modifiedRule("SrcServiceAccountMatch", &proto.ServiceAccountMatch{}),
@@ -1637,6 +1643,10 @@ var _ = Describe("XDP state", func() {
 							name: "dstServiceAccountMatchDefined",
 							rule: modifiedRule("DstServiceAccountMatch", &proto.ServiceAccountMatch{}),
 						},
+						{
+							name: "limitDefined",
+							rule: modifiedRule("Limit", &proto.RuleLimit{NewConnectionsPerSecond: 10}),
+						},
 					}
 					ts := testStruct{
 						currentState: make(map[string]testIfaceData, len(policyInfos)),
@@ -72,6 +72,14 @@ type MatchCriteria interface {
 	ICMPV6TypeAndCode(t, c uint8) MatchCriteria
 	NotICMPV6TypeAndCode(t, c uint8) MatchCriteria
 
+	// HashLimitAbove matches packets over the given rate (per second) and burst.  If perSource
+	// is true, the limit is tracked separately for each source IP.  The name identifies the
+	// limit's state in the dataplane so it must be unique to the rule.
+	HashLimitAbove(name string, rate, burst uint32, perSource bool) MatchCriteria
+	// ConnLimitAbove matches packets when there are more than the given number of connections,
+	// counted separately for each source IP if perSource is true.
+	ConnLimitAbove(name string, limit uint32, perSource bool) MatchCriteria
+
 	// Only supported in nftables.
 	InInterfaceVMAP(mapname string) MatchCriteria
 	OutInterfaceVMAP(mapname string) MatchCriteria
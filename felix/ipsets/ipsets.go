@@ -31,6 +31,7 @@ import (
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/labelindex"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
@@ -101,6 +102,11 @@ type IPSets struct {
 	// Optional filter.  When non-nil, only these IP set IDs will be rendered into the dataplane
 	// as Linux IP sets.
 	neededIPSetNames set.Set[string]
+
+	// DryRun, if set, causes the IP sets to log the changes that they would make instead of making them.
+	// The dataplane is still read in order to calculate the changes.
+	DryRun       bool
+	dryRunLogger pendingchanges.DryRunLogger
 }
 
 func NewIPSets(ipVersionConfig *IPVersionConfig, recorder logutils.OpRecorder) *IPSets {
@@ -371,6 +377,13 @@ func (s *IPSets) ApplyUpdates(listener UpdateListener) {
 			s.fullResyncRequired = false
 		}
 
+		if s.DryRun {
+			// Log the changes that we would make, but don't make them.
+			s.dryRunLogger.MaybeLog(s.PendingChanges()[0])
+			success = true
+			break
+		}
+
 		// Opportunistically delete some temporary IP sets.  It's possible
 		// that ApplyDeletions doesn't get called if there's another failure
 		// and deleting some temp sets might free up some room.
@@ -874,6 +887,65 @@ func (s *IPSets) dirtyIPSetsForUpdate() []string {
 	return dirtyIPSets
 }
 
+// PendingChanges returns the ipset restore input that would create, update and delete IP sets on the next
+// apply.  Unlike writeUpdates, it doesn't update our record of the dataplane.
+func (s *IPSets) PendingChanges() []pendingchanges.Layer {
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindIPSets,
+		Name:          "ipset",
+		IPVersion:     uint8(s.IPVersionConfig.Family.Version()),
+		ResyncPending: s.fullResyncRequired || s.ipSetsRequiringResync.Len() > 0,
+	}
+	addLine := func(format string, a ...interface{}) {
+		layer.Changes = append(layer.Changes, fmt.Sprintf(format, a...))
+	}
+	for _, setName := range s.dirtyIPSetsForUpdate() {
+		desiredMeta, _ := s.setNameToProgrammedMetadata.Desired().Get(setName)
+		dpMeta, dpExists := s.setNameToProgrammedMetadata.Dataplane().Get(setName)
+		members, ok := s.mainSetNameToMembers[setName]
+		if !ok {
+			continue
+		}
+
+		targetSet := setName
+		needTempIPSet := dpExists && dpMeta != desiredMeta
+		if needTempIPSet {
+			targetSet = s.IPVersionConfig.NameForTempIPSet(s.nextTempIPSetIdx)
+		}
+		if !dpExists || needTempIPSet {
+			switch desiredMeta.Type {
+			case IPSetTypeBitmapPort:
+				addLine("create %s %s range %d-%d",
+					targetSet, desiredMeta.Type, desiredMeta.RangeMin, desiredMeta.RangeMax)
+			default:
+				addLine("create %s %s family %s maxelem %d",
+					targetSet, desiredMeta.Type, s.IPVersionConfig.Family, desiredMeta.MaxSize)
+			}
+		}
+		if needTempIPSet {
+			// The temporary IP set starts empty so it needs all the desired members.
+			members.Desired().Iter(func(member IPSetMember) {
+				addLine("add %s %s", targetSet, member)
+			})
+			addLine("swap %s %s", setName, targetSet)
+			continue
+		}
+		members.PendingDeletions().Iter(func(member IPSetMember) deltatracker.IterAction {
+			addLine("del %s %s --exist", targetSet, member)
+			return deltatracker.IterActionNoOp
+		})
+		members.PendingUpdates().Iter(func(member IPSetMember) deltatracker.IterAction {
+			addLine("add %s %s", targetSet, member)
+			return deltatracker.IterActionNoOp
+		})
+	}
+	s.setNameToProgrammedMetadata.PendingDeletions().Iter(func(setName string) deltatracker.IterAction {
+		addLine("destroy %s", setName)
+		return deltatracker.IterActionNoOp
+	})
+	return []pendingchanges.Layer{layer}
+}
+
 func (s *IPSets) writeUpdates(setName string, w io.Writer, listener UpdateListener) (err error) {
 	logCxt := s.logCxt.WithField("setName", setName)
 	if listener == nil || !listener.CaresAboutIPSet(setName) {
@@ -1009,6 +1081,10 @@ func (s *IPSets) nextFreeTempIPSetName() string {
 // ApplyDeletions tries to delete any IP sets that are no longer needed.
 // Failures are ignored, deletions will be retried the next time we do a resync.
 func (s *IPSets) ApplyDeletions() bool {
+	if s.DryRun {
+		// ApplyUpdates has already logged the pending deletions.
+		return false
+	}
 	numDeletions := 0
 	s.setNameToProgrammedMetadata.PendingDeletions().Iter(func(setName string) deltatracker.IterAction {
 		if numDeletions >= MaxIPSetDeletionsPerIteration {
@@ -325,6 +325,58 @@ var _ = Describe("IP sets dataplane", func() {
 		Expect(dataplane.CmdNames).To(BeNil(), "updates should have been no-ops")
 	})
 
+	It("should report pending changes without applying them", func() {
+		ipsets.AddOrReplaceIPSet(meta, v4Members1And2)
+		Expect(ipsets.PendingChanges()[0].ResyncPending).To(BeTrue())
+		apply()
+		Expect(ipsets.PendingChanges()[0].ResyncPending).To(BeFalse())
+		Expect(ipsets.PendingChanges()[0].Changes).To(BeEmpty())
+
+		ipsets.AddMembers(ipSetID, []string{"10.0.0.3"})
+		ipsets.RemoveMembers(ipSetID, []string{"10.0.0.1"})
+		ipsets.AddOrReplaceIPSet(meta2, []string{"10.0.0.4"})
+		ipsets.RemoveIPSet(ipSetID3)
+		dataplane.CmdNames = nil
+		Expect(ipsets.PendingChanges()[0].Changes).To(ConsistOf(
+			"del "+v4MainIPSetName+" 10.0.0.1 --exist",
+			"add "+v4MainIPSetName+" 10.0.0.3",
+			"create "+v4MainIPSetName2+" hash:ip family inet maxelem 1234",
+			"add "+v4MainIPSetName2+" 10.0.0.4",
+		))
+		Expect(dataplane.CmdNames).To(BeNil())
+		dataplane.ExpectMembers(map[string][]string{
+			v4MainIPSetName: v4Members1And2,
+		})
+
+		apply()
+		Expect(ipsets.PendingChanges()[0].Changes).To(BeEmpty())
+	})
+
+	Describe("in dry-run mode with left-over IP sets in place", func() {
+		BeforeEach(func() {
+			dataplane.IPSetMembers = map[string]set.Set[string]{
+				v4MainIPSetName:  set.From("10.0.0.1"),
+				v4TempIPSetName1: set.From("10.0.0.2"),
+			}
+			ipsets.DryRun = true
+		})
+
+		It("should read the dataplane but not write to it", func() {
+			ipsets.AddOrReplaceIPSet(meta, v4Members1And2)
+			apply()
+			Expect(reschedRequested).To(BeFalse())
+			Expect(dataplane.NumRestoreCalls()).To(Equal(0))
+			Expect(dataplane.IPSetMembers).To(Equal(map[string]set.Set[string]{
+				v4MainIPSetName:  set.From("10.0.0.1"),
+				v4TempIPSetName1: set.From("10.0.0.2"),
+			}))
+			Expect(ipsets.PendingChanges()[0].Changes).To(ConsistOf(
+				"add "+v4MainIPSetName+" 10.0.0.2",
+				"destroy "+v4TempIPSetName1,
+			))
+		})
+	})
+
 	Describe("with left-over IP sets in place", func() {
 		BeforeEach(func() {
 			dataplane.IPSetMembers = map[string]set.Set[string]{
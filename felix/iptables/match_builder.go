@@ -27,6 +27,10 @@ import (
 
 var Wildcard string = "+"
 
+// HashLimitNamePrefix is the prefix of the names of the hashlimit tables that we use for rule
+// limits.
+const HashLimitNamePrefix = "cali-"
+
 var _ generictables.MatchCriteria = matchCriteria{}
 
 type matchCriteria []string
@@ -325,6 +329,22 @@ func (m matchCriteria) NotICMPV6TypeAndCode(t, c uint8) generictables.MatchCrite
 	return append(m, fmt.Sprintf("-m icmp6 ! --icmpv6-type %d/%d", t, c))
 }
 
+func (m matchCriteria) HashLimitAbove(name string, rate, burst uint32, perSource bool) generictables.MatchCriteria {
+	match := fmt.Sprintf("-m hashlimit --hashlimit-above %d/sec --hashlimit-burst %d", rate, burst)
+	if perSource {
+		match += " --hashlimit-mode srcip"
+	}
+	return append(m, match+" --hashlimit-name "+HashLimitNamePrefix+name)
+}
+
+func (m matchCriteria) ConnLimitAbove(name string, limit uint32, perSource bool) generictables.MatchCriteria {
+	// The name is only needed by nftables; connlimit keeps its state in the rule.
+	if perSource {
+		return append(m, fmt.Sprintf("-m connlimit --connlimit-above %d --connlimit-saddr", limit))
+	}
+	return append(m, fmt.Sprintf("-m connlimit --connlimit-above %d --connlimit-mask 0", limit))
+}
+
 func (m matchCriteria) InInterfaceVMAP(mapname string) generictables.MatchCriteria {
 	log.Panic("InInterfaceVMAP not supported in iptables")
 	return m
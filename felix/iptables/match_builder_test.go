@@ -106,6 +106,13 @@ var _ = DescribeTable("MatchBuilder",
 	Entry("NotICMPV6Type", Match().NotICMPV6Type(123), "-m icmp6 ! --icmpv6-type 123"),
 	Entry("ICMPV6TypeAndCode", Match().ICMPV6TypeAndCode(123, 5), "-m icmp6 --icmpv6-type 123/5"),
 	Entry("NotICMPV6TypeAndCode", Match().NotICMPV6TypeAndCode(123, 5), "-m icmp6 ! --icmpv6-type 123/5"),
+	// Rule limits.
+	Entry("HashLimitAbove per source", Match().HashLimitAbove("abcd", 100, 5, true),
+		"-m hashlimit --hashlimit-above 100/sec --hashlimit-burst 5 --hashlimit-mode srcip --hashlimit-name cali-abcd"),
+	Entry("HashLimitAbove per rule", Match().HashLimitAbove("abcd", 100, 5, false),
+		"-m hashlimit --hashlimit-above 100/sec --hashlimit-burst 5 --hashlimit-name cali-abcd"),
+	Entry("ConnLimitAbove per source", Match().ConnLimitAbove("abcd", 50, true), "-m connlimit --connlimit-above 50 --connlimit-saddr"),
+	Entry("ConnLimitAbove per rule", Match().ConnLimitAbove("abcd", 50, false), "-m connlimit --connlimit-above 50 --connlimit-mask 0"),
 	// Check multiple match criteria are joined correctly.
 	Entry("Protocol and ports", Match().Protocol("tcp").SourcePorts(1234).DestPorts(8080),
 		"-p tcp -m multiport --source-ports 1234 -m multiport --destination-ports 8080"),
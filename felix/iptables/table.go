@@ -34,6 +34,7 @@ import (
 	"github.com/projectcalico/calico/felix/generictables"
 	"github.com/projectcalico/calico/felix/iptables/cmdshim"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	logutilslc "github.com/projectcalico/calico/libcalico-go/lib/logutils"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -281,6 +282,10 @@ type Table struct {
 	// Reusable buffer for writing to iptables.
 	restoreInputBuffer RestoreInputBuilder
 
+	// dryRun, if set, causes the table to log the changes that it would make instead of making them.
+	dryRun       bool
+	dryRunLogger pendingchanges.DryRunLogger
+
 	// Factory for making commands, used by UTs to shim exec.Command().
 	newCmd cmdshim.CmdFactory
 	// Shims for time.XXX functions:
@@ -319,6 +324,8 @@ type TableOptions struct {
 	OnStillAlive func()
 	// OpRecorder to tell when we do resyncs etc.
 	OpRecorder logutils.OpRecorder
+	// DryRun, if set, causes the table to calculate and log its updates without applying them.
+	DryRun bool
 }
 
 func NewTable(
@@ -450,6 +457,7 @@ func NewTable(
 		gaugeNumRules:         gaugeNumRules.WithLabelValues(fmt.Sprintf("%d", ipVersion), name),
 		countNumLinesExecuted: countNumLinesExecuted.WithLabelValues(fmt.Sprintf("%d", ipVersion), name),
 		opReporter:            options.OpRecorder,
+		dryRun:                options.DryRun,
 	}
 	table.restoreInputBuffer.NumLinesWritten = table.countNumLinesExecuted
 
@@ -1136,12 +1144,85 @@ func (t *Table) Apply() (rescheduleAfter time.Duration) {
 }
 
 func (t *Table) applyUpdates() error {
-	// If needed, detect the dataplane features.
-	features := t.featureDetector.GetFeatures()
+	if t.dryRun {
+		// Calculate the changes and log them but don't write anything.  We leave the dirty sets alone so that
+		// the changes will be calculated again next time.
+		layer, err := t.pendingChanges()
+		if err != nil {
+			return err
+		}
+		t.dryRunLogger.MaybeLog(layer)
+		return nil
+	}
 
 	// Build up the iptables-restore input in an in-memory buffer.  This allows us to log out the exact input after
 	// a failure, which has proven to be a very useful diagnostic tool.
 	buf := &t.restoreInputBuffer
+	newHashes, newChainToFullRules, err := t.renderUpdates(buf)
+	if err != nil {
+		return err
+	}
+
+	if buf.Empty() {
+		t.logCxt.Debug("Update ended up being no-op, skipping call to ip(6)tables-restore.")
+	} else {
+		// Get the contents of the buffer ready to send to iptables-restore.  Warning: for perf, this is directly
+		// accessing the buffer's internal array; don't touch the buffer after this point.
+		t.opReporter.RecordOperation(fmt.Sprintf("update-%v-v%d", t.name, t.ipVersion))
+
+		if err := t.execIptablesRestore(buf); err != nil {
+			return fmt.Errorf("writing out buffer: %w", err)
+		}
+
+		t.lastWriteTime = t.timeNow()
+		t.postWriteInterval = t.initialPostWriteInterval
+	}
+
+	if t.postWriteInterval != 0 {
+		// If iptables-save/restore is taking a long time (as measured by
+		// the peakIptablesX fields), make sure that we don't try to
+		// recheck the iptables state too soon.
+		dynamicMinPostWriteInterval := (t.peakIptablesSaveTime + t.peakIptablesRestoreTime) * 2
+		if t.postWriteInterval < dynamicMinPostWriteInterval {
+			log.WithFields(log.Fields{
+				"dynamicMin":  dynamicMinPostWriteInterval,
+				"peakSave":    t.peakIptablesSaveTime,
+				"peakRestore": t.peakIptablesRestoreTime,
+			}).Debug(
+				"Post write interval shorter than time to read/write iptables, applying dynamic minimum.")
+			t.postWriteInterval = dynamicMinPostWriteInterval
+		}
+	}
+
+	// Now we've successfully updated iptables, clear the dirty sets.  We do this even if we
+	// found there was nothing to do above, since we may have found out that a dirty chain
+	// was actually a no-op update.
+	t.dirtyChains = set.New[string]()
+	t.dirtyInsertAppend = set.New[string]()
+
+	// Store off the updates.
+	for chainName, hashes := range newHashes {
+		if hashes == nil {
+			delete(t.chainToDataplaneHashes, chainName)
+		} else {
+			t.chainToDataplaneHashes[chainName] = hashes
+		}
+	}
+	t.chainToFullRules = newChainToFullRules
+
+	return nil
+}
+
+// renderUpdates writes the iptables-restore input that is needed to bring the dataplane in sync to the given
+// buffer.  It returns the hashes and full rules that the chains will have once the input has been applied.
+func (t *Table) renderUpdates(buf *RestoreInputBuilder) (
+	newHashes map[string][]string,
+	newChainToFullRules map[string][]string,
+	err error,
+) {
+	// If needed, detect the dataplane features.
+	features := t.featureDetector.GetFeatures()
+
 	buf.Reset() // Defensive.
 
 	// iptables-restore commands live in per-table transactions.
@@ -1182,7 +1263,7 @@ func (t *Table) applyUpdates() error {
 	})
 
 	// Make a second pass over the dirty chains.  This time, we write out the rule changes.
-	newHashes := map[string][]string{}
+	newHashes = map[string][]string{}
 	t.dirtyChains.Iter(func(chainName string) error {
 		if chain, ok := t.desiredStateOfChain(chainName); ok {
 			// Chain update or creation.  Scan the chain against its previous hashes
@@ -1222,7 +1303,7 @@ func (t *Table) applyUpdates() error {
 
 	// Make a copy of our full rules map and keep track of all changes made while processing dirtyInsertAppend.
 	// When we've successfully updated iptables, we'll update our cache of chainToFullRules with this map.
-	newChainToFullRules := map[string][]string{}
+	newChainToFullRules = map[string][]string{}
 	for chain, rules := range t.chainToFullRules {
 		newChainToFullRules[chain] = make([]string, len(rules))
 		copy(newChainToFullRules[chain], rules)
@@ -1312,7 +1393,8 @@ func (t *Table) applyUpdates() error {
 	})
 	// If rendering a delete by line number reached an unexpected state, error out so applyUpdates() can be retried.
 	if deleteRenderingErr != nil {
-		return deleteRenderingErr
+		buf.Reset()
+		return nil, nil, deleteRenderingErr
 	}
 
 	if t.nftablesMode {
@@ -1349,54 +1431,43 @@ func (t *Table) applyUpdates() error {
 
 	buf.EndTransaction()
 
-	if buf.Empty() {
-		t.logCxt.Debug("Update ended up being no-op, skipping call to ip(6)tables-restore.")
-	} else {
-		// Get the contents of the buffer ready to send to iptables-restore.  Warning: for perf, this is directly
-		// accessing the buffer's internal array; don't touch the buffer after this point.
-		t.opReporter.RecordOperation(fmt.Sprintf("update-%v-v%d", t.name, t.ipVersion))
-
-		if err := t.execIptablesRestore(buf); err != nil {
-			return fmt.Errorf("writing out buffer: %w", err)
-		}
+	return newHashes, newChainToFullRules, nil
+}
 
-		t.lastWriteTime = t.timeNow()
-		t.postWriteInterval = t.initialPostWriteInterval
+// PendingChanges returns the iptables-restore input that the table would write on its next Apply.
+func (t *Table) PendingChanges() []pendingchanges.Layer {
+	layer, err := t.pendingChanges()
+	if err != nil {
+		// Rendering fails if our cache of the dataplane is inconsistent, Apply would reload it.
+		t.logCxt.WithError(err).Warn("Failed to calculate pending changes.")
+		layer = t.pendingChangesLayer(nil)
+		layer.ResyncPending = true
 	}
+	return []pendingchanges.Layer{layer}
+}
 
-	if t.postWriteInterval != 0 {
-		// If iptables-save/restore is taking a long time (as measured by
-		// the peakIptablesX fields), make sure that we don't try to
-		// recheck the iptables state too soon.
-		dynamicMinPostWriteInterval := (t.peakIptablesSaveTime + t.peakIptablesRestoreTime) * 2
-		if t.postWriteInterval < dynamicMinPostWriteInterval {
-			log.WithFields(log.Fields{
-				"dynamicMin":  dynamicMinPostWriteInterval,
-				"peakSave":    t.peakIptablesSaveTime,
-				"peakRestore": t.peakIptablesRestoreTime,
-			}).Debug(
-				"Post write interval shorter than time to read/write iptables, applying dynamic minimum.")
-			t.postWriteInterval = dynamicMinPostWriteInterval
-		}
+func (t *Table) pendingChanges() (pendingchanges.Layer, error) {
+	// Render into a separate buffer so that we don't disturb the restore buffer and its metrics.
+	var buf RestoreInputBuilder
+	if _, _, err := t.renderUpdates(&buf); err != nil {
+		return pendingchanges.Layer{}, err
 	}
+	return t.pendingChangesLayer(buf.GetBytesAndReset()), nil
+}
 
-	// Now we've successfully updated iptables, clear the dirty sets.  We do this even if we
-	// found there was nothing to do above, since we may have found out that a dirty chain
-	// was actually a no-op update.
-	t.dirtyChains = set.New[string]()
-	t.dirtyInsertAppend = set.New[string]()
-
-	// Store off the updates.
-	for chainName, hashes := range newHashes {
-		if hashes == nil {
-			delete(t.chainToDataplaneHashes, chainName)
-		} else {
-			t.chainToDataplaneHashes[chainName] = hashes
+func (t *Table) pendingChangesLayer(restoreInput []byte) pendingchanges.Layer {
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindIptables,
+		Name:          t.name,
+		IPVersion:     t.ipVersion,
+		ResyncPending: !t.inSyncWithDataPlane,
+	}
+	for _, line := range strings.Split(string(restoreInput), "\n") {
+		if line != "" {
+			layer.Changes = append(layer.Changes, line)
 		}
 	}
-	t.chainToFullRules = newChainToFullRules
-
-	return nil
+	return layer
 }
 
 func (t *Table) execIptablesRestore(buf *RestoreInputBuilder) error {
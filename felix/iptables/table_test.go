@@ -1941,6 +1941,82 @@ func describeInsertEarlyRules(dataplaneMode string) {
 	})
 }
 
+var _ = Describe("Table pending changes and dry-run", func() {
+	var dataplane *testutils.MockDataplane
+	var table *Table
+
+	newTable := func(dryRun bool) {
+		dataplane = testutils.NewMockDataplane("filter", map[string][]string{
+			"FORWARD": {},
+			"INPUT":   {},
+			"OUTPUT":  {},
+		}, "legacy")
+		featureDetector := environment.NewFeatureDetector(nil)
+		featureDetector.NewCmd = dataplane.NewCmd
+		featureDetector.GetKernelVersionReader = dataplane.GetKernelVersionReader
+		table = NewTable(
+			"filter",
+			4,
+			rules.RuleHashPrefix,
+			&mockMutex{},
+			featureDetector,
+			TableOptions{
+				HistoricChainPrefixes: rules.AllHistoricChainNamePrefixes,
+				NewCmdOverride:        dataplane.NewCmd,
+				SleepOverride:         dataplane.Sleep,
+				NowOverride:           dataplane.Now,
+				BackendMode:           "legacy",
+				LookPathOverride:      testutils.LookPathNoLegacy,
+				OpRecorder:            logutils.NewSummarizer("test loop"),
+				DryRun:                dryRun,
+			},
+		)
+		table.Apply()
+		table.UpdateChain(&generictables.Chain{Name: "cali-foobar", Rules: []generictables.Rule{
+			{Match: Match(), Action: AcceptAction{}},
+		}})
+		table.InsertOrAppendRules("FORWARD", []generictables.Rule{
+			{Match: Match(), Action: JumpAction{Target: "cali-foobar"}},
+		})
+	}
+
+	It("should report the iptables-restore input that it would write", func() {
+		newTable(false)
+		layers := table.PendingChanges()
+		Expect(layers).To(HaveLen(1))
+		Expect(layers[0].Kind).To(Equal("iptables"))
+		Expect(layers[0].Name).To(Equal("filter"))
+		Expect(layers[0].IPVersion).To(BeEquivalentTo(4))
+		Expect(layers[0].Changes).To(ContainElements(
+			"*filter",
+			":cali-foobar - -",
+			"COMMIT",
+		))
+
+		By("not writing to the dataplane")
+		Expect(dataplane.Chains).NotTo(HaveKey("cali-foobar"))
+		Expect(table.PendingChanges()).To(Equal(layers))
+
+		By("having no changes after an Apply")
+		table.Apply()
+		Expect(dataplane.Chains).To(HaveKey("cali-foobar"))
+		Expect(table.PendingChanges()[0].Changes).To(BeEmpty())
+	})
+
+	It("should not write to the dataplane in dry-run mode", func() {
+		newTable(true)
+		dataplane.ResetCmds()
+		table.Apply()
+		Expect(dataplane.CmdNames).NotTo(ContainElement("iptables-legacy-restore"))
+		Expect(dataplane.Chains).To(Equal(map[string][]string{
+			"FORWARD": {},
+			"INPUT":   {},
+			"OUTPUT":  {},
+		}))
+		Expect(table.PendingChanges()[0].Changes).To(ContainElement(":cali-foobar - -"))
+	})
+})
+
 type mockMutex struct {
 	Held     bool
 	WasTaken bool
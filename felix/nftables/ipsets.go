@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -32,6 +33,7 @@ import (
 	"github.com/projectcalico/calico/felix/ip"
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
@@ -101,6 +103,10 @@ type IPSets struct {
 	neededIPSetNames set.Set[string]
 
 	nft knftables.Interface
+
+	// dryRun, if set, causes the IP sets to log the changes that they would make instead of making them.
+	dryRun       bool
+	dryRunLogger pendingchanges.DryRunLogger
 }
 
 func NewIPSets(ipVersionConfig *ipsets.IPVersionConfig, nft knftables.Interface, recorder logutils.OpRecorder) *IPSets {
@@ -354,6 +360,13 @@ func (s *IPSets) ApplyUpdates(listener ipsets.UpdateListener) {
 			s.resyncRequired = false
 		}
 
+		if s.dryRun {
+			// Log the changes that we would make, but don't make them.
+			s.dryRunLogger.MaybeLog(s.PendingChanges()[0])
+			success = true
+			break
+		}
+
 		if err := s.tryUpdates(listener); err != nil {
 			// Update failures may mean that our iptables updates fail.  We need to do an immediate resync.
 			s.logCxt.WithError(err).Warning("Failed to update IP sets. Marking dataplane for resync.")
@@ -403,6 +416,12 @@ func (s *IPSets) tryResync() error {
 		return fmt.Errorf("error listing nftables sets: %s", err)
 	}
 
+	// Meters show up as sets but they belong to the rules that use them, not to us.  The table
+	// cleans them up once they're no longer used.
+	sets = slices.DeleteFunc(sets, func(name string) bool {
+		return strings.HasPrefix(name, MeterNamePrefix)
+	})
+
 	// We'll process each set in parallel, so we need a struct to hold the results.
 	// Once knftables is augmented to support reading many sets at once, we can remove this.
 	type setData struct {
@@ -584,6 +603,45 @@ func (s *IPSets) NFTablesSet(name string) *knftables.Set {
 
 // tryUpdates attempts to apply any pending updates to the dataplane.
 func (s *IPSets) tryUpdates(listener ipsets.UpdateListener) error {
+	start := time.Now()
+
+	// Create a new transaction to update the IP sets.
+	tx := s.nft.NewTransaction()
+	dirtyIPSets := s.buildUpdateTransaction(tx, listener)
+	if len(dirtyIPSets) == 0 {
+		s.logCxt.Debug("No dirty IP sets.")
+		return nil
+	}
+
+	if tx.NumOperations() > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+		defer cancel()
+		if err := s.runTransaction(ctx, tx); err != nil {
+			s.logCxt.WithError(err).Errorf("Failed to update IP sets. %s", tx.String())
+			return fmt.Errorf("error updating nftables sets: %s", err)
+		}
+
+		// If we get here, the writes were successful, reset the IP sets delta tracking now the
+		// dataplane should be in sync.
+		log.Debugf("Updated %d IPSets in %v", len(dirtyIPSets), time.Since(start))
+		for _, setName := range dirtyIPSets {
+			// Mark all pending updates and memebers handled above as programmed.
+			v, _ := s.setNameToProgrammedMetadata.Desired().Get(setName)
+			s.setNameToProgrammedMetadata.Dataplane().Set(setName, v)
+			members := s.mainSetNameToMembers[setName]
+			members.Dataplane().DeleteAll()
+			members.Desired().Iter(func(member SetMember) {
+				members.Dataplane().Add(member)
+			})
+		}
+		s.ipSetsWithDirtyMembers.Clear()
+	}
+	return nil
+}
+
+// buildUpdateTransaction adds the operations that are needed to create and update the dirty IP sets to the
+// given transaction.  It returns the names of the dirty IP sets.
+func (s *IPSets) buildUpdateTransaction(tx *knftables.Transaction, listener ipsets.UpdateListener) []string {
 	var dirtyIPSets []string
 
 	s.ipSetsWithDirtyMembers.Iter(func(setName string) error {
@@ -602,15 +660,9 @@ func (s *IPSets) tryUpdates(listener ipsets.UpdateListener) error {
 		return deltatracker.IterActionNoOp
 	})
 	if len(dirtyIPSets) == 0 {
-		s.logCxt.Debug("No dirty IP sets.")
 		return nil
 	}
 
-	start := time.Now()
-
-	// Create a new transaction to update the IP sets.
-	tx := s.nft.NewTransaction()
-
 	if s.setNameToProgrammedMetadata.Dataplane().Len() == 0 {
 		// Use the total number of IP sets that we believe we have programmed as a proxy for whether
 		// or not the table exists. If this is the first time we've programmed IP sets, make sure we
@@ -652,30 +704,31 @@ func (s *IPSets) tryUpdates(listener ipsets.UpdateListener) error {
 		})
 	}
 
-	if tx.NumOperations() > 0 {
-		ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
-		defer cancel()
-		if err := s.runTransaction(ctx, tx); err != nil {
-			s.logCxt.WithError(err).Errorf("Failed to update IP sets. %s", tx.String())
-			return fmt.Errorf("error updating nftables sets: %s", err)
-		}
+	return dirtyIPSets
+}
 
-		// If we get here, the writes were successful, reset the IP sets delta tracking now the
-		// dataplane should be in sync.
-		log.Debugf("Updated %d IPSets in %v", len(dirtyIPSets), time.Since(start))
-		for _, setName := range dirtyIPSets {
-			// Mark all pending updates and memebers handled above as programmed.
-			v, _ := s.setNameToProgrammedMetadata.Desired().Get(setName)
-			s.setNameToProgrammedMetadata.Dataplane().Set(setName, v)
-			members := s.mainSetNameToMembers[setName]
-			members.Dataplane().DeleteAll()
-			members.Desired().Iter(func(member SetMember) {
-				members.Dataplane().Add(member)
-			})
+// PendingChanges returns the nft commands that would create, update and delete IP sets on the next apply.
+func (s *IPSets) PendingChanges() []pendingchanges.Layer {
+	tx := s.nft.NewTransaction()
+	s.buildUpdateTransaction(tx, nil)
+	s.setNameToProgrammedMetadata.PendingDeletions().Iter(func(setName string) deltatracker.IterAction {
+		tx.Delete(&knftables.Set{Name: setName})
+		return deltatracker.IterActionNoOp
+	})
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindIPSets,
+		Name:          "nftables",
+		IPVersion:     uint8(s.IPVersionConfig.Family.Version()),
+		ResyncPending: s.resyncRequired,
+	}
+	if tx.NumOperations() > 0 {
+		for _, line := range strings.Split(tx.String(), "\n") {
+			if line != "" {
+				layer.Changes = append(layer.Changes, line)
+			}
 		}
-		s.ipSetsWithDirtyMembers.Clear()
 	}
-	return nil
+	return []pendingchanges.Layer{layer}
 }
 
 // ApplyDeletions tries to delete any IP sets that are no longer needed.
@@ -686,6 +739,11 @@ func (s *IPSets) ApplyDeletions() bool {
 	// than the iptables dataplane which deletes one at a time.
 	maxDeletions := 500
 
+	if s.dryRun {
+		// ApplyUpdates has already logged the pending deletions.
+		return false
+	}
+
 	tx := s.nft.NewTransaction()
 	deletedSets := set.New[string]()
 	s.setNameToProgrammedMetadata.PendingDeletions().Iter(func(setName string) deltatracker.IterAction {
@@ -36,6 +36,9 @@ var (
 
 	// ipSetMatch matches clauses that contain an IP set reference.
 	ipSetMatch = regexp.MustCompile("<IPV>.*@(.*)")
+
+	// meterMatch matches clauses that use a meter.
+	meterMatch = regexp.MustCompile(`^meter (` + MeterNamePrefix + `\S+) `)
 )
 
 const (
@@ -44,6 +47,14 @@ const (
 	ProtoUDP    = 17
 	ProtoICMPv6 = 58
 	ProtoSCTP   = 132
+
+	// MeterNamePrefix is the prefix of the names of the meters that we use for rule limits.
+	// Meters are sets that the kernel creates on our behalf, so the IP set manager skips sets with
+	// this prefix and the table deletes them once no rule uses them.
+	MeterNamePrefix = "cali-meter-"
+
+	// meterSize bounds the number of source IPs that each meter tracks.
+	meterSize = 65535
 )
 
 // NFTMatchCriteria extends the generictables.MatchCriteria interface with nftables-specific methods.
@@ -59,6 +70,9 @@ type NFTMatchCriteria interface {
 
 	ConntrackStatus(statusNames string) generictables.MatchCriteria
 	NotConntrackStatus(statusNames string) generictables.MatchCriteria
+
+	// MeterNames returns the names of the meters that the match uses.
+	MeterNames() []string
 }
 
 // Combine creates a copy of m1 and appends the values of m2 to the copy, creating a new MatchCritera with the values
@@ -442,6 +456,16 @@ func (m nftMatch) IPSetNames() []string {
 	return ipSetNames.Slice()
 }
 
+func (m nftMatch) MeterNames() []string {
+	var names []string
+	for _, clause := range m.clauses {
+		if match := meterMatch.FindStringSubmatch(clause); match != nil {
+			names = append(names, insertIPVersion(match[1], m.ipVersion))
+		}
+	}
+	return names
+}
+
 func (m nftMatch) SourcePorts(ports ...uint16) generictables.MatchCriteria {
 	portsString := PortsToMultiport(ports)
 	m.clauses = append(m.clauses, fmt.Sprintf("%s sport %s", m.transportProto(), portsString))
@@ -534,6 +558,32 @@ func (m nftMatch) NotICMPV6TypeAndCode(t, c uint8) generictables.MatchCriteria {
 	return m
 }
 
+func (m nftMatch) HashLimitAbove(name string, rate, burst uint32, perSource bool) generictables.MatchCriteria {
+	limit := fmt.Sprintf("limit rate over %d/second burst %d packets", rate, burst)
+	if perSource {
+		m.clauses = append(m.clauses, fmt.Sprintf("meter %s size %d { <IPV> saddr %s }", meterName("rate", name), meterSize, limit))
+	} else {
+		m.clauses = append(m.clauses, limit)
+	}
+	return m
+}
+
+func (m nftMatch) ConnLimitAbove(name string, limit uint32, perSource bool) generictables.MatchCriteria {
+	count := fmt.Sprintf("ct count over %d", limit)
+	if perSource {
+		m.clauses = append(m.clauses, fmt.Sprintf("meter %s size %d { <IPV> saddr %s }", meterName("conn", name), meterSize, count))
+	} else {
+		m.clauses = append(m.clauses, count)
+	}
+	return m
+}
+
+// meterName returns the name of the meter that holds the per-source state of the given kind of
+// limit.  Meters are keyed on the source address so the IPv4 and IPv6 rules need their own.
+func meterName(kind, name string) string {
+	return MeterNamePrefix + "<IPV>-" + kind + "-" + name
+}
+
 func (m nftMatch) InInterfaceVMAP(name string) generictables.MatchCriteria {
 	m.clauses = append(m.clauses, fmt.Sprintf("iifname vmap @<LAYER>-%s", LegalizeSetName(name)))
 	return m
@@ -129,6 +129,16 @@ var _ = DescribeTable("MatchBuilder",
 	Entry("ICMPV6TypeAndCode", Match().ICMPV6TypeAndCode(123, 5), "icmpv6 type 123 code 5"),
 	Entry("NotICMPV6TypeAndCode", Match().NotICMPV6TypeAndCode(123, 5), "icmpv6 type != 123 code != 5"),
 
+	// Rule limits.
+	Entry("HashLimitAbove per source", Match().HashLimitAbove("abcd", 100, 5, true),
+		"meter cali-meter-ip-rate-abcd size 65535 { ip saddr limit rate over 100/second burst 5 packets }"),
+	Entry("HashLimitAbove per source v6", Match().HashLimitAbove("abcd", 100, 5, true).(NFTMatchCriteria).IPVersion(6),
+		"meter cali-meter-ip6-rate-abcd size 65535 { ip6 saddr limit rate over 100/second burst 5 packets }"),
+	Entry("HashLimitAbove per rule", Match().HashLimitAbove("abcd", 100, 5, false), "limit rate over 100/second burst 5 packets"),
+	Entry("ConnLimitAbove per source", Match().ConnLimitAbove("abcd", 50, true),
+		"meter cali-meter-ip-conn-abcd size 65535 { ip saddr ct count over 50 }"),
+	Entry("ConnLimitAbove per rule", Match().ConnLimitAbove("abcd", 50, false), "ct count over 50"),
+
 	// VMAPs
 	Entry("InInterfaceVMAP", Match().InInterfaceVMAP("vmap1234").(NFTMatchCriteria).SetLayer("filter"), "iifname vmap @filter-vmap1234"),
 	Entry("OutInterfaceVMAP", Match().OutInterfaceVMAP("vmap1234").(NFTMatchCriteria).SetLayer("raw"), "oifname vmap @raw-vmap1234"),
@@ -163,3 +173,20 @@ var _ = DescribeTable("IPSetNames",
 	Entry("Multiple matches", Match().SourceIPSet("calits:12345abc-_").DestIPSet("calits:54321cba-_"), []string{"calits-12345abc-_", "calits-54321cba-_"}),
 	Entry("Duplicate matches", Match().SourceIPPortSet("calits:12345abc-_").DestIPPortSet("calits:12345abc-_"), []string{"calits-12345abc-_"}),
 )
+
+var _ = DescribeTable("MeterNames",
+	func(match generictables.MatchCriteria, exp []string) {
+		Expect(match.(NFTMatchCriteria).MeterNames()).To(ConsistOf(exp))
+	},
+
+	Entry("HashLimitAbove per source", Match().HashLimitAbove("abcd", 100, 5, true), []string{"cali-meter-ip-rate-abcd"}),
+	Entry("HashLimitAbove per source v6", Match().HashLimitAbove("abcd", 100, 5, true).(NFTMatchCriteria).IPVersion(6), []string{"cali-meter-ip6-rate-abcd"}),
+	Entry("ConnLimitAbove per source", Match().ConnLimitAbove("abcd", 50, true), []string{"cali-meter-ip-conn-abcd"}),
+	Entry("Both limits", Match().ConntrackState("NEW").HashLimitAbove("abcd", 100, 5, true).ConnLimitAbove("abcd", 50, true),
+		[]string{"cali-meter-ip-rate-abcd", "cali-meter-ip-conn-abcd"}),
+
+	// No meters.
+	Entry("empty match", Match(), nil),
+	Entry("HashLimitAbove per rule", Match().HashLimitAbove("abcd", 100, 5, false), nil),
+	Entry("ConnLimitAbove per rule", Match().ConnLimitAbove("abcd", 50, false), nil),
+)
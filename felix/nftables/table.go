@@ -34,6 +34,7 @@ import (
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/iptables/cmdshim"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/pendingchanges"
 	logutilslc "github.com/projectcalico/calico/libcalico-go/lib/logutils"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -212,6 +213,17 @@ type NftablesTable struct {
 	// to slices of rules in that chain.
 	chainToFullRules map[string][]*knftables.Rule
 
+	// chainToMeters maps from chain name to the names of the meters that the chain's rules use.  Only
+	// chains that use meters have an entry.
+	chainToMeters map[string][]string
+
+	// metersInDataplane contains the names of the meters that we think are in the dataplane.  The kernel
+	// creates a meter when a rule that uses it is added but it doesn't remove the meter along with the
+	// rule, so we delete meters once no rule uses them.  metersInSync is false if metersInDataplane needs
+	// to be reloaded from the dataplane.
+	metersInDataplane set.Set[string]
+	metersInSync      bool
+
 	// hashCommentPrefix holds the prefix that we prepend to our rule-tracking hashes.
 	hashCommentPrefix string
 
@@ -247,6 +259,10 @@ type NftablesTable struct {
 	reason       string
 
 	contextTimeout time.Duration
+
+	// dryRun, if set, causes the table to log the changes that it would make instead of making them.
+	dryRun       bool
+	dryRunLogger pendingchanges.DryRunLogger
 }
 
 type TableOptions struct {
@@ -270,6 +286,9 @@ type TableOptions struct {
 
 	// OpRecorder to tell when we do resyncs etc.
 	OpRecorder logutils.OpRecorder
+
+	// DryRun, if set, causes the table and its IP sets to calculate and log their updates without applying them.
+	DryRun bool
 }
 
 func NewTable(
@@ -335,9 +354,11 @@ func NewTable(
 		log.WithError(err).Panic("Failed to create knftables client")
 	}
 	ipv := ipsets.NewIPVersionConfig(ipsetFamily, ipsets.IPSetNamePrefix, nil, nil)
+	ipSets := NewIPSets(ipv, nft, options.OpRecorder)
+	ipSets.dryRun = options.DryRun
 
 	table := &NftablesTable{
-		IPSetsDataplane:        NewIPSets(ipv, nft, options.OpRecorder),
+		IPSetsDataplane:        ipSets,
 		name:                   name,
 		nft:                    nft,
 		render:                 NewNFTRenderer(hashPrefix, ipVersion),
@@ -351,6 +372,8 @@ func NewTable(
 		dirtyChains:            set.New[string](),
 		chainToDataplaneHashes: map[string][]string{},
 		chainToFullRules:       map[string][]*knftables.Rule{},
+		chainToMeters:          map[string][]string{},
+		metersInDataplane:      set.New[string](),
 		logCxt:                 log.WithFields(logFields),
 		updateRateLimitedLog: logutilslc.NewRateLimitedLogger(
 			logutilslc.OptInterval(30*time.Second),
@@ -370,6 +393,7 @@ func NewTable(
 		opReporter:     options.OpRecorder,
 
 		contextTimeout: defaultTimeout,
+		dryRun:         options.DryRun,
 	}
 	table.MapsDataplane = NewMaps(
 		ipv,
@@ -412,6 +436,7 @@ func (t *NftablesTable) InsertOrAppendRules(chainName string, rules []generictab
 	if chain := t.chainNameToChain[chainName]; chain != nil {
 		chain.Rules = rules
 	}
+	t.updateChainMeters(chainName, rules, t.chainToAppendedRules[chainName])
 
 	// Incref any newly-referenced chains, then decref the old ones.  By incrementing first we
 	// avoid marking a still-referenced chain as dirty.
@@ -430,6 +455,7 @@ func (t *NftablesTable) AppendRules(chainName string, rules []generictables.Rule
 	numRulesDelta := len(rules) - len(oldRules)
 	t.gaugeNumRules.Add(float64(numRulesDelta))
 	t.dirtyBaseChains.Add(chainName)
+	t.updateChainMeters(chainName, t.chainToInsertedRules[chainName], rules)
 
 	// Incref any newly-referenced chains, then decref the old ones.  By incrementing first we
 	// avoid marking a still-referenced chain as dirty.
@@ -455,6 +481,7 @@ func (t *NftablesTable) UpdateChain(chain *generictables.Chain) {
 		t.maybeDecrefReferredChains(chain.Name, oldChain.Rules)
 	}
 	t.chainNameToChain[chain.Name] = chain
+	t.updateChainMeters(chain.Name, chain.Rules)
 	numRulesDelta := len(chain.Rules) - oldNumRules
 	t.gaugeNumRules.Add(float64(numRulesDelta))
 	if t.chainIsReferenced(chain.Name) {
@@ -474,12 +501,41 @@ func (t *NftablesTable) RemoveChainByName(name string) {
 		t.gaugeNumRules.Sub(float64(len(oldChain.Rules)))
 		t.maybeDecrefReferredChains(name, oldChain.Rules)
 		delete(t.chainNameToChain, name)
+		delete(t.chainToMeters, name)
 		if t.chainIsReferenced(name) {
 			t.dirtyChains.Add(name)
 		}
 	}
 }
 
+// updateChainMeters records the meters that are used by the given rules of a chain.
+func (t *NftablesTable) updateChainMeters(chainName string, ruleSlices ...[]generictables.Rule) {
+	var meters []string
+	for _, rules := range ruleSlices {
+		for _, rule := range rules {
+			if rule.Match != nil {
+				meters = append(meters, rule.Match.(NFTMatchCriteria).IPVersion(t.ipVersion).(NFTMatchCriteria).MeterNames()...)
+			}
+		}
+	}
+	if len(meters) == 0 {
+		delete(t.chainToMeters, chainName)
+		return
+	}
+	t.chainToMeters[chainName] = meters
+}
+
+// desiredMeters returns the names of the meters that are used by the chains that we program.
+func (t *NftablesTable) desiredMeters() set.Set[string] {
+	meters := set.New[string]()
+	for chainName, names := range t.chainToMeters {
+		if t.chainIsReferenced(chainName) {
+			meters.AddAll(names)
+		}
+	}
+	return meters
+}
+
 func (t *NftablesTable) chainIsReferenced(name string) bool {
 	return t.chainRefCounts[name] > 0
 }
@@ -615,12 +671,40 @@ func (t *NftablesTable) loadDataplaneState() {
 		t.dirtyChains.Add(chainName)
 	}
 
+	if !t.metersInSync {
+		t.loadMetersFromDataplane()
+	}
+
 	t.logCxt.Debug("Finished loading nftables state")
 	t.chainToDataplaneHashes = dataplaneHashes
 	t.chainToFullRules = dataplaneRules
 	t.inSyncWithDataPlane = true
 }
 
+// loadMetersFromDataplane loads the names of the meters in the table, including any that were left
+// behind by a previous run.  Any that are no longer used are deleted on the next write.
+func (t *NftablesTable) loadMetersFromDataplane() {
+	ctx, cancel := context.WithTimeout(context.Background(), t.contextTimeout)
+	defer cancel()
+
+	// Fall back to assuming that there are no meters if we can't list them; that may leak a meter
+	// until the next resync but it can't fail the next write.
+	t.metersInDataplane = set.New[string]()
+	countNumListCalls.Inc()
+	sets, err := t.nft.List(ctx, "set")
+	if err != nil && !knftables.IsNotFound(err) {
+		countNumListErrors.Inc()
+		t.logCxt.WithError(err).Warn("Failed to list nftables meters, will retry on next resync")
+		return
+	}
+	for _, name := range sets {
+		if strings.HasPrefix(name, MeterNamePrefix) {
+			t.metersInDataplane.Add(name)
+		}
+	}
+	t.metersInSync = true
+}
+
 // expectedHashesForInsertAppendChain calculates the expected hashes for a whole top-level chain
 // given our inserts and appends. Hashes for inserted rules are calculated first.
 // To avoid recalculation, it returns the inserted rule hashes as a second output and appended rule hashes
@@ -751,6 +835,7 @@ func (t *NftablesTable) attemptToGetHashesAndRulesFromDataplane() (hashes map[st
 
 func (t *NftablesTable) InvalidateDataplaneCache(reason string) {
 	logCxt := t.logCxt.WithField("reason", reason)
+	t.metersInSync = false
 	if !t.inSyncWithDataPlane {
 		logCxt.Debug("Would invalidate dataplane cache but it was already invalid.")
 		return
@@ -852,18 +937,90 @@ func (t *NftablesTable) Apply() (rescheduleAfter time.Duration) {
 }
 
 func (t *NftablesTable) applyUpdates() error {
-	// If needed, detect the dataplane features.
-	features := t.featureDetector.GetFeatures()
+	if t.dryRun {
+		// Calculate the changes and log them but don't write anything.  We leave the dirty sets alone so that
+		// the changes will be calculated again next time.
+		t.dryRunLogger.MaybeLog(t.pendingChangesLayer())
+		return nil
+	}
 
 	// Start a new nftables transaction.
 	tx := t.nft.NewTransaction()
+	mapUpdates, newHashes, newChainToFullRules, newMeters := t.buildTransaction(tx)
+
+	if tx.NumOperations() == 0 {
+		t.logCxt.Debug("Update ended up being no-op, skipping call to nftables.")
+	} else {
+		// Run the transaction.
+		t.opReporter.RecordOperation(fmt.Sprintf("update-%v-v%d", t.name, t.ipVersion))
+
+		if logrus.IsLevelEnabled(logrus.TraceLevel) {
+			t.logCxt.Tracef("Updating nftables: %s", tx.String())
+		}
+
+		if err := t.runTransaction(tx); err != nil {
+			// Let's just print out the entire ruleset for debugging purposes.
+			cmd := t.newCmd("nft", "list", "ruleset")
+			output, err2 := cmd.Output()
+			if err2 != nil {
+				t.logCxt.WithError(err2).Error("Failed to load nftables ruleset")
+			} else {
+				t.logCxt.WithField("ruleset", string(output)).Error("Current ruleset after error")
+			}
+
+			t.logCxt.WithError(err).WithField("tx", tx.String()).Error("Failed to run nft transaction")
+			t.metersInSync = false
+			return fmt.Errorf("error performing nft transaction: %s", err)
+		}
+
+		// Update Map implementation after successful nftables transaction.
+		t.MapsDataplane.FinishMapUpdates(mapUpdates)
+	}
+
+	// Now we've successfully updated nftables, clear the dirty sets.  We do this even if we
+	// found there was nothing to do above, since we may have found out that a dirty chain
+	// was actually a no-op update.
+	t.dirtyChains = set.New[string]()
+	t.dirtyBaseChains = set.New[string]()
+
+	// Store off the updates.
+	for chainName, hashes := range newHashes {
+		if hashes == nil {
+			delete(t.chainToDataplaneHashes, chainName)
+		} else {
+			t.chainToDataplaneHashes[chainName] = hashes
+		}
+	}
+	t.chainToFullRules = newChainToFullRules
+	t.metersInDataplane = newMeters
+
+	// Invalidate the in-memory dataplane state so that we reload on the next write. This ensures we have the correct handles
+	// in-memory for each of the objects we've just written. nftables requires an object's handle in order to
+	// perform update or delete operations.
+	t.InvalidateDataplaneCache("post-write")
+	// We've just written the meters so there's no need to reload them.
+	t.metersInSync = true
+	return nil
+}
+
+// buildTransaction adds the operations that are needed to bring the dataplane in sync to the given transaction.
+// It returns the map updates that the transaction includes, along with the hashes and full rules that the chains
+// will have, and the meters that will exist, once it has been run.
+func (t *NftablesTable) buildTransaction(tx *knftables.Transaction) (
+	mapUpdates *MapUpdates,
+	newHashes map[string][]string,
+	newChainToFullRules map[string][]*knftables.Rule,
+	newMeters set.Set[string],
+) {
+	// If needed, detect the dataplane features.
+	features := t.featureDetector.GetFeatures()
 
 	// Get the set of map updates we need to make. We'll interleave these with the chain updates.
 	// in the correct order. Namely:
 	// - Create any new maps.
 	// - Create any new chains / rules.
 	// - Add elements to maps.
-	mapUpdates := t.MapsDataplane.MapUpdates()
+	mapUpdates = t.MapsDataplane.MapUpdates()
 
 	// If we don't see any chains, then we need to create it.
 	if len(t.chainToDataplaneHashes) == 0 {
@@ -912,7 +1069,7 @@ func (t *NftablesTable) applyUpdates() error {
 	})
 
 	// Make a second pass over the dirty chains.  This time, we write out the rule changes.
-	newHashes := map[string][]string{}
+	newHashes = map[string][]string{}
 	t.dirtyChains.Iter(func(chainName string) error {
 		if chain, ok := t.desiredStateOfChain(chainName); ok {
 			// Chain update or creation.  Scan the chain against its previous hashes
@@ -974,7 +1131,7 @@ func (t *NftablesTable) applyUpdates() error {
 
 	// Make a copy of our full rules map and keep track of all changes made while processing dirtyBaseChains.
 	// When we've successfully updated nftables, we'll update our cache of chainToFullRules with this map.
-	newChainToFullRules := map[string][]*knftables.Rule{}
+	newChainToFullRules = map[string][]*knftables.Rule{}
 	for chain, rules := range t.chainToFullRules {
 		newChainToFullRules[chain] = make([]*knftables.Rule, len(rules))
 		copy(newChainToFullRules[chain], rules)
@@ -1057,55 +1214,46 @@ func (t *NftablesTable) applyUpdates() error {
 		tx.Delete(m)
 	}
 
-	if tx.NumOperations() == 0 {
-		t.logCxt.Debug("Update ended up being no-op, skipping call to nftables.")
-	} else {
-		// Run the transaction.
-		t.opReporter.RecordOperation(fmt.Sprintf("update-%v-v%d", t.name, t.ipVersion))
-
-		if logrus.IsLevelEnabled(logrus.TraceLevel) {
-			t.logCxt.Tracef("Updating nftables: %s", tx.String())
+	// Likewise, delete any meters that are no longer used by our rules.
+	newMeters = t.desiredMeters()
+	t.metersInDataplane.Iter(func(name string) error {
+		if !newMeters.Contains(name) {
+			t.logCxt.WithField("meter", name).Debug("Deleting meter that is no longer used")
+			tx.Delete(&knftables.Set{Name: name})
 		}
+		return nil
+	})
 
-		if err := t.runTransaction(tx); err != nil {
-			// Let's just print out the entire ruleset for debugging purposes.
-			cmd := t.newCmd("nft", "list", "ruleset")
-			output, err2 := cmd.Output()
-			if err2 != nil {
-				t.logCxt.WithError(err2).Error("Failed to load nftables ruleset")
-			} else {
-				t.logCxt.WithField("ruleset", string(output)).Error("Current ruleset after error")
-			}
-
-			t.logCxt.WithError(err).WithField("tx", tx.String()).Error("Failed to run nft transaction")
-			return fmt.Errorf("error performing nft transaction: %s", err)
-		}
+	return mapUpdates, newHashes, newChainToFullRules, newMeters
+}
 
-		// Update Map implementation after successful nftables transaction.
-		t.MapsDataplane.FinishMapUpdates(mapUpdates)
+// PendingChanges returns the nft commands that the table would run on its next Apply, along with the pending
+// changes of its IP sets.
+func (t *NftablesTable) PendingChanges() []pendingchanges.Layer {
+	layers := []pendingchanges.Layer{t.pendingChangesLayer()}
+	if r, ok := t.IPSetsDataplane.(pendingchanges.Reporter); ok {
+		layers = append(layers, r.PendingChanges()...)
 	}
+	return layers
+}
 
-	// Now we've successfully updated nftables, clear the dirty sets.  We do this even if we
-	// found there was nothing to do above, since we may have found out that a dirty chain
-	// was actually a no-op update.
-	t.dirtyChains = set.New[string]()
-	t.dirtyBaseChains = set.New[string]()
-
-	// Store off the updates.
-	for chainName, hashes := range newHashes {
-		if hashes == nil {
-			delete(t.chainToDataplaneHashes, chainName)
-		} else {
-			t.chainToDataplaneHashes[chainName] = hashes
+func (t *NftablesTable) pendingChangesLayer() pendingchanges.Layer {
+	tx := t.nft.NewTransaction()
+	t.buildTransaction(tx)
+	layer := pendingchanges.Layer{
+		Kind:          pendingchanges.KindNftables,
+		Name:          t.name,
+		IPVersion:     t.ipVersion,
+		ResyncPending: !t.inSyncWithDataPlane,
+	}
+	if tx.NumOperations() > 0 {
+		for _, line := range strings.Split(tx.String(), "\n") {
+			if line != "" {
+				layer.Changes = append(layer.Changes, line)
+			}
 		}
 	}
-	t.chainToFullRules = newChainToFullRules
-
-	// Invalidate the in-memory dataplane state so that we reload on the next write. This ensures we have the correct handles
-	// in-memory for each of the objects we've just written. nftables requires an object's handle in order to
-	// perform update or delete operations.
-	t.InvalidateDataplaneCache("post-write")
-	return nil
+	return layer
 }
 
 func (t *NftablesTable) runTransaction(tx *knftables.Transaction) error {
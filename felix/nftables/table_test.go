@@ -24,6 +24,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/environment"
 	"github.com/projectcalico/calico/felix/generictables"
+	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/iptables/testutils"
 	"github.com/projectcalico/calico/felix/logutils"
 	"github.com/projectcalico/calico/felix/nftables"
@@ -48,6 +49,56 @@ var expectedBaseChains = []string{
 	"raw-OUTPUT",
 }
 
+var _ = Describe("Table in dry-run mode", func() {
+	var table *NftablesTable
+	var f *fakeNFT
+	BeforeEach(func() {
+		newDataplane := func(fam knftables.Family, name string) (knftables.Interface, error) {
+			f = NewFake(fam, name)
+			return f, nil
+		}
+		table = NewTable(
+			"calico",
+			4,
+			rules.RuleHashPrefix,
+			environment.NewFeatureDetector(nil),
+			TableOptions{
+				NewDataplane:     newDataplane,
+				LookPathOverride: testutils.LookPathNoLegacy,
+				OpRecorder:       logutils.NewSummarizer("test loop"),
+				DryRun:           true,
+			},
+		)
+	})
+
+	It("should report its pending changes but not apply them", func() {
+		table.UpdateChain(&generictables.Chain{
+			Name: "filter-FORWARD",
+			Rules: []generictables.Rule{
+				{Match: Match(), Action: AcceptAction{}},
+			},
+		})
+		table.AddOrReplaceIPSet(ipsets.IPSetMetadata{
+			SetID:   "s:abcdef",
+			Type:    ipsets.IPSetTypeHashIP,
+			MaxSize: 1024,
+		}, []string{"10.0.0.1"})
+		table.ApplyUpdates(nil)
+		Expect(table.ApplyDeletions()).To(BeFalse())
+		table.Apply()
+		Expect(f.transactions).To(BeEmpty())
+
+		layers := table.PendingChanges()
+		Expect(layers).To(HaveLen(2))
+		Expect(layers[0].Kind).To(Equal("nftables"))
+		Expect(layers[0].Name).To(Equal("calico"))
+		Expect(layers[0].Changes).To(ContainElement(ContainSubstring("add chain ip calico filter-FORWARD")))
+		Expect(layers[0].Changes).To(ContainElement(ContainSubstring("accept")))
+		Expect(layers[1].Kind).To(Equal("ipsets"))
+		Expect(layers[1].Changes).To(ContainElement(ContainSubstring("10.0.0.1")))
+	})
+})
+
 var _ = Describe("Table with an empty dataplane", func() {
 	var table *NftablesTable
 	var featureDetector *environment.FeatureDetector
@@ -160,6 +211,31 @@ var _ = Describe("Table with an empty dataplane", func() {
 		}).To(Panic())
 	})
 
+	It("should delete meters once they're no longer used", func() {
+		// Simulate a meter that was left behind by a previous run, along with the meter that the
+		// kernel creates for our rule.
+		tx := f.NewTransaction()
+		tx.Add(&knftables.Table{})
+		tx.Add(&knftables.Set{Name: "cali-meter-ip-rate-stale", Type: "ipv4_addr"})
+		tx.Add(&knftables.Set{Name: "cali-meter-ip-rate-abcd", Type: "ipv4_addr"})
+		tx.Add(&knftables.Set{Name: "cali40s:other", Type: "ipv4_addr"})
+		Expect(f.Run(context.TODO(), tx)).To(Succeed())
+
+		table.InsertOrAppendRules("filter-FORWARD", []generictables.Rule{
+			{Match: nftables.Match().HashLimitAbove("abcd", 100, 5, true), Action: DropAction{}},
+		})
+		table.Apply()
+		sets, err := f.List(context.TODO(), "set")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sets).To(ConsistOf("cali-meter-ip-rate-abcd", "cali40s:other"))
+
+		table.InsertOrAppendRules("filter-FORWARD", nil)
+		table.Apply()
+		sets, err = f.List(context.TODO(), "set")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sets).To(ConsistOf("cali40s:other"))
+	})
+
 	Describe("after inserting a rule", func() {
 		BeforeEach(func() {
 			table.InsertOrAppendRules("filter-FORWARD", []generictables.Rule{
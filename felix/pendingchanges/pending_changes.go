@@ -0,0 +1,161 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pendingchanges describes the changes that the dataplane layers (iptables and nftables tables, IP sets
+// and BPF maps) would make on their next apply.  The changes are calculated by comparing each layer's desired
+// state with its cache of the dataplane; they are used by the pending changes debug endpoint and by the dataplane
+// dry-run mode.
+package pendingchanges
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	KindIptables = "iptables"
+	KindNftables = "nftables"
+	KindIPSets   = "ipsets"
+	KindBPFMap   = "bpf-map"
+
+	// HTTPPath is the path of the pending changes endpoint on the debug server.
+	HTTPPath = "/debug/dataplane/pending-changes"
+)
+
+// Layer describes the changes that a dataplane layer would make on its next apply.
+type Layer struct {
+	// Kind is the kind of the layer; one of the Kind... constants.
+	Kind string `json:"kind"`
+	// Name identifies the layer within its kind, for example the name of an iptables table or a BPF map.
+	Name      string `json:"name"`
+	IPVersion uint8  `json:"ipVersion,omitempty"`
+	// ResyncPending is true if the layer is due to reload its cache of the dataplane before it next applies
+	// its changes.  The changes are calculated against the cache, so they may be inaccurate until the resync
+	// has been done.
+	ResyncPending bool `json:"resyncPending,omitempty"`
+	// Changes lists the pending changes in the syntax of the layer's own dataplane commands; for example, an
+	// iptables table lists its iptables-restore input.
+	Changes []string `json:"changes"`
+}
+
+// Empty returns true if the layer has no pending changes.
+func (l Layer) Empty() bool {
+	return len(l.Changes) == 0
+}
+
+// String returns a human-readable description of the layer, one change per line.
+func (l Layer) String() string {
+	var sb strings.Builder
+	sb.WriteString("# ")
+	sb.WriteString(l.Kind)
+	if l.Name != "" {
+		sb.WriteString(" ")
+		sb.WriteString(l.Name)
+	}
+	if l.IPVersion != 0 {
+		_, _ = fmt.Fprintf(&sb, " (IPv%d)", l.IPVersion)
+	}
+	if l.ResyncPending {
+		sb.WriteString(" [resync pending]")
+	}
+	sb.WriteString("\n")
+	for _, c := range l.Changes {
+		sb.WriteString(c)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Reporter is implemented by dataplane layers that can report their pending changes.  As with the other
+// methods on a dataplane layer, PendingChanges must be called from the goroutine that applies the layer.
+type Reporter interface {
+	PendingChanges() []Layer
+}
+
+// Source provides the pending changes of a whole dataplane, collected from its layers.
+type Source interface {
+	PendingChanges(ctx context.Context) ([]Layer, error)
+}
+
+// NewHandler returns an HTTP handler that reports the pending changes of the given dataplane.  By default it
+// returns the layers as JSON, omitting layers that have no changes.  The "format=text" query parameter selects a
+// human-readable format and "all=true" includes the layers that have no changes.
+func NewHandler(source Source) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+		layers, err := source.PendingChanges(ctx)
+		if err != nil {
+			log.WithError(err).Warn("Failed to get pending dataplane changes.")
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		includeEmpty := r.URL.Query().Get("all") == "true"
+		filtered := []Layer{}
+		for _, l := range layers {
+			if includeEmpty || !l.Empty() {
+				filtered = append(filtered, l)
+			}
+		}
+		layers = filtered
+
+		if r.URL.Query().Get("format") == "text" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			for _, l := range layers {
+				_, _ = fmt.Fprintln(w, l.String())
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(layers); err != nil {
+			log.WithError(err).Warn("Failed to write pending dataplane changes.")
+		}
+	})
+}
+
+// DryRunLogger logs the pending changes of a layer that is running in dry-run mode.  To avoid logging the same
+// changes on every apply, it only logs when the changes differ from those that it logged last time.
+type DryRunLogger struct {
+	lastLogged []string
+}
+
+// MaybeLog logs the pending changes of the layer if they have changed since the last call.
+func (d *DryRunLogger) MaybeLog(l Layer) {
+	if slices.Equal(d.lastLogged, l.Changes) {
+		return
+	}
+	d.lastLogged = slices.Clone(l.Changes)
+	logCxt := log.WithFields(log.Fields{
+		"kind":      l.Kind,
+		"name":      l.Name,
+		"ipVersion": l.IPVersion,
+	})
+	if l.Empty() {
+		logCxt.Info("Dry-run: dataplane layer is in sync, no changes pending.")
+		return
+	}
+	logCxt.WithField("numChanges", len(l.Changes)).Info("Dry-run: not applying dataplane changes.")
+	for _, c := range l.Changes {
+		logCxt.WithField("change", c).Info("Dry-run: pending dataplane change.")
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pendingchanges_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+
+	. "github.com/projectcalico/calico/felix/pendingchanges"
+)
+
+type mockSource struct {
+	layers []Layer
+	err    error
+}
+
+func (m *mockSource) PendingChanges(ctx context.Context) ([]Layer, error) {
+	return m.layers, m.err
+}
+
+var testLayers = []Layer{
+	{
+		Kind:      KindIptables,
+		Name:      "filter",
+		IPVersion: 4,
+		Changes:   []string{"*filter", ":cali-FORWARD - -", "COMMIT"},
+	},
+	{
+		Kind:          KindIPSets,
+		Name:          "ipset",
+		IPVersion:     4,
+		ResyncPending: true,
+	},
+}
+
+func get(t *testing.T, source Source, url string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, url, nil)
+	w := httptest.NewRecorder()
+	NewHandler(source).ServeHTTP(w, req)
+	return w
+}
+
+func TestHandler_JSON(t *testing.T) {
+	RegisterTestingT(t)
+	w := get(t, &mockSource{layers: testLayers}, HTTPPath)
+	Expect(w.Code).To(Equal(http.StatusOK))
+	Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	var layers []Layer
+	Expect(json.Unmarshal(w.Body.Bytes(), &layers)).To(Succeed())
+	Expect(layers).To(Equal(testLayers[:1]), "Layers without changes should be omitted by default")
+}
+
+func TestHandler_All(t *testing.T) {
+	RegisterTestingT(t)
+	w := get(t, &mockSource{layers: testLayers}, HTTPPath+"?all=true")
+	Expect(w.Code).To(Equal(http.StatusOK))
+
+	var layers []Layer
+	Expect(json.Unmarshal(w.Body.Bytes(), &layers)).To(Succeed())
+	Expect(layers).To(HaveLen(2))
+	Expect(layers[1].ResyncPending).To(BeTrue())
+}
+
+func TestHandler_NoChanges(t *testing.T) {
+	RegisterTestingT(t)
+	w := get(t, &mockSource{}, HTTPPath)
+	Expect(w.Code).To(Equal(http.StatusOK))
+	Expect(w.Body.String()).To(Equal("[]\n"))
+}
+
+func TestHandler_Text(t *testing.T) {
+	RegisterTestingT(t)
+	w := get(t, &mockSource{layers: testLayers}, HTTPPath+"?format=text&all=true")
+	Expect(w.Code).To(Equal(http.StatusOK))
+	Expect(w.Body.String()).To(Equal(
+		"# iptables filter (IPv4)\n" +
+			"*filter\n" +
+			":cali-FORWARD - -\n" +
+			"COMMIT\n" +
+			"\n" +
+			"# ipsets ipset (IPv4) [resync pending]\n" +
+			"\n",
+	))
+}
+
+func TestHandler_Error(t *testing.T) {
+	RegisterTestingT(t)
+	w := get(t, &mockSource{err: errors.New("timed out")}, HTTPPath)
+	Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+	Expect(w.Body.String()).To(ContainSubstring("timed out"))
+}
+
+func TestDryRunLogger(t *testing.T) {
+	RegisterTestingT(t)
+	hook := test.NewGlobal()
+	defer hook.Reset()
+	log.SetLevel(log.InfoLevel)
+
+	var logger DryRunLogger
+	logger.MaybeLog(testLayers[0])
+	// One summary line plus one line per change.
+	Expect(hook.AllEntries()).To(HaveLen(4))
+	Expect(hook.AllEntries()[1].Data["change"]).To(Equal("*filter"))
+
+	hook.Reset()
+	logger.MaybeLog(testLayers[0])
+	Expect(hook.AllEntries()).To(BeEmpty(), "Unchanged changes shouldn't be logged again")
+
+	logger.MaybeLog(Layer{Kind: KindIptables, Name: "filter", IPVersion: 4})
+	Expect(hook.AllEntries()).To(HaveLen(1))
+	Expect(hook.LastEntry().Message).To(ContainSubstring("in sync"))
+}
@@ -375,7 +375,7 @@ func (x Statistic_Direction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Statistic_Direction.Descriptor instead.
 func (Statistic_Direction) EnumDescriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{67, 0}
+	return file_felixbackend_proto_rawDescGZIP(), []int{70, 0}
 }
 
 // Whether the data is relative. ABSOLUTE data gives the total for the flow
@@ -424,7 +424,7 @@ func (x Statistic_Relativity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Statistic_Relativity.Descriptor instead.
 func (Statistic_Relativity) EnumDescriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{67, 1}
+	return file_felixbackend_proto_rawDescGZIP(), []int{70, 1}
 }
 
 // Kind indicates what this statistic is about.
@@ -471,7 +471,7 @@ func (x Statistic_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Statistic_Kind.Descriptor instead.
 func (Statistic_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{67, 2}
+	return file_felixbackend_proto_rawDescGZIP(), []int{70, 2}
 }
 
 // Whether the rule appears in INBOUND or OUTBOUND rules for the policy /
@@ -519,7 +519,7 @@ func (x RuleTrace_Direction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuleTrace_Direction.Descriptor instead.
 func (RuleTrace_Direction) EnumDescriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{68, 0}
+	return file_felixbackend_proto_rawDescGZIP(), []int{71, 0}
 }
 
 type SyncRequest struct {
@@ -602,6 +602,8 @@ type ToDataplane struct {
 	//	*ToDataplane_WireguardEndpointV6Remove
 	//	*ToDataplane_HostMetadataV6Update
 	//	*ToDataplane_HostMetadataV6Remove
+	//	*ToDataplane_PacketCaptureUpdate
+	//	*ToDataplane_PacketCaptureRemove
 	Payload       isToDataplane_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -984,6 +986,24 @@ func (x *ToDataplane) GetHostMetadataV6Remove() *HostMetadataV6Remove {
 	return nil
 }
 
+func (x *ToDataplane) GetPacketCaptureUpdate() *PacketCaptureUpdate {
+	if x != nil {
+		if x, ok := x.Payload.(*ToDataplane_PacketCaptureUpdate); ok {
+			return x.PacketCaptureUpdate
+		}
+	}
+	return nil
+}
+
+func (x *ToDataplane) GetPacketCaptureRemove() *PacketCaptureRemove {
+	if x != nil {
+		if x, ok := x.Payload.(*ToDataplane_PacketCaptureRemove); ok {
+			return x.PacketCaptureRemove
+		}
+	}
+	return nil
+}
+
 type isToDataplane_Payload interface {
 	isToDataplane_Payload()
 }
@@ -1180,6 +1200,18 @@ type ToDataplane_HostMetadataV6Remove struct {
 	HostMetadataV6Remove *HostMetadataV6Remove `protobuf:"bytes,36,opt,name=host_metadata_v6_remove,json=hostMetadataV6Remove,proto3,oneof"`
 }
 
+type ToDataplane_PacketCaptureUpdate struct {
+	// PacketCaptureUpdate is sent when a PacketCapture that selects local endpoints is
+	// added/updated, or when the set of local endpoints that it selects changes.
+	PacketCaptureUpdate *PacketCaptureUpdate `protobuf:"bytes,39,opt,name=packet_capture_update,json=packetCaptureUpdate,proto3,oneof"`
+}
+
+type ToDataplane_PacketCaptureRemove struct {
+	// PacketCaptureRemove is sent when a PacketCapture is removed or no longer selects
+	// any local endpoints.
+	PacketCaptureRemove *PacketCaptureRemove `protobuf:"bytes,40,opt,name=packet_capture_remove,json=packetCaptureRemove,proto3,oneof"`
+}
+
 func (*ToDataplane_InSync) isToDataplane_Payload() {}
 
 func (*ToDataplane_IpsetUpdate) isToDataplane_Payload() {}
@@ -1254,6 +1286,10 @@ func (*ToDataplane_HostMetadataV6Update) isToDataplane_Payload() {}
 
 func (*ToDataplane_HostMetadataV6Remove) isToDataplane_Payload() {}
 
+func (*ToDataplane_PacketCaptureUpdate) isToDataplane_Payload() {}
+
+func (*ToDataplane_PacketCaptureRemove) isToDataplane_Payload() {}
+
 type FromDataplane struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SequenceNumber uint64                 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
@@ -2228,8 +2264,12 @@ type Rule struct {
 	SrcServiceAccountMatch *ServiceAccountMatch `protobuf:"bytes,120,opt,name=src_service_account_match,json=srcServiceAccountMatch,proto3" json:"src_service_account_match,omitempty"`
 	DstServiceAccountMatch *ServiceAccountMatch `protobuf:"bytes,121,opt,name=dst_service_account_match,json=dstServiceAccountMatch,proto3" json:"dst_service_account_match,omitempty"`
 	// Pass through of the v3 datamodel HTTP match criteria.
-	HttpMatch *HTTPMatch    `protobuf:"bytes,122,opt,name=http_match,json=httpMatch,proto3" json:"http_match,omitempty"`
-	Metadata  *RuleMetadata `protobuf:"bytes,123,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	HttpMatch *HTTPMatch `protobuf:"bytes,122,opt,name=http_match,json=httpMatch,proto3" json:"http_match,omitempty"`
+	// Pass through of the v3 datamodel gRPC match criteria.
+	GrpcMatch *GRPCMatch `protobuf:"bytes,124,opt,name=grpc_match,json=grpcMatch,proto3" json:"grpc_match,omitempty"`
+	// Limit on the connections that the rule allows.  Only set on allow rules.
+	Limit    *RuleLimit    `protobuf:"bytes,125,opt,name=limit,proto3" json:"limit,omitempty"`
+	Metadata *RuleMetadata `protobuf:"bytes,123,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// An opaque ID/hash for the rule.
 	RuleId        string `protobuf:"bytes,201,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -2554,6 +2594,20 @@ func (x *Rule) GetHttpMatch() *HTTPMatch {
 	return nil
 }
 
+func (x *Rule) GetGrpcMatch() *GRPCMatch {
+	if x != nil {
+		return x.GrpcMatch
+	}
+	return nil
+}
+
+func (x *Rule) GetLimit() *RuleLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
 func (x *Rule) GetMetadata() *RuleMetadata {
 	if x != nil {
 		return x.Metadata
@@ -2656,9 +2710,13 @@ func (x *ServiceAccountMatch) GetNames() []string {
 }
 
 type HTTPMatch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Methods       []string               `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
-	Paths         []*HTTPMatch_PathMatch `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Methods       []string                   `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+	Paths         []*HTTPMatch_PathMatch     `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Hosts         []string                   `protobuf:"bytes,3,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Headers       []*HTTPMatch_KeyValueMatch `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	QueryParams   []*HTTPMatch_KeyValueMatch `protobuf:"bytes,5,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	Jwt           *HTTPMatch_JWTMatch        `protobuf:"bytes,6,opt,name=jwt,proto3" json:"jwt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2707,6 +2765,158 @@ func (x *HTTPMatch) GetPaths() []*HTTPMatch_PathMatch {
 	return nil
 }
 
+func (x *HTTPMatch) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *HTTPMatch) GetHeaders() []*HTTPMatch_KeyValueMatch {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPMatch) GetQueryParams() []*HTTPMatch_KeyValueMatch {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *HTTPMatch) GetJwt() *HTTPMatch_JWTMatch {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+type GRPCMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []string               `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Methods       []string               `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GRPCMatch) Reset() {
+	*x = GRPCMatch{}
+	mi := &file_felixbackend_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCMatch) ProtoMessage() {}
+
+func (x *GRPCMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCMatch.ProtoReflect.Descriptor instead.
+func (*GRPCMatch) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GRPCMatch) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *GRPCMatch) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type RuleLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Zero if the rule has no rate limit.
+	NewConnectionsPerSecond uint32 `protobuf:"varint,1,opt,name=new_connections_per_second,json=newConnectionsPerSecond,proto3" json:"new_connections_per_second,omitempty"`
+	Burst                   uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Zero if the rule has no connection limit.
+	MaxConnections uint32 `protobuf:"varint,3,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// If true, the limits apply to all of the traffic that matches the rule, rather than to each
+	// source IP separately.
+	PerRule       bool `protobuf:"varint,4,opt,name=per_rule,json=perRule,proto3" json:"per_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleLimit) Reset() {
+	*x = RuleLimit{}
+	mi := &file_felixbackend_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleLimit) ProtoMessage() {}
+
+func (x *RuleLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleLimit.ProtoReflect.Descriptor instead.
+func (*RuleLimit) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RuleLimit) GetNewConnectionsPerSecond() uint32 {
+	if x != nil {
+		return x.NewConnectionsPerSecond
+	}
+	return 0
+}
+
+func (x *RuleLimit) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RuleLimit) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *RuleLimit) GetPerRule() bool {
+	if x != nil {
+		return x.PerRule
+	}
+	return false
+}
+
 type RuleMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Annotations   map[string]string      `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -2716,7 +2926,7 @@ type RuleMetadata struct {
 
 func (x *RuleMetadata) Reset() {
 	*x = RuleMetadata{}
-	mi := &file_felixbackend_proto_msgTypes[20]
+	mi := &file_felixbackend_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2728,7 +2938,7 @@ func (x *RuleMetadata) String() string {
 func (*RuleMetadata) ProtoMessage() {}
 
 func (x *RuleMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[20]
+	mi := &file_felixbackend_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2741,7 +2951,7 @@ func (x *RuleMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleMetadata.ProtoReflect.Descriptor instead.
 func (*RuleMetadata) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{20}
+	return file_felixbackend_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RuleMetadata) GetAnnotations() map[string]string {
@@ -2761,7 +2971,7 @@ type IcmpTypeAndCode struct {
 
 func (x *IcmpTypeAndCode) Reset() {
 	*x = IcmpTypeAndCode{}
-	mi := &file_felixbackend_proto_msgTypes[21]
+	mi := &file_felixbackend_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2773,7 +2983,7 @@ func (x *IcmpTypeAndCode) String() string {
 func (*IcmpTypeAndCode) ProtoMessage() {}
 
 func (x *IcmpTypeAndCode) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[21]
+	mi := &file_felixbackend_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,7 +2996,7 @@ func (x *IcmpTypeAndCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IcmpTypeAndCode.ProtoReflect.Descriptor instead.
 func (*IcmpTypeAndCode) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{21}
+	return file_felixbackend_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *IcmpTypeAndCode) GetType() int32 {
@@ -2816,7 +3026,7 @@ type Protocol struct {
 
 func (x *Protocol) Reset() {
 	*x = Protocol{}
-	mi := &file_felixbackend_proto_msgTypes[22]
+	mi := &file_felixbackend_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2828,7 +3038,7 @@ func (x *Protocol) String() string {
 func (*Protocol) ProtoMessage() {}
 
 func (x *Protocol) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[22]
+	mi := &file_felixbackend_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2841,7 +3051,7 @@ func (x *Protocol) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Protocol.ProtoReflect.Descriptor instead.
 func (*Protocol) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{22}
+	return file_felixbackend_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Protocol) GetNumberOrName() isProtocol_NumberOrName {
@@ -2896,7 +3106,7 @@ type PortRange struct {
 
 func (x *PortRange) Reset() {
 	*x = PortRange{}
-	mi := &file_felixbackend_proto_msgTypes[23]
+	mi := &file_felixbackend_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2908,7 +3118,7 @@ func (x *PortRange) String() string {
 func (*PortRange) ProtoMessage() {}
 
 func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[23]
+	mi := &file_felixbackend_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2921,7 +3131,7 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
 func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{23}
+	return file_felixbackend_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PortRange) GetFirst() int32 {
@@ -2949,7 +3159,7 @@ type WorkloadEndpointID struct {
 
 func (x *WorkloadEndpointID) Reset() {
 	*x = WorkloadEndpointID{}
-	mi := &file_felixbackend_proto_msgTypes[24]
+	mi := &file_felixbackend_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2961,7 +3171,7 @@ func (x *WorkloadEndpointID) String() string {
 func (*WorkloadEndpointID) ProtoMessage() {}
 
 func (x *WorkloadEndpointID) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[24]
+	mi := &file_felixbackend_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,7 +3184,7 @@ func (x *WorkloadEndpointID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadEndpointID.ProtoReflect.Descriptor instead.
 func (*WorkloadEndpointID) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{24}
+	return file_felixbackend_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WorkloadEndpointID) GetOrchestratorId() string {
@@ -3008,7 +3218,7 @@ type WorkloadEndpointUpdate struct {
 
 func (x *WorkloadEndpointUpdate) Reset() {
 	*x = WorkloadEndpointUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[25]
+	mi := &file_felixbackend_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3020,7 +3230,7 @@ func (x *WorkloadEndpointUpdate) String() string {
 func (*WorkloadEndpointUpdate) ProtoMessage() {}
 
 func (x *WorkloadEndpointUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[25]
+	mi := &file_felixbackend_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3033,7 +3243,7 @@ func (x *WorkloadEndpointUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadEndpointUpdate.ProtoReflect.Descriptor instead.
 func (*WorkloadEndpointUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{25}
+	return file_felixbackend_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *WorkloadEndpointUpdate) GetId() *WorkloadEndpointID {
@@ -3072,7 +3282,7 @@ type WorkloadEndpoint struct {
 
 func (x *WorkloadEndpoint) Reset() {
 	*x = WorkloadEndpoint{}
-	mi := &file_felixbackend_proto_msgTypes[26]
+	mi := &file_felixbackend_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3084,7 +3294,7 @@ func (x *WorkloadEndpoint) String() string {
 func (*WorkloadEndpoint) ProtoMessage() {}
 
 func (x *WorkloadEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[26]
+	mi := &file_felixbackend_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3097,7 +3307,7 @@ func (x *WorkloadEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadEndpoint.ProtoReflect.Descriptor instead.
 func (*WorkloadEndpoint) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{26}
+	return file_felixbackend_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *WorkloadEndpoint) GetState() string {
@@ -3214,7 +3424,7 @@ type QoSControls struct {
 
 func (x *QoSControls) Reset() {
 	*x = QoSControls{}
-	mi := &file_felixbackend_proto_msgTypes[27]
+	mi := &file_felixbackend_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3226,7 +3436,7 @@ func (x *QoSControls) String() string {
 func (*QoSControls) ProtoMessage() {}
 
 func (x *QoSControls) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[27]
+	mi := &file_felixbackend_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3239,7 +3449,7 @@ func (x *QoSControls) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QoSControls.ProtoReflect.Descriptor instead.
 func (*QoSControls) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{27}
+	return file_felixbackend_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QoSControls) GetIngressBandwidth() int64 {
@@ -3307,7 +3517,7 @@ type LocalBGPPeer struct {
 
 func (x *LocalBGPPeer) Reset() {
 	*x = LocalBGPPeer{}
-	mi := &file_felixbackend_proto_msgTypes[28]
+	mi := &file_felixbackend_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3319,7 +3529,7 @@ func (x *LocalBGPPeer) String() string {
 func (*LocalBGPPeer) ProtoMessage() {}
 
 func (x *LocalBGPPeer) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[28]
+	mi := &file_felixbackend_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3332,7 +3542,7 @@ func (x *LocalBGPPeer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalBGPPeer.ProtoReflect.Descriptor instead.
 func (*LocalBGPPeer) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{28}
+	return file_felixbackend_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LocalBGPPeer) GetBgpPeerName() string {
@@ -3351,7 +3561,7 @@ type WorkloadEndpointRemove struct {
 
 func (x *WorkloadEndpointRemove) Reset() {
 	*x = WorkloadEndpointRemove{}
-	mi := &file_felixbackend_proto_msgTypes[29]
+	mi := &file_felixbackend_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3363,7 +3573,7 @@ func (x *WorkloadEndpointRemove) String() string {
 func (*WorkloadEndpointRemove) ProtoMessage() {}
 
 func (x *WorkloadEndpointRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[29]
+	mi := &file_felixbackend_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3376,7 +3586,7 @@ func (x *WorkloadEndpointRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadEndpointRemove.ProtoReflect.Descriptor instead.
 func (*WorkloadEndpointRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{29}
+	return file_felixbackend_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *WorkloadEndpointRemove) GetId() *WorkloadEndpointID {
@@ -3395,7 +3605,7 @@ type HostEndpointID struct {
 
 func (x *HostEndpointID) Reset() {
 	*x = HostEndpointID{}
-	mi := &file_felixbackend_proto_msgTypes[30]
+	mi := &file_felixbackend_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3407,7 +3617,7 @@ func (x *HostEndpointID) String() string {
 func (*HostEndpointID) ProtoMessage() {}
 
 func (x *HostEndpointID) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[30]
+	mi := &file_felixbackend_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,7 +3630,7 @@ func (x *HostEndpointID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEndpointID.ProtoReflect.Descriptor instead.
 func (*HostEndpointID) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{30}
+	return file_felixbackend_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *HostEndpointID) GetEndpointId() string {
@@ -3440,7 +3650,7 @@ type HostEndpointUpdate struct {
 
 func (x *HostEndpointUpdate) Reset() {
 	*x = HostEndpointUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[31]
+	mi := &file_felixbackend_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3452,7 +3662,7 @@ func (x *HostEndpointUpdate) String() string {
 func (*HostEndpointUpdate) ProtoMessage() {}
 
 func (x *HostEndpointUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[31]
+	mi := &file_felixbackend_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3465,7 +3675,7 @@ func (x *HostEndpointUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEndpointUpdate.ProtoReflect.Descriptor instead.
 func (*HostEndpointUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{31}
+	return file_felixbackend_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *HostEndpointUpdate) GetId() *HostEndpointID {
@@ -3498,7 +3708,7 @@ type HostEndpoint struct {
 
 func (x *HostEndpoint) Reset() {
 	*x = HostEndpoint{}
-	mi := &file_felixbackend_proto_msgTypes[32]
+	mi := &file_felixbackend_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3510,7 +3720,7 @@ func (x *HostEndpoint) String() string {
 func (*HostEndpoint) ProtoMessage() {}
 
 func (x *HostEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[32]
+	mi := &file_felixbackend_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3523,7 +3733,7 @@ func (x *HostEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEndpoint.ProtoReflect.Descriptor instead.
 func (*HostEndpoint) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{32}
+	return file_felixbackend_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *HostEndpoint) GetName() string {
@@ -3591,7 +3801,7 @@ type HostEndpointRemove struct {
 
 func (x *HostEndpointRemove) Reset() {
 	*x = HostEndpointRemove{}
-	mi := &file_felixbackend_proto_msgTypes[33]
+	mi := &file_felixbackend_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3603,7 +3813,7 @@ func (x *HostEndpointRemove) String() string {
 func (*HostEndpointRemove) ProtoMessage() {}
 
 func (x *HostEndpointRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[33]
+	mi := &file_felixbackend_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3616,7 +3826,7 @@ func (x *HostEndpointRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEndpointRemove.ProtoReflect.Descriptor instead.
 func (*HostEndpointRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{33}
+	return file_felixbackend_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *HostEndpointRemove) GetId() *HostEndpointID {
@@ -3638,7 +3848,7 @@ type TierInfo struct {
 
 func (x *TierInfo) Reset() {
 	*x = TierInfo{}
-	mi := &file_felixbackend_proto_msgTypes[34]
+	mi := &file_felixbackend_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3650,7 +3860,7 @@ func (x *TierInfo) String() string {
 func (*TierInfo) ProtoMessage() {}
 
 func (x *TierInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[34]
+	mi := &file_felixbackend_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3663,7 +3873,7 @@ func (x *TierInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TierInfo.ProtoReflect.Descriptor instead.
 func (*TierInfo) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{34}
+	return file_felixbackend_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *TierInfo) GetName() string {
@@ -3704,7 +3914,7 @@ type NatInfo struct {
 
 func (x *NatInfo) Reset() {
 	*x = NatInfo{}
-	mi := &file_felixbackend_proto_msgTypes[35]
+	mi := &file_felixbackend_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3716,7 +3926,7 @@ func (x *NatInfo) String() string {
 func (*NatInfo) ProtoMessage() {}
 
 func (x *NatInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[35]
+	mi := &file_felixbackend_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3729,7 +3939,7 @@ func (x *NatInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NatInfo.ProtoReflect.Descriptor instead.
 func (*NatInfo) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{35}
+	return file_felixbackend_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *NatInfo) GetExtIp() string {
@@ -3756,7 +3966,7 @@ type ProcessStatusUpdate struct {
 
 func (x *ProcessStatusUpdate) Reset() {
 	*x = ProcessStatusUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[36]
+	mi := &file_felixbackend_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3768,7 +3978,7 @@ func (x *ProcessStatusUpdate) String() string {
 func (*ProcessStatusUpdate) ProtoMessage() {}
 
 func (x *ProcessStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[36]
+	mi := &file_felixbackend_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3781,7 +3991,7 @@ func (x *ProcessStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessStatusUpdate.ProtoReflect.Descriptor instead.
 func (*ProcessStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{36}
+	return file_felixbackend_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ProcessStatusUpdate) GetIsoTimestamp() string {
@@ -3808,7 +4018,7 @@ type HostEndpointStatusUpdate struct {
 
 func (x *HostEndpointStatusUpdate) Reset() {
 	*x = HostEndpointStatusUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[37]
+	mi := &file_felixbackend_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3820,7 +4030,7 @@ func (x *HostEndpointStatusUpdate) String() string {
 func (*HostEndpointStatusUpdate) ProtoMessage() {}
 
 func (x *HostEndpointStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[37]
+	mi := &file_felixbackend_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3833,7 +4043,7 @@ func (x *HostEndpointStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEndpointStatusUpdate.ProtoReflect.Descriptor instead.
 func (*HostEndpointStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{37}
+	return file_felixbackend_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *HostEndpointStatusUpdate) GetId() *HostEndpointID {
@@ -3859,7 +4069,7 @@ type EndpointStatus struct {
 
 func (x *EndpointStatus) Reset() {
 	*x = EndpointStatus{}
-	mi := &file_felixbackend_proto_msgTypes[38]
+	mi := &file_felixbackend_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3871,7 +4081,7 @@ func (x *EndpointStatus) String() string {
 func (*EndpointStatus) ProtoMessage() {}
 
 func (x *EndpointStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[38]
+	mi := &file_felixbackend_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3884,7 +4094,7 @@ func (x *EndpointStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EndpointStatus.ProtoReflect.Descriptor instead.
 func (*EndpointStatus) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{38}
+	return file_felixbackend_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *EndpointStatus) GetStatus() string {
@@ -3903,7 +4113,7 @@ type HostEndpointStatusRemove struct {
 
 func (x *HostEndpointStatusRemove) Reset() {
 	*x = HostEndpointStatusRemove{}
-	mi := &file_felixbackend_proto_msgTypes[39]
+	mi := &file_felixbackend_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3915,7 +4125,7 @@ func (x *HostEndpointStatusRemove) String() string {
 func (*HostEndpointStatusRemove) ProtoMessage() {}
 
 func (x *HostEndpointStatusRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[39]
+	mi := &file_felixbackend_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3928,7 +4138,7 @@ func (x *HostEndpointStatusRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostEndpointStatusRemove.ProtoReflect.Descriptor instead.
 func (*HostEndpointStatusRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{39}
+	return file_felixbackend_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *HostEndpointStatusRemove) GetId() *HostEndpointID {
@@ -3949,7 +4159,7 @@ type WorkloadEndpointStatusUpdate struct {
 
 func (x *WorkloadEndpointStatusUpdate) Reset() {
 	*x = WorkloadEndpointStatusUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[40]
+	mi := &file_felixbackend_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3961,7 +4171,7 @@ func (x *WorkloadEndpointStatusUpdate) String() string {
 func (*WorkloadEndpointStatusUpdate) ProtoMessage() {}
 
 func (x *WorkloadEndpointStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[40]
+	mi := &file_felixbackend_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3974,7 +4184,7 @@ func (x *WorkloadEndpointStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadEndpointStatusUpdate.ProtoReflect.Descriptor instead.
 func (*WorkloadEndpointStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{40}
+	return file_felixbackend_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *WorkloadEndpointStatusUpdate) GetId() *WorkloadEndpointID {
@@ -4007,7 +4217,7 @@ type WorkloadEndpointStatusRemove struct {
 
 func (x *WorkloadEndpointStatusRemove) Reset() {
 	*x = WorkloadEndpointStatusRemove{}
-	mi := &file_felixbackend_proto_msgTypes[41]
+	mi := &file_felixbackend_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4019,7 +4229,7 @@ func (x *WorkloadEndpointStatusRemove) String() string {
 func (*WorkloadEndpointStatusRemove) ProtoMessage() {}
 
 func (x *WorkloadEndpointStatusRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[41]
+	mi := &file_felixbackend_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4032,7 +4242,7 @@ func (x *WorkloadEndpointStatusRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadEndpointStatusRemove.ProtoReflect.Descriptor instead.
 func (*WorkloadEndpointStatusRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{41}
+	return file_felixbackend_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *WorkloadEndpointStatusRemove) GetId() *WorkloadEndpointID {
@@ -4047,14 +4257,21 @@ type WireguardStatusUpdate struct {
 	// Wireguard public-key set on the interface.
 	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	// The IP version of this update
-	IpVersion     IPVersion `protobuf:"varint,2,opt,name=ip_version,json=ipVersion,proto3,enum=felix.IPVersion" json:"ip_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IpVersion IPVersion `protobuf:"varint,2,opt,name=ip_version,json=ipVersion,proto3,enum=felix.IPVersion" json:"ip_version,omitempty"`
+	// The public-key that the interface is rotating to, only set while a key rotation is in
+	// progress.
+	NextPublicKey string `protobuf:"bytes,3,opt,name=next_public_key,json=nextPublicKey,proto3" json:"next_public_key,omitempty"`
+	// Time at which the key pair set on the interface was generated, in seconds since the epoch.
+	PublicKeyTimestamp int64 `protobuf:"varint,4,opt,name=public_key_timestamp,json=publicKeyTimestamp,proto3" json:"public_key_timestamp,omitempty"`
+	// The next public-keys of rotating peers that are programmed on the interface.
+	AcceptedNextPublicKeys []string `protobuf:"bytes,5,rep,name=accepted_next_public_keys,json=acceptedNextPublicKeys,proto3" json:"accepted_next_public_keys,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *WireguardStatusUpdate) Reset() {
 	*x = WireguardStatusUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[42]
+	mi := &file_felixbackend_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4066,7 +4283,7 @@ func (x *WireguardStatusUpdate) String() string {
 func (*WireguardStatusUpdate) ProtoMessage() {}
 
 func (x *WireguardStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[42]
+	mi := &file_felixbackend_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4079,7 +4296,7 @@ func (x *WireguardStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardStatusUpdate.ProtoReflect.Descriptor instead.
 func (*WireguardStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{42}
+	return file_felixbackend_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *WireguardStatusUpdate) GetPublicKey() string {
@@ -4096,6 +4313,27 @@ func (x *WireguardStatusUpdate) GetIpVersion() IPVersion {
 	return IPVersion_ANY
 }
 
+func (x *WireguardStatusUpdate) GetNextPublicKey() string {
+	if x != nil {
+		return x.NextPublicKey
+	}
+	return ""
+}
+
+func (x *WireguardStatusUpdate) GetPublicKeyTimestamp() int64 {
+	if x != nil {
+		return x.PublicKeyTimestamp
+	}
+	return 0
+}
+
+func (x *WireguardStatusUpdate) GetAcceptedNextPublicKeys() []string {
+	if x != nil {
+		return x.AcceptedNextPublicKeys
+	}
+	return nil
+}
+
 type DataplaneInSync struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -4104,7 +4342,7 @@ type DataplaneInSync struct {
 
 func (x *DataplaneInSync) Reset() {
 	*x = DataplaneInSync{}
-	mi := &file_felixbackend_proto_msgTypes[43]
+	mi := &file_felixbackend_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4116,7 +4354,7 @@ func (x *DataplaneInSync) String() string {
 func (*DataplaneInSync) ProtoMessage() {}
 
 func (x *DataplaneInSync) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[43]
+	mi := &file_felixbackend_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4129,7 +4367,7 @@ func (x *DataplaneInSync) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataplaneInSync.ProtoReflect.Descriptor instead.
 func (*DataplaneInSync) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{43}
+	return file_felixbackend_proto_rawDescGZIP(), []int{45}
 }
 
 type HostMetadataV4V6Update struct {
@@ -4145,7 +4383,7 @@ type HostMetadataV4V6Update struct {
 
 func (x *HostMetadataV4V6Update) Reset() {
 	*x = HostMetadataV4V6Update{}
-	mi := &file_felixbackend_proto_msgTypes[44]
+	mi := &file_felixbackend_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4157,7 +4395,7 @@ func (x *HostMetadataV4V6Update) String() string {
 func (*HostMetadataV4V6Update) ProtoMessage() {}
 
 func (x *HostMetadataV4V6Update) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[44]
+	mi := &file_felixbackend_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4170,7 +4408,7 @@ func (x *HostMetadataV4V6Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostMetadataV4V6Update.ProtoReflect.Descriptor instead.
 func (*HostMetadataV4V6Update) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{44}
+	return file_felixbackend_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *HostMetadataV4V6Update) GetHostname() string {
@@ -4218,7 +4456,7 @@ type HostMetadataV4V6Remove struct {
 
 func (x *HostMetadataV4V6Remove) Reset() {
 	*x = HostMetadataV4V6Remove{}
-	mi := &file_felixbackend_proto_msgTypes[45]
+	mi := &file_felixbackend_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4230,7 +4468,7 @@ func (x *HostMetadataV4V6Remove) String() string {
 func (*HostMetadataV4V6Remove) ProtoMessage() {}
 
 func (x *HostMetadataV4V6Remove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[45]
+	mi := &file_felixbackend_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4243,7 +4481,7 @@ func (x *HostMetadataV4V6Remove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostMetadataV4V6Remove.ProtoReflect.Descriptor instead.
 func (*HostMetadataV4V6Remove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{45}
+	return file_felixbackend_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *HostMetadataV4V6Remove) GetHostname() string {
@@ -4270,7 +4508,7 @@ type HostMetadataUpdate struct {
 
 func (x *HostMetadataUpdate) Reset() {
 	*x = HostMetadataUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[46]
+	mi := &file_felixbackend_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4282,7 +4520,7 @@ func (x *HostMetadataUpdate) String() string {
 func (*HostMetadataUpdate) ProtoMessage() {}
 
 func (x *HostMetadataUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[46]
+	mi := &file_felixbackend_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4295,7 +4533,7 @@ func (x *HostMetadataUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostMetadataUpdate.ProtoReflect.Descriptor instead.
 func (*HostMetadataUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{46}
+	return file_felixbackend_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *HostMetadataUpdate) GetHostname() string {
@@ -4322,7 +4560,7 @@ type HostMetadataRemove struct {
 
 func (x *HostMetadataRemove) Reset() {
 	*x = HostMetadataRemove{}
-	mi := &file_felixbackend_proto_msgTypes[47]
+	mi := &file_felixbackend_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4334,7 +4572,7 @@ func (x *HostMetadataRemove) String() string {
 func (*HostMetadataRemove) ProtoMessage() {}
 
 func (x *HostMetadataRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[47]
+	mi := &file_felixbackend_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4347,7 +4585,7 @@ func (x *HostMetadataRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostMetadataRemove.ProtoReflect.Descriptor instead.
 func (*HostMetadataRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{47}
+	return file_felixbackend_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *HostMetadataRemove) GetHostname() string {
@@ -4374,7 +4612,7 @@ type HostMetadataV6Update struct {
 
 func (x *HostMetadataV6Update) Reset() {
 	*x = HostMetadataV6Update{}
-	mi := &file_felixbackend_proto_msgTypes[48]
+	mi := &file_felixbackend_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4386,7 +4624,7 @@ func (x *HostMetadataV6Update) String() string {
 func (*HostMetadataV6Update) ProtoMessage() {}
 
 func (x *HostMetadataV6Update) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[48]
+	mi := &file_felixbackend_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4399,7 +4637,7 @@ func (x *HostMetadataV6Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostMetadataV6Update.ProtoReflect.Descriptor instead.
 func (*HostMetadataV6Update) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{48}
+	return file_felixbackend_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *HostMetadataV6Update) GetHostname() string {
@@ -4426,7 +4664,7 @@ type HostMetadataV6Remove struct {
 
 func (x *HostMetadataV6Remove) Reset() {
 	*x = HostMetadataV6Remove{}
-	mi := &file_felixbackend_proto_msgTypes[49]
+	mi := &file_felixbackend_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4438,7 +4676,7 @@ func (x *HostMetadataV6Remove) String() string {
 func (*HostMetadataV6Remove) ProtoMessage() {}
 
 func (x *HostMetadataV6Remove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[49]
+	mi := &file_felixbackend_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4451,7 +4689,7 @@ func (x *HostMetadataV6Remove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HostMetadataV6Remove.ProtoReflect.Descriptor instead.
 func (*HostMetadataV6Remove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{49}
+	return file_felixbackend_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *HostMetadataV6Remove) GetHostname() string {
@@ -4478,7 +4716,7 @@ type IPAMPoolUpdate struct {
 
 func (x *IPAMPoolUpdate) Reset() {
 	*x = IPAMPoolUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[50]
+	mi := &file_felixbackend_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4490,7 +4728,7 @@ func (x *IPAMPoolUpdate) String() string {
 func (*IPAMPoolUpdate) ProtoMessage() {}
 
 func (x *IPAMPoolUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[50]
+	mi := &file_felixbackend_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4503,7 +4741,7 @@ func (x *IPAMPoolUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPAMPoolUpdate.ProtoReflect.Descriptor instead.
 func (*IPAMPoolUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{50}
+	return file_felixbackend_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *IPAMPoolUpdate) GetId() string {
@@ -4529,7 +4767,7 @@ type IPAMPoolRemove struct {
 
 func (x *IPAMPoolRemove) Reset() {
 	*x = IPAMPoolRemove{}
-	mi := &file_felixbackend_proto_msgTypes[51]
+	mi := &file_felixbackend_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4541,7 +4779,7 @@ func (x *IPAMPoolRemove) String() string {
 func (*IPAMPoolRemove) ProtoMessage() {}
 
 func (x *IPAMPoolRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[51]
+	mi := &file_felixbackend_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4554,7 +4792,7 @@ func (x *IPAMPoolRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPAMPoolRemove.ProtoReflect.Descriptor instead.
 func (*IPAMPoolRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{51}
+	return file_felixbackend_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *IPAMPoolRemove) GetId() string {
@@ -4576,7 +4814,7 @@ type IPAMPool struct {
 
 func (x *IPAMPool) Reset() {
 	*x = IPAMPool{}
-	mi := &file_felixbackend_proto_msgTypes[52]
+	mi := &file_felixbackend_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4588,7 +4826,7 @@ func (x *IPAMPool) String() string {
 func (*IPAMPool) ProtoMessage() {}
 
 func (x *IPAMPool) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[52]
+	mi := &file_felixbackend_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4601,7 +4839,7 @@ func (x *IPAMPool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPAMPool.ProtoReflect.Descriptor instead.
 func (*IPAMPool) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{52}
+	return file_felixbackend_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *IPAMPool) GetCidr() string {
@@ -4643,7 +4881,7 @@ type Encapsulation struct {
 
 func (x *Encapsulation) Reset() {
 	*x = Encapsulation{}
-	mi := &file_felixbackend_proto_msgTypes[53]
+	mi := &file_felixbackend_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4655,7 +4893,7 @@ func (x *Encapsulation) String() string {
 func (*Encapsulation) ProtoMessage() {}
 
 func (x *Encapsulation) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[53]
+	mi := &file_felixbackend_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4668,7 +4906,7 @@ func (x *Encapsulation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Encapsulation.ProtoReflect.Descriptor instead.
 func (*Encapsulation) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{53}
+	return file_felixbackend_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Encapsulation) GetIpipEnabled() bool {
@@ -4702,7 +4940,7 @@ type ServiceAccountUpdate struct {
 
 func (x *ServiceAccountUpdate) Reset() {
 	*x = ServiceAccountUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[54]
+	mi := &file_felixbackend_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4714,7 +4952,7 @@ func (x *ServiceAccountUpdate) String() string {
 func (*ServiceAccountUpdate) ProtoMessage() {}
 
 func (x *ServiceAccountUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[54]
+	mi := &file_felixbackend_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4727,7 +4965,7 @@ func (x *ServiceAccountUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceAccountUpdate.ProtoReflect.Descriptor instead.
 func (*ServiceAccountUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{54}
+	return file_felixbackend_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ServiceAccountUpdate) GetId() *ServiceAccountID {
@@ -4753,7 +4991,7 @@ type ServiceAccountRemove struct {
 
 func (x *ServiceAccountRemove) Reset() {
 	*x = ServiceAccountRemove{}
-	mi := &file_felixbackend_proto_msgTypes[55]
+	mi := &file_felixbackend_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4765,7 +5003,7 @@ func (x *ServiceAccountRemove) String() string {
 func (*ServiceAccountRemove) ProtoMessage() {}
 
 func (x *ServiceAccountRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[55]
+	mi := &file_felixbackend_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4778,7 +5016,7 @@ func (x *ServiceAccountRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceAccountRemove.ProtoReflect.Descriptor instead.
 func (*ServiceAccountRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{55}
+	return file_felixbackend_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ServiceAccountRemove) GetId() *ServiceAccountID {
@@ -4798,7 +5036,7 @@ type ServiceAccountID struct {
 
 func (x *ServiceAccountID) Reset() {
 	*x = ServiceAccountID{}
-	mi := &file_felixbackend_proto_msgTypes[56]
+	mi := &file_felixbackend_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4810,7 +5048,7 @@ func (x *ServiceAccountID) String() string {
 func (*ServiceAccountID) ProtoMessage() {}
 
 func (x *ServiceAccountID) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[56]
+	mi := &file_felixbackend_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4823,7 +5061,7 @@ func (x *ServiceAccountID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceAccountID.ProtoReflect.Descriptor instead.
 func (*ServiceAccountID) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{56}
+	return file_felixbackend_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ServiceAccountID) GetNamespace() string {
@@ -4850,7 +5088,7 @@ type NamespaceUpdate struct {
 
 func (x *NamespaceUpdate) Reset() {
 	*x = NamespaceUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[57]
+	mi := &file_felixbackend_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4862,7 +5100,7 @@ func (x *NamespaceUpdate) String() string {
 func (*NamespaceUpdate) ProtoMessage() {}
 
 func (x *NamespaceUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[57]
+	mi := &file_felixbackend_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4875,7 +5113,7 @@ func (x *NamespaceUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceUpdate.ProtoReflect.Descriptor instead.
 func (*NamespaceUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{57}
+	return file_felixbackend_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *NamespaceUpdate) GetId() *NamespaceID {
@@ -4901,7 +5139,7 @@ type NamespaceRemove struct {
 
 func (x *NamespaceRemove) Reset() {
 	*x = NamespaceRemove{}
-	mi := &file_felixbackend_proto_msgTypes[58]
+	mi := &file_felixbackend_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4913,7 +5151,7 @@ func (x *NamespaceRemove) String() string {
 func (*NamespaceRemove) ProtoMessage() {}
 
 func (x *NamespaceRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[58]
+	mi := &file_felixbackend_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4926,7 +5164,7 @@ func (x *NamespaceRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceRemove.ProtoReflect.Descriptor instead.
 func (*NamespaceRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{58}
+	return file_felixbackend_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *NamespaceRemove) GetId() *NamespaceID {
@@ -4945,7 +5183,7 @@ type NamespaceID struct {
 
 func (x *NamespaceID) Reset() {
 	*x = NamespaceID{}
-	mi := &file_felixbackend_proto_msgTypes[59]
+	mi := &file_felixbackend_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4957,7 +5195,7 @@ func (x *NamespaceID) String() string {
 func (*NamespaceID) ProtoMessage() {}
 
 func (x *NamespaceID) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[59]
+	mi := &file_felixbackend_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4970,7 +5208,7 @@ func (x *NamespaceID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceID.ProtoReflect.Descriptor instead.
 func (*NamespaceID) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{59}
+	return file_felixbackend_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *NamespaceID) GetName() string {
@@ -4991,7 +5229,7 @@ type TunnelType struct {
 
 func (x *TunnelType) Reset() {
 	*x = TunnelType{}
-	mi := &file_felixbackend_proto_msgTypes[60]
+	mi := &file_felixbackend_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5003,7 +5241,7 @@ func (x *TunnelType) String() string {
 func (*TunnelType) ProtoMessage() {}
 
 func (x *TunnelType) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[60]
+	mi := &file_felixbackend_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5016,7 +5254,7 @@ func (x *TunnelType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TunnelType.ProtoReflect.Descriptor instead.
 func (*TunnelType) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{60}
+	return file_felixbackend_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *TunnelType) GetIpip() bool {
@@ -5061,7 +5299,7 @@ type RouteUpdate struct {
 
 func (x *RouteUpdate) Reset() {
 	*x = RouteUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[61]
+	mi := &file_felixbackend_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5073,7 +5311,7 @@ func (x *RouteUpdate) String() string {
 func (*RouteUpdate) ProtoMessage() {}
 
 func (x *RouteUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[61]
+	mi := &file_felixbackend_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5086,7 +5324,7 @@ func (x *RouteUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteUpdate.ProtoReflect.Descriptor instead.
 func (*RouteUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{61}
+	return file_felixbackend_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *RouteUpdate) GetTypes() RouteType {
@@ -5168,7 +5406,7 @@ type RouteRemove struct {
 
 func (x *RouteRemove) Reset() {
 	*x = RouteRemove{}
-	mi := &file_felixbackend_proto_msgTypes[62]
+	mi := &file_felixbackend_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5180,7 +5418,7 @@ func (x *RouteRemove) String() string {
 func (*RouteRemove) ProtoMessage() {}
 
 func (x *RouteRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[62]
+	mi := &file_felixbackend_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5193,7 +5431,7 @@ func (x *RouteRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteRemove.ProtoReflect.Descriptor instead.
 func (*RouteRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{62}
+	return file_felixbackend_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *RouteRemove) GetDst() string {
@@ -5218,7 +5456,7 @@ type VXLANTunnelEndpointUpdate struct {
 
 func (x *VXLANTunnelEndpointUpdate) Reset() {
 	*x = VXLANTunnelEndpointUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[63]
+	mi := &file_felixbackend_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5230,7 +5468,7 @@ func (x *VXLANTunnelEndpointUpdate) String() string {
 func (*VXLANTunnelEndpointUpdate) ProtoMessage() {}
 
 func (x *VXLANTunnelEndpointUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[63]
+	mi := &file_felixbackend_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5243,7 +5481,7 @@ func (x *VXLANTunnelEndpointUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VXLANTunnelEndpointUpdate.ProtoReflect.Descriptor instead.
 func (*VXLANTunnelEndpointUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{63}
+	return file_felixbackend_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *VXLANTunnelEndpointUpdate) GetNode() string {
@@ -5304,7 +5542,7 @@ type VXLANTunnelEndpointRemove struct {
 
 func (x *VXLANTunnelEndpointRemove) Reset() {
 	*x = VXLANTunnelEndpointRemove{}
-	mi := &file_felixbackend_proto_msgTypes[64]
+	mi := &file_felixbackend_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5316,7 +5554,7 @@ func (x *VXLANTunnelEndpointRemove) String() string {
 func (*VXLANTunnelEndpointRemove) ProtoMessage() {}
 
 func (x *VXLANTunnelEndpointRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[64]
+	mi := &file_felixbackend_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5329,7 +5567,7 @@ func (x *VXLANTunnelEndpointRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VXLANTunnelEndpointRemove.ProtoReflect.Descriptor instead.
 func (*VXLANTunnelEndpointRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{64}
+	return file_felixbackend_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *VXLANTunnelEndpointRemove) GetNode() string {
@@ -5349,7 +5587,7 @@ type ReportResult struct {
 
 func (x *ReportResult) Reset() {
 	*x = ReportResult{}
-	mi := &file_felixbackend_proto_msgTypes[65]
+	mi := &file_felixbackend_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5361,7 +5599,7 @@ func (x *ReportResult) String() string {
 func (*ReportResult) ProtoMessage() {}
 
 func (x *ReportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[65]
+	mi := &file_felixbackend_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5374,7 +5612,7 @@ func (x *ReportResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportResult.ProtoReflect.Descriptor instead.
 func (*ReportResult) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{65}
+	return file_felixbackend_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ReportResult) GetSuccessful() bool {
@@ -5401,14 +5639,17 @@ type DataplaneStats struct {
 	// to profiles.
 	Rules []*RuleTrace `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
 	// Whether the flow was allowed or denied
-	Action        Action `protobuf:"varint,8,opt,name=action,proto3,enum=felix.Action" json:"action,omitempty"`
+	Action Action `protobuf:"varint,8,opt,name=action,proto3,enum=felix.Action" json:"action,omitempty"`
+	// The gRPC call that was made on the flow, set when the action was decided
+	// by an application layer rule.
+	GrpcCall      *GRPCCall `protobuf:"bytes,9,opt,name=grpc_call,json=grpcCall,proto3" json:"grpc_call,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DataplaneStats) Reset() {
 	*x = DataplaneStats{}
-	mi := &file_felixbackend_proto_msgTypes[66]
+	mi := &file_felixbackend_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5420,7 +5661,7 @@ func (x *DataplaneStats) String() string {
 func (*DataplaneStats) ProtoMessage() {}
 
 func (x *DataplaneStats) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[66]
+	mi := &file_felixbackend_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5433,7 +5674,7 @@ func (x *DataplaneStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataplaneStats.ProtoReflect.Descriptor instead.
 func (*DataplaneStats) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{66}
+	return file_felixbackend_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *DataplaneStats) GetSrcIp() string {
@@ -5492,6 +5733,66 @@ func (x *DataplaneStats) GetAction() Action {
 	return Action_ALLOWED
 }
 
+func (x *DataplaneStats) GetGrpcCall() *GRPCCall {
+	if x != nil {
+		return x.GrpcCall
+	}
+	return nil
+}
+
+// GRPCCall identifies a gRPC method.
+type GRPCCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GRPCCall) Reset() {
+	*x = GRPCCall{}
+	mi := &file_felixbackend_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GRPCCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GRPCCall) ProtoMessage() {}
+
+func (x *GRPCCall) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GRPCCall.ProtoReflect.Descriptor instead.
+func (*GRPCCall) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GRPCCall) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GRPCCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
 // Statistic is a piece of summary information for a flow.
 type Statistic struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
@@ -5509,7 +5810,7 @@ type Statistic struct {
 
 func (x *Statistic) Reset() {
 	*x = Statistic{}
-	mi := &file_felixbackend_proto_msgTypes[67]
+	mi := &file_felixbackend_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5521,7 +5822,7 @@ func (x *Statistic) String() string {
 func (*Statistic) ProtoMessage() {}
 
 func (x *Statistic) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[67]
+	mi := &file_felixbackend_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5534,7 +5835,7 @@ func (x *Statistic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Statistic.ProtoReflect.Descriptor instead.
 func (*Statistic) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{67}
+	return file_felixbackend_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Statistic) GetDirection() Statistic_Direction {
@@ -5590,7 +5891,7 @@ type RuleTrace struct {
 
 func (x *RuleTrace) Reset() {
 	*x = RuleTrace{}
-	mi := &file_felixbackend_proto_msgTypes[68]
+	mi := &file_felixbackend_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5602,7 +5903,7 @@ func (x *RuleTrace) String() string {
 func (*RuleTrace) ProtoMessage() {}
 
 func (x *RuleTrace) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[68]
+	mi := &file_felixbackend_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5615,7 +5916,7 @@ func (x *RuleTrace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleTrace.ProtoReflect.Descriptor instead.
 func (*RuleTrace) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{68}
+	return file_felixbackend_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RuleTrace) GetId() isRuleTrace_Id {
@@ -5701,13 +6002,19 @@ type WireguardEndpointUpdate struct {
 	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	// The IP address of the IPv4 wireguard interface.
 	InterfaceIpv4Addr string `protobuf:"bytes,3,opt,name=interface_ipv4_addr,json=interfaceIpv4Addr,proto3" json:"interface_ipv4_addr,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The public key that this endpoint is rotating to, only set while a key rotation is in progress.
+	NextPublicKey string `protobuf:"bytes,4,opt,name=next_public_key,json=nextPublicKey,proto3" json:"next_public_key,omitempty"`
+	// Time at which the current IPv4 key pair was generated, in seconds since the epoch.
+	PublicKeyTimestamp int64 `protobuf:"varint,5,opt,name=public_key_timestamp,json=publicKeyTimestamp,proto3" json:"public_key_timestamp,omitempty"`
+	// The next IPv4 public keys of rotating peers that this endpoint has programmed.
+	AcceptedNextPublicKeys []string `protobuf:"bytes,6,rep,name=accepted_next_public_keys,json=acceptedNextPublicKeys,proto3" json:"accepted_next_public_keys,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *WireguardEndpointUpdate) Reset() {
 	*x = WireguardEndpointUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[69]
+	mi := &file_felixbackend_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5719,7 +6026,7 @@ func (x *WireguardEndpointUpdate) String() string {
 func (*WireguardEndpointUpdate) ProtoMessage() {}
 
 func (x *WireguardEndpointUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[69]
+	mi := &file_felixbackend_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5732,7 +6039,7 @@ func (x *WireguardEndpointUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardEndpointUpdate.ProtoReflect.Descriptor instead.
 func (*WireguardEndpointUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{69}
+	return file_felixbackend_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *WireguardEndpointUpdate) GetHostname() string {
@@ -5756,19 +6063,40 @@ func (x *WireguardEndpointUpdate) GetInterfaceIpv4Addr() string {
 	return ""
 }
 
-type WireguardEndpointRemove struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the IPv4 wireguard host.
-	Hostname      string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WireguardEndpointUpdate) GetNextPublicKey() string {
+	if x != nil {
+		return x.NextPublicKey
+	}
+	return ""
 }
 
-func (x *WireguardEndpointRemove) Reset() {
-	*x = WireguardEndpointRemove{}
-	mi := &file_felixbackend_proto_msgTypes[70]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WireguardEndpointUpdate) GetPublicKeyTimestamp() int64 {
+	if x != nil {
+		return x.PublicKeyTimestamp
+	}
+	return 0
+}
+
+func (x *WireguardEndpointUpdate) GetAcceptedNextPublicKeys() []string {
+	if x != nil {
+		return x.AcceptedNextPublicKeys
+	}
+	return nil
+}
+
+type WireguardEndpointRemove struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the IPv4 wireguard host.
+	Hostname      string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WireguardEndpointRemove) Reset() {
+	*x = WireguardEndpointRemove{}
+	mi := &file_felixbackend_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *WireguardEndpointRemove) String() string {
@@ -5778,7 +6106,7 @@ func (x *WireguardEndpointRemove) String() string {
 func (*WireguardEndpointRemove) ProtoMessage() {}
 
 func (x *WireguardEndpointRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[70]
+	mi := &file_felixbackend_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5791,7 +6119,7 @@ func (x *WireguardEndpointRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardEndpointRemove.ProtoReflect.Descriptor instead.
 func (*WireguardEndpointRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{70}
+	return file_felixbackend_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *WireguardEndpointRemove) GetHostname() string {
@@ -5809,13 +6137,19 @@ type WireguardEndpointV6Update struct {
 	PublicKeyV6 string `protobuf:"bytes,2,opt,name=public_key_v6,json=publicKeyV6,proto3" json:"public_key_v6,omitempty"`
 	// The IP address of the IPv6 wireguard interface.
 	InterfaceIpv6Addr string `protobuf:"bytes,3,opt,name=interface_ipv6_addr,json=interfaceIpv6Addr,proto3" json:"interface_ipv6_addr,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The public key that this endpoint is rotating to, only set while a key rotation is in progress.
+	NextPublicKeyV6 string `protobuf:"bytes,4,opt,name=next_public_key_v6,json=nextPublicKeyV6,proto3" json:"next_public_key_v6,omitempty"`
+	// Time at which the current IPv6 key pair was generated, in seconds since the epoch.
+	PublicKeyTimestamp int64 `protobuf:"varint,5,opt,name=public_key_timestamp,json=publicKeyTimestamp,proto3" json:"public_key_timestamp,omitempty"`
+	// The next IPv6 public keys of rotating peers that this endpoint has programmed.
+	AcceptedNextPublicKeysV6 []string `protobuf:"bytes,6,rep,name=accepted_next_public_keys_v6,json=acceptedNextPublicKeysV6,proto3" json:"accepted_next_public_keys_v6,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *WireguardEndpointV6Update) Reset() {
 	*x = WireguardEndpointV6Update{}
-	mi := &file_felixbackend_proto_msgTypes[71]
+	mi := &file_felixbackend_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5827,7 +6161,7 @@ func (x *WireguardEndpointV6Update) String() string {
 func (*WireguardEndpointV6Update) ProtoMessage() {}
 
 func (x *WireguardEndpointV6Update) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[71]
+	mi := &file_felixbackend_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5840,7 +6174,7 @@ func (x *WireguardEndpointV6Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardEndpointV6Update.ProtoReflect.Descriptor instead.
 func (*WireguardEndpointV6Update) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{71}
+	return file_felixbackend_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *WireguardEndpointV6Update) GetHostname() string {
@@ -5864,6 +6198,27 @@ func (x *WireguardEndpointV6Update) GetInterfaceIpv6Addr() string {
 	return ""
 }
 
+func (x *WireguardEndpointV6Update) GetNextPublicKeyV6() string {
+	if x != nil {
+		return x.NextPublicKeyV6
+	}
+	return ""
+}
+
+func (x *WireguardEndpointV6Update) GetPublicKeyTimestamp() int64 {
+	if x != nil {
+		return x.PublicKeyTimestamp
+	}
+	return 0
+}
+
+func (x *WireguardEndpointV6Update) GetAcceptedNextPublicKeysV6() []string {
+	if x != nil {
+		return x.AcceptedNextPublicKeysV6
+	}
+	return nil
+}
+
 type WireguardEndpointV6Remove struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the IPv6 wireguard host.
@@ -5874,7 +6229,7 @@ type WireguardEndpointV6Remove struct {
 
 func (x *WireguardEndpointV6Remove) Reset() {
 	*x = WireguardEndpointV6Remove{}
-	mi := &file_felixbackend_proto_msgTypes[72]
+	mi := &file_felixbackend_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5886,7 +6241,7 @@ func (x *WireguardEndpointV6Remove) String() string {
 func (*WireguardEndpointV6Remove) ProtoMessage() {}
 
 func (x *WireguardEndpointV6Remove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[72]
+	mi := &file_felixbackend_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5899,7 +6254,7 @@ func (x *WireguardEndpointV6Remove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WireguardEndpointV6Remove.ProtoReflect.Descriptor instead.
 func (*WireguardEndpointV6Remove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{72}
+	return file_felixbackend_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *WireguardEndpointV6Remove) GetHostname() string {
@@ -5922,7 +6277,7 @@ type GlobalBGPConfigUpdate struct {
 
 func (x *GlobalBGPConfigUpdate) Reset() {
 	*x = GlobalBGPConfigUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[73]
+	mi := &file_felixbackend_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5934,7 +6289,7 @@ func (x *GlobalBGPConfigUpdate) String() string {
 func (*GlobalBGPConfigUpdate) ProtoMessage() {}
 
 func (x *GlobalBGPConfigUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[73]
+	mi := &file_felixbackend_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5947,7 +6302,7 @@ func (x *GlobalBGPConfigUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GlobalBGPConfigUpdate.ProtoReflect.Descriptor instead.
 func (*GlobalBGPConfigUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{73}
+	return file_felixbackend_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GlobalBGPConfigUpdate) GetServiceClusterCidrs() []string {
@@ -5996,7 +6351,7 @@ type ServicePort struct {
 
 func (x *ServicePort) Reset() {
 	*x = ServicePort{}
-	mi := &file_felixbackend_proto_msgTypes[74]
+	mi := &file_felixbackend_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6008,7 +6363,7 @@ func (x *ServicePort) String() string {
 func (*ServicePort) ProtoMessage() {}
 
 func (x *ServicePort) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[74]
+	mi := &file_felixbackend_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6021,7 +6376,7 @@ func (x *ServicePort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
 func (*ServicePort) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{74}
+	return file_felixbackend_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ServicePort) GetProtocol() string {
@@ -6060,7 +6415,7 @@ type ServiceUpdate struct {
 
 func (x *ServiceUpdate) Reset() {
 	*x = ServiceUpdate{}
-	mi := &file_felixbackend_proto_msgTypes[75]
+	mi := &file_felixbackend_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6072,7 +6427,7 @@ func (x *ServiceUpdate) String() string {
 func (*ServiceUpdate) ProtoMessage() {}
 
 func (x *ServiceUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[75]
+	mi := &file_felixbackend_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6085,7 +6440,7 @@ func (x *ServiceUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceUpdate.ProtoReflect.Descriptor instead.
 func (*ServiceUpdate) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{75}
+	return file_felixbackend_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ServiceUpdate) GetName() string {
@@ -6147,7 +6502,7 @@ type ServiceRemove struct {
 
 func (x *ServiceRemove) Reset() {
 	*x = ServiceRemove{}
-	mi := &file_felixbackend_proto_msgTypes[76]
+	mi := &file_felixbackend_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6159,7 +6514,7 @@ func (x *ServiceRemove) String() string {
 func (*ServiceRemove) ProtoMessage() {}
 
 func (x *ServiceRemove) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[76]
+	mi := &file_felixbackend_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6172,7 +6527,7 @@ func (x *ServiceRemove) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceRemove.ProtoReflect.Descriptor instead.
 func (*ServiceRemove) Descriptor() ([]byte, []int) {
-	return file_felixbackend_proto_rawDescGZIP(), []int{76}
+	return file_felixbackend_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ServiceRemove) GetName() string {
@@ -6189,12 +6544,198 @@ func (x *ServiceRemove) GetNamespace() string {
 	return ""
 }
 
+type PacketCaptureID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PacketCaptureID) Reset() {
+	*x = PacketCaptureID{}
+	mi := &file_felixbackend_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PacketCaptureID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptureID) ProtoMessage() {}
+
+func (x *PacketCaptureID) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptureID.ProtoReflect.Descriptor instead.
+func (*PacketCaptureID) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PacketCaptureID) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PacketCaptureID) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PacketCaptureUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    *PacketCaptureID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// BPF filter expression, in tcpdump syntax; empty to capture all packets.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Time at which the capture should stop, in seconds since the epoch; 0 for no limit.
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Size at which capture files are rotated; 0 to use Felix's default.
+	MaxFileSizeBytes int64 `protobuf:"varint,4,opt,name=max_file_size_bytes,json=maxFileSizeBytes,proto3" json:"max_file_size_bytes,omitempty"`
+	// Number of capture files to keep per interface; 0 to use Felix's default.
+	MaxFiles int32 `protobuf:"varint,5,opt,name=max_files,json=maxFiles,proto3" json:"max_files,omitempty"`
+	// Local workload endpoints that the capture selects.
+	Endpoints     []*WorkloadEndpointID `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PacketCaptureUpdate) Reset() {
+	*x = PacketCaptureUpdate{}
+	mi := &file_felixbackend_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PacketCaptureUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptureUpdate) ProtoMessage() {}
+
+func (x *PacketCaptureUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptureUpdate.ProtoReflect.Descriptor instead.
+func (*PacketCaptureUpdate) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PacketCaptureUpdate) GetId() *PacketCaptureID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *PacketCaptureUpdate) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *PacketCaptureUpdate) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *PacketCaptureUpdate) GetMaxFileSizeBytes() int64 {
+	if x != nil {
+		return x.MaxFileSizeBytes
+	}
+	return 0
+}
+
+func (x *PacketCaptureUpdate) GetMaxFiles() int32 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *PacketCaptureUpdate) GetEndpoints() []*WorkloadEndpointID {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type PacketCaptureRemove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *PacketCaptureID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PacketCaptureRemove) Reset() {
+	*x = PacketCaptureRemove{}
+	mi := &file_felixbackend_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PacketCaptureRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PacketCaptureRemove) ProtoMessage() {}
+
+func (x *PacketCaptureRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PacketCaptureRemove.ProtoReflect.Descriptor instead.
+func (*PacketCaptureRemove) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PacketCaptureRemove) GetId() *PacketCaptureID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
 type HTTPMatch_PathMatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to PathMatch:
 	//
 	//	*HTTPMatch_PathMatch_Exact
 	//	*HTTPMatch_PathMatch_Prefix
+	//	*HTTPMatch_PathMatch_Regex
 	PathMatch     isHTTPMatch_PathMatch_PathMatch `protobuf_oneof:"path_match"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -6202,7 +6743,7 @@ type HTTPMatch_PathMatch struct {
 
 func (x *HTTPMatch_PathMatch) Reset() {
 	*x = HTTPMatch_PathMatch{}
-	mi := &file_felixbackend_proto_msgTypes[80]
+	mi := &file_felixbackend_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6214,7 +6755,7 @@ func (x *HTTPMatch_PathMatch) String() string {
 func (*HTTPMatch_PathMatch) ProtoMessage() {}
 
 func (x *HTTPMatch_PathMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_felixbackend_proto_msgTypes[80]
+	mi := &file_felixbackend_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6255,6 +6796,15 @@ func (x *HTTPMatch_PathMatch) GetPrefix() string {
 	return ""
 }
 
+func (x *HTTPMatch_PathMatch) GetRegex() string {
+	if x != nil {
+		if x, ok := x.PathMatch.(*HTTPMatch_PathMatch_Regex); ok {
+			return x.Regex
+		}
+	}
+	return ""
+}
+
 type isHTTPMatch_PathMatch_PathMatch interface {
 	isHTTPMatch_PathMatch_PathMatch()
 }
@@ -6267,16 +6817,273 @@ type HTTPMatch_PathMatch_Prefix struct {
 	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
 }
 
+type HTTPMatch_PathMatch_Regex struct {
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof"`
+}
+
 func (*HTTPMatch_PathMatch_Exact) isHTTPMatch_PathMatch_PathMatch() {}
 
 func (*HTTPMatch_PathMatch_Prefix) isHTTPMatch_PathMatch_PathMatch() {}
 
+func (*HTTPMatch_PathMatch_Regex) isHTTPMatch_PathMatch_PathMatch() {}
+
+// KeyValueMatch matches a header or query parameter.
+type HTTPMatch_KeyValueMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to ValueMatch:
+	//
+	//	*HTTPMatch_KeyValueMatch_Exact
+	//	*HTTPMatch_KeyValueMatch_Prefix
+	//	*HTTPMatch_KeyValueMatch_Regex
+	//	*HTTPMatch_KeyValueMatch_Present
+	ValueMatch    isHTTPMatch_KeyValueMatch_ValueMatch `protobuf_oneof:"value_match"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPMatch_KeyValueMatch) Reset() {
+	*x = HTTPMatch_KeyValueMatch{}
+	mi := &file_felixbackend_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPMatch_KeyValueMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPMatch_KeyValueMatch) ProtoMessage() {}
+
+func (x *HTTPMatch_KeyValueMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPMatch_KeyValueMatch.ProtoReflect.Descriptor instead.
+func (*HTTPMatch_KeyValueMatch) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *HTTPMatch_KeyValueMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPMatch_KeyValueMatch) GetValueMatch() isHTTPMatch_KeyValueMatch_ValueMatch {
+	if x != nil {
+		return x.ValueMatch
+	}
+	return nil
+}
+
+func (x *HTTPMatch_KeyValueMatch) GetExact() string {
+	if x != nil {
+		if x, ok := x.ValueMatch.(*HTTPMatch_KeyValueMatch_Exact); ok {
+			return x.Exact
+		}
+	}
+	return ""
+}
+
+func (x *HTTPMatch_KeyValueMatch) GetPrefix() string {
+	if x != nil {
+		if x, ok := x.ValueMatch.(*HTTPMatch_KeyValueMatch_Prefix); ok {
+			return x.Prefix
+		}
+	}
+	return ""
+}
+
+func (x *HTTPMatch_KeyValueMatch) GetRegex() string {
+	if x != nil {
+		if x, ok := x.ValueMatch.(*HTTPMatch_KeyValueMatch_Regex); ok {
+			return x.Regex
+		}
+	}
+	return ""
+}
+
+func (x *HTTPMatch_KeyValueMatch) GetPresent() bool {
+	if x != nil {
+		if x, ok := x.ValueMatch.(*HTTPMatch_KeyValueMatch_Present); ok {
+			return x.Present
+		}
+	}
+	return false
+}
+
+type isHTTPMatch_KeyValueMatch_ValueMatch interface {
+	isHTTPMatch_KeyValueMatch_ValueMatch()
+}
+
+type HTTPMatch_KeyValueMatch_Exact struct {
+	Exact string `protobuf:"bytes,2,opt,name=exact,proto3,oneof"`
+}
+
+type HTTPMatch_KeyValueMatch_Prefix struct {
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3,oneof"`
+}
+
+type HTTPMatch_KeyValueMatch_Regex struct {
+	Regex string `protobuf:"bytes,4,opt,name=regex,proto3,oneof"`
+}
+
+type HTTPMatch_KeyValueMatch_Present struct {
+	Present bool `protobuf:"varint,5,opt,name=present,proto3,oneof"`
+}
+
+func (*HTTPMatch_KeyValueMatch_Exact) isHTTPMatch_KeyValueMatch_ValueMatch() {}
+
+func (*HTTPMatch_KeyValueMatch_Prefix) isHTTPMatch_KeyValueMatch_ValueMatch() {}
+
+func (*HTTPMatch_KeyValueMatch_Regex) isHTTPMatch_KeyValueMatch_ValueMatch() {}
+
+func (*HTTPMatch_KeyValueMatch_Present) isHTTPMatch_KeyValueMatch_ValueMatch() {}
+
+type HTTPMatch_JWTMatch struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Jwks          string                           `protobuf:"bytes,1,opt,name=jwks,proto3" json:"jwks,omitempty"`
+	Issuer        string                           `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Audiences     []string                         `protobuf:"bytes,3,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	Claims        []*HTTPMatch_JWTMatch_ClaimMatch `protobuf:"bytes,4,rep,name=claims,proto3" json:"claims,omitempty"`
+	Algorithms    []string                         `protobuf:"bytes,5,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPMatch_JWTMatch) Reset() {
+	*x = HTTPMatch_JWTMatch{}
+	mi := &file_felixbackend_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPMatch_JWTMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPMatch_JWTMatch) ProtoMessage() {}
+
+func (x *HTTPMatch_JWTMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPMatch_JWTMatch.ProtoReflect.Descriptor instead.
+func (*HTTPMatch_JWTMatch) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *HTTPMatch_JWTMatch) GetJwks() string {
+	if x != nil {
+		return x.Jwks
+	}
+	return ""
+}
+
+func (x *HTTPMatch_JWTMatch) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *HTTPMatch_JWTMatch) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (x *HTTPMatch_JWTMatch) GetClaims() []*HTTPMatch_JWTMatch_ClaimMatch {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *HTTPMatch_JWTMatch) GetAlgorithms() []string {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+type HTTPMatch_JWTMatch_ClaimMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPMatch_JWTMatch_ClaimMatch) Reset() {
+	*x = HTTPMatch_JWTMatch_ClaimMatch{}
+	mi := &file_felixbackend_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPMatch_JWTMatch_ClaimMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPMatch_JWTMatch_ClaimMatch) ProtoMessage() {}
+
+func (x *HTTPMatch_JWTMatch_ClaimMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_felixbackend_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPMatch_JWTMatch_ClaimMatch.ProtoReflect.Descriptor instead.
+func (*HTTPMatch_JWTMatch_ClaimMatch) Descriptor() ([]byte, []int) {
+	return file_felixbackend_proto_rawDescGZIP(), []int{19, 2, 0}
+}
+
+func (x *HTTPMatch_JWTMatch_ClaimMatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPMatch_JWTMatch_ClaimMatch) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_felixbackend_proto protoreflect.FileDescriptor
 
 const file_felixbackend_proto_rawDesc = "" +
 	"\n" +
 	"\x12felixbackend.proto\x12\x05felix\"\r\n" +
-	"\vSyncRequest\"\xcb\x16\n" +
+	"\vSyncRequest\"\xef\x17\n" +
 	"\vToDataplane\x12'\n" +
 	"\x0fsequence_number\x18\x0f \x01(\x04R\x0esequenceNumber\x12(\n" +
 	"\ain_sync\x18\x01 \x01(\v2\r.felix.InSyncH\x00R\x06inSync\x127\n" +
@@ -6318,7 +7125,9 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\x1cwireguard_endpoint_v6_update\x18! \x01(\v2 .felix.WireguardEndpointV6UpdateH\x00R\x19wireguardEndpointV6Update\x12c\n" +
 	"\x1cwireguard_endpoint_v6_remove\x18\" \x01(\v2 .felix.WireguardEndpointV6RemoveH\x00R\x19wireguardEndpointV6Remove\x12T\n" +
 	"\x17host_metadata_v6_update\x18# \x01(\v2\x1b.felix.HostMetadataV6UpdateH\x00R\x14hostMetadataV6Update\x12T\n" +
-	"\x17host_metadata_v6_remove\x18$ \x01(\v2\x1b.felix.HostMetadataV6RemoveH\x00R\x14hostMetadataV6RemoveB\t\n" +
+	"\x17host_metadata_v6_remove\x18$ \x01(\v2\x1b.felix.HostMetadataV6RemoveH\x00R\x14hostMetadataV6Remove\x12P\n" +
+	"\x15packet_capture_update\x18' \x01(\v2\x1a.felix.PacketCaptureUpdateH\x00R\x13packetCaptureUpdate\x12P\n" +
+	"\x15packet_capture_remove\x18( \x01(\v2\x1a.felix.PacketCaptureRemoveH\x00R\x13packetCaptureRemoveB\t\n" +
 	"\apayload\"\xd3\x05\n" +
 	"\rFromDataplane\x12'\n" +
 	"\x0fsequence_number\x18\b \x01(\x04R\x0esequenceNumber\x12P\n" +
@@ -6386,7 +7195,7 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\x0eoutbound_rules\x18\x02 \x03(\v2\v.felix.RuleR\routboundRules\x12\x1c\n" +
 	"\tuntracked\x18\x03 \x01(\bR\tuntracked\x12\x19\n" +
 	"\bpre_dnat\x18\x04 \x01(\bR\apreDnat\x12+\n" +
-	"\x11original_selector\x18\x06 \x01(\tR\x10originalSelector\"\xaa\x10\n" +
+	"\x11original_selector\x18\x06 \x01(\tR\x10originalSelector\"\x83\x11\n" +
 	"\x04Rule\x12\x16\n" +
 	"\x06action\x18\x01 \x01(\tR\x06action\x12/\n" +
 	"\n" +
@@ -6429,6 +7238,9 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\x19dst_service_account_match\x18y \x01(\v2\x1a.felix.ServiceAccountMatchR\x16dstServiceAccountMatch\x12/\n" +
 	"\n" +
 	"http_match\x18z \x01(\v2\x10.felix.HTTPMatchR\thttpMatch\x12/\n" +
+	"\n" +
+	"grpc_match\x18| \x01(\v2\x10.felix.GRPCMatchR\tgrpcMatch\x12&\n" +
+	"\x05limit\x18} \x01(\v2\x10.felix.RuleLimitR\x05limit\x12/\n" +
 	"\bmetadata\x18{ \x01(\v2\x13.felix.RuleMetadataR\bmetadata\x12\x18\n" +
 	"\arule_id\x18\xc9\x01 \x01(\tR\x06ruleIdB\x06\n" +
 	"\x04icmpB\n" +
@@ -6437,15 +7249,47 @@ const file_felixbackend_proto_rawDesc = "" +
 	"log_prefix\"G\n" +
 	"\x13ServiceAccountMatch\x12\x1a\n" +
 	"\bselector\x18\x01 \x01(\tR\bselector\x12\x14\n" +
-	"\x05names\x18\x02 \x03(\tR\x05names\"\xa4\x01\n" +
+	"\x05names\x18\x02 \x03(\tR\x05names\"\x86\x06\n" +
 	"\tHTTPMatch\x12\x18\n" +
 	"\amethods\x18\x01 \x03(\tR\amethods\x120\n" +
-	"\x05paths\x18\x02 \x03(\v2\x1a.felix.HTTPMatch.PathMatchR\x05paths\x1aK\n" +
+	"\x05paths\x18\x02 \x03(\v2\x1a.felix.HTTPMatch.PathMatchR\x05paths\x12\x14\n" +
+	"\x05hosts\x18\x03 \x03(\tR\x05hosts\x128\n" +
+	"\aheaders\x18\x04 \x03(\v2\x1e.felix.HTTPMatch.KeyValueMatchR\aheaders\x12A\n" +
+	"\fquery_params\x18\x05 \x03(\v2\x1e.felix.HTTPMatch.KeyValueMatchR\vqueryParams\x12+\n" +
+	"\x03jwt\x18\x06 \x01(\v2\x19.felix.HTTPMatch.JWTMatchR\x03jwt\x1ac\n" +
 	"\tPathMatch\x12\x16\n" +
 	"\x05exact\x18\x01 \x01(\tH\x00R\x05exact\x12\x18\n" +
-	"\x06prefix\x18\x02 \x01(\tH\x00R\x06prefixB\f\n" +
+	"\x06prefix\x18\x02 \x01(\tH\x00R\x06prefix\x12\x16\n" +
+	"\x05regex\x18\x03 \x01(\tH\x00R\x05regexB\f\n" +
+	"\n" +
+	"path_match\x1a\x98\x01\n" +
+	"\rKeyValueMatch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x05exact\x18\x02 \x01(\tH\x00R\x05exact\x12\x18\n" +
+	"\x06prefix\x18\x03 \x01(\tH\x00R\x06prefix\x12\x16\n" +
+	"\x05regex\x18\x04 \x01(\tH\x00R\x05regex\x12\x1a\n" +
+	"\apresent\x18\x05 \x01(\bH\x00R\apresentB\r\n" +
+	"\vvalue_match\x1a\xec\x01\n" +
+	"\bJWTMatch\x12\x12\n" +
+	"\x04jwks\x18\x01 \x01(\tR\x04jwks\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x1c\n" +
+	"\taudiences\x18\x03 \x03(\tR\taudiences\x12<\n" +
+	"\x06claims\x18\x04 \x03(\v2$.felix.HTTPMatch.JWTMatch.ClaimMatchR\x06claims\x12\x1e\n" +
+	"\n" +
+	"algorithms\x18\x05 \x03(\tR\n" +
+	"algorithms\x1a8\n" +
 	"\n" +
-	"path_match\"\x96\x01\n" +
+	"ClaimMatch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"A\n" +
+	"\tGRPCMatch\x12\x1a\n" +
+	"\bservices\x18\x01 \x03(\tR\bservices\x12\x18\n" +
+	"\amethods\x18\x02 \x03(\tR\amethods\"\xa2\x01\n" +
+	"\tRuleLimit\x12;\n" +
+	"\x1anew_connections_per_second\x18\x01 \x01(\rR\x17newConnectionsPerSecond\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\rR\x05burst\x12'\n" +
+	"\x0fmax_connections\x18\x03 \x01(\rR\x0emaxConnections\x12\x19\n" +
+	"\bper_rule\x18\x04 \x01(\bR\aperRule\"\x96\x01\n" +
 	"\fRuleMetadata\x12F\n" +
 	"\vannotations\x18\x01 \x03(\v2$.felix.RuleMetadata.AnnotationsEntryR\vannotations\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
@@ -6544,12 +7388,15 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\v2\x15.felix.EndpointStatusR\x06status\x123\n" +
 	"\bendpoint\x18\x03 \x01(\v2\x17.felix.WorkloadEndpointR\bendpoint\"I\n" +
 	"\x1cWorkloadEndpointStatusRemove\x12)\n" +
-	"\x02id\x18\x01 \x01(\v2\x19.felix.WorkloadEndpointIDR\x02id\"g\n" +
+	"\x02id\x18\x01 \x01(\v2\x19.felix.WorkloadEndpointIDR\x02id\"\xfc\x01\n" +
 	"\x15WireguardStatusUpdate\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x01 \x01(\tR\tpublicKey\x12/\n" +
 	"\n" +
-	"ip_version\x18\x02 \x01(\x0e2\x10.felix.IPVersionR\tipVersion\"\x11\n" +
+	"ip_version\x18\x02 \x01(\x0e2\x10.felix.IPVersionR\tipVersion\x12&\n" +
+	"\x0fnext_public_key\x18\x03 \x01(\tR\rnextPublicKey\x120\n" +
+	"\x14public_key_timestamp\x18\x04 \x01(\x03R\x12publicKeyTimestamp\x129\n" +
+	"\x19accepted_next_public_keys\x18\x05 \x03(\tR\x16acceptedNextPublicKeys\"\x11\n" +
 	"\x0fDataplaneInSync\"\x88\x02\n" +
 	"\x16HostMetadataV4V6Update\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1b\n" +
@@ -6648,7 +7495,7 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\fReportResult\x12\x1e\n" +
 	"\n" +
 	"successful\x18\x01 \x01(\bR\n" +
-	"successful\"\x98\x02\n" +
+	"successful\"\xc6\x02\n" +
 	"\x0eDataplaneStats\x12\x15\n" +
 	"\x06src_ip\x18\x01 \x01(\tR\x05srcIp\x12\x15\n" +
 	"\x06dst_ip\x18\x02 \x01(\tR\x05dstIp\x12\x19\n" +
@@ -6657,7 +7504,11 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\bprotocol\x18\x05 \x01(\v2\x0f.felix.ProtocolR\bprotocol\x12&\n" +
 	"\x05stats\x18\x06 \x03(\v2\x10.felix.StatisticR\x05stats\x12&\n" +
 	"\x05rules\x18\a \x03(\v2\x10.felix.RuleTraceR\x05rules\x12%\n" +
-	"\x06action\x18\b \x01(\x0e2\r.felix.ActionR\x06action\"\xcf\x02\n" +
+	"\x06action\x18\b \x01(\x0e2\r.felix.ActionR\x06action\x12,\n" +
+	"\tgrpc_call\x18\t \x01(\v2\x0f.felix.GRPCCallR\bgrpcCall\"<\n" +
+	"\bGRPCCall\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\"\xcf\x02\n" +
 	"\tStatistic\x128\n" +
 	"\tdirection\x18\x01 \x01(\x0e2\x1a.felix.Statistic.DirectionR\tdirection\x12;\n" +
 	"\n" +
@@ -6685,18 +7536,24 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\tDirection\x12\v\n" +
 	"\aINBOUND\x10\x00\x12\f\n" +
 	"\bOUTBOUND\x10\x01B\x04\n" +
-	"\x02id\"\x84\x01\n" +
+	"\x02id\"\x99\x02\n" +
 	"\x17WireguardEndpointUpdate\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x02 \x01(\tR\tpublicKey\x12.\n" +
-	"\x13interface_ipv4_addr\x18\x03 \x01(\tR\x11interfaceIpv4Addr\"5\n" +
+	"\x13interface_ipv4_addr\x18\x03 \x01(\tR\x11interfaceIpv4Addr\x12&\n" +
+	"\x0fnext_public_key\x18\x04 \x01(\tR\rnextPublicKey\x120\n" +
+	"\x14public_key_timestamp\x18\x05 \x01(\x03R\x12publicKeyTimestamp\x129\n" +
+	"\x19accepted_next_public_keys\x18\x06 \x03(\tR\x16acceptedNextPublicKeys\"5\n" +
 	"\x17WireguardEndpointRemove\x12\x1a\n" +
-	"\bhostname\x18\x01 \x01(\tR\bhostname\"\x8b\x01\n" +
+	"\bhostname\x18\x01 \x01(\tR\bhostname\"\xaa\x02\n" +
 	"\x19WireguardEndpointV6Update\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\"\n" +
 	"\rpublic_key_v6\x18\x02 \x01(\tR\vpublicKeyV6\x12.\n" +
-	"\x13interface_ipv6_addr\x18\x03 \x01(\tR\x11interfaceIpv6Addr\"7\n" +
+	"\x13interface_ipv6_addr\x18\x03 \x01(\tR\x11interfaceIpv6Addr\x12+\n" +
+	"\x12next_public_key_v6\x18\x04 \x01(\tR\x0fnextPublicKeyV6\x120\n" +
+	"\x14public_key_timestamp\x18\x05 \x01(\x03R\x12publicKeyTimestamp\x12>\n" +
+	"\x1caccepted_next_public_keys_v6\x18\x06 \x03(\tR\x18acceptedNextPublicKeysV6\"7\n" +
 	"\x19WireguardEndpointV6Remove\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\"\xbf\x02\n" +
 	"\x15GlobalBGPConfigUpdate\x122\n" +
@@ -6720,7 +7577,19 @@ const file_felixbackend_proto_rawDesc = "" +
 	"\x05ports\x18\a \x03(\v2\x12.felix.ServicePortR\x05ports\"A\n" +
 	"\rServiceRemove\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace*(\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"C\n" +
+	"\x0fPacketCaptureID\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xf5\x01\n" +
+	"\x13PacketCaptureUpdate\x12&\n" +
+	"\x02id\x18\x01 \x01(\v2\x16.felix.PacketCaptureIDR\x02id\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x03R\aendTime\x12-\n" +
+	"\x13max_file_size_bytes\x18\x04 \x01(\x03R\x10maxFileSizeBytes\x12\x1b\n" +
+	"\tmax_files\x18\x05 \x01(\x05R\bmaxFiles\x127\n" +
+	"\tendpoints\x18\x06 \x03(\v2\x19.felix.WorkloadEndpointIDR\tendpoints\"=\n" +
+	"\x13PacketCaptureRemove\x12&\n" +
+	"\x02id\x18\x01 \x01(\v2\x16.felix.PacketCaptureIDR\x02id*(\n" +
 	"\tIPVersion\x12\a\n" +
 	"\x03ANY\x10\x00\x12\b\n" +
 	"\x04IPV4\x10\x04\x12\b\n" +
@@ -6765,104 +7634,113 @@ func file_felixbackend_proto_rawDescGZIP() []byte {
 }
 
 var file_felixbackend_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_felixbackend_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_felixbackend_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
 var file_felixbackend_proto_goTypes = []any{
-	(IPVersion)(0),                       // 0: felix.IPVersion
-	(WorkloadType)(0),                    // 1: felix.WorkloadType
-	(RouteType)(0),                       // 2: felix.RouteType
-	(IPPoolType)(0),                      // 3: felix.IPPoolType
-	(Action)(0),                          // 4: felix.Action
-	(IPSetUpdate_IPSetType)(0),           // 5: felix.IPSetUpdate.IPSetType
-	(Statistic_Direction)(0),             // 6: felix.Statistic.Direction
-	(Statistic_Relativity)(0),            // 7: felix.Statistic.Relativity
-	(Statistic_Kind)(0),                  // 8: felix.Statistic.Kind
-	(RuleTrace_Direction)(0),             // 9: felix.RuleTrace.Direction
-	(*SyncRequest)(nil),                  // 10: felix.SyncRequest
-	(*ToDataplane)(nil),                  // 11: felix.ToDataplane
-	(*FromDataplane)(nil),                // 12: felix.FromDataplane
-	(*ConfigUpdate)(nil),                 // 13: felix.ConfigUpdate
-	(*RawConfig)(nil),                    // 14: felix.RawConfig
-	(*InSync)(nil),                       // 15: felix.InSync
-	(*IPSetUpdate)(nil),                  // 16: felix.IPSetUpdate
-	(*IPSetDeltaUpdate)(nil),             // 17: felix.IPSetDeltaUpdate
-	(*IPSetRemove)(nil),                  // 18: felix.IPSetRemove
-	(*ActiveProfileUpdate)(nil),          // 19: felix.ActiveProfileUpdate
-	(*ActiveProfileRemove)(nil),          // 20: felix.ActiveProfileRemove
-	(*ProfileID)(nil),                    // 21: felix.ProfileID
-	(*Profile)(nil),                      // 22: felix.Profile
-	(*ActivePolicyUpdate)(nil),           // 23: felix.ActivePolicyUpdate
-	(*ActivePolicyRemove)(nil),           // 24: felix.ActivePolicyRemove
-	(*PolicyID)(nil),                     // 25: felix.PolicyID
-	(*Policy)(nil),                       // 26: felix.Policy
-	(*Rule)(nil),                         // 27: felix.Rule
-	(*ServiceAccountMatch)(nil),          // 28: felix.ServiceAccountMatch
-	(*HTTPMatch)(nil),                    // 29: felix.HTTPMatch
-	(*RuleMetadata)(nil),                 // 30: felix.RuleMetadata
-	(*IcmpTypeAndCode)(nil),              // 31: felix.IcmpTypeAndCode
-	(*Protocol)(nil),                     // 32: felix.Protocol
-	(*PortRange)(nil),                    // 33: felix.PortRange
-	(*WorkloadEndpointID)(nil),           // 34: felix.WorkloadEndpointID
-	(*WorkloadEndpointUpdate)(nil),       // 35: felix.WorkloadEndpointUpdate
-	(*WorkloadEndpoint)(nil),             // 36: felix.WorkloadEndpoint
-	(*QoSControls)(nil),                  // 37: felix.QoSControls
-	(*LocalBGPPeer)(nil),                 // 38: felix.LocalBGPPeer
-	(*WorkloadEndpointRemove)(nil),       // 39: felix.WorkloadEndpointRemove
-	(*HostEndpointID)(nil),               // 40: felix.HostEndpointID
-	(*HostEndpointUpdate)(nil),           // 41: felix.HostEndpointUpdate
-	(*HostEndpoint)(nil),                 // 42: felix.HostEndpoint
-	(*HostEndpointRemove)(nil),           // 43: felix.HostEndpointRemove
-	(*TierInfo)(nil),                     // 44: felix.TierInfo
-	(*NatInfo)(nil),                      // 45: felix.NatInfo
-	(*ProcessStatusUpdate)(nil),          // 46: felix.ProcessStatusUpdate
-	(*HostEndpointStatusUpdate)(nil),     // 47: felix.HostEndpointStatusUpdate
-	(*EndpointStatus)(nil),               // 48: felix.EndpointStatus
-	(*HostEndpointStatusRemove)(nil),     // 49: felix.HostEndpointStatusRemove
-	(*WorkloadEndpointStatusUpdate)(nil), // 50: felix.WorkloadEndpointStatusUpdate
-	(*WorkloadEndpointStatusRemove)(nil), // 51: felix.WorkloadEndpointStatusRemove
-	(*WireguardStatusUpdate)(nil),        // 52: felix.WireguardStatusUpdate
-	(*DataplaneInSync)(nil),              // 53: felix.DataplaneInSync
-	(*HostMetadataV4V6Update)(nil),       // 54: felix.HostMetadataV4V6Update
-	(*HostMetadataV4V6Remove)(nil),       // 55: felix.HostMetadataV4V6Remove
-	(*HostMetadataUpdate)(nil),           // 56: felix.HostMetadataUpdate
-	(*HostMetadataRemove)(nil),           // 57: felix.HostMetadataRemove
-	(*HostMetadataV6Update)(nil),         // 58: felix.HostMetadataV6Update
-	(*HostMetadataV6Remove)(nil),         // 59: felix.HostMetadataV6Remove
-	(*IPAMPoolUpdate)(nil),               // 60: felix.IPAMPoolUpdate
-	(*IPAMPoolRemove)(nil),               // 61: felix.IPAMPoolRemove
-	(*IPAMPool)(nil),                     // 62: felix.IPAMPool
-	(*Encapsulation)(nil),                // 63: felix.Encapsulation
-	(*ServiceAccountUpdate)(nil),         // 64: felix.ServiceAccountUpdate
-	(*ServiceAccountRemove)(nil),         // 65: felix.ServiceAccountRemove
-	(*ServiceAccountID)(nil),             // 66: felix.ServiceAccountID
-	(*NamespaceUpdate)(nil),              // 67: felix.NamespaceUpdate
-	(*NamespaceRemove)(nil),              // 68: felix.NamespaceRemove
-	(*NamespaceID)(nil),                  // 69: felix.NamespaceID
-	(*TunnelType)(nil),                   // 70: felix.TunnelType
-	(*RouteUpdate)(nil),                  // 71: felix.RouteUpdate
-	(*RouteRemove)(nil),                  // 72: felix.RouteRemove
-	(*VXLANTunnelEndpointUpdate)(nil),    // 73: felix.VXLANTunnelEndpointUpdate
-	(*VXLANTunnelEndpointRemove)(nil),    // 74: felix.VXLANTunnelEndpointRemove
-	(*ReportResult)(nil),                 // 75: felix.ReportResult
-	(*DataplaneStats)(nil),               // 76: felix.DataplaneStats
-	(*Statistic)(nil),                    // 77: felix.Statistic
-	(*RuleTrace)(nil),                    // 78: felix.RuleTrace
-	(*WireguardEndpointUpdate)(nil),      // 79: felix.WireguardEndpointUpdate
-	(*WireguardEndpointRemove)(nil),      // 80: felix.WireguardEndpointRemove
-	(*WireguardEndpointV6Update)(nil),    // 81: felix.WireguardEndpointV6Update
-	(*WireguardEndpointV6Remove)(nil),    // 82: felix.WireguardEndpointV6Remove
-	(*GlobalBGPConfigUpdate)(nil),        // 83: felix.GlobalBGPConfigUpdate
-	(*ServicePort)(nil),                  // 84: felix.ServicePort
-	(*ServiceUpdate)(nil),                // 85: felix.ServiceUpdate
-	(*ServiceRemove)(nil),                // 86: felix.ServiceRemove
-	nil,                                  // 87: felix.ConfigUpdate.ConfigEntry
-	nil,                                  // 88: felix.ConfigUpdate.SourceToRawConfigEntry
-	nil,                                  // 89: felix.RawConfig.ConfigEntry
-	(*HTTPMatch_PathMatch)(nil),          // 90: felix.HTTPMatch.PathMatch
-	nil,                                  // 91: felix.RuleMetadata.AnnotationsEntry
-	nil,                                  // 92: felix.WorkloadEndpoint.AnnotationsEntry
-	nil,                                  // 93: felix.HostMetadataV4V6Update.LabelsEntry
-	nil,                                  // 94: felix.ServiceAccountUpdate.LabelsEntry
-	nil,                                  // 95: felix.NamespaceUpdate.LabelsEntry
+	(IPVersion)(0),                        // 0: felix.IPVersion
+	(WorkloadType)(0),                     // 1: felix.WorkloadType
+	(RouteType)(0),                        // 2: felix.RouteType
+	(IPPoolType)(0),                       // 3: felix.IPPoolType
+	(Action)(0),                           // 4: felix.Action
+	(IPSetUpdate_IPSetType)(0),            // 5: felix.IPSetUpdate.IPSetType
+	(Statistic_Direction)(0),              // 6: felix.Statistic.Direction
+	(Statistic_Relativity)(0),             // 7: felix.Statistic.Relativity
+	(Statistic_Kind)(0),                   // 8: felix.Statistic.Kind
+	(RuleTrace_Direction)(0),              // 9: felix.RuleTrace.Direction
+	(*SyncRequest)(nil),                   // 10: felix.SyncRequest
+	(*ToDataplane)(nil),                   // 11: felix.ToDataplane
+	(*FromDataplane)(nil),                 // 12: felix.FromDataplane
+	(*ConfigUpdate)(nil),                  // 13: felix.ConfigUpdate
+	(*RawConfig)(nil),                     // 14: felix.RawConfig
+	(*InSync)(nil),                        // 15: felix.InSync
+	(*IPSetUpdate)(nil),                   // 16: felix.IPSetUpdate
+	(*IPSetDeltaUpdate)(nil),              // 17: felix.IPSetDeltaUpdate
+	(*IPSetRemove)(nil),                   // 18: felix.IPSetRemove
+	(*ActiveProfileUpdate)(nil),           // 19: felix.ActiveProfileUpdate
+	(*ActiveProfileRemove)(nil),           // 20: felix.ActiveProfileRemove
+	(*ProfileID)(nil),                     // 21: felix.ProfileID
+	(*Profile)(nil),                       // 22: felix.Profile
+	(*ActivePolicyUpdate)(nil),            // 23: felix.ActivePolicyUpdate
+	(*ActivePolicyRemove)(nil),            // 24: felix.ActivePolicyRemove
+	(*PolicyID)(nil),                      // 25: felix.PolicyID
+	(*Policy)(nil),                        // 26: felix.Policy
+	(*Rule)(nil),                          // 27: felix.Rule
+	(*ServiceAccountMatch)(nil),           // 28: felix.ServiceAccountMatch
+	(*HTTPMatch)(nil),                     // 29: felix.HTTPMatch
+	(*GRPCMatch)(nil),                     // 30: felix.GRPCMatch
+	(*RuleLimit)(nil),                     // 31: felix.RuleLimit
+	(*RuleMetadata)(nil),                  // 32: felix.RuleMetadata
+	(*IcmpTypeAndCode)(nil),               // 33: felix.IcmpTypeAndCode
+	(*Protocol)(nil),                      // 34: felix.Protocol
+	(*PortRange)(nil),                     // 35: felix.PortRange
+	(*WorkloadEndpointID)(nil),            // 36: felix.WorkloadEndpointID
+	(*WorkloadEndpointUpdate)(nil),        // 37: felix.WorkloadEndpointUpdate
+	(*WorkloadEndpoint)(nil),              // 38: felix.WorkloadEndpoint
+	(*QoSControls)(nil),                   // 39: felix.QoSControls
+	(*LocalBGPPeer)(nil),                  // 40: felix.LocalBGPPeer
+	(*WorkloadEndpointRemove)(nil),        // 41: felix.WorkloadEndpointRemove
+	(*HostEndpointID)(nil),                // 42: felix.HostEndpointID
+	(*HostEndpointUpdate)(nil),            // 43: felix.HostEndpointUpdate
+	(*HostEndpoint)(nil),                  // 44: felix.HostEndpoint
+	(*HostEndpointRemove)(nil),            // 45: felix.HostEndpointRemove
+	(*TierInfo)(nil),                      // 46: felix.TierInfo
+	(*NatInfo)(nil),                       // 47: felix.NatInfo
+	(*ProcessStatusUpdate)(nil),           // 48: felix.ProcessStatusUpdate
+	(*HostEndpointStatusUpdate)(nil),      // 49: felix.HostEndpointStatusUpdate
+	(*EndpointStatus)(nil),                // 50: felix.EndpointStatus
+	(*HostEndpointStatusRemove)(nil),      // 51: felix.HostEndpointStatusRemove
+	(*WorkloadEndpointStatusUpdate)(nil),  // 52: felix.WorkloadEndpointStatusUpdate
+	(*WorkloadEndpointStatusRemove)(nil),  // 53: felix.WorkloadEndpointStatusRemove
+	(*WireguardStatusUpdate)(nil),         // 54: felix.WireguardStatusUpdate
+	(*DataplaneInSync)(nil),               // 55: felix.DataplaneInSync
+	(*HostMetadataV4V6Update)(nil),        // 56: felix.HostMetadataV4V6Update
+	(*HostMetadataV4V6Remove)(nil),        // 57: felix.HostMetadataV4V6Remove
+	(*HostMetadataUpdate)(nil),            // 58: felix.HostMetadataUpdate
+	(*HostMetadataRemove)(nil),            // 59: felix.HostMetadataRemove
+	(*HostMetadataV6Update)(nil),          // 60: felix.HostMetadataV6Update
+	(*HostMetadataV6Remove)(nil),          // 61: felix.HostMetadataV6Remove
+	(*IPAMPoolUpdate)(nil),                // 62: felix.IPAMPoolUpdate
+	(*IPAMPoolRemove)(nil),                // 63: felix.IPAMPoolRemove
+	(*IPAMPool)(nil),                      // 64: felix.IPAMPool
+	(*Encapsulation)(nil),                 // 65: felix.Encapsulation
+	(*ServiceAccountUpdate)(nil),          // 66: felix.ServiceAccountUpdate
+	(*ServiceAccountRemove)(nil),          // 67: felix.ServiceAccountRemove
+	(*ServiceAccountID)(nil),              // 68: felix.ServiceAccountID
+	(*NamespaceUpdate)(nil),               // 69: felix.NamespaceUpdate
+	(*NamespaceRemove)(nil),               // 70: felix.NamespaceRemove
+	(*NamespaceID)(nil),                   // 71: felix.NamespaceID
+	(*TunnelType)(nil),                    // 72: felix.TunnelType
+	(*RouteUpdate)(nil),                   // 73: felix.RouteUpdate
+	(*RouteRemove)(nil),                   // 74: felix.RouteRemove
+	(*VXLANTunnelEndpointUpdate)(nil),     // 75: felix.VXLANTunnelEndpointUpdate
+	(*VXLANTunnelEndpointRemove)(nil),     // 76: felix.VXLANTunnelEndpointRemove
+	(*ReportResult)(nil),                  // 77: felix.ReportResult
+	(*DataplaneStats)(nil),                // 78: felix.DataplaneStats
+	(*GRPCCall)(nil),                      // 79: felix.GRPCCall
+	(*Statistic)(nil),                     // 80: felix.Statistic
+	(*RuleTrace)(nil),                     // 81: felix.RuleTrace
+	(*WireguardEndpointUpdate)(nil),       // 82: felix.WireguardEndpointUpdate
+	(*WireguardEndpointRemove)(nil),       // 83: felix.WireguardEndpointRemove
+	(*WireguardEndpointV6Update)(nil),     // 84: felix.WireguardEndpointV6Update
+	(*WireguardEndpointV6Remove)(nil),     // 85: felix.WireguardEndpointV6Remove
+	(*GlobalBGPConfigUpdate)(nil),         // 86: felix.GlobalBGPConfigUpdate
+	(*ServicePort)(nil),                   // 87: felix.ServicePort
+	(*ServiceUpdate)(nil),                 // 88: felix.ServiceUpdate
+	(*ServiceRemove)(nil),                 // 89: felix.ServiceRemove
+	(*PacketCaptureID)(nil),               // 90: felix.PacketCaptureID
+	(*PacketCaptureUpdate)(nil),           // 91: felix.PacketCaptureUpdate
+	(*PacketCaptureRemove)(nil),           // 92: felix.PacketCaptureRemove
+	nil,                                   // 93: felix.ConfigUpdate.ConfigEntry
+	nil,                                   // 94: felix.ConfigUpdate.SourceToRawConfigEntry
+	nil,                                   // 95: felix.RawConfig.ConfigEntry
+	(*HTTPMatch_PathMatch)(nil),           // 96: felix.HTTPMatch.PathMatch
+	(*HTTPMatch_KeyValueMatch)(nil),       // 97: felix.HTTPMatch.KeyValueMatch
+	(*HTTPMatch_JWTMatch)(nil),            // 98: felix.HTTPMatch.JWTMatch
+	(*HTTPMatch_JWTMatch_ClaimMatch)(nil), // 99: felix.HTTPMatch.JWTMatch.ClaimMatch
+	nil,                                   // 100: felix.RuleMetadata.AnnotationsEntry
+	nil,                                   // 101: felix.WorkloadEndpoint.AnnotationsEntry
+	nil,                                   // 102: felix.HostMetadataV4V6Update.LabelsEntry
+	nil,                                   // 103: felix.ServiceAccountUpdate.LabelsEntry
+	nil,                                   // 104: felix.NamespaceUpdate.LabelsEntry
 }
 var file_felixbackend_proto_depIdxs = []int32{
 	15,  // 0: felix.ToDataplane.in_sync:type_name -> felix.InSync
@@ -6873,129 +7751,141 @@ var file_felixbackend_proto_depIdxs = []int32{
 	20,  // 5: felix.ToDataplane.active_profile_remove:type_name -> felix.ActiveProfileRemove
 	23,  // 6: felix.ToDataplane.active_policy_update:type_name -> felix.ActivePolicyUpdate
 	24,  // 7: felix.ToDataplane.active_policy_remove:type_name -> felix.ActivePolicyRemove
-	41,  // 8: felix.ToDataplane.host_endpoint_update:type_name -> felix.HostEndpointUpdate
-	43,  // 9: felix.ToDataplane.host_endpoint_remove:type_name -> felix.HostEndpointRemove
-	35,  // 10: felix.ToDataplane.workload_endpoint_update:type_name -> felix.WorkloadEndpointUpdate
-	39,  // 11: felix.ToDataplane.workload_endpoint_remove:type_name -> felix.WorkloadEndpointRemove
+	43,  // 8: felix.ToDataplane.host_endpoint_update:type_name -> felix.HostEndpointUpdate
+	45,  // 9: felix.ToDataplane.host_endpoint_remove:type_name -> felix.HostEndpointRemove
+	37,  // 10: felix.ToDataplane.workload_endpoint_update:type_name -> felix.WorkloadEndpointUpdate
+	41,  // 11: felix.ToDataplane.workload_endpoint_remove:type_name -> felix.WorkloadEndpointRemove
 	13,  // 12: felix.ToDataplane.config_update:type_name -> felix.ConfigUpdate
-	56,  // 13: felix.ToDataplane.host_metadata_update:type_name -> felix.HostMetadataUpdate
-	57,  // 14: felix.ToDataplane.host_metadata_remove:type_name -> felix.HostMetadataRemove
-	54,  // 15: felix.ToDataplane.host_metadata_v4v6_update:type_name -> felix.HostMetadataV4V6Update
-	55,  // 16: felix.ToDataplane.host_metadata_v4v6_remove:type_name -> felix.HostMetadataV4V6Remove
-	60,  // 17: felix.ToDataplane.ipam_pool_update:type_name -> felix.IPAMPoolUpdate
-	61,  // 18: felix.ToDataplane.ipam_pool_remove:type_name -> felix.IPAMPoolRemove
-	64,  // 19: felix.ToDataplane.service_account_update:type_name -> felix.ServiceAccountUpdate
-	65,  // 20: felix.ToDataplane.service_account_remove:type_name -> felix.ServiceAccountRemove
-	67,  // 21: felix.ToDataplane.namespace_update:type_name -> felix.NamespaceUpdate
-	68,  // 22: felix.ToDataplane.namespace_remove:type_name -> felix.NamespaceRemove
-	71,  // 23: felix.ToDataplane.route_update:type_name -> felix.RouteUpdate
-	72,  // 24: felix.ToDataplane.route_remove:type_name -> felix.RouteRemove
-	73,  // 25: felix.ToDataplane.vtep_update:type_name -> felix.VXLANTunnelEndpointUpdate
-	74,  // 26: felix.ToDataplane.vtep_remove:type_name -> felix.VXLANTunnelEndpointRemove
-	79,  // 27: felix.ToDataplane.wireguard_endpoint_update:type_name -> felix.WireguardEndpointUpdate
-	80,  // 28: felix.ToDataplane.wireguard_endpoint_remove:type_name -> felix.WireguardEndpointRemove
-	83,  // 29: felix.ToDataplane.global_bgp_config_update:type_name -> felix.GlobalBGPConfigUpdate
-	63,  // 30: felix.ToDataplane.encapsulation:type_name -> felix.Encapsulation
-	85,  // 31: felix.ToDataplane.service_update:type_name -> felix.ServiceUpdate
-	86,  // 32: felix.ToDataplane.service_remove:type_name -> felix.ServiceRemove
-	81,  // 33: felix.ToDataplane.wireguard_endpoint_v6_update:type_name -> felix.WireguardEndpointV6Update
-	82,  // 34: felix.ToDataplane.wireguard_endpoint_v6_remove:type_name -> felix.WireguardEndpointV6Remove
-	58,  // 35: felix.ToDataplane.host_metadata_v6_update:type_name -> felix.HostMetadataV6Update
-	59,  // 36: felix.ToDataplane.host_metadata_v6_remove:type_name -> felix.HostMetadataV6Remove
-	46,  // 37: felix.FromDataplane.process_status_update:type_name -> felix.ProcessStatusUpdate
-	47,  // 38: felix.FromDataplane.host_endpoint_status_update:type_name -> felix.HostEndpointStatusUpdate
-	49,  // 39: felix.FromDataplane.host_endpoint_status_remove:type_name -> felix.HostEndpointStatusRemove
-	50,  // 40: felix.FromDataplane.workload_endpoint_status_update:type_name -> felix.WorkloadEndpointStatusUpdate
-	51,  // 41: felix.FromDataplane.workload_endpoint_status_remove:type_name -> felix.WorkloadEndpointStatusRemove
-	52,  // 42: felix.FromDataplane.wireguard_status_update:type_name -> felix.WireguardStatusUpdate
-	53,  // 43: felix.FromDataplane.dataplane_in_sync:type_name -> felix.DataplaneInSync
-	87,  // 44: felix.ConfigUpdate.config:type_name -> felix.ConfigUpdate.ConfigEntry
-	88,  // 45: felix.ConfigUpdate.source_to_raw_config:type_name -> felix.ConfigUpdate.SourceToRawConfigEntry
-	89,  // 46: felix.RawConfig.config:type_name -> felix.RawConfig.ConfigEntry
-	5,   // 47: felix.IPSetUpdate.type:type_name -> felix.IPSetUpdate.IPSetType
-	21,  // 48: felix.ActiveProfileUpdate.id:type_name -> felix.ProfileID
-	22,  // 49: felix.ActiveProfileUpdate.profile:type_name -> felix.Profile
-	21,  // 50: felix.ActiveProfileRemove.id:type_name -> felix.ProfileID
-	27,  // 51: felix.Profile.inbound_rules:type_name -> felix.Rule
-	27,  // 52: felix.Profile.outbound_rules:type_name -> felix.Rule
-	25,  // 53: felix.ActivePolicyUpdate.id:type_name -> felix.PolicyID
-	26,  // 54: felix.ActivePolicyUpdate.policy:type_name -> felix.Policy
-	25,  // 55: felix.ActivePolicyRemove.id:type_name -> felix.PolicyID
-	27,  // 56: felix.Policy.inbound_rules:type_name -> felix.Rule
-	27,  // 57: felix.Policy.outbound_rules:type_name -> felix.Rule
-	0,   // 58: felix.Rule.ip_version:type_name -> felix.IPVersion
-	32,  // 59: felix.Rule.protocol:type_name -> felix.Protocol
-	33,  // 60: felix.Rule.src_ports:type_name -> felix.PortRange
-	33,  // 61: felix.Rule.dst_ports:type_name -> felix.PortRange
-	31,  // 62: felix.Rule.icmp_type_code:type_name -> felix.IcmpTypeAndCode
-	32,  // 63: felix.Rule.not_protocol:type_name -> felix.Protocol
-	33,  // 64: felix.Rule.not_src_ports:type_name -> felix.PortRange
-	33,  // 65: felix.Rule.not_dst_ports:type_name -> felix.PortRange
-	31,  // 66: felix.Rule.not_icmp_type_code:type_name -> felix.IcmpTypeAndCode
-	28,  // 67: felix.Rule.src_service_account_match:type_name -> felix.ServiceAccountMatch
-	28,  // 68: felix.Rule.dst_service_account_match:type_name -> felix.ServiceAccountMatch
-	29,  // 69: felix.Rule.http_match:type_name -> felix.HTTPMatch
-	30,  // 70: felix.Rule.metadata:type_name -> felix.RuleMetadata
-	90,  // 71: felix.HTTPMatch.paths:type_name -> felix.HTTPMatch.PathMatch
-	91,  // 72: felix.RuleMetadata.annotations:type_name -> felix.RuleMetadata.AnnotationsEntry
-	34,  // 73: felix.WorkloadEndpointUpdate.id:type_name -> felix.WorkloadEndpointID
-	36,  // 74: felix.WorkloadEndpointUpdate.endpoint:type_name -> felix.WorkloadEndpoint
-	44,  // 75: felix.WorkloadEndpoint.tiers:type_name -> felix.TierInfo
-	45,  // 76: felix.WorkloadEndpoint.ipv4_nat:type_name -> felix.NatInfo
-	45,  // 77: felix.WorkloadEndpoint.ipv6_nat:type_name -> felix.NatInfo
-	92,  // 78: felix.WorkloadEndpoint.annotations:type_name -> felix.WorkloadEndpoint.AnnotationsEntry
-	37,  // 79: felix.WorkloadEndpoint.qos_controls:type_name -> felix.QoSControls
-	38,  // 80: felix.WorkloadEndpoint.local_bgp_peer:type_name -> felix.LocalBGPPeer
-	1,   // 81: felix.WorkloadEndpoint.type:type_name -> felix.WorkloadType
-	34,  // 82: felix.WorkloadEndpointRemove.id:type_name -> felix.WorkloadEndpointID
-	40,  // 83: felix.HostEndpointUpdate.id:type_name -> felix.HostEndpointID
-	42,  // 84: felix.HostEndpointUpdate.endpoint:type_name -> felix.HostEndpoint
-	44,  // 85: felix.HostEndpoint.tiers:type_name -> felix.TierInfo
-	44,  // 86: felix.HostEndpoint.untracked_tiers:type_name -> felix.TierInfo
-	44,  // 87: felix.HostEndpoint.pre_dnat_tiers:type_name -> felix.TierInfo
-	44,  // 88: felix.HostEndpoint.forward_tiers:type_name -> felix.TierInfo
-	40,  // 89: felix.HostEndpointRemove.id:type_name -> felix.HostEndpointID
-	40,  // 90: felix.HostEndpointStatusUpdate.id:type_name -> felix.HostEndpointID
-	48,  // 91: felix.HostEndpointStatusUpdate.status:type_name -> felix.EndpointStatus
-	40,  // 92: felix.HostEndpointStatusRemove.id:type_name -> felix.HostEndpointID
-	34,  // 93: felix.WorkloadEndpointStatusUpdate.id:type_name -> felix.WorkloadEndpointID
-	48,  // 94: felix.WorkloadEndpointStatusUpdate.status:type_name -> felix.EndpointStatus
-	36,  // 95: felix.WorkloadEndpointStatusUpdate.endpoint:type_name -> felix.WorkloadEndpoint
-	34,  // 96: felix.WorkloadEndpointStatusRemove.id:type_name -> felix.WorkloadEndpointID
-	0,   // 97: felix.WireguardStatusUpdate.ip_version:type_name -> felix.IPVersion
-	93,  // 98: felix.HostMetadataV4V6Update.labels:type_name -> felix.HostMetadataV4V6Update.LabelsEntry
-	62,  // 99: felix.IPAMPoolUpdate.pool:type_name -> felix.IPAMPool
-	66,  // 100: felix.ServiceAccountUpdate.id:type_name -> felix.ServiceAccountID
-	94,  // 101: felix.ServiceAccountUpdate.labels:type_name -> felix.ServiceAccountUpdate.LabelsEntry
-	66,  // 102: felix.ServiceAccountRemove.id:type_name -> felix.ServiceAccountID
-	69,  // 103: felix.NamespaceUpdate.id:type_name -> felix.NamespaceID
-	95,  // 104: felix.NamespaceUpdate.labels:type_name -> felix.NamespaceUpdate.LabelsEntry
-	69,  // 105: felix.NamespaceRemove.id:type_name -> felix.NamespaceID
-	2,   // 106: felix.RouteUpdate.types:type_name -> felix.RouteType
-	3,   // 107: felix.RouteUpdate.ip_pool_type:type_name -> felix.IPPoolType
-	70,  // 108: felix.RouteUpdate.tunnel_type:type_name -> felix.TunnelType
-	32,  // 109: felix.DataplaneStats.protocol:type_name -> felix.Protocol
-	77,  // 110: felix.DataplaneStats.stats:type_name -> felix.Statistic
-	78,  // 111: felix.DataplaneStats.rules:type_name -> felix.RuleTrace
-	4,   // 112: felix.DataplaneStats.action:type_name -> felix.Action
-	6,   // 113: felix.Statistic.direction:type_name -> felix.Statistic.Direction
-	7,   // 114: felix.Statistic.relativity:type_name -> felix.Statistic.Relativity
-	8,   // 115: felix.Statistic.kind:type_name -> felix.Statistic.Kind
-	4,   // 116: felix.Statistic.action:type_name -> felix.Action
-	25,  // 117: felix.RuleTrace.policy:type_name -> felix.PolicyID
-	21,  // 118: felix.RuleTrace.profile:type_name -> felix.ProfileID
-	9,   // 119: felix.RuleTrace.direction:type_name -> felix.RuleTrace.Direction
-	84,  // 120: felix.ServiceUpdate.ports:type_name -> felix.ServicePort
-	14,  // 121: felix.ConfigUpdate.SourceToRawConfigEntry.value:type_name -> felix.RawConfig
-	10,  // 122: felix.PolicySync.Sync:input_type -> felix.SyncRequest
-	76,  // 123: felix.PolicySync.Report:input_type -> felix.DataplaneStats
-	11,  // 124: felix.PolicySync.Sync:output_type -> felix.ToDataplane
-	75,  // 125: felix.PolicySync.Report:output_type -> felix.ReportResult
-	124, // [124:126] is the sub-list for method output_type
-	122, // [122:124] is the sub-list for method input_type
-	122, // [122:122] is the sub-list for extension type_name
-	122, // [122:122] is the sub-list for extension extendee
-	0,   // [0:122] is the sub-list for field type_name
+	58,  // 13: felix.ToDataplane.host_metadata_update:type_name -> felix.HostMetadataUpdate
+	59,  // 14: felix.ToDataplane.host_metadata_remove:type_name -> felix.HostMetadataRemove
+	56,  // 15: felix.ToDataplane.host_metadata_v4v6_update:type_name -> felix.HostMetadataV4V6Update
+	57,  // 16: felix.ToDataplane.host_metadata_v4v6_remove:type_name -> felix.HostMetadataV4V6Remove
+	62,  // 17: felix.ToDataplane.ipam_pool_update:type_name -> felix.IPAMPoolUpdate
+	63,  // 18: felix.ToDataplane.ipam_pool_remove:type_name -> felix.IPAMPoolRemove
+	66,  // 19: felix.ToDataplane.service_account_update:type_name -> felix.ServiceAccountUpdate
+	67,  // 20: felix.ToDataplane.service_account_remove:type_name -> felix.ServiceAccountRemove
+	69,  // 21: felix.ToDataplane.namespace_update:type_name -> felix.NamespaceUpdate
+	70,  // 22: felix.ToDataplane.namespace_remove:type_name -> felix.NamespaceRemove
+	73,  // 23: felix.ToDataplane.route_update:type_name -> felix.RouteUpdate
+	74,  // 24: felix.ToDataplane.route_remove:type_name -> felix.RouteRemove
+	75,  // 25: felix.ToDataplane.vtep_update:type_name -> felix.VXLANTunnelEndpointUpdate
+	76,  // 26: felix.ToDataplane.vtep_remove:type_name -> felix.VXLANTunnelEndpointRemove
+	82,  // 27: felix.ToDataplane.wireguard_endpoint_update:type_name -> felix.WireguardEndpointUpdate
+	83,  // 28: felix.ToDataplane.wireguard_endpoint_remove:type_name -> felix.WireguardEndpointRemove
+	86,  // 29: felix.ToDataplane.global_bgp_config_update:type_name -> felix.GlobalBGPConfigUpdate
+	65,  // 30: felix.ToDataplane.encapsulation:type_name -> felix.Encapsulation
+	88,  // 31: felix.ToDataplane.service_update:type_name -> felix.ServiceUpdate
+	89,  // 32: felix.ToDataplane.service_remove:type_name -> felix.ServiceRemove
+	84,  // 33: felix.ToDataplane.wireguard_endpoint_v6_update:type_name -> felix.WireguardEndpointV6Update
+	85,  // 34: felix.ToDataplane.wireguard_endpoint_v6_remove:type_name -> felix.WireguardEndpointV6Remove
+	60,  // 35: felix.ToDataplane.host_metadata_v6_update:type_name -> felix.HostMetadataV6Update
+	61,  // 36: felix.ToDataplane.host_metadata_v6_remove:type_name -> felix.HostMetadataV6Remove
+	91,  // 37: felix.ToDataplane.packet_capture_update:type_name -> felix.PacketCaptureUpdate
+	92,  // 38: felix.ToDataplane.packet_capture_remove:type_name -> felix.PacketCaptureRemove
+	48,  // 39: felix.FromDataplane.process_status_update:type_name -> felix.ProcessStatusUpdate
+	49,  // 40: felix.FromDataplane.host_endpoint_status_update:type_name -> felix.HostEndpointStatusUpdate
+	51,  // 41: felix.FromDataplane.host_endpoint_status_remove:type_name -> felix.HostEndpointStatusRemove
+	52,  // 42: felix.FromDataplane.workload_endpoint_status_update:type_name -> felix.WorkloadEndpointStatusUpdate
+	53,  // 43: felix.FromDataplane.workload_endpoint_status_remove:type_name -> felix.WorkloadEndpointStatusRemove
+	54,  // 44: felix.FromDataplane.wireguard_status_update:type_name -> felix.WireguardStatusUpdate
+	55,  // 45: felix.FromDataplane.dataplane_in_sync:type_name -> felix.DataplaneInSync
+	93,  // 46: felix.ConfigUpdate.config:type_name -> felix.ConfigUpdate.ConfigEntry
+	94,  // 47: felix.ConfigUpdate.source_to_raw_config:type_name -> felix.ConfigUpdate.SourceToRawConfigEntry
+	95,  // 48: felix.RawConfig.config:type_name -> felix.RawConfig.ConfigEntry
+	5,   // 49: felix.IPSetUpdate.type:type_name -> felix.IPSetUpdate.IPSetType
+	21,  // 50: felix.ActiveProfileUpdate.id:type_name -> felix.ProfileID
+	22,  // 51: felix.ActiveProfileUpdate.profile:type_name -> felix.Profile
+	21,  // 52: felix.ActiveProfileRemove.id:type_name -> felix.ProfileID
+	27,  // 53: felix.Profile.inbound_rules:type_name -> felix.Rule
+	27,  // 54: felix.Profile.outbound_rules:type_name -> felix.Rule
+	25,  // 55: felix.ActivePolicyUpdate.id:type_name -> felix.PolicyID
+	26,  // 56: felix.ActivePolicyUpdate.policy:type_name -> felix.Policy
+	25,  // 57: felix.ActivePolicyRemove.id:type_name -> felix.PolicyID
+	27,  // 58: felix.Policy.inbound_rules:type_name -> felix.Rule
+	27,  // 59: felix.Policy.outbound_rules:type_name -> felix.Rule
+	0,   // 60: felix.Rule.ip_version:type_name -> felix.IPVersion
+	34,  // 61: felix.Rule.protocol:type_name -> felix.Protocol
+	35,  // 62: felix.Rule.src_ports:type_name -> felix.PortRange
+	35,  // 63: felix.Rule.dst_ports:type_name -> felix.PortRange
+	33,  // 64: felix.Rule.icmp_type_code:type_name -> felix.IcmpTypeAndCode
+	34,  // 65: felix.Rule.not_protocol:type_name -> felix.Protocol
+	35,  // 66: felix.Rule.not_src_ports:type_name -> felix.PortRange
+	35,  // 67: felix.Rule.not_dst_ports:type_name -> felix.PortRange
+	33,  // 68: felix.Rule.not_icmp_type_code:type_name -> felix.IcmpTypeAndCode
+	28,  // 69: felix.Rule.src_service_account_match:type_name -> felix.ServiceAccountMatch
+	28,  // 70: felix.Rule.dst_service_account_match:type_name -> felix.ServiceAccountMatch
+	29,  // 71: felix.Rule.http_match:type_name -> felix.HTTPMatch
+	30,  // 72: felix.Rule.grpc_match:type_name -> felix.GRPCMatch
+	31,  // 73: felix.Rule.limit:type_name -> felix.RuleLimit
+	32,  // 74: felix.Rule.metadata:type_name -> felix.RuleMetadata
+	96,  // 75: felix.HTTPMatch.paths:type_name -> felix.HTTPMatch.PathMatch
+	97,  // 76: felix.HTTPMatch.headers:type_name -> felix.HTTPMatch.KeyValueMatch
+	97,  // 77: felix.HTTPMatch.query_params:type_name -> felix.HTTPMatch.KeyValueMatch
+	98,  // 78: felix.HTTPMatch.jwt:type_name -> felix.HTTPMatch.JWTMatch
+	100, // 79: felix.RuleMetadata.annotations:type_name -> felix.RuleMetadata.AnnotationsEntry
+	36,  // 80: felix.WorkloadEndpointUpdate.id:type_name -> felix.WorkloadEndpointID
+	38,  // 81: felix.WorkloadEndpointUpdate.endpoint:type_name -> felix.WorkloadEndpoint
+	46,  // 82: felix.WorkloadEndpoint.tiers:type_name -> felix.TierInfo
+	47,  // 83: felix.WorkloadEndpoint.ipv4_nat:type_name -> felix.NatInfo
+	47,  // 84: felix.WorkloadEndpoint.ipv6_nat:type_name -> felix.NatInfo
+	101, // 85: felix.WorkloadEndpoint.annotations:type_name -> felix.WorkloadEndpoint.AnnotationsEntry
+	39,  // 86: felix.WorkloadEndpoint.qos_controls:type_name -> felix.QoSControls
+	40,  // 87: felix.WorkloadEndpoint.local_bgp_peer:type_name -> felix.LocalBGPPeer
+	1,   // 88: felix.WorkloadEndpoint.type:type_name -> felix.WorkloadType
+	36,  // 89: felix.WorkloadEndpointRemove.id:type_name -> felix.WorkloadEndpointID
+	42,  // 90: felix.HostEndpointUpdate.id:type_name -> felix.HostEndpointID
+	44,  // 91: felix.HostEndpointUpdate.endpoint:type_name -> felix.HostEndpoint
+	46,  // 92: felix.HostEndpoint.tiers:type_name -> felix.TierInfo
+	46,  // 93: felix.HostEndpoint.untracked_tiers:type_name -> felix.TierInfo
+	46,  // 94: felix.HostEndpoint.pre_dnat_tiers:type_name -> felix.TierInfo
+	46,  // 95: felix.HostEndpoint.forward_tiers:type_name -> felix.TierInfo
+	42,  // 96: felix.HostEndpointRemove.id:type_name -> felix.HostEndpointID
+	42,  // 97: felix.HostEndpointStatusUpdate.id:type_name -> felix.HostEndpointID
+	50,  // 98: felix.HostEndpointStatusUpdate.status:type_name -> felix.EndpointStatus
+	42,  // 99: felix.HostEndpointStatusRemove.id:type_name -> felix.HostEndpointID
+	36,  // 100: felix.WorkloadEndpointStatusUpdate.id:type_name -> felix.WorkloadEndpointID
+	50,  // 101: felix.WorkloadEndpointStatusUpdate.status:type_name -> felix.EndpointStatus
+	38,  // 102: felix.WorkloadEndpointStatusUpdate.endpoint:type_name -> felix.WorkloadEndpoint
+	36,  // 103: felix.WorkloadEndpointStatusRemove.id:type_name -> felix.WorkloadEndpointID
+	0,   // 104: felix.WireguardStatusUpdate.ip_version:type_name -> felix.IPVersion
+	102, // 105: felix.HostMetadataV4V6Update.labels:type_name -> felix.HostMetadataV4V6Update.LabelsEntry
+	64,  // 106: felix.IPAMPoolUpdate.pool:type_name -> felix.IPAMPool
+	68,  // 107: felix.ServiceAccountUpdate.id:type_name -> felix.ServiceAccountID
+	103, // 108: felix.ServiceAccountUpdate.labels:type_name -> felix.ServiceAccountUpdate.LabelsEntry
+	68,  // 109: felix.ServiceAccountRemove.id:type_name -> felix.ServiceAccountID
+	71,  // 110: felix.NamespaceUpdate.id:type_name -> felix.NamespaceID
+	104, // 111: felix.NamespaceUpdate.labels:type_name -> felix.NamespaceUpdate.LabelsEntry
+	71,  // 112: felix.NamespaceRemove.id:type_name -> felix.NamespaceID
+	2,   // 113: felix.RouteUpdate.types:type_name -> felix.RouteType
+	3,   // 114: felix.RouteUpdate.ip_pool_type:type_name -> felix.IPPoolType
+	72,  // 115: felix.RouteUpdate.tunnel_type:type_name -> felix.TunnelType
+	34,  // 116: felix.DataplaneStats.protocol:type_name -> felix.Protocol
+	80,  // 117: felix.DataplaneStats.stats:type_name -> felix.Statistic
+	81,  // 118: felix.DataplaneStats.rules:type_name -> felix.RuleTrace
+	4,   // 119: felix.DataplaneStats.action:type_name -> felix.Action
+	79,  // 120: felix.DataplaneStats.grpc_call:type_name -> felix.GRPCCall
+	6,   // 121: felix.Statistic.direction:type_name -> felix.Statistic.Direction
+	7,   // 122: felix.Statistic.relativity:type_name -> felix.Statistic.Relativity
+	8,   // 123: felix.Statistic.kind:type_name -> felix.Statistic.Kind
+	4,   // 124: felix.Statistic.action:type_name -> felix.Action
+	25,  // 125: felix.RuleTrace.policy:type_name -> felix.PolicyID
+	21,  // 126: felix.RuleTrace.profile:type_name -> felix.ProfileID
+	9,   // 127: felix.RuleTrace.direction:type_name -> felix.RuleTrace.Direction
+	87,  // 128: felix.ServiceUpdate.ports:type_name -> felix.ServicePort
+	90,  // 129: felix.PacketCaptureUpdate.id:type_name -> felix.PacketCaptureID
+	36,  // 130: felix.PacketCaptureUpdate.endpoints:type_name -> felix.WorkloadEndpointID
+	90,  // 131: felix.PacketCaptureRemove.id:type_name -> felix.PacketCaptureID
+	14,  // 132: felix.ConfigUpdate.SourceToRawConfigEntry.value:type_name -> felix.RawConfig
+	99,  // 133: felix.HTTPMatch.JWTMatch.claims:type_name -> felix.HTTPMatch.JWTMatch.ClaimMatch
+	10,  // 134: felix.PolicySync.Sync:input_type -> felix.SyncRequest
+	78,  // 135: felix.PolicySync.Report:input_type -> felix.DataplaneStats
+	11,  // 136: felix.PolicySync.Sync:output_type -> felix.ToDataplane
+	77,  // 137: felix.PolicySync.Report:output_type -> felix.ReportResult
+	136, // [136:138] is the sub-list for method output_type
+	134, // [134:136] is the sub-list for method input_type
+	134, // [134:134] is the sub-list for extension type_name
+	134, // [134:134] is the sub-list for extension extendee
+	0,   // [0:134] is the sub-list for field type_name
 }
 
 func init() { file_felixbackend_proto_init() }
@@ -7041,6 +7931,8 @@ func file_felixbackend_proto_init() {
 		(*ToDataplane_WireguardEndpointV6Remove)(nil),
 		(*ToDataplane_HostMetadataV6Update)(nil),
 		(*ToDataplane_HostMetadataV6Remove)(nil),
+		(*ToDataplane_PacketCaptureUpdate)(nil),
+		(*ToDataplane_PacketCaptureRemove)(nil),
 	}
 	file_felixbackend_proto_msgTypes[2].OneofWrappers = []any{
 		(*FromDataplane_ProcessStatusUpdate)(nil),
@@ -7057,18 +7949,25 @@ func file_felixbackend_proto_init() {
 		(*Rule_NotIcmpType)(nil),
 		(*Rule_NotIcmpTypeCode)(nil),
 	}
-	file_felixbackend_proto_msgTypes[22].OneofWrappers = []any{
+	file_felixbackend_proto_msgTypes[24].OneofWrappers = []any{
 		(*Protocol_Number)(nil),
 		(*Protocol_Name)(nil),
 	}
-	file_felixbackend_proto_msgTypes[68].OneofWrappers = []any{
+	file_felixbackend_proto_msgTypes[71].OneofWrappers = []any{
 		(*RuleTrace_Policy)(nil),
 		(*RuleTrace_Profile)(nil),
 		(*RuleTrace_None)(nil),
 	}
-	file_felixbackend_proto_msgTypes[80].OneofWrappers = []any{
+	file_felixbackend_proto_msgTypes[86].OneofWrappers = []any{
 		(*HTTPMatch_PathMatch_Exact)(nil),
 		(*HTTPMatch_PathMatch_Prefix)(nil),
+		(*HTTPMatch_PathMatch_Regex)(nil),
+	}
+	file_felixbackend_proto_msgTypes[87].OneofWrappers = []any{
+		(*HTTPMatch_KeyValueMatch_Exact)(nil),
+		(*HTTPMatch_KeyValueMatch_Prefix)(nil),
+		(*HTTPMatch_KeyValueMatch_Regex)(nil),
+		(*HTTPMatch_KeyValueMatch_Present)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7076,7 +7975,7 @@ func file_felixbackend_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_felixbackend_proto_rawDesc), len(file_felixbackend_proto_rawDesc)),
 			NumEnums:      10,
-			NumMessages:   86,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -15,6 +15,8 @@
 package rules
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -577,6 +579,7 @@ func (r *DefaultRuleRenderer) CombineMatchAndActionsForProtoRule(
 	untracked bool,
 ) []generictables.Rule {
 	var rules []generictables.Rule
+	var limitRules []generictables.Rule
 	var mark uint32
 
 	if pRule.Action == "log" {
@@ -601,6 +604,27 @@ func (r *DefaultRuleRenderer) CombineMatchAndActionsForProtoRule(
 		// If this is not a staged policy then allow needs to set the accept mark.
 		mark = r.MarkAccept
 
+		// Drop new connections that are over the rule's limits.  The limits rely on conntrack so
+		// they can't be applied to untracked traffic; the validator rejects them there.
+		if limit := pRule.GetLimit(); limit != nil && !untracked {
+			limitName := RuleLimitName(name, idx)
+			perSource := !limit.PerRule
+			if limit.NewConnectionsPerSecond > 0 {
+				limitRules = append(limitRules, generictables.Rule{
+					Match: r.NewMatch().ConntrackState("NEW").
+						HashLimitAbove(limitName, limit.NewConnectionsPerSecond, limit.Burst, perSource),
+					Action: r.IptablesFilterDenyAction(),
+				})
+			}
+			if limit.MaxConnections > 0 {
+				limitRules = append(limitRules, generictables.Rule{
+					Match: r.NewMatch().ConntrackState("NEW").
+						ConnLimitAbove(limitName, limit.MaxConnections, perSource),
+					Action: r.IptablesFilterDenyAction(),
+				})
+			}
+		}
+
 		// NFLOG the allow - we don't do this for untracked due to the performance hit.
 		if !untracked && r.FlowLogsEnabled {
 			rules = append(rules, generictables.Rule{
@@ -674,6 +698,12 @@ func (r *DefaultRuleRenderer) CombineMatchAndActionsForProtoRule(
 		match = r.NewMatch().MarkSingleBitSet(mark)
 	}
 
+	for _, rule := range limitRules {
+		// The limit matches update their state each time they're evaluated so they must come
+		// after the rest of the match.
+		rule.Match = r.CombineMatches(match, rule.Match)
+		finalRules = append(finalRules, rule)
+	}
 	for _, rule := range rules {
 		rule.Match = r.CombineMatches(rule.Match, match)
 		finalRules = append(finalRules, rule)
@@ -682,6 +712,14 @@ func (r *DefaultRuleRenderer) CombineMatchAndActionsForProtoRule(
 	return finalRules
 }
 
+// RuleLimitName returns the name of the dataplane state for the limits of the rule at the given
+// index in the given chain.  It's kept short because iptables limits hashlimit names to 15
+// characters, including its prefix.
+func RuleLimitName(chainName string, idx int) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", chainName, idx)))
+	return base64.RawURLEncoding.EncodeToString(hash[:])[:10]
+}
+
 func appendProtocolMatch(match generictables.MatchCriteria, protocol *proto.Protocol, logCxt *logrus.Entry) generictables.MatchCriteria {
 	if protocol == nil {
 		return match
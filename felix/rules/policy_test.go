@@ -258,6 +258,47 @@ var _ = Describe("Protobuf rule to iptables rule conversion", func() {
 		ruleTestData...,
 	)
 
+	DescribeTable(
+		"Allow rules with limits should be correctly rendered",
+		func(limit *proto.RuleLimit, expLimitMatches []string) {
+			rrConfigNormal.FlowLogsEnabled = false
+			renderer := NewRenderer(rrConfigNormal)
+			in := &proto.Rule{Action: "allow", Limit: limit}
+			rules := renderer.ProtoRuleToIptablesRules(in, 4,
+				RuleOwnerTypePolicy, RuleDirIngress, 0, "default.foo", false)
+			// The rule should set the mark, drop new connections that are over the
+			// limits and then return.
+			Expect(rules).To(HaveLen(len(expLimitMatches) + 2))
+			Expect(rules[0].Action).To(Equal(iptables.SetMarkAction{Mark: 0x80}))
+			for i, m := range expLimitMatches {
+				Expect(rules[i+1].Match.Render()).To(Equal("-m mark --mark 0x80/0x80 -m conntrack --ctstate NEW " + m))
+				Expect(rules[i+1].Action).To(Equal(iptables.DropAction{}))
+			}
+			Expect(rules[len(rules)-1]).To(Equal(generictables.Rule{
+				Match:  iptables.Match().MarkSingleBitSet(0x80),
+				Action: iptables.ReturnAction{},
+			}))
+		},
+		Entry("Per-source rate limit",
+			&proto.RuleLimit{NewConnectionsPerSecond: 100, Burst: 5},
+			[]string{"-m hashlimit --hashlimit-above 100/sec --hashlimit-burst 5 --hashlimit-mode srcip --hashlimit-name cali-" + RuleLimitName("default.foo", 0)}),
+		Entry("Per-rule connection limit",
+			&proto.RuleLimit{MaxConnections: 50, PerRule: true},
+			[]string{"-m connlimit --connlimit-above 50 --connlimit-mask 0"}),
+		Entry("Rate and connection limits",
+			&proto.RuleLimit{NewConnectionsPerSecond: 10, Burst: 20, MaxConnections: 50},
+			[]string{
+				"-m hashlimit --hashlimit-above 10/sec --hashlimit-burst 20 --hashlimit-mode srcip --hashlimit-name cali-" + RuleLimitName("default.foo", 0),
+				"-m connlimit --connlimit-above 50 --connlimit-saddr",
+			}),
+	)
+
+	It("should give each rule's limits their own name", func() {
+		Expect(RuleLimitName("default.foo", 0)).To(HaveLen(10))
+		Expect(RuleLimitName("default.foo", 0)).NotTo(Equal(RuleLimitName("default.foo", 1)))
+		Expect(RuleLimitName("default.foo", 0)).NotTo(Equal(RuleLimitName("default.bar", 0)))
+	})
+
 	DescribeTable(
 		"pass rules should be correctly rendered",
 		func(ipVer int, in *proto.Rule, expMatch string) {
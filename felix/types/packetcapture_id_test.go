@@ -0,0 +1,59 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	googleproto "google.golang.org/protobuf/proto"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+func TestProtoToPacketCaptureID(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *proto.PacketCaptureID
+		want PacketCaptureID
+	}{
+		{"empty", &proto.PacketCaptureID{}, PacketCaptureID{}},
+		{"non-empty", &proto.PacketCaptureID{Namespace: "ns", Name: "foo"}, PacketCaptureID{"ns", "foo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProtoToPacketCaptureID(tt.p); got != tt.want {
+				t.Errorf("ProtoToPacketCaptureID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPacketCaptureIDToProto(t *testing.T) {
+	tests := []struct {
+		name string
+		p    PacketCaptureID
+		want *proto.PacketCaptureID
+	}{
+		{"empty", PacketCaptureID{}, &proto.PacketCaptureID{}},
+		{"non-empty", PacketCaptureID{"ns", "foo"}, &proto.PacketCaptureID{Namespace: "ns", Name: "foo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PacketCaptureIDToProto(tt.p); !googleproto.Equal(got, tt.want) {
+				t.Errorf("PacketCaptureIDToProto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
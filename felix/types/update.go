@@ -14,7 +14,11 @@
 
 package types
 
-import "github.com/projectcalico/calico/felix/proto"
+import (
+	"strings"
+
+	"github.com/projectcalico/calico/felix/proto"
+)
 
 type VXLANTunnelEndpointUpdate struct {
 	Node             string
@@ -33,6 +37,13 @@ type WireguardEndpointUpdate struct {
 	PublicKey string
 	// The IP address of the IPv4 wireguard interface.
 	InterfaceIpv4Addr string
+	// The public key that this endpoint is rotating to.
+	NextPublicKey string
+	// Time at which the current key pair was generated, in seconds since the epoch.
+	PublicKeyTimestamp int64
+	// The next public keys of rotating peers that this endpoint has programmed, comma separated so that the
+	// update remains comparable.
+	AcceptedNextPublicKeys string
 }
 
 type WireguardEndpointV6Update struct {
@@ -42,6 +53,13 @@ type WireguardEndpointV6Update struct {
 	PublicKeyV6 string
 	// The IP address of the IPv6 wireguard interface.
 	InterfaceIpv6Addr string
+	// The public key that this endpoint is rotating to.
+	NextPublicKeyV6 string
+	// Time at which the current key pair was generated, in seconds since the epoch.
+	PublicKeyTimestamp int64
+	// The next public keys of rotating peers that this endpoint has programmed, comma separated so that the
+	// update remains comparable.
+	AcceptedNextPublicKeysV6 string
 }
 
 type RouteUpdate struct {
@@ -71,17 +89,23 @@ func ProtoToVXLANTunnelEndpointUpdate(msg *proto.VXLANTunnelEndpointUpdate) VXLA
 
 func ProtoToWireguardEndpointUpdate(msg *proto.WireguardEndpointUpdate) WireguardEndpointUpdate {
 	return WireguardEndpointUpdate{
-		Hostname:          msg.Hostname,
-		PublicKey:         msg.PublicKey,
-		InterfaceIpv4Addr: msg.InterfaceIpv4Addr,
+		Hostname:               msg.Hostname,
+		PublicKey:              msg.PublicKey,
+		InterfaceIpv4Addr:      msg.InterfaceIpv4Addr,
+		NextPublicKey:          msg.NextPublicKey,
+		PublicKeyTimestamp:     msg.PublicKeyTimestamp,
+		AcceptedNextPublicKeys: strings.Join(msg.AcceptedNextPublicKeys, ","),
 	}
 }
 
 func ProtoToWireguardEndpointV6Update(msg *proto.WireguardEndpointV6Update) WireguardEndpointV6Update {
 	return WireguardEndpointV6Update{
-		Hostname:          msg.Hostname,
-		PublicKeyV6:       msg.PublicKeyV6,
-		InterfaceIpv6Addr: msg.InterfaceIpv6Addr,
+		Hostname:                 msg.Hostname,
+		PublicKeyV6:              msg.PublicKeyV6,
+		InterfaceIpv6Addr:        msg.InterfaceIpv6Addr,
+		NextPublicKeyV6:          msg.NextPublicKeyV6,
+		PublicKeyTimestamp:       msg.PublicKeyTimestamp,
+		AcceptedNextPublicKeysV6: strings.Join(msg.AcceptedNextPublicKeysV6, ","),
 	}
 }
 
@@ -64,6 +64,17 @@ func TestProtoToWireguardEndpointUpdate(t *testing.T) {
 				Hostname: "hostname", PublicKey: "public", InterfaceIpv4Addr: "ipv4",
 			},
 		},
+		{
+			"rotating",
+			&proto.WireguardEndpointUpdate{
+				Hostname: "hostname", PublicKey: "public", NextPublicKey: "next", PublicKeyTimestamp: 1000,
+				AcceptedNextPublicKeys: []string{"peer1-next", "peer2-next"},
+			},
+			WireguardEndpointUpdate{
+				Hostname: "hostname", PublicKey: "public", NextPublicKey: "next", PublicKeyTimestamp: 1000,
+				AcceptedNextPublicKeys: "peer1-next,peer2-next",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -90,6 +101,17 @@ func TestProtoToWireguardEndpointV6Update(t *testing.T) {
 				Hostname: "hostname", PublicKeyV6: "public", InterfaceIpv6Addr: "ipv6",
 			},
 		},
+		{
+			"rotating",
+			&proto.WireguardEndpointV6Update{
+				Hostname: "hostname", PublicKeyV6: "public", NextPublicKeyV6: "next", PublicKeyTimestamp: 1000,
+				AcceptedNextPublicKeysV6: []string{"peer1-next", "peer2-next"},
+			},
+			WireguardEndpointV6Update{
+				Hostname: "hostname", PublicKeyV6: "public", NextPublicKeyV6: "next", PublicKeyTimestamp: 1000,
+				AcceptedNextPublicKeysV6: "peer1-next,peer2-next",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -601,13 +601,22 @@ func removeWireguardPublicKey(
 		}
 
 		// if there is any config mismatch, wipe the datastore's publickey (forces peers to send unencrypted traffic)
-		if ipVersion == 4 && thisNode.Status.WireguardPublicKey != "" || ipVersion == 6 && thisNode.Status.WireguardPublicKeyV6 != "" {
+		if ipVersion == 4 && (thisNode.Status.WireguardPublicKey != "" || thisNode.Status.WireguardNextPublicKey != "" ||
+			len(thisNode.Status.WireguardAcceptedNextPublicKeys) > 0) ||
+			ipVersion == 6 && (thisNode.Status.WireguardPublicKeyV6 != "" || thisNode.Status.WireguardNextPublicKeyV6 != "" ||
+				len(thisNode.Status.WireguardAcceptedNextPublicKeysV6) > 0) {
 			logCtx.Info("Wireguard key set on node - removing")
 			switch ipVersion {
 			case 4:
 				thisNode.Status.WireguardPublicKey = ""
+				thisNode.Status.WireguardNextPublicKey = ""
+				thisNode.Status.WireguardPublicKeyTimestamp = nil
+				thisNode.Status.WireguardAcceptedNextPublicKeys = nil
 			case 6:
 				thisNode.Status.WireguardPublicKeyV6 = ""
+				thisNode.Status.WireguardNextPublicKeyV6 = ""
+				thisNode.Status.WireguardPublicKeyTimestampV6 = nil
+				thisNode.Status.WireguardAcceptedNextPublicKeysV6 = nil
 			}
 			cxt, cancel = context.WithTimeout(context.Background(), bootstrapK8sClientTimeout)
 			_, err = calicoClient.Nodes().Update(cxt, thisNode, options.SetOptions{})
@@ -34,4 +34,8 @@ type Config struct {
 	PersistentKeepAlive time.Duration
 	RouteSyncDisabled   bool
 	ThreadedNAPI        bool
+
+	// Key rotation configuration.  A zero KeyRotationInterval disables rotation.
+	KeyRotationInterval    time.Duration
+	KeyRotationGracePeriod time.Duration
 }
@@ -0,0 +1,444 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package wireguard
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/netlinkshim"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+// Key rotation
+// ------------
+//
+// When key rotation is enabled, each node rotates its key pair once the current key reaches the rotation interval:
+//
+// 1. The node generates the next key pair and publishes the next public key alongside its current public key.
+//    The device keeps using the current private key.
+// 2. Peers program a "standby" peer for the next public key. The standby peer has the node's endpoint but no allowed
+//    IPs, so it does not change routing, but it means the peers already know the next key when the node starts to
+//    use it. Once the standby peer is programmed, each peer publishes the next key as one of its accepted keys.
+// 3. Once the grace period has passed and every peer that the node programs has accepted the next key, the node
+//    switches the device to the next private key and publishes it as its current public key. Switching the key
+//    resets the sessions on the device; the handshakes that replace them use the new key, and are accepted by the
+//    standby peers.
+// 4. The kernel only allows an allowed IP to belong to a single peer, so the standby peer cannot share the node's
+//    allowed IPs with the peer for the current key. A handshake can only complete on the standby peer once the node
+//    is using the next key, so peers poll the handshakes of their standby peers and move the allowed IPs over as soon
+//    as one completes, without waiting for the new current key to be published. Moving them any earlier would send
+//    traffic to a key that the node cannot use yet. If the peers see the new current key first, they move the
+//    allowed IPs at that point instead.
+//
+// Packets between the node and a peer are therefore only dropped between the switch and the peer's next poll of its
+// standby peers. A node does not switch its key while any of its peers has not accepted the next key, so rotation
+// waits for peers that do not support it.
+
+// keyRotationHandshakePollInterval is the interval at which the handshakes of standby peers are checked.
+const keyRotationHandshakePollInterval = time.Second
+
+var (
+	keyRotationsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "felix_wireguard_key_rotations",
+		Help: "Number of times Felix has switched the wireguard device to a new key pair.",
+	}, []string{"ip_version"})
+	keyAgeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "felix_wireguard_key_age_seconds",
+		Help: "Time since the key pair of the wireguard device was generated.",
+	}, []string{"ip_version"})
+)
+
+func init() {
+	prometheus.MustRegister(keyRotationsCounter)
+	prometheus.MustRegister(keyAgeGauge)
+}
+
+// KeyStatus is the key state of the local node, published through the status callback.
+type KeyStatus struct {
+	// PublicKey is the public key of the device, or the zero key if wireguard is not enabled or supported.
+	PublicKey wgtypes.Key
+	// NextPublicKey is the public key that the device is rotating to, or the zero key if no rotation is in progress.
+	NextPublicKey wgtypes.Key
+	// KeyTimestamp is the time at which the key pair of the device was generated.
+	KeyTimestamp time.Time
+	// AcceptedNextPublicKeys are the next public keys of rotating peers that are programmed on the device.
+	AcceptedNextPublicKeys []wgtypes.Key
+}
+
+// standbyPeer is a peer programmed for the next public key of a node that is rotating its key.
+type standbyPeer struct {
+	key          wgtypes.Key
+	endpointAddr ip.Addr
+}
+
+// EndpointWireguardKeyRotationUpdate is called with the key rotation state of an endpoint (a node). For the local node
+// this restores the age of the key after a restart; for peers it controls the standby peers and records which next
+// keys the peer has accepted.
+func (w *Wireguard) EndpointWireguardKeyRotationUpdate(
+	name string, nextPublicKey wgtypes.Key, keyTimestamp time.Time, acceptedNextPublicKeys []wgtypes.Key,
+) {
+	logCtx := w.logCtx.WithFields(log.Fields{"node": name, "nextPublicKey": nextPublicKey, "keyTimestamp": keyTimestamp})
+	logCtx.Debug("EndpointWireguardKeyRotationUpdate")
+	if !w.Enabled() {
+		logCtx.Debug("Not enabled - ignoring")
+		return
+	}
+
+	if name == w.hostname {
+		if w.ourKeyTimestampKey == zeroKey && w.ourPublicKey != nil && *w.ourPublicKey != zeroKey && !keyTimestamp.IsZero() {
+			// We have not determined the age of our key yet. Use the published timestamp; if the key on the device
+			// turns out to be different we'll reset it.
+			logCtx.Debug("Restoring key timestamp")
+			w.ourKeyTimestamp = keyTimestamp
+			w.ourKeyTimestampKey = *w.ourPublicKey
+		} else if nextPublicKey != w.ourNextPublicKey() || !keyTimestamp.Equal(w.ourKeyTimestamp) ||
+			!slices.Equal(sortedKeys(acceptedNextPublicKeys), w.acceptedNextKeys) {
+			// The published rotation state does not match ours, most likely because Felix restarted part way
+			// through a rotation. Republish our state.
+			logCtx.Debug("Published key rotation state does not match")
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		}
+		return
+	}
+
+	if len(acceptedNextPublicKeys) == 0 {
+		delete(w.peerAcceptedNextKeys, name)
+	} else {
+		w.peerAcceptedNextKeys[name] = set.FromArray(acceptedNextPublicKeys)
+	}
+
+	update := w.getOrInitNodeUpdateData(name)
+	if existing, ok := w.nodes[name]; ok && existing.nextPublicKey == nextPublicKey {
+		logCtx.Debug("Next public key unchanged from programmed")
+		update.nextPublicKey = nil
+	} else {
+		logCtx.Debug("Storing updated next public key")
+		update.nextPublicKey = &nextPublicKey
+	}
+	w.setNodeUpdate(name, update)
+}
+
+// ourNextPublicKey returns the public key the local node is rotating to, or the zero key if no rotation is in progress.
+func (w *Wireguard) ourNextPublicKey() wgtypes.Key {
+	if w.ourNextPrivateKey == nil {
+		return zeroKey
+	}
+	return w.ourNextPrivateKey.PublicKey()
+}
+
+// ourKeyStatus returns the key status to publish for the local node.
+func (w *Wireguard) ourKeyStatus() KeyStatus {
+	if w.ourPublicKey == nil || *w.ourPublicKey == zeroKey {
+		return KeyStatus{}
+	}
+	status := KeyStatus{
+		PublicKey:              *w.ourPublicKey,
+		NextPublicKey:          w.ourNextPublicKey(),
+		AcceptedNextPublicKeys: w.acceptedNextKeys,
+	}
+	if w.ourKeyTimestampKey == *w.ourPublicKey {
+		status.KeyTimestamp = w.ourKeyTimestamp
+	}
+	return status
+}
+
+// maybeRotateKey drives the key rotation of the local node. It is called once the device is in-sync, and:
+// -  Starts tracking the age of a key that we have not seen before.
+// -  Generates and publishes the next key once the current key reaches the rotation interval.
+// -  Switches the device to the next key once the grace period has passed and all peers have accepted it.
+func (w *Wireguard) maybeRotateKey(wireguardClient netlinkshim.Wireguard) error {
+	if w.ourPublicKey == nil || *w.ourPublicKey == zeroKey {
+		return nil
+	}
+	now := w.time.Now()
+	ipVersion := fmt.Sprint(w.ipVersion)
+
+	if w.ourKeyTimestampKey != *w.ourPublicKey {
+		// The key on the device is not the one we have a timestamp for, so the key is new or its age is unknown.
+		// Count the age from now.
+		w.logCtx.WithField("publicKey", *w.ourPublicKey).Info("Tracking age of wireguard key")
+		w.ourKeyTimestamp = now.Truncate(time.Second)
+		w.ourKeyTimestampKey = *w.ourPublicKey
+		w.ourNextPrivateKey = nil
+		w.ourPublicKeyAgreesWithDataplaneMsg = false
+	}
+	keyAgeGauge.WithLabelValues(ipVersion).Set(now.Sub(w.ourKeyTimestamp).Seconds())
+
+	if w.config.KeyRotationInterval <= 0 {
+		if w.ourNextPrivateKey != nil {
+			w.logCtx.Info("Key rotation disabled, abandoning in-progress rotation")
+			w.ourNextPrivateKey = nil
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		}
+		return nil
+	}
+
+	if w.ourNextPrivateKey == nil {
+		if now.Sub(w.ourKeyTimestamp) < w.config.KeyRotationInterval {
+			return nil
+		}
+		nextKey, err := wgtypes.GeneratePrivateKey()
+		if err != nil {
+			w.logCtx.WithError(err).Error("error generating private-key")
+			return err
+		}
+		w.logCtx.WithField("nextPublicKey", nextKey.PublicKey()).Info("Starting wireguard key rotation")
+		w.ourNextPrivateKey = &nextKey
+		w.ourNextKeyGenerated = now
+		w.ourNextKeyNumPeersWaited = 0
+		w.ourPublicKeyAgreesWithDataplaneMsg = false
+		return nil
+	}
+
+	if now.Sub(w.ourNextKeyGenerated) < w.config.KeyRotationGracePeriod {
+		return nil
+	}
+	if pending := w.peersPendingNextKey(w.ourNextPrivateKey.PublicKey()); len(pending) > 0 {
+		// Peers that have not programmed the next key would reject our handshakes once we switch.
+		if len(pending) != w.ourNextKeyNumPeersWaited {
+			w.logCtx.WithField("numPeers", len(pending)).Info("Waiting for peers to accept the next wireguard key")
+			w.ourNextKeyNumPeersWaited = len(pending)
+		}
+		w.logCtx.WithField("peers", pending).Debug("Peers have not accepted the next wireguard key")
+		return nil
+	}
+
+	// The grace period has passed and all peers have accepted the next key, switch the device to it.
+	if err := w.applyWireguardConfig(wireguardClient, &wgtypes.Config{PrivateKey: w.ourNextPrivateKey}); err != nil {
+		w.logCtx.WithError(err).Info("Failed to switch wireguard device to the next key")
+		return err
+	}
+	publicKey := w.ourNextPrivateKey.PublicKey()
+	w.logCtx.WithField("publicKey", publicKey).Info("Switched wireguard device to the next key")
+	w.ourPublicKey = &publicKey
+	w.ourKeyTimestamp = w.ourNextKeyGenerated.Truncate(time.Second)
+	w.ourKeyTimestampKey = publicKey
+	w.ourNextPrivateKey = nil
+	w.ourPublicKeyAgreesWithDataplaneMsg = false
+	keyRotationsCounter.WithLabelValues(ipVersion).Inc()
+	keyAgeGauge.WithLabelValues(ipVersion).Set(now.Sub(w.ourKeyTimestamp).Seconds())
+	return nil
+}
+
+// peersPendingNextKey returns the names of the programmed peers that have not accepted the supplied next key.
+func (w *Wireguard) peersPendingNextKey(nextKey wgtypes.Key) []string {
+	var pending []string
+	for name, node := range w.nodes {
+		if !w.shouldProgramWireguardPeer(name, node) {
+			continue
+		} else if accepted := w.peerAcceptedNextKeys[name]; accepted != nil && accepted.Contains(nextKey) {
+			continue
+		}
+		pending = append(pending, name)
+	}
+	slices.Sort(pending)
+	return pending
+}
+
+// updateAcceptedNextKeys updates the accepted next keys from the standby peers once they are programmed, and flags
+// that a status update is required if they have changed.
+func (w *Wireguard) updateAcceptedNextKeys() {
+	keys := make([]wgtypes.Key, 0, len(w.standbyPeers))
+	for _, peer := range w.standbyPeers {
+		keys = append(keys, peer.key)
+	}
+	keys = sortedKeys(keys)
+	if slices.Equal(keys, w.acceptedNextKeys) {
+		return
+	}
+	w.logCtx.WithField("numKeys", len(keys)).Debug("Accepted next keys updated")
+	w.acceptedNextKeys = keys
+	w.ourPublicKeyAgreesWithDataplaneMsg = false
+}
+
+// sortedKeys returns a sorted copy of the supplied keys.
+func sortedKeys(keys []wgtypes.Key) []wgtypes.Key {
+	sorted := slices.Clone(keys)
+	slices.SortFunc(sorted, func(a, b wgtypes.Key) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	return sorted
+}
+
+// switchRotatedPeerKeys switches peers that are rotating their keys over to their next key once a handshake has
+// completed on the standby peer, which shows that the peer is using the next key. The switch is applied as a node
+// update, exactly as if the peer had published the next key as its current key; if the peer later publishes a
+// different key, that update replaces it.
+func (w *Wireguard) switchRotatedPeerKeys() {
+	if len(w.standbyPeers) == 0 || !w.inSyncLink {
+		return
+	}
+	wireguardClient, err := w.getWireguardClient()
+	if err != nil {
+		w.logCtx.WithError(err).Debug("Unable to get wireguard client to check standby peers")
+		return
+	}
+	device, err := wireguardClient.DeviceByName(w.interfaceName)
+	if err != nil {
+		w.logCtx.WithError(err).Info("Unable to query wireguard device to check standby peers")
+		return
+	}
+	handshakes := map[wgtypes.Key]time.Time{}
+	for _, peer := range device.Peers {
+		handshakes[peer.PublicKey] = peer.LastHandshakeTime
+	}
+
+	for name, standby := range w.standbyPeers {
+		node := w.nodes[name]
+		if node == nil || node.nextPublicKey != standby.key {
+			continue
+		} else if handshakes[standby.key].IsZero() {
+			continue
+		} else if update := w.nodeUpdates[name]; update != nil && (update.publicKey != nil || update.nextPublicKey != nil) {
+			// The peer has published new key state, which takes precedence.
+			continue
+		}
+		w.logCtx.WithFields(log.Fields{"node": name, "publicKey": standby.key}).Info(
+			"Standby peer has completed a handshake, switching peer to its next key")
+		nextKey := standby.key
+		update := w.getOrInitNodeUpdateData(name)
+		update.publicKey = &nextKey
+		update.nextPublicKey = &zeroKey
+		w.setNodeUpdate(name, update)
+	}
+}
+
+// RescheduleAfter returns the time after which Apply needs to be called again for key rotation to progress, even if
+// there are no other updates, or zero if there is no need.
+func (w *Wireguard) RescheduleAfter() time.Duration {
+	if !w.Enabled() {
+		return 0
+	}
+	if len(w.standbyPeers) > 0 {
+		// Poll the handshakes of the standby peers.
+		return keyRotationHandshakePollInterval
+	}
+	if w.ourNextPrivateKey != nil {
+		if remaining := w.config.KeyRotationGracePeriod - w.time.Since(w.ourNextKeyGenerated); remaining > 0 {
+			return remaining
+		}
+	}
+	return 0
+}
+
+// desiredStandbyPeers returns the standby peers that should be programmed, keyed by node name. A node only has a
+// standby peer if it is programmed itself and its next key is not claimed by any other node.
+func (w *Wireguard) desiredStandbyPeers() map[string]standbyPeer {
+	desired := map[string]standbyPeer{}
+	nodeByKey := map[wgtypes.Key]string{}
+	conflicting := map[wgtypes.Key]bool{}
+	for name, node := range w.nodes {
+		if node.nextPublicKey == zeroKey || node.nextPublicKey == node.publicKey {
+			continue
+		} else if !w.shouldProgramWireguardPeer(name, node) {
+			continue
+		} else if names := w.publicKeyToNodeNames[node.nextPublicKey]; names != nil && names.Len() > 0 {
+			w.logCtx.WithField("node", name).Info("Next public key is already claimed by a node")
+			continue
+		} else if w.ourPublicKey != nil && node.nextPublicKey == *w.ourPublicKey {
+			w.logCtx.WithField("node", name).Info("Next public key is the same as the local public key")
+			continue
+		}
+		if _, ok := nodeByKey[node.nextPublicKey]; ok {
+			conflicting[node.nextPublicKey] = true
+			continue
+		}
+		nodeByKey[node.nextPublicKey] = name
+		desired[name] = standbyPeer{key: node.nextPublicKey, endpointAddr: node.endpointAddr}
+	}
+	for key := range conflicting {
+		w.logCtx.WithField("nextPublicKey", key).Info("Next public key is claimed by multiple nodes")
+		delete(desired, nodeByKey[key])
+	}
+	return desired
+}
+
+// isProgrammedPublicKey returns true if the key is the current public key of a peer that should be programmed.
+func (w *Wireguard) isProgrammedPublicKey(key wgtypes.Key) bool {
+	item := getOnlyItemInSet(w.publicKeyToNodeNames[key])
+	if item == nil {
+		return false
+	}
+	node := w.nodes[*item]
+	return node != nil && w.shouldProgramWireguardPeer(*item, node)
+}
+
+// standbyPeerConfig returns the peer configuration for a standby peer.
+func (w *Wireguard) standbyPeerConfig(peer standbyPeer) wgtypes.PeerConfig {
+	return wgtypes.PeerConfig{
+		PublicKey:                   peer.key,
+		Endpoint:                    w.endpointUDPAddr(peer.endpointAddr.AsNetIP()),
+		ReplaceAllowedIPs:           true,
+		PersistentKeepaliveInterval: &w.config.PersistentKeepAlive,
+	}
+}
+
+// addStandbyPeerDeltas adds the changes to the standby peers since the last update to the supplied wireguard delta
+// update, and returns the combined update. Keys that the update already contains are left alone: they are being
+// programmed as regular peers.
+func (w *Wireguard) addStandbyPeerDeltas(wireguardUpdate *wgtypes.Config) *wgtypes.Config {
+	desired := w.desiredStandbyPeers()
+	defer func() {
+		w.standbyPeers = desired
+	}()
+
+	var update wgtypes.Config
+	if wireguardUpdate != nil {
+		update = *wireguardUpdate
+	}
+	inUpdate := map[wgtypes.Key]bool{}
+	for _, peer := range update.Peers {
+		inUpdate[peer.PublicKey] = true
+	}
+	desiredKeys := map[wgtypes.Key]bool{}
+	for _, peer := range desired {
+		desiredKeys[peer.key] = true
+	}
+
+	for name, peer := range w.standbyPeers {
+		if desiredKeys[peer.key] || inUpdate[peer.key] || w.isProgrammedPublicKey(peer.key) {
+			// Still required, either as a standby peer or because the node has switched to the key.
+			continue
+		}
+		w.logCtx.WithFields(log.Fields{"node": name, "publicKey": peer.key}).Debug("Removing standby peer")
+		update.Peers = append(update.Peers, wgtypes.PeerConfig{PublicKey: peer.key, Remove: true})
+		inUpdate[peer.key] = true
+	}
+	for name, peer := range desired {
+		if existing, ok := w.standbyPeers[name]; ok && existing == peer {
+			continue
+		} else if inUpdate[peer.key] {
+			continue
+		}
+		w.logCtx.WithFields(log.Fields{"node": name, "publicKey": peer.key}).Debug("Programming standby peer")
+		update.Peers = append(update.Peers, w.standbyPeerConfig(peer))
+		inUpdate[peer.key] = true
+	}
+
+	if len(update.Peers) == 0 && wireguardUpdate == nil {
+		return nil
+	}
+	return &update
+}
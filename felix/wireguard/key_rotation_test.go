@@ -0,0 +1,406 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguard_test
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+
+	"github.com/projectcalico/calico/felix/environment"
+	"github.com/projectcalico/calico/felix/ifacemonitor"
+	"github.com/projectcalico/calico/felix/logutils"
+	mocknetlink "github.com/projectcalico/calico/felix/netlinkshim/mocknetlink"
+	"github.com/projectcalico/calico/felix/timeshim/mocktime"
+	. "github.com/projectcalico/calico/felix/wireguard"
+)
+
+// newKeyRotationWireguard creates a wireguard instance for the named node with key rotation enabled, and brings up its
+// device.
+func newKeyRotationWireguard(name string, t *mocktime.MockTime, s *mockCallbacks) (*Wireguard, *mocknetlink.MockLink) {
+	wgDataplane := mocknetlink.New()
+	rtDataplane := mocknetlink.New()
+	rrDataplane := mocknetlink.New()
+
+	config := &Config{
+		Enabled:                true,
+		ListeningPort:          listeningPort,
+		FirewallMark:           int(firewallMark),
+		RoutingRulePriority:    rulePriority,
+		RoutingTableIndex:      tableIndex,
+		InterfaceName:          ifaceName,
+		MTU:                    mtu,
+		KeyRotationInterval:    time.Hour,
+		KeyRotationGracePeriod: 5 * time.Minute,
+	}
+
+	wg := NewWithShims(
+		name,
+		config,
+		4,
+		rtDataplane.NewMockNetlink,
+		rrDataplane.NewMockNetlink,
+		wgDataplane.NewMockNetlink,
+		wgDataplane.NewMockWireguard,
+		10*time.Second,
+		t,
+		FelixRouteProtocol,
+		s.status,
+		s.writeProcSys,
+		logutils.NewSummarizer("test loop"),
+		&environment.FakeFeatureDetector{
+			Features: environment.Features{
+				KernelSideRouteFiltering: true,
+			},
+		},
+	)
+
+	Expect(wg.Apply()).To(Equal(ErrWaitingForLink))
+	wgDataplane.SetIface(ifaceName, true, true)
+	rtDataplane.AddIface(101, ifaceName, true, true)
+	wg.OnIfaceStateChanged(ifaceName, 101, ifacemonitor.StateUp)
+	Expect(wg.Apply()).NotTo(HaveOccurred())
+	link := wgDataplane.NameToLink[ifaceName]
+	Expect(link.WireguardPublicKey).NotTo(Equal(zeroKey))
+	return wg, link
+}
+
+// publishKeyStatus passes the last key status published by a node to a wireguard instance, as the datastore would.
+func publishKeyStatus(name string, s *mockCallbacks, wg *Wireguard) {
+	wg.EndpointWireguardUpdate(name, s.lastStatus.PublicKey, nil)
+	wg.EndpointWireguardKeyRotationUpdate(
+		name, s.lastStatus.NextPublicKey, s.lastStatus.KeyTimestamp, s.lastStatus.AcceptedNextPublicKeys,
+	)
+}
+
+// completeHandshake records a handshake on a peer, as the kernel would once the remote node uses the peer's key.
+func completeHandshake(link *mocknetlink.MockLink, key wgtypes.Key, now time.Time) {
+	peer := link.WireguardPeers[key]
+	peer.LastHandshakeTime = now
+	link.WireguardPeers[key] = peer
+}
+
+var _ = Describe("Wireguard key rotation", func() {
+	var t *mocktime.MockTime
+	var s *mockCallbacks
+	var wg *Wireguard
+	var link *mocknetlink.MockLink
+	var key wgtypes.Key
+
+	BeforeEach(func() {
+		s = &mockCallbacks{}
+		t = mocktime.New()
+		t.SetAutoIncrement(11 * time.Second)
+		wg, link = newKeyRotationWireguard(hostname, t, s)
+		key = link.WireguardPublicKey
+	})
+
+	It("should publish the key timestamp with the public key", func() {
+		Expect(s.lastStatus.PublicKey).To(Equal(key))
+		Expect(s.lastStatus.NextPublicKey).To(Equal(zeroKey))
+		Expect(s.lastStatus.KeyTimestamp.IsZero()).To(BeFalse())
+	})
+
+	It("should publish the next key after the rotation interval and switch to it after the grace period", func() {
+		timestamp := s.lastStatus.KeyTimestamp
+
+		By("not rotating before the rotation interval")
+		t.IncrementTime(30 * time.Minute)
+		Expect(wg.Apply()).NotTo(HaveOccurred())
+		Expect(s.numStatusCallbacks).To(Equal(1))
+
+		By("publishing the next key once the rotation interval has passed")
+		t.IncrementTime(30 * time.Minute)
+		Expect(wg.Apply()).NotTo(HaveOccurred())
+		Expect(s.numStatusCallbacks).To(Equal(2))
+		nextKey := s.lastStatus.NextPublicKey
+		Expect(nextKey).NotTo(Equal(zeroKey))
+		Expect(s.lastStatus.PublicKey).To(Equal(key))
+		Expect(s.lastStatus.KeyTimestamp).To(Equal(timestamp))
+		Expect(link.WireguardPublicKey).To(Equal(key))
+
+		By("switching the device to the next key after the grace period")
+		t.IncrementTime(5 * time.Minute)
+		Expect(wg.Apply()).NotTo(HaveOccurred())
+		Expect(s.numStatusCallbacks).To(Equal(3))
+		Expect(link.WireguardPublicKey).To(Equal(nextKey))
+		Expect(link.WireguardPrivateKey.PublicKey()).To(Equal(nextKey))
+		Expect(s.lastStatus.PublicKey).To(Equal(nextKey))
+		Expect(s.lastStatus.NextPublicKey).To(Equal(zeroKey))
+		Expect(s.lastStatus.KeyTimestamp.After(timestamp)).To(BeTrue())
+	})
+
+	It("should republish the rotation state if the published state does not match", func() {
+		wg.EndpointWireguardUpdate(hostname, key, nil)
+		wg.EndpointWireguardKeyRotationUpdate(hostname, mustGeneratePrivateKey().PublicKey(), s.lastStatus.KeyTimestamp, nil)
+		Expect(wg.Apply()).NotTo(HaveOccurred())
+		Expect(s.numStatusCallbacks).To(Equal(2))
+		Expect(s.lastStatus.PublicKey).To(Equal(key))
+		Expect(s.lastStatus.NextPublicKey).To(Equal(zeroKey))
+	})
+
+	Describe("with a peer", func() {
+		var keyPeer1, nextKeyPeer1 wgtypes.Key
+
+		BeforeEach(func() {
+			keyPeer1 = mustGeneratePrivateKey().PublicKey()
+			nextKeyPeer1 = mustGeneratePrivateKey().PublicKey()
+			wg.EndpointWireguardUpdate(hostname, key, nil)
+			wg.EndpointWireguardUpdate(peer1, keyPeer1, nil)
+			wg.EndpointUpdate(peer1, ipv4_peer1)
+			wg.RouteUpdate(peer1, cidr_1)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers[keyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+		})
+
+		It("should program a standby peer for the next key of the peer", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+			Expect(link.WireguardPeers[keyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+			Expect(link.WireguardPeers).To(HaveKey(nextKeyPeer1))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(BeEmpty())
+			Expect(link.WireguardPeers[nextKeyPeer1].Endpoint).To(Equal(&net.UDPAddr{
+				IP:   ipv4_peer1.AsNetIP(),
+				Port: listeningPort,
+			}))
+
+			By("keeping the standby peer across a resync")
+			wg.QueueResync()
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(BeEmpty())
+
+			By("moving the allowed IPs to the standby peer when the peer switches key")
+			wg.EndpointWireguardUpdate(peer1, nextKeyPeer1, nil)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).To(HaveKey(nextKeyPeer1))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+		})
+
+		It("should publish the next key of the peer as accepted once the standby peer is programmed", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(s.lastStatus.AcceptedNextPublicKeys).To(Equal([]wgtypes.Key{nextKeyPeer1}))
+			Expect(wg.RescheduleAfter()).To(Equal(time.Second))
+
+			By("no longer publishing the key once the peer abandons the rotation")
+			numStatusCallbacks := s.numStatusCallbacks
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(s.numStatusCallbacks).To(Equal(numStatusCallbacks + 1))
+			Expect(s.lastStatus.AcceptedNextPublicKeys).To(BeEmpty())
+			Expect(wg.RescheduleAfter()).To(BeZero())
+		})
+
+		It("should keep the allowed IPs on the current peer until the standby peer has a handshake", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+
+			By("keeping the allowed IPs on the peer for the current key while the standby peer has no handshake")
+			t.IncrementTime(time.Hour)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+			Expect(link.WireguardPeers[keyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(BeEmpty())
+
+			By("moving the allowed IPs to the standby peer once it has a handshake")
+			completeHandshake(link, nextKeyPeer1, t.Now())
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).NotTo(HaveKey(keyPeer1))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+
+			By("leaving the peer alone when it publishes the new key")
+			wg.EndpointWireguardUpdate(peer1, nextKeyPeer1, nil)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+
+			By("keeping the allowed IPs on the new key across a resync")
+			wg.QueueResync()
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers[nextKeyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+		})
+
+		It("should revert to the current key if the peer publishes it after the switch", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			completeHandshake(link, nextKeyPeer1, t.Now())
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).To(HaveKey(nextKeyPeer1))
+
+			wg.EndpointWireguardUpdate(peer1, keyPeer1, nil)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers[keyPeer1].AllowedIPs).To(ConsistOf(ipnet_1))
+		})
+
+		It("should wait for the peer to accept the next key before switching to it", func() {
+			t.IncrementTime(time.Hour)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			nextKey := s.lastStatus.NextPublicKey
+			Expect(nextKey).NotTo(Equal(zeroKey))
+			Expect(wg.RescheduleAfter()).To(BeNumerically("~", 5*time.Minute, time.Minute))
+
+			By("not switching after the grace period while the peer has not accepted the next key")
+			t.IncrementTime(time.Hour)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPublicKey).To(Equal(key))
+
+			By("not switching when the peer accepts a different key")
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), []wgtypes.Key{nextKeyPeer1})
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPublicKey).To(Equal(key))
+
+			By("switching once the peer has accepted the next key")
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), []wgtypes.Key{nextKeyPeer1, nextKey})
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPublicKey).To(Equal(nextKey))
+			Expect(s.lastStatus.PublicKey).To(Equal(nextKey))
+		})
+
+		It("should not wait for a peer that is no longer programmed", func() {
+			t.IncrementTime(time.Hour)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			nextKey := s.lastStatus.NextPublicKey
+			t.IncrementTime(5 * time.Minute)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPublicKey).To(Equal(key))
+
+			wg.EndpointWireguardRemove(peer1)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPublicKey).To(Equal(nextKey))
+		})
+
+		It("should remove the standby peer if the peer abandons the rotation", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).To(HaveKey(keyPeer1))
+		})
+
+		It("should remove the standby peer when the peer is removed", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+
+			wg.EndpointWireguardRemove(peer1)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(BeEmpty())
+		})
+
+		It("should not program a standby peer for a key that is claimed by another node", func() {
+			wg.EndpointWireguardUpdate(peer2, nextKeyPeer1, nil)
+			wg.EndpointUpdate(peer2, ipv4_peer2)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, nextKeyPeer1, t.Now(), nil)
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+			Expect(link.WireguardPeers[nextKeyPeer1].Endpoint).To(Equal(&net.UDPAddr{
+				IP:   ipv4_peer2.AsNetIP(),
+				Port: listeningPort,
+			}))
+		})
+	})
+
+	Describe("between two nodes", func() {
+		var sPeer *mockCallbacks
+		var wgPeer *Wireguard
+		var linkPeer *mocknetlink.MockLink
+
+		BeforeEach(func() {
+			sPeer = &mockCallbacks{}
+			wgPeer, linkPeer = newKeyRotationWireguard(peer1, t, sPeer)
+
+			wg.EndpointUpdate(peer1, ipv4_peer1)
+			wg.RouteUpdate(peer1, cidr_1)
+			wgPeer.EndpointUpdate(hostname, ipv4_host)
+			wgPeer.RouteUpdate(hostname, cidr_2)
+			for _, w := range []*Wireguard{wg, wgPeer} {
+				publishKeyStatus(hostname, s, w)
+				publishKeyStatus(peer1, sPeer, w)
+			}
+			Expect(wg.Apply()).NotTo(HaveOccurred())
+			Expect(wgPeer.Apply()).NotTo(HaveOccurred())
+			Expect(linkPeer.WireguardPeers[key].AllowedIPs).To(ConsistOf(ipnet_2))
+		})
+
+		// peerAcceptsHandshakes returns true if the peer has a wireguard peer for the key the node is using, and so
+		// accepts the handshakes of the node.
+		peerAcceptsHandshakes := func() bool {
+			_, ok := linkPeer.WireguardPeers[link.WireguardPublicKey]
+			return ok
+		}
+
+		// peerRoutesToNode returns true if the peer routes traffic for the node to the key the node is using.
+		peerRoutesToNode := func() bool {
+			peer, ok := linkPeer.WireguardPeers[link.WireguardPublicKey]
+			return ok && len(peer.AllowedIPs) == 1 && peer.AllowedIPs[0].String() == ipnet_2.String()
+		}
+
+		It("should hand over to the next key without the peer rejecting handshakes or routing to an unused key", func() {
+			t.IncrementTime(time.Hour)
+			var switched bool
+			for i := 0; i < 20 && !(switched && peerRoutesToNode()); i++ {
+				By("applying the node")
+				keyBefore := link.WireguardPublicKey
+				Expect(wg.Apply()).NotTo(HaveOccurred())
+				Expect(peerAcceptsHandshakes()).To(BeTrue(), "node switched to a key its peer has not programmed")
+				if link.WireguardPublicKey != keyBefore {
+					switched = true
+					if linkPeer.WireguardPeers[link.WireguardPublicKey].LastHandshakeTime.IsZero() {
+						// The node has reset its sessions, and the handshakes with its new key complete against the
+						// standby peer. The peer follows on its next poll, which it has scheduled.
+						Expect(peerRoutesToNode()).To(BeFalse())
+						completeHandshake(linkPeer, link.WireguardPublicKey, t.Now())
+						Expect(wgPeer.RescheduleAfter()).To(Equal(time.Second))
+					}
+				} else {
+					Expect(peerRoutesToNode()).To(BeTrue(), "peer moved its routes before the node switched")
+				}
+
+				By("applying the peer")
+				Expect(wgPeer.Apply()).NotTo(HaveOccurred())
+				Expect(peerAcceptsHandshakes()).To(BeTrue())
+				Expect(peerRoutesToNode()).To(BeTrue(), "peer did not follow the node on its first poll")
+
+				By("exchanging the published key state")
+				for _, w := range []*Wireguard{wg, wgPeer} {
+					publishKeyStatus(hostname, s, w)
+					publishKeyStatus(peer1, sPeer, w)
+				}
+				t.IncrementTime(time.Minute)
+			}
+			Expect(switched).To(BeTrue())
+			Expect(link.WireguardPublicKey).NotTo(Equal(key))
+			Expect(linkPeer.WireguardPeers).To(HaveLen(1))
+			Expect(wgPeer.RescheduleAfter()).To(BeZero())
+		})
+	})
+})
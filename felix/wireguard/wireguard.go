@@ -70,6 +70,7 @@ var (
 type nodeData struct {
 	endpointAddr          ip.Addr
 	publicKey             wgtypes.Key
+	nextPublicKey         wgtypes.Key
 	cidrs                 set.Set[ip.CIDR]
 	programmedInWireguard bool
 	routingToWireguard    bool
@@ -96,9 +97,10 @@ type nodeUpdateData struct {
 	cidrsDeleted set.Set[ip.CIDR]
 
 	// Only used for peers.
-	deleted      bool
-	endpointAddr *ip.Addr
-	publicKey    *wgtypes.Key
+	deleted       bool
+	endpointAddr  *ip.Addr
+	publicKey     *wgtypes.Key
+	nextPublicKey *wgtypes.Key
 }
 
 func newNodeUpdateData() *nodeUpdateData {
@@ -136,6 +138,24 @@ type Wireguard struct {
 	ourPublicKeyAgreesWithDataplaneMsg bool
 	ourHostAddr                        ip.Addr
 
+	// Key rotation state for the local node. The timestamp is the time at which the key ourKeyTimestampKey was
+	// generated. The next private key is only held in memory: if Felix restarts part way through a rotation, it
+	// abandons the rotation and starts a new one.
+	ourKeyTimestamp          time.Time
+	ourKeyTimestampKey       wgtypes.Key
+	ourNextPrivateKey        *wgtypes.Key
+	ourNextKeyGenerated      time.Time
+	ourNextKeyNumPeersWaited int
+
+	// Standby peers for the next public keys of peers that are rotating their keys, keyed by node name. The
+	// accepted keys are the keys of the standby peers that are programmed, as last published by the status callback.
+	standbyPeers     map[string]standbyPeer
+	acceptedNextKeys []wgtypes.Key
+
+	// The next public keys that each peer has published as accepted, keyed by node name. This is always updated
+	// directly from EndpointWireguardKeyRotationUpdate.
+	peerAcceptedNextKeys map[string]set.Set[wgtypes.Key]
+
 	// Local route information. This contains the complete set of local routes: workloads, tunnels, hosts (for host
 	// encryption). This is always updated directly from the various update methods.
 	localIPs          set.Set[ip.Addr]
@@ -160,8 +180,8 @@ type Wireguard struct {
 	routetable *routetable.ClassView
 	routerule  *routerule.RouteRules
 
-	// Callback function used to notify of public key updates for the local nodeData
-	statusCallback func(publicKey wgtypes.Key) error
+	// Callback function used to notify of key updates for the local nodeData
+	statusCallback func(status KeyStatus) error
 	opRecorder     logutils.OpRecorder
 
 	// The write proc sys function.
@@ -177,7 +197,7 @@ func New(
 	ipVersion uint8,
 	netlinkTimeout time.Duration,
 	deviceRouteProtocol netlink.RouteProtocol,
-	statusCallback func(publicKey wgtypes.Key) error,
+	statusCallback func(status KeyStatus) error,
 	opRecorder logutils.OpRecorder,
 	featureDetector environment.FeatureDetectorIface,
 ) *Wireguard {
@@ -211,7 +231,7 @@ func NewWithShims(
 	netlinkTimeout time.Duration,
 	timeShim timeshim.Interface,
 	deviceRouteProtocol netlink.RouteProtocol,
-	statusCallback func(publicKey wgtypes.Key) error,
+	statusCallback func(status KeyStatus) error,
 	writeProcSys func(path, value string) error,
 	opRecorder logutils.OpRecorder,
 	featureDetector environment.FeatureDetectorIface,
@@ -292,6 +312,8 @@ func NewWithShims(
 		cidrToNodeName:       map[ip.CIDR]string{},
 		publicKeyToNodeNames: map[wgtypes.Key]set.Set[string]{},
 		nodeUpdates:          map[string]*nodeUpdateData{},
+		standbyPeers:         map[string]standbyPeer{},
+		peerAcceptedNextKeys: map[string]set.Set[wgtypes.Key]{},
 		routetable:           routetable.NewClassView(routetable.RouteClassWireguard, rt),
 		routerule:            rr,
 		statusCallback:       statusCallback,
@@ -611,10 +633,12 @@ func (w *Wireguard) EndpointWireguardRemove(name string) {
 		return
 	}
 
-	// Create update to remove the public key.
+	// Create update to remove the public keys.
 	update := w.getOrInitNodeUpdateData(name)
 	update.publicKey = &zeroKey
+	update.nextPublicKey = &zeroKey
 	w.setNodeUpdate(name, update)
+	delete(w.peerAcceptedNextKeys, name)
 }
 
 func (w *Wireguard) QueueResync() {
@@ -646,7 +670,7 @@ func (w *Wireguard) Apply() (err error) {
 		// If we need to send the key then send on the callback method.
 		if !w.ourPublicKeyAgreesWithDataplaneMsg && w.ourPublicKey != nil {
 			w.logCtx.WithField("ourPublicKey", *w.ourPublicKey).Info("Public key out of sync or updated")
-			if errKey := w.statusCallback(*w.ourPublicKey); errKey != nil {
+			if errKey := w.statusCallback(w.ourKeyStatus()); errKey != nil {
 				err = errKey
 				return
 			}
@@ -692,6 +716,10 @@ func (w *Wireguard) Apply() (err error) {
 		w.localCIDRsUpdated = false
 	}
 
+	// Switch peers that are rotating their keys over to their next key once they have started using it. This results
+	// in node deltas for those peers.
+	w.switchRotatedPeerKeys()
+
 	// We scan the updates multiple times to perform the following ordered updates:
 	// 1. Deletion of nodes and wireguard nodes (we handle these separately from other updates because it is easier
 	//    to handle a delete/re-add this way without needing to calculate delta configs.
@@ -815,6 +843,10 @@ func (w *Wireguard) Apply() (err error) {
 				return
 			}
 			wireguardNodeUpdate = w.constructWireguardDeltaFromNodeUpdates(conflictingKeys)
+			if len(w.nodeUpdates) > 0 {
+				// Peer updates may change the set of standby peers for key rotation.
+				wireguardNodeUpdate = w.addStandbyPeerDeltas(wireguardNodeUpdate)
+			}
 			if errWireguard = w.applyWireguardConfig(wireguardClient, wireguardNodeUpdate); errWireguard != nil {
 				w.logCtx.WithError(errWireguard).Info("Failed to create or update wireguard nodes")
 				return
@@ -837,6 +869,14 @@ func (w *Wireguard) Apply() (err error) {
 				w.ourPublicKeyAgreesWithDataplaneMsg = false
 			}
 		}
+
+		// The device is in-sync, so the standby peers are programmed. Publish their keys and rotate our key if it is
+		// due.
+		w.updateAcceptedNextKeys()
+		if errWireguard = w.maybeRotateKey(wireguardClient); errWireguard != nil {
+			w.logCtx.WithError(errWireguard).Info("Failed to rotate wireguard key")
+			return
+		}
 		w.inSyncWireguard = true
 	}()
 
@@ -1069,6 +1109,12 @@ func (w *Wireguard) updateCacheFromNodeUpdates() (conflictingKeys set.Set[wgtype
 			updated = true
 		}
 
+		if update.nextPublicKey != nil {
+			logCtx.WithField("nextPublicKey", *update.nextPublicKey).Debug("Store next public key")
+			node.nextPublicKey = *update.nextPublicKey
+			updated = true
+		}
+
 		update.cidrsDeleted.Iter(func(cidr ip.CIDR) error {
 			logCtx.WithField("cidr", cidr).Debug("Discarding CIDR")
 			node.cidrs.Discard(cidr)
@@ -1327,6 +1373,13 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 	// Track which keys we have processed.
 	processedKeys := set.New[wgtypes.Key]()
 
+	// Determine the standby peers required for peers that are rotating their keys.
+	w.standbyPeers = w.desiredStandbyPeers()
+	standbyPeersByKey := map[wgtypes.Key]standbyPeer{}
+	for _, peer := range w.standbyPeers {
+		standbyPeersByKey[peer.key] = peer
+	}
+
 	// Handle nodes that are configured
 	for peerIdx := range device.Peers {
 		key := device.Peers[peerIdx].PublicKey
@@ -1337,7 +1390,20 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 		processedKeys.Add(key)
 
 		logCtx := w.logCtx.WithFields(log.Fields{"publicKey": key, "node": node})
-		if node == nil {
+		if standby, ok := standbyPeersByKey[key]; ok && node == nil {
+			// This is a standby peer. It should have the node's endpoint and no allowed IPs.
+			configuredAddr := device.Peers[peerIdx].Endpoint
+			expectedEndpointIP := standby.endpointAddr.AsNetIP()
+			if len(device.Peers[peerIdx].AllowedIPs) > 0 || configuredAddr == nil ||
+				configuredAddr.Port != w.ListeningPort() || !configuredAddr.IP.Equal(expectedEndpointIP) {
+				logCtx.Info("Standby peer needs updating")
+				peer := w.standbyPeerConfig(standby)
+				peer.UpdateOnly = true
+				wireguardUpdate.Peers = append(wireguardUpdate.Peers, peer)
+				wireguardUpdateRequired = true
+			}
+			continue
+		} else if node == nil {
 			logCtx.Info("Peer key is not expected or is associated with multiple nodes")
 			wireguardUpdate.Peers = append(wireguardUpdate.Peers, wgtypes.PeerConfig{
 				PublicKey: key,
@@ -1424,6 +1490,16 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 		wireguardUpdateRequired = true
 	}
 
+	// Handle standby peers that are not configured.
+	for name, standby := range w.standbyPeers {
+		if processedKeys.Contains(standby.key) {
+			continue
+		}
+		w.logCtx.WithFields(log.Fields{"publicKey": standby.key, "node": name}).Info("Add standby peer to wireguard")
+		wireguardUpdate.Peers = append(wireguardUpdate.Peers, w.standbyPeerConfig(standby))
+		wireguardUpdateRequired = true
+	}
+
 	if wireguardUpdateRequired {
 		return publicKey, &wireguardUpdate, nil
 	}
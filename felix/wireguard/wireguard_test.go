@@ -172,6 +172,7 @@ type mockCallbacks struct {
 	numStatusCallbacks int
 	statusErr          error
 	statusKey          wgtypes.Key
+	lastStatus         KeyStatus
 
 	numProcSysCallbacks int
 	procSysPath         string
@@ -179,13 +180,14 @@ type mockCallbacks struct {
 	procSysErr          error
 }
 
-func (m *mockCallbacks) status(publicKey wgtypes.Key) error {
-	log.Debugf("Status update with public key: %s", publicKey)
+func (m *mockCallbacks) status(status KeyStatus) error {
+	log.Debugf("Status update with public key: %s", status.PublicKey)
 	m.numStatusCallbacks++
 	if m.statusErr != nil {
 		return m.statusErr
 	}
-	m.statusKey = publicKey
+	m.statusKey = status.PublicKey
+	m.lastStatus = status
 
 	log.Debugf("Num callbacks: %d", m.numStatusCallbacks)
 	return nil
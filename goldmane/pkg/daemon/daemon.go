@@ -107,10 +107,10 @@ func ConfigFromEnv() Config {
 	return cfg
 }
 
-func newGRPCServer(cfg *Config) (*grpc.Server, error) {
+func newGRPCServer(ctx context.Context, cfg *Config) (*grpc.Server, error) {
 	opts := []grpc.ServerOption{}
 	if cfg.ServerCertPath != "" && cfg.ServerKeyPath != "" {
-		tlsCfg, err := calicotls.NewMutualTLSConfig(cfg.ServerCertPath, cfg.ServerKeyPath, cfg.CACertPath)
+		tlsCfg, err := calicotls.NewReloadingMutualTLSConfig(ctx, "goldmane", cfg.ServerCertPath, cfg.ServerKeyPath, cfg.CACertPath)
 		if err != nil {
 			return nil, err
 		}
@@ -144,7 +144,7 @@ func Run(ctx context.Context, cfg Config) {
 	}
 
 	// Create the shared gRPC server with TLS enabled.
-	grpcServer, err := newGRPCServer(&cfg)
+	grpcServer, err := newGRPCServer(ctx, &cfg)
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to create gRPC server")
 	}
@@ -52,6 +52,7 @@ type Window struct {
 	NumConnectionsStarted   int64
 	NumConnectionsCompleted int64
 	NumConnectionsLive      int64
+	GrpcCalls               unique.Handle[string]
 }
 
 func (w *Window) Within(startGte, startLt int64) bool {
@@ -148,6 +149,7 @@ func (d *DiachronicFlow) addToWindow(flow *types.Flow, index int) {
 	d.Windows[index].NumConnectionsLive += flow.NumConnectionsLive
 	d.Windows[index].SourceLabels = intersection(d.Windows[index].SourceLabels, flow.SourceLabels)
 	d.Windows[index].DestLabels = intersection(d.Windows[index].DestLabels, flow.DestLabels)
+	d.Windows[index].GrpcCalls = union(d.Windows[index].GrpcCalls, flow.GrpcCalls)
 }
 
 func (d *DiachronicFlow) insertWindow(flow *types.Flow, index int, start, end int64) {
@@ -163,6 +165,7 @@ func (d *DiachronicFlow) insertWindow(flow *types.Flow, index int, start, end in
 		NumConnectionsLive:      flow.NumConnectionsLive,
 		SourceLabels:            flow.SourceLabels,
 		DestLabels:              flow.DestLabels,
+		GrpcCalls:               flow.GrpcCalls,
 	}
 	d.Windows = append(d.Windows[:index], append([]Window{w}, d.Windows[index:]...)...)
 
@@ -188,6 +191,7 @@ func (d *DiachronicFlow) appendWindow(flow *types.Flow, start, end int64) {
 		NumConnectionsLive:      flow.NumConnectionsLive,
 		SourceLabels:            flow.SourceLabels,
 		DestLabels:              flow.DestLabels,
+		GrpcCalls:               flow.GrpcCalls,
 	}
 	d.Windows = append(d.Windows, w)
 
@@ -267,6 +271,9 @@ func (d *DiachronicFlow) AggregateWindows(windows []*Window) *types.Flow {
 			f.DestLabels = w.DestLabels
 		}
 
+		// Merge gRPC calls. We use the union of the calls across all windows.
+		f.GrpcCalls = union(f.GrpcCalls, w.GrpcCalls)
+
 		// Update the flow's start and end times.
 		if f.StartTime == 0 || w.start < f.StartTime {
 			f.StartTime = w.start
@@ -320,3 +327,16 @@ func intersection(a unique.Handle[string], b unique.Handle[string]) unique.Handl
 	}
 	return unique.Make(strings.Join(common, ","))
 }
+
+// union returns the union of two sets of strings. i.e., all the values that exist in either
+// input set.
+func union(a unique.Handle[string], b unique.Handle[string]) unique.Handle[string] {
+	var all []string
+	for _, h := range []unique.Handle[string]{a, b} {
+		if h != (unique.Handle[string]{}) && h.Value() != "" {
+			all = append(all, strings.Split(h.Value(), ",")...)
+		}
+	}
+	slices.Sort(all)
+	return unique.Make(strings.Join(slices.Compact(all), ","))
+}
@@ -104,3 +104,39 @@ func TestDiachronicFlow(t *testing.T) {
 	af = df.Aggregate(0, 400)
 	require.Nil(t, af)
 }
+
+func TestDiachronicFlowGrpcCalls(t *testing.T) {
+	defer setupTest(t)()
+
+	k := types.NewFlowKey(
+		&types.FlowKeySource{},
+		&types.FlowKeyDestination{},
+		&types.FlowKeyMeta{},
+		&proto.PolicyTrace{},
+	)
+	df := storage.NewDiachronicFlow(k, 0)
+	flow := func(calls string) *types.Flow {
+		return &types.Flow{
+			Key:          k,
+			SourceLabels: unique.Make(""),
+			DestLabels:   unique.Make(""),
+			GrpcCalls:    unique.Make(calls),
+		}
+	}
+
+	// Add flows with different gRPC calls, two of them to the same window. Flows with no gRPC
+	// calls should not clear the calls already recorded.
+	df.AddFlow(flow("helloworld.Greeter/SayHello"), 0, 1)
+	df.AddFlow(flow("helloworld.Greeter/SayGoodbye"), 0, 1)
+	df.AddFlow(flow(""), 1, 2)
+	df.AddFlow(flow("helloworld.Greeter/SayHello"), 2, 3)
+
+	af := df.Aggregate(0, 3)
+	require.Equal(t, []string{"helloworld.Greeter/SayGoodbye", "helloworld.Greeter/SayHello"}, types.FlowToProto(af).GrpcCalls)
+
+	af = df.Aggregate(1, 3)
+	require.Equal(t, []string{"helloworld.Greeter/SayHello"}, types.FlowToProto(af).GrpcCalls)
+
+	af = df.Aggregate(1, 2)
+	require.Nil(t, types.FlowToProto(af).GrpcCalls)
+}
@@ -152,6 +152,7 @@ type Flow struct {
 	NumConnectionsStarted   int64
 	NumConnectionsCompleted int64
 	NumConnectionsLive      int64
+	GrpcCalls               unique.Handle[string]
 }
 
 type PolicyTrace struct {
@@ -207,6 +208,7 @@ func ProtoToFlow(p *proto.Flow) *Flow {
 		NumConnectionsStarted:   p.NumConnectionsStarted,
 		NumConnectionsCompleted: p.NumConnectionsCompleted,
 		NumConnectionsLive:      p.NumConnectionsLive,
+		GrpcCalls:               toHandles(p.GrpcCalls),
 	}
 }
 
@@ -282,6 +284,7 @@ func FlowIntoProto(f *Flow, pf *proto.Flow) {
 	pf.NumConnectionsStarted = f.NumConnectionsStarted
 	pf.NumConnectionsCompleted = f.NumConnectionsCompleted
 	pf.NumConnectionsLive = f.NumConnectionsLive
+	pf.GrpcCalls = fromHandles(f.GrpcCalls)
 }
 
 func flowKeyIntoProto(k *FlowKey, pfk *proto.FlowKey) {
@@ -327,6 +330,7 @@ func FlowToProto(f *Flow) *proto.Flow {
 		NumConnectionsStarted:   f.NumConnectionsStarted,
 		NumConnectionsCompleted: f.NumConnectionsCompleted,
 		NumConnectionsLive:      f.NumConnectionsLive,
+		GrpcCalls:               fromHandles(f.GrpcCalls),
 	}
 }
 
@@ -373,7 +377,7 @@ func toHandles(labels []string) unique.Handle[string] {
 }
 
 func fromHandles(handles unique.Handle[string]) []string {
-	if handles.Value() == "" {
+	if handles == (unique.Handle[string]{}) || handles.Value() == "" {
 		return nil
 	}
 	return strings.Split(handles.Value(), ",")
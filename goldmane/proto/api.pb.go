@@ -202,6 +202,8 @@ const (
 	// Calico Profiles.
 	PolicyKind_Profile   PolicyKind = 9
 	PolicyKind_EndOfTier PolicyKind = 10
+	// Kubernetes ClusterNetworkPolicy, in either the Admin or Baseline tier.
+	PolicyKind_ClusterNetworkPolicy PolicyKind = 11
 )
 
 // Enum value maps for PolicyKind.
@@ -218,6 +220,7 @@ var (
 		8:  "BaselineAdminNetworkPolicy",
 		9:  "Profile",
 		10: "EndOfTier",
+		11: "ClusterNetworkPolicy",
 	}
 	PolicyKind_value = map[string]int32{
 		"KindUnspecified":               0,
@@ -231,6 +234,7 @@ var (
 		"BaselineAdminNetworkPolicy":    8,
 		"Profile":                       9,
 		"EndOfTier":                     10,
+		"ClusterNetworkPolicy":          11,
 	}
 )
 
@@ -1773,8 +1777,11 @@ type Flow struct {
 	// NumConnectionsLive tracks the total number of still active connections recorded for this Flow. It counts each
 	// connection that matches the FlowKey that was active at this Flow's EndTime.
 	NumConnectionsLive int64 `protobuf:"varint,12,opt,name=num_connections_live,json=numConnectionsLive,proto3" json:"num_connections_live,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// GrpcCalls contains the gRPC calls, in the form "service/method", whose verdict was decided
+	// by an application layer policy rule.
+	GrpcCalls     []string `protobuf:"bytes,13,rep,name=grpc_calls,json=grpcCalls,proto3" json:"grpc_calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Flow) Reset() {
@@ -1891,6 +1898,13 @@ func (x *Flow) GetNumConnectionsLive() int64 {
 	return 0
 }
 
+func (x *Flow) GetGrpcCalls() []string {
+	if x != nil {
+		return x.GrpcCalls
+	}
+	return nil
+}
+
 type PolicyTrace struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// EnforcedPolicies shows the active dataplane policy rules traversed by this Flow.
@@ -2167,11 +2181,11 @@ type StatisticsResult struct {
 	// Policy identifies the policy / rule for which this data applies. Its meaning is contextualized
 	// by the GroupBy field.
 	//
-	//   - StatisticsGroupBy_Policy: this field represents the specific Policy, and statistics are aggregated across all
-	//     rules within that policy. Rule identifiers (Action, RuleID) will be omitted.
+	// - StatisticsGroupBy_Policy: this field represents the specific Policy, and statistics are aggregated across all
+	//                             rules within that policy. Rule identifiers (Action, RuleID) will be omitted.
 	//
-	//   - StatisticsGroupBy_PolicyRule: this field identifies a specific rule within a Policy, and statistics are scoped to
-	//     that particular rule.
+	// - StatisticsGroupBy_PolicyRule: this field identifies a specific rule within a Policy, and statistics are scoped to
+	//                                 that particular rule.
 	Policy *PolicyHit `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	// For statistics results targeting a specific policy rule, the direction
 	// contextualizes the rule ID as either an ingress or egress rule.
@@ -2394,7 +2408,7 @@ const file_api_proto_rawDesc = "" +
 	"\x05proto\x18\f \x01(\tR\x05proto\x12.\n" +
 	"\breporter\x18\r \x01(\x0e2\x12.goldmane.ReporterR\breporter\x12(\n" +
 	"\x06action\x18\x0e \x01(\x0e2\x10.goldmane.ActionR\x06action\x121\n" +
-	"\bpolicies\x18\x0f \x01(\v2\x15.goldmane.PolicyTraceR\bpolicies\"\xc9\x03\n" +
+	"\bpolicies\x18\x0f \x01(\v2\x15.goldmane.PolicyTraceR\bpolicies\"\xe8\x03\n" +
 	"\x04Flow\x12#\n" +
 	"\x03Key\x18\x01 \x01(\v2\x11.goldmane.FlowKeyR\x03Key\x12\x1d\n" +
 	"\n" +
@@ -2412,7 +2426,9 @@ const file_api_proto_rawDesc = "" +
 	"\x17num_connections_started\x18\n" +
 	" \x01(\x03R\x15numConnectionsStarted\x12:\n" +
 	"\x19num_connections_completed\x18\v \x01(\x03R\x17numConnectionsCompleted\x120\n" +
-	"\x14num_connections_live\x18\f \x01(\x03R\x12numConnectionsLive\"\x8f\x01\n" +
+	"\x14num_connections_live\x18\f \x01(\x03R\x12numConnectionsLive\x12\x1d\n" +
+	"\n" +
+	"grpc_calls\x18\r \x03(\tR\tgrpcCalls\"\x8f\x01\n" +
 	"\vPolicyTrace\x12@\n" +
 	"\x11enforced_policies\x18\x01 \x03(\v2\x13.goldmane.PolicyHitR\x10enforcedPolicies\x12>\n" +
 	"\x10pending_policies\x18\x02 \x03(\v2\x13.goldmane.PolicyHitR\x0fpendingPolicies\"\x96\x02\n" +
@@ -2467,7 +2483,7 @@ const file_api_proto_rawDesc = "" +
 	"\x04Pass\x10\x03*!\n" +
 	"\tMatchType\x12\t\n" +
 	"\x05Exact\x10\x00\x12\t\n" +
-	"\x05Fuzzy\x10\x01*\x95\x02\n" +
+	"\x05Fuzzy\x10\x01*\xaf\x02\n" +
 	"\n" +
 	"PolicyKind\x12\x13\n" +
 	"\x0fKindUnspecified\x10\x00\x12\x17\n" +
@@ -2481,7 +2497,8 @@ const file_api_proto_rawDesc = "" +
 	"\x1aBaselineAdminNetworkPolicy\x10\b\x12\v\n" +
 	"\aProfile\x10\t\x12\r\n" +
 	"\tEndOfTier\x10\n" +
-	"*v\n" +
+	"\x12\x18\n" +
+	"\x14ClusterNetworkPolicy\x10\v*v\n" +
 	"\x06SortBy\x12\b\n" +
 	"\x04Time\x10\x00\x12\f\n" +
 	"\bDestName\x10\x01\x12\x11\n" +
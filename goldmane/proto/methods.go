@@ -77,7 +77,8 @@ func (h *PolicyHit) Validate() error {
 	case PolicyKind_GlobalNetworkPolicy,
 		PolicyKind_StagedGlobalNetworkPolicy,
 		PolicyKind_AdminNetworkPolicy,
-		PolicyKind_BaselineAdminNetworkPolicy:
+		PolicyKind_BaselineAdminNetworkPolicy,
+		PolicyKind_ClusterNetworkPolicy:
 		if h.Namespace != "" {
 			return fmt.Errorf("unexpected namespace for global policy")
 		}
@@ -121,6 +122,9 @@ func makeNamePart(h *PolicyHit) (string, error) {
 		namePart = fmt.Sprintf("kanp.adminnetworkpolicy.%s", h.Name)
 	case PolicyKind_BaselineAdminNetworkPolicy:
 		namePart = fmt.Sprintf("kbanp.baselineadminnetworkpolicy.%s", h.Name)
+	case PolicyKind_ClusterNetworkPolicy:
+		// ClusterNetworkPolicies live in either the adminnetworkpolicy or baselineadminnetworkpolicy tier.
+		namePart = fmt.Sprintf("kcnp.%s.%s", h.Tier, h.Name)
 	case PolicyKind_Profile:
 		// Profile names are __PROFILE__.name. The name part may include indicators of the kind of
 		// profile - e.g., __PROFILE__.kns.default, __PROFILE__.ksa.svcacct.
@@ -187,6 +191,9 @@ func HitFromString(s string) (*PolicyHit, error) {
 		} else if strings.HasPrefix(n, "kbanp.") {
 			kind = PolicyKind_BaselineAdminNetworkPolicy
 			n = strings.TrimPrefix(n, "kbanp.")
+		} else if strings.HasPrefix(n, "kcnp.") {
+			kind = PolicyKind_ClusterNetworkPolicy
+			n = strings.TrimPrefix(n, "kcnp.")
 		} else if strings.HasPrefix(n, "__PROFILE__.") {
 			kind = PolicyKind_Profile
 		} else {
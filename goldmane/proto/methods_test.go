@@ -50,6 +50,11 @@ func TestConversion(t *testing.T) {
 		"3|baselineadminnetworkpolicy|kbanp.baselineadminnetworkpolicy.name.with.dots|pass|4",
 		"2|baselineadminnetworkpolicy|kbanp.baselineadminnetworkpolicy.name.with.dots|pass|1",
 
+		// ClusterNetworkPolicy, in either tier.
+		"1|adminnetworkpolicy|kcnp.adminnetworkpolicy.name|pass|0",
+		"1|adminnetworkpolicy|kcnp.adminnetworkpolicy.name.with.dots|allow|2",
+		"4|baselineadminnetworkpolicy|kcnp.baselineadminnetworkpolicy.name|deny|1",
+
 		// Profile rules.
 		"1|__PROFILE__|__PROFILE__.kns.default|allow|0",
 		"2|__PROFILE__|__PROFILE__.kns.default|allow|1",
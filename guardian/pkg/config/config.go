@@ -106,32 +106,22 @@ func (cfg *Config) String() string {
 	return string(data)
 }
 
-func (cfg *Config) TLSConfig() (*tls.Config, *tls.Certificate, error) {
+// TLSConfig returns the TLS config for the tunnel to the management cluster, and the reloader
+// for the certificates it uses.  The caller must run the reloader for changes to the
+// certificates to be picked up.
+func (cfg *Config) TLSConfig() (*tls.Config, *calicotls.CertReloader, error) {
 	certPath := fmt.Sprintf("%s/managed-cluster.crt", cfg.CertPath)
 	keyPath := fmt.Sprintf("%s/managed-cluster.key", cfg.CertPath)
 
-	pemCert, err := os.ReadFile(certPath)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load tunnel cert from path %s: %w", certPath, err)
-	}
-	pemKey, err := os.ReadFile(keyPath)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load tunnel key from path %s: %w", certPath, err)
-	}
-
-	cert, err := tls.X509KeyPair(pemCert, pemKey)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create X509 key pair: %w", err)
-	}
 	tlsConfig, err := calicotls.NewTLSConfig()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create TLS Config: %w", err)
 	}
-	tlsConfig.Certificates = []tls.Certificate{cert}
 
+	var rootCAPath string
 	rootCA := x509.NewCertPool()
 	if strings.ToLower(cfg.VoltronCAType) != "public" {
-		rootCAPath := fmt.Sprintf("%s/management-cluster.crt", cfg.CertPath)
+		rootCAPath = fmt.Sprintf("%s/management-cluster.crt", cfg.CertPath)
 		pemServerCrt, err := os.ReadFile(rootCAPath)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to read server cert from path %s: %w", rootCAPath, err)
@@ -158,7 +148,13 @@ func (cfg *Config) TLSConfig() (*tls.Config, *tls.Certificate, error) {
 
 	tlsConfig.RootCAs = rootCA
 
-	return tlsConfig, &cert, nil
+	certs, err := calicotls.NewCertReloader("guardian-tunnel", certPath, keyPath, rootCAPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load tunnel certificates: %w", err)
+	}
+	certs.ConfigureClient(tlsConfig)
+
+	return tlsConfig, certs, nil
 }
 
 func (cfg *Config) configureLogging() {
@@ -48,10 +48,11 @@ func Run(ctx context.Context, cfg config.Config, proxyTargets []server.Target) {
 		server.WithConnectionRetryInterval(cfg.ConnectionRetryInterval),
 	}
 
-	tlsConfig, cert, err := cfg.TLSConfig()
+	tlsConfig, certs, err := cfg.TLSConfig()
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to create tls config")
 	}
+	go certs.Run(ctx)
 
 	logrus.Infof("Using server name %s", tlsConfig.ServerName)
 
@@ -60,7 +61,7 @@ func Run(ctx context.Context, cfg config.Config, proxyTargets []server.Target) {
 		logrus.WithError(err).Fatal("Failed to create session dialer.")
 	}
 
-	srv, err := server.New(ctx, cert, dialer, srvOpts...)
+	srv, err := server.New(ctx, certs.GetCertificate, dialer, srvOpts...)
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to create server")
 	}
@@ -43,7 +43,8 @@ type server struct {
 	proxyMux *http.ServeMux
 	targets  []Target
 
-	tunnelCert *tls.Certificate
+	// getTunnelCert returns the certificate to serve tunneled connections with.
+	getTunnelCert func(*tls.ClientHelloInfo) (*tls.Certificate, error)
 
 	tunnel tunnel.Tunnel
 
@@ -58,14 +59,14 @@ type server struct {
 	shutdownFunc func()
 }
 
-func New(shutdownCtx context.Context, tunnelCert *tls.Certificate, dialer tunnel.SessionDialer, opts ...Option) (Server, error) {
+func New(shutdownCtx context.Context, getTunnelCert func(*tls.ClientHelloInfo) (*tls.Certificate, error), dialer tunnel.SessionDialer, opts ...Option) (Server, error) {
 	shutdownCtx, cancel := context.WithCancel(shutdownCtx)
 	srv := &server{
 		http:              new(http.Server),
 		connRetryAttempts: 5,
 		connRetryInterval: 2 * time.Second,
 		listenPort:        "8080",
-		tunnelCert:        tunnelCert,
+		getTunnelCert:     getTunnelCert,
 		shutdownCtx:       shutdownCtx,
 		shutdownFunc:      cancel,
 	}
@@ -114,7 +115,7 @@ func (srv *server) ListenAndServeManagementCluster() error {
 	if err != nil {
 		return fmt.Errorf("failed to create TLS Config: %w", err)
 	}
-	tlsConfig.Certificates = []tls.Certificate{*srv.tunnelCert}
+	tlsConfig.GetCertificate = srv.getTunnelCert
 	tlsConfig.NextProtos = []string{"h2"}
 
 	listener = tls.NewListener(listener, tlsConfig)
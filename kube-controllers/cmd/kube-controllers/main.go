@@ -38,6 +38,7 @@ import (
 	"github.com/projectcalico/calico/kube-controllers/pkg/config"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/controller"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/flannelmigration"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/ippoolmigration"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/loadbalancer"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/namespace"
 	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/networkpolicy"
@@ -480,6 +481,11 @@ func (cc *controllerControl) InitControllers(ctx context.Context, cfg config.Run
 		cc.controllers["ServiceAccount"] = serviceAccountController
 	}
 
+	if cfg.Controllers.IPPoolMigration != nil {
+		ipPoolMigrationController := ippoolmigration.NewIPPoolMigrationController(ctx, k8sClientset, calicoClient, *cfg.Controllers.IPPoolMigration)
+		cc.controllers["IPPoolMigration"] = ipPoolMigrationController
+	}
+
 	if cfg.Controllers.LoadBalancer != nil {
 		loadBalancerController := loadbalancer.NewLoadBalancerController(k8sClientset, calicoClient, *cfg.Controllers.LoadBalancer, serviceInformer, dataFeed)
 		cc.controllers["LoadBalancer"] = loadBalancerController
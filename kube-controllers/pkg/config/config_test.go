@@ -212,6 +212,8 @@ var _ = Describe("Config", func() {
 						LoadBalancer: &v3.LoadBalancerControllerConfig{
 							AssignIPs: v3.RequestedServicesOnly,
 						},
+						IPPoolMigration: &v3.IPPoolMigrationControllerConfig{
+							ReconcilerPeriod: &v1.Duration{Duration: time.Second * 34}},
 					},
 				}
 				m = &mockKCC{get: kcc}
@@ -258,6 +260,9 @@ var _ = Describe("Config", func() {
 				Expect(rc.LoadBalancer).To(Equal(&config.LoadBalancerControllerConfig{
 					AssignIPs: v3.RequestedServicesOnly,
 				}))
+				Expect(rc.IPPoolMigration).To(Equal(&config.GenericControllerConfig{
+					ReconcilerPeriod: time.Second * 34,
+				}))
 				close(done)
 			})
 
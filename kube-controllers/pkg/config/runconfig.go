@@ -60,6 +60,7 @@ type ControllersConfig struct {
 	ServiceAccount   *GenericControllerConfig
 	Namespace        *GenericControllerConfig
 	LoadBalancer     *LoadBalancerControllerConfig
+	IPPoolMigration  *GenericControllerConfig
 }
 
 type GenericControllerConfig struct {
@@ -587,6 +588,10 @@ func mergeReconcilerPeriod(envVars map[string]string, status *v3.KubeControllers
 			rc.Namespace.ReconcilerPeriod = d
 			sc.Namespace.ReconcilerPeriod = &v1.Duration{Duration: d}
 		}
+		if rc.IPPoolMigration != nil {
+			rc.IPPoolMigration.ReconcilerPeriod = d
+			sc.IPPoolMigration.ReconcilerPeriod = &v1.Duration{Duration: d}
+		}
 	}
 }
 
@@ -601,6 +606,7 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 	s := ac.ServiceAccount
 	ns := ac.Namespace
 	lb := ac.LoadBalancer
+	ipm := ac.IPPoolMigration
 
 	v, p := envVars[EnvEnabledControllers]
 	if p {
@@ -625,6 +631,9 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 			case "loadbalancer":
 				rc.LoadBalancer = &LoadBalancerControllerConfig{}
 				sc.LoadBalancer = &v3.LoadBalancerControllerConfig{}
+			case "ippoolmigration":
+				rc.IPPoolMigration = &GenericControllerConfig{}
+				sc.IPPoolMigration = &v3.IPPoolMigrationControllerConfig{}
 			case "flannelmigration":
 				log.WithField(EnvEnabledControllers, v).Fatal("cannot run flannelmigration with other controllers")
 			default:
@@ -668,6 +677,11 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 			rc.LoadBalancer = &LoadBalancerControllerConfig{}
 			sc.LoadBalancer = &v3.LoadBalancerControllerConfig{}
 		}
+
+		if ipm != nil {
+			rc.IPPoolMigration = &GenericControllerConfig{}
+			sc.IPPoolMigration = &v3.IPPoolMigrationControllerConfig{}
+		}
 	}
 
 	// Set reconciler periods, if enabled
@@ -703,6 +717,14 @@ func mergeEnabledControllers(envVars map[string]string, status *v3.KubeControlle
 		}
 		sc.ServiceAccount.ReconcilerPeriod = s.ReconcilerPeriod
 	}
+	if rc.IPPoolMigration != nil && ipm != nil {
+		if ipm.ReconcilerPeriod == nil {
+			rc.IPPoolMigration.ReconcilerPeriod = time.Second * 30
+		} else {
+			rc.IPPoolMigration.ReconcilerPeriod = ipm.ReconcilerPeriod.Duration
+		}
+		sc.IPPoolMigration.ReconcilerPeriod = ipm.ReconcilerPeriod
+	}
 }
 
 func mergeLogLevel(envVars map[string]string, status *v3.KubeControllersConfigurationStatus, rCfg *RunConfig, apiCfg v3.KubeControllersConfigurationSpec) {
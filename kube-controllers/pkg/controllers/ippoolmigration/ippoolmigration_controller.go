@@ -0,0 +1,598 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippoolmigration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	uruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	"github.com/projectcalico/calico/kube-controllers/pkg/controllers/controller"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+const (
+	defaultReconcilerPeriod = 30 * time.Second
+	defaultPodsPerMinute    = 10
+
+	// waitInterval is how often a running migration is checked while it waits for an evicted
+	// pod to go away or for its replacement to be assigned an address.
+	waitInterval = 5 * time.Second
+
+	// The namespace annotations that the Calico CNI plugin reads to pick the IP pools to assign
+	// addresses from.
+	ipv4PoolsAnnotation = "cni.projectcalico.org/ipv4pools"
+	ipv6PoolsAnnotation = "cni.projectcalico.org/ipv6pools"
+)
+
+// podRef identifies a single incarnation of a pod, so that a replacement with the same name
+// (for example, a StatefulSet pod) is not mistaken for the pod that was evicted.
+type podRef struct {
+	namespace string
+	name      string
+	uid       types.UID
+}
+
+func (r podRef) String() string {
+	return r.namespace + "/" + r.name
+}
+
+// ipPoolMigrationController drives IPPoolMigration resources.  For each running migration it
+// annotates the selected namespaces so that their pods are assigned target pool addresses, evicts
+// the selected pods that have source pool addresses one at a time, in namespace and name order,
+// and once none are left it releases the affinities of the source pool's empty blocks.
+type ipPoolMigrationController struct {
+	ctx          context.Context
+	k8sClientset kubernetes.Interface
+	calicoClient client.Interface
+	cfg          config.GenericControllerConfig
+
+	// lastEvicted is the pod most recently evicted by each migration, keyed by migration name.
+	// The next pod is only evicted once this pod has gone.
+	lastEvicted map[string]podRef
+}
+
+// NewIPPoolMigrationController returns a controller which migrates pods between IP pools as
+// requested by IPPoolMigration resources.
+func NewIPPoolMigrationController(ctx context.Context, k8sClientset kubernetes.Interface, calicoClient client.Interface, cfg config.GenericControllerConfig) controller.Controller {
+	return &ipPoolMigrationController{
+		ctx:          ctx,
+		k8sClientset: k8sClientset,
+		calicoClient: calicoClient,
+		cfg:          cfg,
+		lastEvicted:  map[string]podRef{},
+	}
+}
+
+// Run starts the controller.
+func (c *ipPoolMigrationController) Run(stopCh chan struct{}) {
+	defer uruntime.HandleCrash()
+
+	log.Info("Starting IPPoolMigration controller")
+	t := time.NewTimer(0)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			log.Info("Stopping IPPoolMigration controller")
+			return
+		case <-t.C:
+			t.Reset(c.reconcile())
+		}
+	}
+}
+
+// reconcile makes progress on every IPPoolMigration and returns how long to wait before the
+// next reconcile.
+func (c *ipPoolMigrationController) reconcile() time.Duration {
+	next := c.cfg.ReconcilerPeriod
+	if next <= 0 {
+		next = defaultReconcilerPeriod
+	}
+
+	migrations, err := c.calicoClient.IPPoolMigrations().List(c.ctx, options.ListOptions{})
+	if err != nil {
+		log.WithError(err).Warn("Failed to list IPPoolMigrations")
+		return next
+	}
+
+	names := map[string]bool{}
+	for i := range migrations.Items {
+		m := &migrations.Items[i]
+		names[m.Name] = true
+		if d := c.reconcileMigration(m); d > 0 && d < next {
+			next = d
+		}
+	}
+
+	// Forget about migrations that have been deleted.
+	for name := range c.lastEvicted {
+		if !names[name] {
+			delete(c.lastEvicted, name)
+		}
+	}
+	return next
+}
+
+// reconcileMigration moves a single migration on and writes back its status if it changed.  It
+// returns how soon the migration needs to be looked at again, or 0 if the reconciler period will
+// do.
+func (c *ipPoolMigrationController) reconcileMigration(m *api.IPPoolMigration) time.Duration {
+	logCtx := log.WithField("migration", m.Name)
+	status := m.Status.DeepCopy()
+
+	var requeue time.Duration
+	var err error
+	switch m.Status.Phase {
+	case "", api.IPPoolMigrationPhasePending:
+		err = c.startMigration(m, status)
+		if err == nil && status.Phase == api.IPPoolMigrationPhaseRunning {
+			// Start restarting pods straight away.
+			requeue = time.Nanosecond
+		}
+	case api.IPPoolMigrationPhaseRunning:
+		requeue, err = c.migratePods(m, status)
+	default:
+		delete(c.lastEvicted, m.Name)
+		return 0
+	}
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to reconcile IPPoolMigration, will retry")
+	}
+
+	if !reflect.DeepEqual(*status, m.Status) {
+		logCtx.WithField("phase", status.Phase).Debug("Updating IPPoolMigration status")
+		m.Status = *status
+		if _, err := c.calicoClient.IPPoolMigrations().Update(c.ctx, m, options.SetOptions{}); err != nil {
+			logCtx.WithError(err).Warn("Failed to update IPPoolMigration status")
+		}
+	}
+	return requeue
+}
+
+// startMigration checks that the migration can go ahead and points the selected namespaces at
+// the target pool, so that restarted pods are not assigned addresses from the source pool.  The
+// source pool stays enabled for the pods in other namespaces.
+func (c *ipPoolMigrationController) startMigration(m *api.IPPoolMigration, status *api.IPPoolMigrationStatus) error {
+	source, target, err := c.getPools(m, status)
+	if err != nil || source == nil {
+		return err
+	}
+	if target.Spec.Disabled {
+		failMigration(status, fmt.Sprintf("target IP pool %s is disabled", target.Name))
+		return nil
+	}
+
+	sel, err := selector.Parse(m.Spec.NamespaceSelector)
+	if err != nil {
+		failMigration(status, fmt.Sprintf("invalid namespace selector: %v", err))
+		return nil
+	}
+	namespaces, err := c.selectedNamespaces(sel)
+	if err != nil {
+		return err
+	}
+	if err := c.pinNamespaces(namespaces, source, target); err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	status.Phase = api.IPPoolMigrationPhaseRunning
+	status.Message = "Restarting pods"
+	status.StartTime = &now
+	return nil
+}
+
+// migratePods evicts the next pod with a source pool address, once the previous one has been
+// replaced and the rate limit allows it.  When there are no such pods left, it releases the
+// affinities of the source pool's empty blocks and completes the migration.  If a restarted pod
+// is assigned an address outside of the target pool, the migration fails.
+func (c *ipPoolMigrationController) migratePods(m *api.IPPoolMigration, status *api.IPPoolMigrationStatus) (time.Duration, error) {
+	source, target, err := c.getPools(m, status)
+	if err != nil || source == nil {
+		return 0, err
+	}
+	_, sourceCIDR, err := cnet.ParseCIDR(source.Spec.CIDR)
+	if err != nil {
+		return 0, err
+	}
+	_, targetCIDR, err := cnet.ParseCIDR(target.Spec.CIDR)
+	if err != nil {
+		return 0, err
+	}
+
+	sel, err := selector.Parse(m.Spec.NamespaceSelector)
+	if err != nil {
+		failMigration(status, fmt.Sprintf("invalid namespace selector: %v", err))
+		return 0, nil
+	}
+	namespaces, err := c.selectedNamespaces(sel)
+	if err != nil {
+		return 0, err
+	}
+	// Namespaces may have started to match the selector since the migration started.
+	if err := c.pinNamespaces(namespaces, source, target); err != nil {
+		return 0, err
+	}
+	pods, err := c.podsInNamespaces(namespaces)
+	if err != nil {
+		return 0, err
+	}
+
+	p := classifyPods(pods, *sourceCIDR, *targetCIDR, status.StartTime, status.LastRestartTime)
+	status.PodsRemaining = len(p.restartable)
+	status.PodsOnTargetPool = p.onTarget
+	status.PodsOutsideTargetPool = p.outsideTarget
+	status.PodsNotRestartable = p.notRestartable
+
+	if p.outsideTarget > 0 {
+		// Carrying on would only move more pods to the wrong pool, most likely because of an
+		// IP pool annotation on the pods.
+		failMigration(status, fmt.Sprintf("%d pods created since the migration started were assigned addresses outside the target pool", p.outsideTarget))
+		delete(c.lastEvicted, m.Name)
+		log.WithField("migration", m.Name).Warn("IPPoolMigration failed, pods were assigned addresses outside the target pool")
+		return 0, nil
+	}
+
+	// Wait for the pod we evicted last to go away, and for its replacement to get an address,
+	// before evicting another.
+	if ref, ok := c.lastEvicted[m.Name]; ok {
+		if podExists(pods, ref) {
+			status.Message = fmt.Sprintf("Waiting for pod %s to terminate", ref)
+			return waitInterval, nil
+		}
+		delete(c.lastEvicted, m.Name)
+	}
+	if p.pending > 0 {
+		status.Message = fmt.Sprintf("Waiting for %d restarted pods to be assigned addresses", p.pending)
+		return waitInterval, nil
+	}
+
+	if len(p.restartable) == 0 {
+		// Blocks that still hold addresses, whether of pods in other namespaces or of pods
+		// that couldn't be restarted, keep their affinity.
+		if err := c.calicoClient.IPAM().ReleasePoolAffinities(c.ctx, *sourceCIDR, true); err != nil {
+			return 0, err
+		}
+		now := metav1.Now()
+		status.SourceBlocksReleased = true
+		status.Phase = api.IPPoolMigrationPhaseCompleted
+		status.CompletionTime = &now
+		status.PodsBlockedByDisruptionBudget = 0
+		status.Message = "Migration completed"
+		if p.notRestartable > 0 {
+			status.Message = fmt.Sprintf("Migration completed; %d pods without a controller still have source pool addresses", p.notRestartable)
+		}
+		log.WithField("migration", m.Name).Info("IPPoolMigration completed")
+		return 0, nil
+	}
+
+	podsPerMinute := defaultPodsPerMinute
+	if m.Spec.PodsPerMinute != nil && *m.Spec.PodsPerMinute > 0 {
+		podsPerMinute = *m.Spec.PodsPerMinute
+	}
+	interval := time.Minute / time.Duration(podsPerMinute)
+	if status.LastRestartTime != nil {
+		if wait := interval - time.Since(status.LastRestartTime.Time); wait > 0 {
+			status.Message = "Restarting pods"
+			return wait, nil
+		}
+	}
+
+	evicted, blocked, err := c.evictNextPod(p.restartable)
+	status.PodsBlockedByDisruptionBudget = blocked
+	if err != nil {
+		return 0, err
+	}
+	if evicted == nil {
+		status.Message = "Waiting for PodDisruptionBudgets to allow the remaining pods to be restarted"
+		return 0, nil
+	}
+
+	ref := podRef{namespace: evicted.Namespace, name: evicted.Name, uid: evicted.UID}
+	log.WithFields(log.Fields{"migration": m.Name, "pod": ref}).Info("Evicted pod")
+	c.lastEvicted[m.Name] = ref
+	now := metav1.Now()
+	status.LastRestartTime = &now
+	status.PodsRestarted++
+	status.PodsRemaining--
+	status.Message = fmt.Sprintf("Waiting for pod %s to terminate", ref)
+	return waitInterval, nil
+}
+
+// getPools returns the source and target pools of the migration.  If either pool does not exist,
+// or they are of different IP families, the migration is failed and nil pools are returned.
+func (c *ipPoolMigrationController) getPools(m *api.IPPoolMigration, status *api.IPPoolMigrationStatus) (*api.IPPool, *api.IPPool, error) {
+	var pools []*api.IPPool
+	for _, name := range []string{m.Spec.SourcePool, m.Spec.TargetPool} {
+		pool, err := c.calicoClient.IPPools().Get(c.ctx, name, options.GetOptions{})
+		if err != nil {
+			if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+				failMigration(status, fmt.Sprintf("IP pool %s does not exist", name))
+				return nil, nil, nil
+			}
+			return nil, nil, err
+		}
+		pools = append(pools, pool)
+	}
+
+	sourceIP, _, err := cnet.ParseCIDR(pools[0].Spec.CIDR)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetIP, _, err := cnet.ParseCIDR(pools[1].Spec.CIDR)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sourceIP.Version() != targetIP.Version() {
+		failMigration(status, "source and target IP pools must be of the same IP family")
+		return nil, nil, nil
+	}
+	return pools[0], pools[1], nil
+}
+
+// selectedNamespaces returns the namespaces that match the given selector.  As with the
+// namespaceSelector of a NetworkPolicy, the namespace's name can be matched with the
+// projectcalico.org/name label.
+func (c *ipPoolMigrationController) selectedNamespaces(sel *selector.Selector) ([]v1.Namespace, error) {
+	namespaces, err := c.k8sClientset.CoreV1().Namespaces().List(c.ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []v1.Namespace
+	for _, ns := range namespaces.Items {
+		labels := map[string]string{conversion.NameLabel: ns.Name}
+		for k, v := range ns.Labels {
+			labels[k] = v
+		}
+		if sel.Evaluate(labels) {
+			selected = append(selected, ns)
+		}
+	}
+	return selected, nil
+}
+
+// podsInNamespaces returns the pods in the given namespaces.
+func (c *ipPoolMigrationController) podsInNamespaces(namespaces []v1.Namespace) ([]v1.Pod, error) {
+	var pods []v1.Pod
+	for _, ns := range namespaces {
+		list, err := c.k8sClientset.CoreV1().Pods(ns.Name).List(c.ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, list.Items...)
+	}
+	return pods, nil
+}
+
+// pinNamespaces sets the IP pool annotation of each of the given namespaces so that the CNI
+// plugin assigns addresses to their new pods from the target pool rather than the source pool.
+func (c *ipPoolMigrationController) pinNamespaces(namespaces []v1.Namespace, source, target *api.IPPool) error {
+	key := ipv4PoolsAnnotation
+	if ip, _, err := cnet.ParseCIDR(target.Spec.CIDR); err == nil && ip.Version() == 6 {
+		key = ipv6PoolsAnnotation
+	}
+
+	for _, ns := range namespaces {
+		value, update := poolAnnotation(ns.Annotations[key], source, target)
+		if !update {
+			continue
+		}
+		log.WithFields(log.Fields{"namespace": ns.Name, "pools": value}).Info("Pointing namespace at the target IP pool")
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{key: value},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = c.k8sClientset.CoreV1().Namespaces().Patch(c.ctx, ns.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// poolAnnotation returns the IP pool annotation that a namespace with the given annotation should
+// have, and whether it needs to change.  A namespace without the annotation is pointed at the
+// target pool, and the source pool is replaced by the target pool in an existing annotation.  An
+// annotation that doesn't include the source pool is left alone.
+func poolAnnotation(current string, source, target *api.IPPool) (string, bool) {
+	pools := []string{target.Name}
+	if current != "" {
+		pools = nil
+		if err := json.Unmarshal([]byte(current), &pools); err != nil {
+			log.WithError(err).WithField("annotation", current).Warn("Failed to parse IP pool annotation, leaving it alone")
+			return current, false
+		}
+	}
+
+	// Replace the source pool in place, keeping the order of any other pools.
+	var updated []string
+	replaced, hasTarget := current == "", false
+	for _, pool := range pools {
+		switch pool {
+		case source.Name, source.Spec.CIDR:
+			replaced = true
+			pool = target.Name
+		}
+		if pool == target.Name || pool == target.Spec.CIDR {
+			if hasTarget {
+				continue
+			}
+			hasTarget = true
+		}
+		updated = append(updated, pool)
+	}
+	if !replaced {
+		return current, false
+	}
+	value, _ := json.Marshal(updated)
+	return string(value), true
+}
+
+// evictNextPod evicts the first of the given pods that the PodDisruptionBudgets allow to be
+// evicted.  It returns the evicted pod, or nil if none could be evicted, and the number of pods
+// whose eviction was refused because of a PodDisruptionBudget.
+func (c *ipPoolMigrationController) evictNextPod(pods []*v1.Pod) (*v1.Pod, int, error) {
+	blocked := 0
+	for _, pod := range pods {
+		eviction := &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+			},
+		}
+		err := c.k8sClientset.CoreV1().Pods(pod.Namespace).EvictV1(c.ctx, eviction)
+		switch {
+		case err == nil:
+			return pod, blocked, nil
+		case apierrors.IsTooManyRequests(err):
+			// The API server refuses evictions that would violate a PodDisruptionBudget with a
+			// 429; leave this pod for a later attempt and try the next.
+			log.WithField("pod", pod.Namespace+"/"+pod.Name).Debug("Eviction blocked by PodDisruptionBudget")
+			blocked++
+		case apierrors.IsNotFound(err):
+			// The pod has already gone.
+		default:
+			return nil, blocked, err
+		}
+	}
+	return nil, blocked, nil
+}
+
+// podClassification counts the selected pods by where their addresses come from.
+type podClassification struct {
+	// restartable are the pods with source pool addresses that have a controller to recreate
+	// them, sorted by namespace and name.
+	restartable []*v1.Pod
+
+	// notRestartable is the number of pods with source pool addresses and no controller.
+	notRestartable int
+
+	// onTarget is the number of pods with target pool addresses.
+	onTarget int
+
+	// outsideTarget is the number of pods created since the migration started that were given
+	// addresses outside of the target pool.
+	outsideTarget int
+
+	// pending is the number of pods created since the last restart that have no address yet.
+	pending int
+}
+
+func classifyPods(pods []v1.Pod, source, target cnet.IPNet, startTime, lastRestartTime *metav1.Time) podClassification {
+	var p podClassification
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.HostNetwork || pod.DeletionTimestamp != nil ||
+			pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		ips := podIPs(pod)
+		if len(ips) == 0 {
+			if lastRestartTime != nil && !pod.CreationTimestamp.Before(lastRestartTime) {
+				p.pending++
+			}
+			continue
+		}
+
+		onSource, onTarget := false, false
+		for _, ip := range ips {
+			if source.Contains(ip.IP) {
+				onSource = true
+			}
+			if target.Contains(ip.IP) {
+				onTarget = true
+			}
+		}
+
+		switch {
+		case onSource && metav1.GetControllerOf(pod) != nil:
+			p.restartable = append(p.restartable, pod)
+		case onSource:
+			p.notRestartable++
+		case onTarget:
+			p.onTarget++
+		}
+		if !onTarget && startTime != nil && !pod.CreationTimestamp.Before(startTime) {
+			p.outsideTarget++
+		}
+	}
+
+	sort.Slice(p.restartable, func(i, j int) bool {
+		if p.restartable[i].Namespace != p.restartable[j].Namespace {
+			return p.restartable[i].Namespace < p.restartable[j].Namespace
+		}
+		return p.restartable[i].Name < p.restartable[j].Name
+	})
+	return p
+}
+
+// podIPs returns the addresses assigned to the pod.
+func podIPs(pod *v1.Pod) []cnet.IP {
+	var ips []cnet.IP
+	for _, podIP := range pod.Status.PodIPs {
+		if ip := cnet.ParseIP(podIP.IP); ip != nil {
+			ips = append(ips, *ip)
+		}
+	}
+	if len(ips) == 0 && pod.Status.PodIP != "" {
+		if ip := cnet.ParseIP(pod.Status.PodIP); ip != nil {
+			ips = append(ips, *ip)
+		}
+	}
+	return ips
+}
+
+func podExists(pods []v1.Pod, ref podRef) bool {
+	for _, pod := range pods {
+		if pod.Namespace == ref.namespace && pod.Name == ref.name && pod.UID == ref.uid {
+			return true
+		}
+	}
+	return false
+}
+
+func failMigration(status *api.IPPoolMigrationStatus, msg string) {
+	now := metav1.Now()
+	status.Phase = api.IPPoolMigrationPhaseFailed
+	status.Message = msg
+	status.CompletionTime = &now
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ippoolmigration
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/projectcalico/calico/kube-controllers/pkg/config"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+func makePod(namespace, name, ip string, created time.Time, owned bool) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			UID:               types.UID("uid-" + name),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	if ip != "" {
+		pod.Status.PodIP = ip
+		pod.Status.PodIPs = []v1.PodIP{{IP: ip}}
+	}
+	if owned {
+		isController := true
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1",
+			Kind:       "ReplicaSet",
+			Name:       name + "-rs",
+			Controller: &isController,
+		}}
+	}
+	return pod
+}
+
+var _ = Describe("IPPoolMigration controller UTs", func() {
+	_, source, _ := cnet.ParseCIDR("10.0.0.0/16")
+	_, target, _ := cnet.ParseCIDR("10.1.0.0/16")
+	start := time.Now().Add(-time.Hour)
+	startTime := metav1.NewTime(start)
+	sourcePool := &api.IPPool{ObjectMeta: metav1.ObjectMeta{Name: "source"}, Spec: api.IPPoolSpec{CIDR: "10.0.0.0/16"}}
+	targetPool := &api.IPPool{ObjectMeta: metav1.ObjectMeta{Name: "target"}, Spec: api.IPPoolSpec{CIDR: "10.1.0.0/16"}}
+
+	It("should classify pods by pool", func() {
+		hostNetworked := makePod("ns1", "host", "10.0.0.9", start.Add(-time.Hour), true)
+		hostNetworked.Spec.HostNetwork = true
+		terminating := makePod("ns1", "terminating", "10.0.0.10", start.Add(-time.Hour), true)
+		terminating.DeletionTimestamp = &startTime
+		lastRestart := metav1.NewTime(start.Add(30 * time.Minute))
+
+		pods := []v1.Pod{
+			*makePod("ns2", "b", "10.0.0.1", start.Add(-time.Hour), true),
+			*makePod("ns1", "z", "10.0.0.2", start.Add(-time.Hour), true),
+			*makePod("ns1", "bare", "10.0.0.3", start.Add(-time.Hour), false),
+			*makePod("ns1", "moved", "10.1.0.1", start.Add(time.Minute), true),
+			*makePod("ns1", "elsewhere", "10.2.0.1", start.Add(time.Minute), true),
+			*makePod("ns1", "starting", "", start.Add(time.Hour), true),
+			*makePod("ns1", "stuck", "", start.Add(-time.Hour), true),
+			*hostNetworked,
+			*terminating,
+		}
+
+		p := classifyPods(pods, *source, *target, &startTime, &lastRestart)
+		Expect(p.restartable).To(HaveLen(2))
+		Expect(p.restartable[0].Namespace + "/" + p.restartable[0].Name).To(Equal("ns1/z"))
+		Expect(p.restartable[1].Namespace + "/" + p.restartable[1].Name).To(Equal("ns2/b"))
+		Expect(p.notRestartable).To(Equal(1))
+		Expect(p.onTarget).To(Equal(1))
+		Expect(p.outsideTarget).To(Equal(1))
+		Expect(p.pending).To(Equal(1))
+	})
+
+	It("should replace the source pool in IP pool annotations", func() {
+		for _, tc := range []struct {
+			current  string
+			expected string
+			update   bool
+		}{
+			{"", `["target"]`, true},
+			{`["source"]`, `["target"]`, true},
+			{`["10.0.0.0/16"]`, `["target"]`, true},
+			{`["other","source"]`, `["other","target"]`, true},
+			{`["source","target"]`, `["target"]`, true},
+			{`["target"]`, `["target"]`, false},
+			{`["other"]`, `["other"]`, false},
+			{`not json`, `not json`, false},
+		} {
+			value, update := poolAnnotation(tc.current, sourcePool, targetPool)
+			Expect(value).To(Equal(tc.expected), tc.current)
+			Expect(update).To(Equal(tc.update), tc.current)
+		}
+	})
+
+	Context("with a fake clientset", func() {
+		var c *ipPoolMigrationController
+		var cs *fake.Clientset
+		var evicted []string
+
+		BeforeEach(func() {
+			cs = fake.NewSimpleClientset(
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Labels: map[string]string{"migrate": "yes"}}},
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2"}},
+				makePod("ns1", "a", "10.0.0.1", start, true),
+				makePod("ns1", "b", "10.0.0.2", start, true),
+				makePod("ns2", "c", "10.0.0.3", start, true),
+			)
+
+			// Refuse to evict pod "a", as if it were protected by a PodDisruptionBudget.
+			evicted = nil
+			cs.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if action.GetSubresource() != "eviction" {
+					return false, nil, nil
+				}
+				eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+				if eviction.Name == "a" {
+					return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+				}
+				evicted = append(evicted, eviction.Namespace+"/"+eviction.Name)
+				return true, nil, nil
+			})
+
+			c = NewIPPoolMigrationController(context.Background(), cs, nil, config.GenericControllerConfig{}).(*ipPoolMigrationController)
+		})
+
+		selectedPods := func(expr string) []v1.Pod {
+			sel, err := selector.Parse(expr)
+			Expect(err).NotTo(HaveOccurred())
+			namespaces, err := c.selectedNamespaces(sel)
+			Expect(err).NotTo(HaveOccurred())
+			pods, err := c.podsInNamespaces(namespaces)
+			Expect(err).NotTo(HaveOccurred())
+			return pods
+		}
+
+		It("should only return pods in the selected namespaces", func() {
+			Expect(selectedPods("migrate == 'yes'")).To(HaveLen(2))
+
+			pods := selectedPods("projectcalico.org/name == 'ns2'")
+			Expect(pods).To(HaveLen(1))
+			Expect(pods[0].Name).To(Equal("c"))
+
+			Expect(selectedPods("")).To(HaveLen(3))
+		})
+
+		It("should point only the selected namespaces at the target pool", func() {
+			sel, err := selector.Parse("migrate == 'yes'")
+			Expect(err).NotTo(HaveOccurred())
+			namespaces, err := c.selectedNamespaces(sel)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.pinNamespaces(namespaces, sourcePool, targetPool)).To(Succeed())
+
+			ns1, err := cs.CoreV1().Namespaces().Get(context.Background(), "ns1", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ns1.Annotations).To(HaveKeyWithValue(ipv4PoolsAnnotation, `["target"]`))
+			Expect(ns1.Labels).To(HaveKeyWithValue("migrate", "yes"))
+			ns2, err := cs.CoreV1().Namespaces().Get(context.Background(), "ns2", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ns2.Annotations).NotTo(HaveKey(ipv4PoolsAnnotation))
+		})
+
+		It("should skip pods whose eviction is blocked by a PodDisruptionBudget", func() {
+			p := classifyPods(selectedPods(""), *source, *target, &startTime, nil)
+			Expect(p.restartable).To(HaveLen(3))
+
+			pod, blocked, err := c.evictNextPod(p.restartable)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocked).To(Equal(1))
+			Expect(pod.Name).To(Equal("b"))
+			Expect(evicted).To(Equal([]string{"ns1/b"}))
+
+			pod, blocked, err = c.evictNextPod(p.restartable[:1])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocked).To(Equal(1))
+			Expect(pod).To(BeNil())
+		})
+	})
+})
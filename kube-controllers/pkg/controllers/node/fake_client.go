@@ -109,6 +109,14 @@ func (f *FakeCalicoClient) NetworkSets() clientv3.NetworkSetInterface {
 	panic("not implemented")
 }
 
+func (f *FakeCalicoClient) PacketCaptures() clientv3.PacketCaptureInterface {
+	panic("not implemented")
+}
+
+func (f *FakeCalicoClient) IPPoolMigrations() clientv3.IPPoolMigrationInterface {
+	panic("not implemented")
+}
+
 // HostEndpoints returns an interface for managing host endpoint resources.
 func (f *FakeCalicoClient) HostEndpoints() clientv3.HostEndpointInterface {
 	panic("not implemented")
@@ -288,6 +296,12 @@ func (f *fakeIPAMClient) IPsByHandle(ctx context.Context, handleID string) ([]cn
 	panic("not implemented") // TODO: Implement
 }
 
+// HandlesByNode returns the IDs of all handles that have at least one
+// IP address assigned with the given node recorded in its attributes.
+func (f *fakeIPAMClient) HandlesByNode(ctx context.Context, node string) ([]string, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 // ReleaseByHandle releases all IP addresses that have been assigned
 // using the provided handle.  Returns an error if no addresses
 // are assigned with the given handle.
@@ -346,7 +360,7 @@ func (f *fakeIPAMClient) ReleaseHostAffinities(ctx context.Context, affinityCfg
 
 // ReleasePoolAffinities releases affinity for all blocks within
 // the specified pool across all hosts.
-func (f *fakeIPAMClient) ReleasePoolAffinities(ctx context.Context, pool cnet.IPNet) error {
+func (f *fakeIPAMClient) ReleasePoolAffinities(ctx context.Context, pool cnet.IPNet, mustBeEmpty bool) error {
 	panic("not implemented") // TODO: Implement
 }
 
@@ -26,6 +26,7 @@ type DatastoreType string
 const (
 	EtcdV3              DatastoreType = "etcdv3"
 	Kubernetes          DatastoreType = "kubernetes"
+	File                DatastoreType = "file"
 	KindCalicoAPIConfig               = "CalicoAPIConfig"
 )
 
@@ -45,6 +46,8 @@ type CalicoAPIConfigSpec struct {
 	EtcdConfig
 	// Inline the k8s config fields.
 	KubeConfig
+	// Inline the file datastore config fields.
+	FileConfig
 }
 
 type EtcdConfig struct {
@@ -85,6 +88,16 @@ type KubeConfig struct {
 	K8sCurrentContext string `json:"k8sCurrentContext" envconfig:"K8S_CURRENT_CONTEXT" default:""`
 }
 
+// FileConfig contains the configuration of the file datastore, which stores resources as YAML
+// files in a local directory.  It is intended for hosts that run Calico without an etcd cluster
+// or a Kubernetes API server.
+type FileConfig struct {
+	// FileDatastoreDir is the directory that holds the datastore.  It is created if it does not
+	// exist.  All clients that share the directory (for example calicoctl and calico/node) see
+	// each others' changes.
+	FileDatastoreDir string `json:"fileDatastoreDir" envconfig:"FILE_DATASTORE_DIR" default:""`
+}
+
 // NewCalicoAPIConfig creates a new (zeroed) CalicoAPIConfig struct with the
 // TypeMetadata initialised to the current version.
 func NewCalicoAPIConfig() *CalicoAPIConfig {
@@ -94,6 +94,9 @@ func applyConfigDefaults(c *CalicoAPIConfig) {
 		if c.Spec.EtcdEndpoints != "" {
 			log.Debug("EtcdEndpoints specified, detected etcdv3.")
 			c.Spec.DatastoreType = EtcdV3
+		} else if c.Spec.FileDatastoreDir != "" {
+			log.Debug("FileDatastoreDir specified, detected file datastore.")
+			c.Spec.DatastoreType = File
 		} else {
 			log.Debug("No EtcdEndpoints specified, defaulting to kubernetes.")
 			c.Spec.DatastoreType = Kubernetes
@@ -0,0 +1,189 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceClusterNetworkPolicies = "clusternetworkpolicies"
+	KindClusterNetworkPolicy       = "ClusterNetworkPolicy"
+	KindClusterNetworkPolicyList   = "ClusterNetworkPolicyList"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNetworkPolicy is a cluster-scoped network policy that is evaluated either before
+// (Admin tier) or after (Baseline tier) namespaced NetworkPolicy.
+type ClusterNetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterNetworkPolicySpec   `json:"spec"`
+	Status ClusterNetworkPolicyStatus `json:"status,omitempty"`
+}
+
+// ClusterNetworkPolicyStatus defines the observed state of a ClusterNetworkPolicy.
+type ClusterNetworkPolicyStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Tier is the tier that a ClusterNetworkPolicy is evaluated in.
+type Tier string
+
+const (
+	// AdminTier policies are evaluated before namespaced NetworkPolicy.
+	AdminTier Tier = "Admin"
+	// BaselineTier policies are evaluated after namespaced NetworkPolicy.
+	BaselineTier Tier = "Baseline"
+)
+
+// ClusterNetworkPolicySpec defines the desired state of a ClusterNetworkPolicy.
+type ClusterNetworkPolicySpec struct {
+	// Tier is the tier in which the policy is evaluated.
+	Tier Tier `json:"tier"`
+
+	// Priority is the priority of the policy within its tier.  Lower values are evaluated
+	// first.  The behaviour of two policies with the same priority in the same tier is
+	// undefined.
+	Priority int32 `json:"priority"`
+
+	// Subject defines the pods to which this policy applies.  Exactly one field must be set.
+	Subject ClusterNetworkPolicySubject `json:"subject"`
+
+	// Ingress is the list of ingress rules, evaluated in order.
+	Ingress []ClusterNetworkPolicyIngressRule `json:"ingress,omitempty"`
+
+	// Egress is the list of egress rules, evaluated in order.
+	Egress []ClusterNetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
+// ClusterNetworkPolicySubject selects the pods that a policy applies to.  Exactly one field
+// must be set.
+type ClusterNetworkPolicySubject struct {
+	// Namespaces selects all pods in the selected namespaces.
+	Namespaces *metav1.LabelSelector `json:"namespaces,omitempty"`
+
+	// Pods selects the matching pods in the selected namespaces.
+	Pods *NamespacedPod `json:"pods,omitempty"`
+}
+
+// NamespacedPod selects pods using a namespace selector and a pod selector.
+type NamespacedPod struct {
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	PodSelector       metav1.LabelSelector `json:"podSelector"`
+}
+
+// ClusterNetworkPolicyRuleAction is the action taken when a rule matches.
+type ClusterNetworkPolicyRuleAction string
+
+const (
+	// ClusterNetworkPolicyRuleActionAccept accepts the traffic without evaluating any
+	// further policy.
+	ClusterNetworkPolicyRuleActionAccept ClusterNetworkPolicyRuleAction = "Accept"
+	// ClusterNetworkPolicyRuleActionDeny drops the traffic without evaluating any further
+	// policy.
+	ClusterNetworkPolicyRuleActionDeny ClusterNetworkPolicyRuleAction = "Deny"
+	// ClusterNetworkPolicyRuleActionPass skips the remaining policies in the tier, handing
+	// the traffic to the next tier.  Only valid in the Admin tier.
+	ClusterNetworkPolicyRuleActionPass ClusterNetworkPolicyRuleAction = "Pass"
+)
+
+// ClusterNetworkPolicyIngressRule matches traffic from the given peers on the given
+// protocols.
+type ClusterNetworkPolicyIngressRule struct {
+	Name      string                            `json:"name,omitempty"`
+	Action    ClusterNetworkPolicyRuleAction    `json:"action"`
+	From      []ClusterNetworkPolicyIngressPeer `json:"from"`
+	Protocols []ClusterNetworkPolicyProtocol    `json:"protocols,omitempty"`
+}
+
+// ClusterNetworkPolicyEgressRule matches traffic to the given peers on the given protocols.
+type ClusterNetworkPolicyEgressRule struct {
+	Name      string                           `json:"name,omitempty"`
+	Action    ClusterNetworkPolicyRuleAction   `json:"action"`
+	To        []ClusterNetworkPolicyEgressPeer `json:"to"`
+	Protocols []ClusterNetworkPolicyProtocol   `json:"protocols,omitempty"`
+}
+
+// ClusterNetworkPolicyIngressPeer is the source of ingress traffic.  Exactly one field must
+// be set.
+type ClusterNetworkPolicyIngressPeer struct {
+	Namespaces *metav1.LabelSelector `json:"namespaces,omitempty"`
+	Pods       *NamespacedPod        `json:"pods,omitempty"`
+}
+
+// ClusterNetworkPolicyEgressPeer is the destination of egress traffic.  Exactly one field
+// must be set.
+type ClusterNetworkPolicyEgressPeer struct {
+	Namespaces *metav1.LabelSelector `json:"namespaces,omitempty"`
+	Pods       *NamespacedPod        `json:"pods,omitempty"`
+
+	// Nodes selects the host network of the matching nodes.
+	Nodes *metav1.LabelSelector `json:"nodes,omitempty"`
+
+	// Networks is a list of CIDRs.
+	Networks []CIDR `json:"networks,omitempty"`
+
+	// DomainNames is a list of fully-qualified domain names, optionally with a leading
+	// wildcard label.  Only valid on Accept rules in the Admin tier.
+	DomainNames []DomainName `json:"domainNames,omitempty"`
+}
+
+// CIDR is an IPv4 or IPv6 network in CIDR notation.
+type CIDR string
+
+// DomainName is a fully-qualified domain name, such as "example.com" or "*.example.com".
+type DomainName string
+
+// ClusterNetworkPolicyProtocol matches traffic by protocol and destination port.  Exactly
+// one field must be set.
+type ClusterNetworkPolicyProtocol struct {
+	TCP  *ClusterNetworkPolicyProtocolPorts `json:"tcp,omitempty"`
+	UDP  *ClusterNetworkPolicyProtocolPorts `json:"udp,omitempty"`
+	SCTP *ClusterNetworkPolicyProtocolPorts `json:"sctp,omitempty"`
+
+	// DestinationNamedPort matches the named container port of the destination pod, on
+	// whichever protocol that port is declared with.
+	DestinationNamedPort string `json:"destinationNamedPort,omitempty"`
+}
+
+// ClusterNetworkPolicyProtocolPorts matches a destination port of a single protocol.  If
+// DestinationPort is nil then all ports of the protocol match.
+type ClusterNetworkPolicyProtocolPorts struct {
+	DestinationPort *Port `json:"destinationPort,omitempty"`
+}
+
+// Port is a single port or a port range.  Exactly one field must be set.
+type Port struct {
+	Number int32      `json:"number,omitempty"`
+	Range  *PortRange `json:"range,omitempty"`
+}
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Start int32 `json:"start"`
+	End   int32 `json:"end"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterNetworkPolicyList contains a list of ClusterNetworkPolicy resources.
+type ClusterNetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterNetworkPolicy `json:"items"`
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package v1alpha2 contains the subset of the Kubernetes network-policy-api v1alpha2 types that
+Calico consumes.  ClusterNetworkPolicy consolidates the v1alpha1 AdminNetworkPolicy and
+BaselineAdminNetworkPolicy resources into a single resource with an Admin and a Baseline tier.
+
+The upstream project does not yet publish a Go client for this API version, so the types are
+mirrored here and should be kept in line with the policy.networking.k8s.io CRD.
+*/
+
+// +k8s:deepcopy-gen=package,register
+
+package v1alpha2
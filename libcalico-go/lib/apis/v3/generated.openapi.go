@@ -3024,6 +3024,62 @@ func schema_libcalico_go_lib_apis_v3_NodeStatus(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"wireguardPublicKeyTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardPublicKeyTimestamp is the time at which the IPv4 Wireguard key pair was generated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"wireguardPublicKeyTimestampV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardPublicKeyTimestampV6 is the time at which the IPv6 Wireguard key pair was generated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"wireguardNextPublicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardNextPublicKey is the IPv4 Wireguard public-key that this node is rotating to.  It is only set while a key rotation is in progress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wireguardNextPublicKeyV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardNextPublicKeyV6 is the IPv6 Wireguard public-key that this node is rotating to.  It is only set while a key rotation is in progress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wireguardAcceptedNextPublicKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardAcceptedNextPublicKeys are the IPv4 Wireguard next public-keys of rotating peers that this node has programmed, and so accepts handshakes with.  A rotating node only switches to its next key once all of its peers have accepted it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"wireguardAcceptedNextPublicKeysV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardAcceptedNextPublicKeysV6 are the IPv6 Wireguard next public-keys of rotating peers that this node has programmed, and so accepts handshakes with.  A rotating node only switches to its next key once all of its peers have accepted it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"podCIDRs": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PodCIDR is a reflection of the Kubernetes node's spec.PodCIDRs field.",
@@ -3042,6 +3098,8 @@ func schema_libcalico_go_lib_apis_v3_NodeStatus(ref common.ReferenceCallback) co
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -87,6 +87,30 @@ type NodeStatus struct {
 	// wireguardPublicKey validates if the string is a valid base64 encoded key.
 	WireguardPublicKeyV6 string `json:"wireguardPublicKeyV6,omitempty" validate:"omitempty,wireguardPublicKey"`
 
+	// WireguardPublicKeyTimestamp is the time at which the IPv4 Wireguard key pair was generated.
+	WireguardPublicKeyTimestamp *metav1.Time `json:"wireguardPublicKeyTimestamp,omitempty"`
+
+	// WireguardPublicKeyTimestampV6 is the time at which the IPv6 Wireguard key pair was generated.
+	WireguardPublicKeyTimestampV6 *metav1.Time `json:"wireguardPublicKeyTimestampV6,omitempty"`
+
+	// WireguardNextPublicKey is the IPv4 Wireguard public-key that this node is rotating to.  It is only set
+	// while a key rotation is in progress.
+	WireguardNextPublicKey string `json:"wireguardNextPublicKey,omitempty" validate:"omitempty,wireguardPublicKey"`
+
+	// WireguardNextPublicKeyV6 is the IPv6 Wireguard public-key that this node is rotating to.  It is only set
+	// while a key rotation is in progress.
+	WireguardNextPublicKeyV6 string `json:"wireguardNextPublicKeyV6,omitempty" validate:"omitempty,wireguardPublicKey"`
+
+	// WireguardAcceptedNextPublicKeys are the IPv4 Wireguard next public-keys of rotating peers that this node
+	// has programmed, and so accepts handshakes with.  A rotating node only switches to its next key once all
+	// of its peers have accepted it.
+	WireguardAcceptedNextPublicKeys []string `json:"wireguardAcceptedNextPublicKeys,omitempty" validate:"omitempty,dive,wireguardPublicKey"`
+
+	// WireguardAcceptedNextPublicKeysV6 are the IPv6 Wireguard next public-keys of rotating peers that this node
+	// has programmed, and so accepts handshakes with.  A rotating node only switches to its next key once all
+	// of its peers have accepted it.
+	WireguardAcceptedNextPublicKeysV6 []string `json:"wireguardAcceptedNextPublicKeysV6,omitempty" validate:"omitempty,dive,wireguardPublicKey"`
+
 	// PodCIDR is a reflection of the Kubernetes node's spec.PodCIDRs field.
 	PodCIDRs []string `json:"podCIDRs,omitempty" validate:"omitempty"`
 }
@@ -115,8 +139,22 @@ type NodeBGPSpec struct {
 	// RouteReflectorClusterID enables this node as a route reflector within the given
 	// cluster.
 	RouteReflectorClusterID string `json:"routeReflectorClusterID,omitempty" validate:"omitempty,ipv4"`
+	// GracefulShutdown, when set, gracefully shuts down the node's BGP sessions.  It is set by
+	// calico/node when the node is cordoned or drained for maintenance.  [Default: ""]
+	GracefulShutdown BGPGracefulShutdown `json:"gracefulShutdown,omitempty" validate:"omitempty,oneof=Signal Withdraw"`
 }
 
+// BGPGracefulShutdown is the graceful shutdown state of a node's BGP sessions.
+type BGPGracefulShutdown string
+
+const (
+	// BGPGracefulShutdownSignal advertises the node's routes with the GRACEFUL_SHUTDOWN
+	// community (RFC 8326), asking peers to move traffic to alternative paths.
+	BGPGracefulShutdownSignal BGPGracefulShutdown = "Signal"
+	// BGPGracefulShutdownWithdraw withdraws all of the node's routes from its peers.
+	BGPGracefulShutdownWithdraw BGPGracefulShutdown = "Withdraw"
+)
+
 // NodeWireguardSpec contains the specification for the Node wireguard configuration.
 type NodeWireguardSpec struct {
 	// InterfaceIPv4Address is the IP address for the IPv4 Wireguard interface.
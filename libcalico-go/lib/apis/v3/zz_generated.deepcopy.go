@@ -551,6 +551,24 @@ func (in *NodeSpec) DeepCopy() *NodeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	*out = *in
+	if in.WireguardPublicKeyTimestamp != nil {
+		in, out := &in.WireguardPublicKeyTimestamp, &out.WireguardPublicKeyTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.WireguardPublicKeyTimestampV6 != nil {
+		in, out := &in.WireguardPublicKeyTimestampV6, &out.WireguardPublicKeyTimestampV6
+		*out = (*in).DeepCopy()
+	}
+	if in.WireguardAcceptedNextPublicKeys != nil {
+		in, out := &in.WireguardAcceptedNextPublicKeys, &out.WireguardAcceptedNextPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WireguardAcceptedNextPublicKeysV6 != nil {
+		in, out := &in.WireguardAcceptedNextPublicKeysV6, &out.WireguardAcceptedNextPublicKeysV6
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.PodCIDRs != nil {
 		in, out := &in.PodCIDRs, &out.PodCIDRs
 		*out = make([]string, len(*in))
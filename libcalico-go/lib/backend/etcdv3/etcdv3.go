@@ -157,7 +157,7 @@ func (c *etcdV3Client) Create(ctx context.Context, d *model.KVPair) (*model.KVPa
 	logCxt := log.WithFields(log.Fields{"model-etcdKey": d.Key, "value": d.Value, "ttl": d.TTL, "rev": d.Revision})
 	logCxt.Debug("Processing Create request")
 
-	err := defaultPolicyName(d)
+	err := DefaultPolicyName(d)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +220,7 @@ func (c *etcdV3Client) Update(ctx context.Context, d *model.KVPair) (*model.KVPa
 	logCxt := log.WithFields(log.Fields{"model-etcdKey": d.Key, "value": d.Value, "ttl": d.TTL, "rev": d.Revision})
 	logCxt.Debug("Processing Update request")
 
-	err := defaultPolicyName(d)
+	err := DefaultPolicyName(d)
 	if err != nil {
 		return nil, err
 	}
@@ -289,7 +289,7 @@ func (c *etcdV3Client) Apply(ctx context.Context, d *model.KVPair) (*model.KVPai
 	logCxt := log.WithFields(log.Fields{"etcdKey": d.Key, "value": d.Value, "ttl": d.TTL, "rev": d.Revision})
 	logCxt.Debug("Processing Apply request")
 
-	err := defaultPolicyName(d)
+	err := DefaultPolicyName(d)
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +330,7 @@ func (c *etcdV3Client) Delete(ctx context.Context, k model.Key, revision string)
 	logCxt := log.WithFields(log.Fields{"model-etcdKey": k, "rev": revision})
 	logCxt.Debug("Processing Delete request")
 
-	k = defaultPolicyKey(k)
+	k = DefaultPolicyKey(k)
 
 	key, err := model.KeyToDefaultDeletePath(k)
 	if err != nil {
@@ -398,7 +398,7 @@ func (c *etcdV3Client) Get(ctx context.Context, k model.Key, revision string) (*
 	logCxt := log.WithFields(log.Fields{"model-etcdKey": k, "rev": revision})
 	logCxt.Debug("Processing Get request")
 
-	k = defaultPolicyKey(k)
+	k = DefaultPolicyKey(k)
 
 	key, err := model.KeyToDefaultPath(k)
 	if err != nil {
@@ -630,7 +630,10 @@ func storePolicyName(name string, annotations map[string]string) (map[string]str
 	return annotations, nil
 }
 
-func defaultPolicyName(d *model.KVPair) error {
+// DefaultPolicyName records the original name of a policy in an annotation and converts the
+// name and key of the KVPair to the tiered form that is used for storage.  It is exported for
+// other backends that share the etcd key layout.
+func DefaultPolicyName(d *model.KVPair) error {
 	if _, ok := d.Value.(*apiv3.NetworkPolicy); ok {
 		value := d.Value.(*apiv3.NetworkPolicy)
 
@@ -703,12 +706,13 @@ func defaultPolicyName(d *model.KVPair) error {
 		value.Name = polName
 	}
 
-	d.Key = defaultPolicyKey(d.Key)
+	d.Key = DefaultPolicyKey(d.Key)
 
 	return nil
 }
 
-func defaultPolicyKey(k model.Key) model.Key {
+// DefaultPolicyKey returns the storage key of a policy, which includes the tier prefix.
+func DefaultPolicyKey(k model.Key) model.Key {
 	if _, ok := k.(model.ResourceKey); ok {
 		resourceKey := k.(model.ResourceKey)
 		if resourceKey.Kind == apiv3.KindNetworkPolicy ||
@@ -0,0 +1,617 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/etcdv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/resources"
+)
+
+var (
+	// PollInterval is the interval at which clients check the directory for changes made by
+	// other clients (or by hand).
+	PollInterval = time.Second
+
+	defaultAllowProfileResourceKey = model.ResourceKey{Name: "projectcalico-default-allow", Kind: apiv3.KindProfile}
+)
+
+const (
+	profilesKey            = "/calico/resources/v3/projectcalico.org/profiles/"
+	defaultAllowProfileKey = "/calico/resources/v3/projectcalico.org/profiles/projectcalico-default-allow"
+
+	// maxHistory is the number of events that are kept to allow watches to resume from an
+	// earlier revision.
+	maxHistory = 10000
+)
+
+// fileClient is a datastore that stores resources as YAML files in a local directory.  It
+// uses the same key layout and value serialization as the etcdv3 datastore.  Every client
+// that shares the directory keeps a cache of its contents, which is refreshed when the
+// directory changes and periodically while there are watchers.
+type fileClient struct {
+	dir  string
+	lock *flock.Flock
+	now  func() time.Time
+
+	// mutex protects the fields below and serializes access to the lock file within this
+	// process.
+	mutex           sync.Mutex
+	index           *index
+	cache           map[string]*cacheEntry
+	revision        int64
+	history         []*event
+	compactRevision int64
+	changed         chan struct{}
+	numWatchers     int
+	stopPolling     chan struct{}
+
+	// The state of the directory when it was last scanned, used to skip scans when nothing
+	// has changed.  See needsRefreshLocked.
+	scanned    dirState
+	lastScan   time.Time
+	nextExpiry int64
+}
+
+// lockMode is the mode in which the lock file is taken.  Reads share the lock, writes (and
+// refreshes that need to update the index) take it exclusively.
+type lockMode int
+
+const (
+	lockShared lockMode = iota
+	lockExclusive
+)
+
+type cacheEntry struct {
+	value          []byte
+	createRevision int64
+	modRevision    int64
+}
+
+// event records a change to a key.  old is nil for a creation and new is nil for a deletion.
+type event struct {
+	key      string
+	revision int64
+	old, new *cacheEntry
+}
+
+func NewFileClient(config *apiconfig.FileConfig) (api.Client, error) {
+	if config.FileDatastoreDir == "" {
+		return nil, errors.New("no file datastore directory specified")
+	}
+	dir, err := filepath.Abs(config.FileDatastoreDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file datastore directory: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create file datastore directory: %w", err)
+	}
+
+	c := &fileClient{
+		dir:     dir,
+		lock:    flock.New(filepath.Join(dir, lockFileName)),
+		now:     time.Now,
+		index:   newIndex(),
+		cache:   map[string]*cacheEntry{},
+		changed: make(chan struct{}),
+	}
+	if err := c.refresh(); err != nil {
+		return nil, err
+	}
+	// Watches can only resume from revisions that this client has seen.
+	c.compactRevision = c.revision
+	c.history = nil
+	return c, nil
+}
+
+// withLock runs the given function while holding the lock on the datastore, after bringing
+// the cache up to date with the directory.  A shared lock is upgraded to an exclusive one if
+// the refresh finds changes that need to be written to the index.
+func (c *fileClient) withLock(mode lockMode, f func() error) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for {
+		if err := c.lockFile(mode); err != nil {
+			return cerrors.ErrorDatastoreError{Err: fmt.Errorf("failed to lock file datastore: %w", err)}
+		}
+		err := c.refreshLocked(mode)
+		if err == nil {
+			break
+		}
+		c.unlockFile()
+		if errors.Is(err, errIndexNeedsUpdate) && mode == lockShared {
+			log.Debug("File datastore index needs an update, retaking lock exclusively")
+			mode = lockExclusive
+			continue
+		}
+		return cerrors.ErrorDatastoreError{Err: err}
+	}
+	defer c.unlockFile()
+
+	if f == nil {
+		return nil
+	}
+	return f()
+}
+
+func (c *fileClient) lockFile(mode lockMode) error {
+	if mode == lockShared {
+		return c.lock.RLock()
+	}
+	return c.lock.Lock()
+}
+
+func (c *fileClient) unlockFile() {
+	if err := c.lock.Unlock(); err != nil {
+		log.WithError(err).Warn("Failed to unlock file datastore")
+	}
+}
+
+func (c *fileClient) refresh() error {
+	return c.withLock(lockShared, nil)
+}
+
+// Create an entry in the datastore.  If the entry already exists, this will return
+// an ErrorResourceAlreadyExists error and the current entry.
+func (c *fileClient) Create(ctx context.Context, d *model.KVPair) (*model.KVPair, error) {
+	keyCopy := d.Key
+	logCxt := log.WithFields(log.Fields{"model-key": d.Key, "value": d.Value, "ttl": d.TTL, "rev": d.Revision})
+	logCxt.Debug("Processing Create request")
+
+	if err := etcdv3.DefaultPolicyName(d); err != nil {
+		return nil, err
+	}
+	key, value, err := getKeyValueStrings(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *model.KVPair
+	var rev int64
+	err = c.withLock(lockExclusive, func() error {
+		if e := c.cache[key]; e != nil {
+			logCxt.Debug("Create failed due to resource already existing")
+			existing, _ = toKVPair(d.Key, key, e)
+			return cerrors.ErrorResourceAlreadyExists{Identifier: keyCopy}
+		}
+		rev, err = c.writeEntryLocked(key, value, d.TTL)
+		return err
+	})
+	if err != nil {
+		return existing, wrapError(err, keyCopy)
+	}
+	return updatedKVPair(d, value, rev)
+}
+
+// Update an entry in the datastore.  If the entry does not exist, this will return
+// an ErrorResourceDoesNotExist error.  If the ResourceVersion is specified and incorrect
+// this will return an ErrorResourceUpdateConflict error and the current entry.
+func (c *fileClient) Update(ctx context.Context, d *model.KVPair) (*model.KVPair, error) {
+	keyCopy := d.Key
+	logCxt := log.WithFields(log.Fields{"model-key": d.Key, "value": d.Value, "ttl": d.TTL, "rev": d.Revision})
+	logCxt.Debug("Processing Update request")
+
+	if err := etcdv3.DefaultPolicyName(d); err != nil {
+		return nil, err
+	}
+	key, value, err := getKeyValueStrings(d)
+	if err != nil {
+		return nil, err
+	}
+	var expectedRev int64
+	if d.Revision != "" {
+		if expectedRev, err = parseRevision(d.Revision); err != nil {
+			return nil, err
+		}
+	}
+
+	var existing *model.KVPair
+	var rev int64
+	err = c.withLock(lockExclusive, func() error {
+		e := c.cache[key]
+		if e == nil {
+			logCxt.Debug("Update failed due to resource not existing")
+			return cerrors.ErrorResourceDoesNotExist{Identifier: keyCopy}
+		}
+		if d.Revision != "" && e.modRevision != expectedRev {
+			logCxt.Debug("Update failed due to resource update conflict")
+			existing, _ = toKVPair(d.Key, key, e)
+			return cerrors.ErrorResourceUpdateConflict{Identifier: keyCopy}
+		}
+		rev, err = c.writeEntryLocked(key, value, d.TTL)
+		return err
+	})
+	if err != nil {
+		return existing, wrapError(err, keyCopy)
+	}
+	return updatedKVPair(d, value, rev)
+}
+
+// Apply creates or updates an entry in the datastore, ignoring the revision.
+func (c *fileClient) Apply(ctx context.Context, d *model.KVPair) (*model.KVPair, error) {
+	log.WithFields(log.Fields{"model-key": d.Key, "value": d.Value, "ttl": d.TTL, "rev": d.Revision}).Debug("Processing Apply request")
+
+	if err := etcdv3.DefaultPolicyName(d); err != nil {
+		return nil, err
+	}
+	key, value, err := getKeyValueStrings(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var rev int64
+	err = c.withLock(lockExclusive, func() error {
+		rev, err = c.writeEntryLocked(key, value, d.TTL)
+		return err
+	})
+	if err != nil {
+		return nil, wrapError(err, d.Key)
+	}
+	return updatedKVPair(d, value, rev)
+}
+
+func (c *fileClient) DeleteKVP(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	return c.Delete(ctx, kvp.Key, kvp.Revision)
+}
+
+// Delete an entry in the datastore.  This errors if the entry does not exists.
+func (c *fileClient) Delete(ctx context.Context, k model.Key, revision string) (*model.KVPair, error) {
+	keyCopy := k
+	logCxt := log.WithFields(log.Fields{"model-key": k, "rev": revision})
+	logCxt.Debug("Processing Delete request")
+
+	k = etcdv3.DefaultPolicyKey(k)
+	key, err := model.KeyToDefaultDeletePath(k)
+	if err != nil {
+		return nil, err
+	}
+	var expectedRev int64
+	if revision != "" {
+		if expectedRev, err = parseRevision(revision); err != nil {
+			return nil, err
+		}
+	}
+
+	var previous *model.KVPair
+	err = c.withLock(lockExclusive, func() error {
+		e := c.cache[key]
+		if e == nil {
+			logCxt.Debug("Delete failed due to resource not existing")
+			return cerrors.ErrorResourceDoesNotExist{Identifier: keyCopy}
+		}
+		previous, _ = toKVPair(k, key, e)
+		if revision != "" && e.modRevision != expectedRev {
+			logCxt.Debug("Delete failed due to resource update conflict")
+			return cerrors.ErrorResourceUpdateConflict{Identifier: keyCopy}
+		}
+		return c.deleteEntryLocked(key)
+	})
+	if err != nil {
+		var conflict cerrors.ErrorResourceUpdateConflict
+		if errors.As(err, &conflict) {
+			return previous, err
+		}
+		return nil, wrapError(err, keyCopy)
+	}
+	return previous, nil
+}
+
+// Get an entry from the datastore.  This errors if the entry does not exist.  Only the
+// latest revision is stored, so the revision parameter is only validated.
+func (c *fileClient) Get(ctx context.Context, k model.Key, revision string) (*model.KVPair, error) {
+	keyCopy := k
+	logCxt := log.WithFields(log.Fields{"model-key": k, "rev": revision})
+	logCxt.Debug("Processing Get request")
+
+	k = etcdv3.DefaultPolicyKey(k)
+	key, err := model.KeyToDefaultPath(k)
+	if err != nil {
+		logCxt.Error("Unable to convert model.Key to a datastore key")
+		return nil, err
+	}
+
+	// Handle the static default-allow profile. Always return the default profile.
+	if key == defaultAllowProfileKey {
+		logCxt.Debug("Returning default-allow profile for get")
+		return resources.DefaultAllowProfile(), nil
+	}
+	if revision != "" {
+		if _, err := parseRevision(revision); err != nil {
+			return nil, err
+		}
+	}
+
+	var kvp *model.KVPair
+	err = c.withLock(lockShared, func() error {
+		e := c.cache[key]
+		if e == nil {
+			return cerrors.ErrorResourceDoesNotExist{Identifier: keyCopy}
+		}
+		kvp, err = toKVPair(k, key, e)
+		return err
+	})
+	if err != nil {
+		return nil, wrapError(err, keyCopy)
+	}
+	return kvp, nil
+}
+
+// List entries in the datastore.  This may return an empty list of there are
+// no entries matching the request in the ListInterface.
+func (c *fileClient) List(ctx context.Context, l model.ListInterface, revision string) (*model.KVPairList, error) {
+	logCxt := log.WithFields(log.Fields{"list-interface": l, "rev": revision})
+	logCxt.Debug("Processing List request")
+
+	if revision != "" {
+		if _, err := parseRevision(revision); err != nil {
+			return nil, err
+		}
+	}
+	var list *model.KVPairList
+	err := c.withLock(lockShared, func() error {
+		list = c.listLocked(l)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *fileClient) listLocked(l model.ListInterface) *model.KVPairList {
+	root, matches := listMatcher(l)
+
+	list := []*model.KVPair{}
+	for _, key := range sortedKeys(c.cache) {
+		if !matches(key) {
+			continue
+		}
+		if kv := convertListEntry(l, key, c.cache[key]); kv != nil {
+			list = append(list, kv)
+		}
+	}
+
+	// If we're listing profiles, we need to handle the statically defined
+	// default-allow profile in the resources package.
+	// We always include the default profile.
+	if root == profilesKey || root == defaultAllowProfileKey {
+		list = append(list, resources.DefaultAllowProfile())
+	}
+
+	return &model.KVPairList{
+		KVPairs:  list,
+		Revision: strconv.FormatInt(c.revision, 10),
+	}
+}
+
+// listMatcher returns the root key for the list, along with a function that returns whether a
+// key is within the scope of the list.  This mirrors the prefix handling of the etcdv3 datastore.
+func listMatcher(l model.ListInterface) (string, func(string) bool) {
+	root := model.ListOptionsToDefaultPathRoot(l)
+	if model.IsListOptionsLastSegmentPrefix(l) {
+		return root, func(key string) bool { return strings.HasPrefix(key, root) }
+	} else if !model.ListOptionsIsFullyQualified(l) {
+		if !strings.HasSuffix(root, "/") {
+			root += "/"
+		}
+		return root, func(key string) bool { return strings.HasPrefix(key, root) }
+	}
+	return root, func(key string) bool { return key == root }
+}
+
+// EnsureInitialized makes sure that the datastore directory exists.
+func (c *fileClient) EnsureInitialized() error {
+	return c.refresh()
+}
+
+// Clean removes all of the Calico data from the datastore.
+func (c *fileClient) Clean() error {
+	log.Debug("Cleaning file datastore of all Calico data")
+	return c.withLock(lockExclusive, func() error {
+		for _, key := range sortedKeys(c.index.Entries) {
+			c.removeFileLocked(key)
+		}
+		c.index.Revision++
+		c.index.Entries = map[string]*indexEntry{}
+		if err := c.writeIndexLocked(c.index); err != nil {
+			return cerrors.ErrorDatastoreError{Err: err}
+		}
+		c.syncCacheLocked()
+		return nil
+	})
+}
+
+// IsClean returns true if there are no entries in the datastore.  This is not part of the
+// exposed API, but is public to allow direct consumers of the backend API to access this.
+func (c *fileClient) IsClean() (bool, error) {
+	var clean bool
+	err := c.withLock(lockShared, func() error {
+		clean = len(c.cache) == 0
+		return nil
+	})
+	return clean, err
+}
+
+// Close stops the background polling of the directory.
+func (c *fileClient) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.stopPolling != nil {
+		close(c.stopPolling)
+		c.stopPolling = nil
+	}
+	return nil
+}
+
+// addWatcher registers a watcher, starting the poll of the directory if this is the first one.
+// Must be called with the mutex held.
+func (c *fileClient) addWatcherLocked() {
+	c.numWatchers++
+	if c.stopPolling == nil {
+		c.stopPolling = make(chan struct{})
+		go c.pollLoop(c.stopPolling)
+	}
+}
+
+// removeWatcher unregisters a watcher, stopping the poll when there are no watchers left.
+func (c *fileClient) removeWatcher() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.numWatchers--
+	if c.numWatchers == 0 && c.stopPolling != nil {
+		close(c.stopPolling)
+		c.stopPolling = nil
+	}
+}
+
+func (c *fileClient) pollLoop(stop chan struct{}) {
+	log.WithField("dir", c.dir).Debug("Starting poll of file datastore")
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			log.WithField("dir", c.dir).Debug("Stopping poll of file datastore")
+			return
+		case <-ticker.C:
+			if err := c.refresh(); err != nil {
+				log.WithError(err).Warn("Failed to refresh from file datastore")
+			}
+		}
+	}
+}
+
+// getKeyValueStrings returns the datastore key and serialized value calculated from the
+// KVPair.
+func getKeyValueStrings(d *model.KVPair) (string, []byte, error) {
+	logCxt := log.WithFields(log.Fields{"model-key": d.Key, "value": d.Value})
+	key, err := model.KeyToDefaultPath(d.Key)
+	if err != nil {
+		logCxt.WithError(err).Error("Failed to convert model-key to datastore key")
+		return "", nil, cerrors.ErrorDatastoreError{
+			Err:        err,
+			Identifier: d.Key,
+		}
+	}
+	value, err := model.SerializeValue(d)
+	if err != nil {
+		logCxt.WithError(err).Error("Failed to serialize value")
+		return "", nil, cerrors.ErrorDatastoreError{
+			Err:        err,
+			Identifier: d.Key,
+		}
+	}
+	return key, value, nil
+}
+
+// updatedKVPair fills in the stored value and revision of a KVPair after a write.
+func updatedKVPair(d *model.KVPair, value []byte, rev int64) (*model.KVPair, error) {
+	v, err := model.ParseValue(d.Key, value)
+	if err != nil {
+		return nil, cerrors.ErrorPartialFailure{Err: fmt.Errorf("Unexpected error parsing stored datastore entry '%s': %+v", value, err)}
+	}
+	d.Value = v
+	d.Revision = strconv.FormatInt(rev, 10)
+	return d, nil
+}
+
+// toKVPair converts a cache entry into a model.KVPair.
+func toKVPair(k model.Key, key string, e *cacheEntry) (*model.KVPair, error) {
+	v, err := model.ParseValue(k, e.value)
+	if err != nil {
+		return nil, cerrors.ErrorParsingDatastoreEntry{
+			RawKey:   key,
+			RawValue: string(e.value),
+			Err:      err,
+		}
+	}
+	return &model.KVPair{
+		Key:      k,
+		Value:    v,
+		Revision: strconv.FormatInt(e.modRevision, 10),
+	}, nil
+}
+
+// convertListEntry converts a cache entry to a model.KVPair with a parsed value.  If the key
+// does not represent the resource specified by the ListInterface, or if the value cannot be
+// parsed, this returns nil.
+func convertListEntry(l model.ListInterface, key string, e *cacheEntry) *model.KVPair {
+	k := l.KeyFromDefaultPath(key)
+	if k == nil {
+		return nil
+	}
+	kv, err := toKVPair(k, key, e)
+	if err != nil {
+		log.WithError(err).WithField("key", key).Debug("Skipping datastore entry that could not be parsed")
+		return nil
+	}
+	return kv
+}
+
+// wrapError returns errors from the datastore, wrapping unexpected errors as datastore errors.
+func wrapError(err error, id interface{}) error {
+	switch err.(type) {
+	case cerrors.ErrorResourceAlreadyExists, cerrors.ErrorResourceDoesNotExist,
+		cerrors.ErrorResourceUpdateConflict, cerrors.ErrorDatastoreError, cerrors.ErrorParsingDatastoreEntry:
+		return err
+	}
+	return cerrors.ErrorDatastoreError{Err: err, Identifier: id}
+}
+
+// parseRevision parses the model.KVPair revision string.
+func parseRevision(revs string) (int64, error) {
+	rev, err := strconv.ParseInt(revs, 10, 64)
+	if err != nil {
+		log.WithField("Revision", revs).Debug("Unable to parse Revision")
+		return 0, cerrors.ErrorValidation{
+			ErroredFields: []cerrors.ErroredField{
+				{
+					Name:  "ResourceVersion",
+					Value: revs,
+				},
+			},
+		}
+	}
+	return rev, nil
+}
+
+// eventsSince returns the events after the given revision, or false if the history no longer
+// goes back that far.  Must be called with the mutex held.
+func (c *fileClient) eventsSinceLocked(rev int64) ([]*event, bool) {
+	if rev < c.compactRevision {
+		return nil, false
+	}
+	i := sort.Search(len(c.history), func(i int) bool { return c.history[i].revision > rev })
+	return c.history[i:], true
+}
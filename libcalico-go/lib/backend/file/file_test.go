@@ -0,0 +1,353 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/file"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/testutils"
+)
+
+func ipPoolKVP(name, cidr string) *model.KVPair {
+	pool := apiv3.NewIPPool()
+	pool.Name = name
+	pool.Spec.CIDR = cidr
+	return &model.KVPair{
+		Key:   model.ResourceKey{Kind: apiv3.KindIPPool, Name: name},
+		Value: pool,
+	}
+}
+
+var _ = Describe("File datastore", func() {
+	var (
+		dir    string
+		client api.Client
+		ctx    context.Context
+	)
+
+	newClient := func() api.Client {
+		c, err := file.NewFileClient(&apiconfig.FileConfig{FileDatastoreDir: dir})
+		Expect(err).NotTo(HaveOccurred())
+		return c
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		dir, err = os.MkdirTemp("", "calico-file-datastore")
+		Expect(err).NotTo(HaveOccurred())
+		client = newClient()
+	})
+
+	AfterEach(func() {
+		Expect(client.Close()).To(Succeed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should reject an empty directory", func() {
+		_, err := file.NewFileClient(&apiconfig.FileConfig{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should create, get, update and delete resources", func() {
+		created, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.Revision).NotTo(BeEmpty())
+		Expect(filepath.Join(dir, "resources/v3/projectcalico.org/ippools/pool1.yaml")).To(BeAnExistingFile())
+
+		_, err = client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).To(BeAssignableToTypeOf(cerrors.ErrorResourceAlreadyExists{}))
+
+		got, err := client.Get(ctx, model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool1"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Revision).To(Equal(created.Revision))
+		Expect(got.Value.(*apiv3.IPPool).Spec.CIDR).To(Equal("10.0.0.0/16"))
+
+		By("rejecting an update with a stale revision")
+		update := ipPoolKVP("pool1", "10.1.0.0/16")
+		update.Revision = created.Revision
+		updated, err := client.Update(ctx, update)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Revision).NotTo(Equal(created.Revision))
+
+		stale := ipPoolKVP("pool1", "10.2.0.0/16")
+		stale.Revision = created.Revision
+		_, err = client.Update(ctx, stale)
+		Expect(err).To(BeAssignableToTypeOf(cerrors.ErrorResourceUpdateConflict{}))
+
+		_, err = client.Delete(ctx, update.Key, created.Revision)
+		Expect(err).To(BeAssignableToTypeOf(cerrors.ErrorResourceUpdateConflict{}))
+
+		deleted, err := client.Delete(ctx, update.Key, updated.Revision)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted.Value.(*apiv3.IPPool).Spec.CIDR).To(Equal("10.1.0.0/16"))
+
+		_, err = client.Get(ctx, update.Key, "")
+		Expect(err).To(BeAssignableToTypeOf(cerrors.ErrorResourceDoesNotExist{}))
+		Expect(filepath.Join(dir, "resources")).NotTo(BeADirectory())
+	})
+
+	It("should store raw values", func() {
+		kvp := &model.KVPair{Key: model.GlobalConfigKey{Name: "LogSeverityScreen"}, Value: "info"}
+		_, err := client.Apply(ctx, kvp)
+		Expect(err).NotTo(HaveOccurred())
+
+		got, err := client.Get(ctx, model.GlobalConfigKey{Name: "LogSeverityScreen"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Value).To(Equal("info"))
+	})
+
+	It("should list resources, including the default-allow profile", func() {
+		_, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Create(ctx, ipPoolKVP("pool2", "10.1.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+
+		l, err := client.List(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l.KVPairs).To(HaveLen(2))
+		Expect(l.Revision).NotTo(BeEmpty())
+
+		l, err = client.List(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool, Name: "pool2"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l.KVPairs).To(HaveLen(1))
+
+		l, err = client.List(ctx, model.ResourceListOptions{Kind: apiv3.KindProfile}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l.KVPairs).To(HaveLen(1))
+	})
+
+	It("should store policies under their tiered names", func() {
+		gnp := apiv3.NewGlobalNetworkPolicy()
+		gnp.Name = "allow-all"
+		gnp.Spec.Tier = "default"
+		kvp := &model.KVPair{
+			Key:   model.ResourceKey{Kind: apiv3.KindGlobalNetworkPolicy, Name: "allow-all"},
+			Value: gnp,
+		}
+		_, err := client.Create(ctx, kvp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filepath.Join(dir, "resources/v3/projectcalico.org/globalnetworkpolicies/default.allow-all.yaml")).To(BeAnExistingFile())
+
+		got, err := client.Get(ctx, model.ResourceKey{Kind: apiv3.KindGlobalNetworkPolicy, Name: "allow-all"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Value.(*apiv3.GlobalNetworkPolicy).Name).To(Equal("allow-all"))
+	})
+
+	It("should share data between clients", func() {
+		other := newClient()
+		defer other.Close()
+
+		created, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+
+		got, err := other.Get(ctx, created.Key, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Revision).To(Equal(created.Revision))
+
+		update := ipPoolKVP("pool1", "10.1.0.0/16")
+		update.Revision = got.Revision
+		_, err = other.Update(ctx, update)
+		Expect(err).NotTo(HaveOccurred())
+
+		stale := ipPoolKVP("pool1", "10.2.0.0/16")
+		stale.Revision = created.Revision
+		_, err = client.Update(ctx, stale)
+		Expect(err).To(BeAssignableToTypeOf(cerrors.ErrorResourceUpdateConflict{}))
+	})
+
+	It("should pick up files that are written by hand", func() {
+		path := filepath.Join(dir, "resources/v3/projectcalico.org/ippools/manual.yaml")
+		Expect(os.MkdirAll(filepath.Dir(path), 0o700)).To(Succeed())
+		Expect(os.WriteFile(path, []byte("kind: IPPool\napiVersion: projectcalico.org/v3\nmetadata:\n  name: manual\nspec:\n  cidr: 192.168.0.0/16\n"), 0o600)).To(Succeed())
+
+		got, err := client.Get(ctx, model.ResourceKey{Kind: apiv3.KindIPPool, Name: "manual"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Value.(*apiv3.IPPool).Spec.CIDR).To(Equal("192.168.0.0/16"))
+
+		By("bumping the revision when the file is edited")
+		Expect(os.WriteFile(path, []byte("kind: IPPool\napiVersion: projectcalico.org/v3\nmetadata:\n  name: manual\nspec:\n  cidr: 192.169.0.0/16\n"), 0o600)).To(Succeed())
+		var edited *model.KVPair
+		Eventually(func() string {
+			edited, err = client.Get(ctx, got.Key, "")
+			Expect(err).NotTo(HaveOccurred())
+			return edited.Value.(*apiv3.IPPool).Spec.CIDR
+		}, "3s", "50ms").Should(Equal("192.169.0.0/16"))
+		Expect(edited.Revision).NotTo(Equal(got.Revision))
+
+		By("removing the entry when the file is removed")
+		Expect(os.Remove(path)).To(Succeed())
+		Eventually(func() error {
+			_, err := client.Get(ctx, got.Key, "")
+			return err
+		}, "3s", "50ms").Should(BeAssignableToTypeOf(cerrors.ErrorResourceDoesNotExist{}))
+	})
+
+	It("should read while another process holds a shared lock", func() {
+		created, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+
+		other := newClient()
+		defer other.Close()
+
+		lock := flock.New(filepath.Join(dir, ".lock"))
+		Expect(lock.RLock()).To(Succeed())
+		locked := true
+		defer func() {
+			if locked {
+				Expect(lock.Unlock()).To(Succeed())
+			}
+		}()
+
+		By("reading from both clients")
+		got, err := other.Get(ctx, created.Key, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Revision).To(Equal(created.Revision))
+		l, err := client.List(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l.KVPairs).To(HaveLen(1))
+
+		By("blocking writes until the lock is released")
+		done := make(chan error)
+		go func() {
+			defer GinkgoRecover()
+			_, err := other.Apply(ctx, ipPoolKVP("pool2", "10.1.0.0/16"))
+			done <- err
+		}()
+		Consistently(done, "200ms").ShouldNot(Receive())
+		Expect(lock.Unlock()).To(Succeed())
+		locked = false
+		Eventually(done).Should(Receive(BeNil()))
+
+		l, err = client.List(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l.KVPairs).To(HaveLen(2))
+	})
+
+	It("should expire entries with a TTL", func() {
+		kvp := &model.KVPair{Key: model.GlobalConfigKey{Name: "Foo"}, Value: "bar", TTL: 100 * time.Millisecond}
+		_, err := client.Create(ctx, kvp)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() error {
+			_, err := client.Get(ctx, model.GlobalConfigKey{Name: "Foo"}, "")
+			return err
+		}, "2s", "20ms").Should(BeAssignableToTypeOf(cerrors.ErrorResourceDoesNotExist{}))
+	})
+
+	It("should watch for changes from the current revision", func() {
+		_, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+
+		w, err := client.Watch(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, api.WatchOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+
+		var e api.WatchEvent
+		Eventually(w.ResultChan()).Should(Receive(&e))
+		Expect(e.Type).To(Equal(api.WatchAdded))
+		Expect(e.New.Key).To(Equal(model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool1"}))
+
+		// A change from another client is picked up by the poll.
+		other := newClient()
+		defer other.Close()
+		_, err = other.Create(ctx, ipPoolKVP("pool2", "10.1.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(w.ResultChan()).Should(Receive(&e))
+		Expect(e.Type).To(Equal(api.WatchAdded))
+		Expect(e.New.Key).To(Equal(model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool2"}))
+
+		_, err = other.Delete(ctx, model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool1"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(w.ResultChan()).Should(Receive(&e))
+		Expect(e.Type).To(Equal(api.WatchDeleted))
+		Expect(e.Old.Key).To(Equal(model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool1"}))
+	})
+
+	It("should resume a watch from a revision", func() {
+		created, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+		update := ipPoolKVP("pool1", "10.1.0.0/16")
+		update.Revision = created.Revision
+		_, err = client.Update(ctx, update)
+		Expect(err).NotTo(HaveOccurred())
+
+		w, err := client.Watch(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, api.WatchOptions{Revision: created.Revision})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+
+		var e api.WatchEvent
+		Eventually(w.ResultChan()).Should(Receive(&e))
+		Expect(e.Type).To(Equal(api.WatchModified))
+		Expect(e.Old.Value.(*apiv3.IPPool).Spec.CIDR).To(Equal("10.0.0.0/16"))
+		Expect(e.New.Value.(*apiv3.IPPool).Spec.CIDR).To(Equal("10.1.0.0/16"))
+
+		By("rejecting a revision from before the client started")
+		other := newClient()
+		defer other.Close()
+		_, err = other.Watch(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, api.WatchOptions{Revision: "1"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should work with a watcher syncer", func() {
+		_, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+
+		rs := testutils.NewSyncerTester()
+		syncer := watchersyncer.New(client, []watchersyncer.ResourceType{
+			{ListInterface: model.ResourceListOptions{Kind: apiv3.KindIPPool}},
+		}, rs)
+		syncer.Start()
+		defer syncer.Stop()
+
+		rs.ExpectStatusUpdate(api.WaitForDatastore)
+		rs.ExpectStatusUpdate(api.ResyncInProgress)
+		rs.ExpectStatusUpdate(api.InSync)
+		rs.ExpectCacheSize(1)
+
+		other := newClient()
+		defer other.Close()
+		_, err = other.Create(ctx, ipPoolKVP("pool2", "10.1.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+		rs.ExpectCacheSize(2)
+
+		_, err = other.Delete(ctx, model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool1"}, "")
+		Expect(err).NotTo(HaveOccurred())
+		rs.ExpectCacheSize(1)
+		rs.ExpectValueMatches(model.ResourceKey{Kind: apiv3.KindIPPool, Name: "pool2"}, Not(BeNil()))
+	})
+
+	It("should clean the datastore", func() {
+		_, err := client.Create(ctx, ipPoolKVP("pool1", "10.0.0.0/16"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Clean()).To(Succeed())
+		l, err := client.List(ctx, model.ResourceListOptions{Kind: apiv3.KindIPPool}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l.KVPairs).To(BeEmpty())
+	})
+})
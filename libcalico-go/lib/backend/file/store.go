@@ -0,0 +1,503 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// The datastore directory mirrors the etcdv3 key layout.  The key
+// /calico/resources/v3/projectcalico.org/ippools/default is stored in the file
+// <dir>/resources/v3/projectcalico.org/ippools/default.yaml.  Since every key maps to a file
+// with the .yaml extension, a key never collides with the directory of its children.
+//
+// Revisions are tracked in an index file at the root of the directory, which is only modified
+// while holding the lock file exclusively.  Files that are added, edited or removed by hand are
+// picked up (and given a new revision) the next time any client scans the directory.  Clients
+// only scan when the index or the root directory has changed, or when the last scan is older
+// than PollInterval, so edits made by hand can take up to PollInterval to be noticed.
+const (
+	keyPrefix     = "/calico/"
+	fileExtension = ".yaml"
+	indexFileName = ".index.json"
+	lockFileName  = ".lock"
+	tmpFilePrefix = ".tmp-"
+)
+
+// index is the on-disk record of the revision of each key.
+type index struct {
+	Revision int64                  `json:"revision"`
+	Entries  map[string]*indexEntry `json:"entries"`
+}
+
+type indexEntry struct {
+	CreateRevision int64 `json:"createRevision"`
+	ModRevision    int64 `json:"modRevision"`
+
+	// Size, ModTime and Hash of the file when it was last written or adopted, used to spot
+	// changes that were made to the file by hand.
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"modTime"`
+	Hash    string `json:"hash"`
+
+	// Expires is the time (in Unix nanoseconds) after which the entry is removed, or zero
+	// if the entry does not expire.
+	Expires int64 `json:"expires,omitempty"`
+}
+
+func newIndex() *index {
+	return &index{Entries: map[string]*indexEntry{}}
+}
+
+// errIndexNeedsUpdate is returned by refreshLocked when the index needs to be updated but only
+// a shared lock is held.
+var errIndexNeedsUpdate = errors.New("file datastore index needs to be updated")
+
+// dirState identifies the state of the datastore directory.  The index file is replaced on every
+// write, so any change to it (or to the root of the directory) means another client (or a user)
+// has changed the datastore.
+type dirState struct {
+	dirModTime   int64
+	index        os.FileInfo
+	indexModTime int64
+}
+
+func (c *fileClient) statDir() (dirState, error) {
+	var st dirState
+	info, err := os.Stat(c.dir)
+	if err != nil {
+		return st, err
+	}
+	st.dirModTime = info.ModTime().UnixNano()
+	info, err = os.Stat(filepath.Join(c.dir, indexFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return st, nil
+	} else if err != nil {
+		return st, err
+	}
+	st.index = info
+	st.indexModTime = info.ModTime().UnixNano()
+	return st, nil
+}
+
+func (s dirState) equal(o dirState) bool {
+	if s.dirModTime != o.dirModTime || s.indexModTime != o.indexModTime {
+		return false
+	}
+	if s.index == nil || o.index == nil {
+		return s.index == o.index
+	}
+	return os.SameFile(s.index, o.index) && s.index.Size() == o.index.Size()
+}
+
+// needsRefreshLocked returns whether the directory has to be scanned, along with its current
+// state.  Must be called with the lock held.
+func (c *fileClient) needsRefreshLocked() (bool, dirState, error) {
+	st, err := c.statDir()
+	if err != nil {
+		return false, st, err
+	}
+	now := c.now()
+	switch {
+	case c.lastScan.IsZero() || now.Sub(c.lastScan) >= PollInterval:
+		return true, st, nil
+	case c.nextExpiry != 0 && c.nextExpiry < now.UnixNano():
+		return true, st, nil
+	}
+	return !st.equal(c.scanned), st, nil
+}
+
+// keyToFile returns the path of the file that stores the given datastore key.
+func (c *fileClient) keyToFile(key string) (string, error) {
+	rel := strings.TrimPrefix(key, keyPrefix)
+	if rel == key || rel == "" || strings.HasSuffix(rel, "/") {
+		return "", errors.New("unsupported datastore key: " + key)
+	}
+	for _, segment := range strings.Split(rel, "/") {
+		if segment == "" || segment == "." || segment == ".." || strings.HasPrefix(segment, ".") {
+			return "", errors.New("unsupported datastore key: " + key)
+		}
+	}
+	return filepath.Join(c.dir, filepath.FromSlash(rel)+fileExtension), nil
+}
+
+// fileToKey returns the datastore key of a file in the datastore, or "" if the file is not
+// part of the datastore.
+func (c *fileClient) fileToKey(path string) string {
+	rel, err := filepath.Rel(c.dir, path)
+	if err != nil || !strings.HasSuffix(rel, fileExtension) {
+		return ""
+	}
+	rel = filepath.ToSlash(strings.TrimSuffix(rel, fileExtension))
+	for _, segment := range strings.Split(rel, "/") {
+		if segment == "" || strings.HasPrefix(segment, ".") {
+			return ""
+		}
+	}
+	return keyPrefix + rel
+}
+
+// encodeValue converts a serialized datastore value to the contents of its file.  JSON
+// objects and arrays are stored as YAML, other values (such as raw strings) are stored as is.
+func encodeValue(value []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(value)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return yaml.JSONToYAML(trimmed)
+	}
+	return value, nil
+}
+
+// decodeValue converts the contents of a file to a serialized datastore value.
+func decodeValue(data []byte) []byte {
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err == nil {
+		switch v.(type) {
+		case map[string]interface{}, []interface{}:
+			if j, err := yaml.YAMLToJSON(data); err == nil {
+				return j
+			}
+		}
+	}
+	return bytes.TrimRight(data, "\n")
+}
+
+func hashData(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// readIndex loads the index from disk.  A missing index is treated as empty.
+func (c *fileClient) readIndex() (*index, error) {
+	data, err := os.ReadFile(filepath.Join(c.dir, indexFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return newIndex(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	idx := newIndex()
+	if err := json.Unmarshal(data, idx); err != nil {
+		return nil, err
+	}
+	if idx.Entries == nil {
+		idx.Entries = map[string]*indexEntry{}
+	}
+	return idx, nil
+}
+
+// writeIndexLocked writes the index to disk.  Since the client made the change itself, it
+// records the new state of the directory so that the write doesn't trigger a scan.  Must be
+// called with the lock held exclusively.
+func (c *fileClient) writeIndexLocked(idx *index) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(c.dir, indexFileName), data); err != nil {
+		return err
+	}
+	if st, err := c.statDir(); err == nil {
+		c.scanned = st
+	}
+	return nil
+}
+
+// writeFileAtomic writes the file via a temporary file in the same directory so that
+// readers never see a partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, tmpFilePrefix)
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+	}
+	return err
+}
+
+// writeEntryLocked writes the value of a key to disk and bumps its revision.  Must be called
+// with the lock held and after refreshLocked.
+func (c *fileClient) writeEntryLocked(key string, value []byte, ttl time.Duration) (int64, error) {
+	path, err := c.keyToFile(key)
+	if err != nil {
+		return 0, err
+	}
+	data, err := encodeValue(value)
+	if err != nil {
+		return 0, err
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+
+	idx := c.index
+	idx.Revision++
+	e := idx.Entries[key]
+	if e == nil {
+		e = &indexEntry{CreateRevision: idx.Revision}
+		idx.Entries[key] = e
+	}
+	e.ModRevision = idx.Revision
+	e.Size = info.Size()
+	e.ModTime = info.ModTime().UnixNano()
+	e.Hash = hashData(data)
+	e.Expires = 0
+	if ttl > 0 {
+		e.Expires = c.now().Add(ttl).UnixNano()
+	}
+	if err := c.writeIndexLocked(idx); err != nil {
+		return 0, err
+	}
+	c.syncCacheLocked()
+	return idx.Revision, nil
+}
+
+// deleteEntryLocked removes a key from disk.  Must be called with the lock held and after
+// refreshLocked.
+func (c *fileClient) deleteEntryLocked(key string) error {
+	c.removeFileLocked(key)
+	c.index.Revision++
+	delete(c.index.Entries, key)
+	if err := c.writeIndexLocked(c.index); err != nil {
+		return err
+	}
+	c.syncCacheLocked()
+	return nil
+}
+
+func (c *fileClient) removeFileLocked(key string) {
+	path, err := c.keyToFile(key)
+	if err != nil {
+		return
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.WithError(err).WithField("file", path).Warn("Failed to remove datastore file")
+		return
+	}
+	// Tidy up any directories that are now empty, stopping at the root of the datastore.
+	for dir := filepath.Dir(path); dir != c.dir && strings.HasPrefix(dir, c.dir); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+}
+
+// refreshLocked brings the index up to date with the contents of the directory, adopting any
+// files that were changed by hand and expiring entries whose TTL has passed, then updates the
+// in-memory cache.  It does nothing if the directory has not changed since the last scan.  If
+// the index needs to be updated and the lock is only shared, it returns errIndexNeedsUpdate.
+// Must be called with the lock held.
+func (c *fileClient) refreshLocked(mode lockMode) error {
+	needed, st, err := c.needsRefreshLocked()
+	if err != nil {
+		return err
+	} else if !needed {
+		return nil
+	}
+	idx, err := c.readIndex()
+	if err != nil {
+		return err
+	}
+	changed := false
+	seen := map[string]bool{}
+
+	err = filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != c.dir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		key := c.fileToKey(path)
+		if key == "" {
+			return nil
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		seen[key] = true
+
+		e := idx.Entries[key]
+		if e != nil && e.Size == info.Size() && e.ModTime == info.ModTime().UnixNano() {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		hash := hashData(data)
+		changed = true
+		if e != nil && e.Hash == hash {
+			e.Size = info.Size()
+			e.ModTime = info.ModTime().UnixNano()
+			return nil
+		}
+		log.WithField("key", key).Info("Adopting change to datastore file")
+		idx.Revision++
+		if e == nil {
+			e = &indexEntry{CreateRevision: idx.Revision}
+			idx.Entries[key] = e
+		}
+		e.ModRevision = idx.Revision
+		e.Size = info.Size()
+		e.ModTime = info.ModTime().UnixNano()
+		e.Hash = hash
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	now := c.now()
+	var removed, expired []string
+	for _, key := range sortedKeys(idx.Entries) {
+		e := idx.Entries[key]
+		if !seen[key] {
+			removed = append(removed, key)
+		} else if e.Expires != 0 && e.Expires < now.UnixNano() {
+			expired = append(expired, key)
+		}
+	}
+	changed = changed || len(removed) > 0 || len(expired) > 0
+	if changed && mode == lockShared {
+		return errIndexNeedsUpdate
+	}
+
+	for _, key := range removed {
+		log.WithField("key", key).Info("Datastore file has been removed")
+		idx.Revision++
+		delete(idx.Entries, key)
+	}
+	for _, key := range expired {
+		log.WithField("key", key).Debug("Datastore entry has expired")
+		c.removeFileLocked(key)
+		idx.Revision++
+		delete(idx.Entries, key)
+	}
+
+	c.scanned = st
+	c.lastScan = now
+	if changed {
+		if err := c.writeIndexLocked(idx); err != nil {
+			return err
+		}
+	}
+	c.index = idx
+	c.syncCacheLocked()
+	return nil
+}
+
+// syncCacheLocked updates the in-memory cache to match the index, recording a watch event for
+// each change.
+func (c *fileClient) syncCacheLocked() {
+	var events []*event
+	for key, e := range c.index.Entries {
+		old := c.cache[key]
+		if old != nil && old.modRevision == e.ModRevision {
+			continue
+		}
+		value := []byte{}
+		if path, err := c.keyToFile(key); err == nil {
+			if data, err := os.ReadFile(path); err == nil {
+				value = decodeValue(data)
+			} else {
+				log.WithError(err).WithField("key", key).Warn("Failed to read datastore file")
+			}
+		}
+		entry := &cacheEntry{
+			value:          value,
+			createRevision: e.CreateRevision,
+			modRevision:    e.ModRevision,
+		}
+		c.cache[key] = entry
+		events = append(events, &event{key: key, revision: e.ModRevision, old: old, new: entry})
+	}
+	for key, old := range c.cache {
+		if _, ok := c.index.Entries[key]; ok {
+			continue
+		}
+		delete(c.cache, key)
+		events = append(events, &event{key: key, revision: c.index.Revision, old: old})
+	}
+	c.revision = c.index.Revision
+	c.nextExpiry = 0
+	for _, e := range c.index.Entries {
+		if e.Expires != 0 && (c.nextExpiry == 0 || e.Expires < c.nextExpiry) {
+			c.nextExpiry = e.Expires
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].revision != events[j].revision {
+			return events[i].revision < events[j].revision
+		}
+		return events[i].key < events[j].key
+	})
+	c.history = append(c.history, events...)
+	if excess := len(c.history) - maxHistory; excess > 0 {
+		c.compactRevision = c.history[excess-1].revision
+		c.history = append([]*event(nil), c.history[excess:]...)
+	}
+
+	// Wake up the watchers.
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
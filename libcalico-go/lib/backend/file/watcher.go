@@ -0,0 +1,196 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+const (
+	resultsBufSize = 100
+)
+
+// Watch entries in the datastore matching the resources specified by the ListInterface.
+// Changes made by other clients are picked up when the directory is next polled.
+func (c *fileClient) Watch(ctx context.Context, l model.ListInterface, options api.WatchOptions) (api.WatchInterface, error) {
+	var rev int64
+	if len(options.Revision) != 0 {
+		var err error
+		rev, err = strconv.ParseInt(options.Revision, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := c.refresh(); err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	var initial *model.KVPairList
+	if rev == 0 {
+		// No initial revision supplied, so start with a list of the current entries.
+		initial = c.listLocked(l)
+		rev = c.revision
+	} else if rev < c.compactRevision {
+		c.mutex.Unlock()
+		return nil, kerrors.NewResourceExpired(fmt.Sprintf("revision %d is no longer available", rev))
+	}
+	c.addWatcherLocked()
+	c.mutex.Unlock()
+
+	wc := &watcher{
+		client:     c,
+		list:       l,
+		revision:   rev,
+		resultChan: make(chan api.WatchEvent, resultsBufSize),
+	}
+	wc.ctx, wc.cancel = context.WithCancel(ctx)
+	go wc.watchLoop(initial)
+	return wc, nil
+}
+
+// watcher implements watch.Interface.
+type watcher struct {
+	client     *fileClient
+	list       model.ListInterface
+	revision   int64
+	ctx        context.Context
+	cancel     context.CancelFunc
+	resultChan chan api.WatchEvent
+	terminated uint32
+}
+
+// Stop stops the watcher and releases associated resources.
+// This calls through to the context cancel function.
+func (wc *watcher) Stop() {
+	wc.cancel()
+}
+
+// ResultChan returns a channel used to receive WatchEvents.
+func (wc *watcher) ResultChan() <-chan api.WatchEvent {
+	return wc.resultChan
+}
+
+// HasTerminated returns true when the watcher has completed termination processing.
+func (wc *watcher) HasTerminated() bool {
+	return atomic.LoadUint32(&wc.terminated) != 0
+}
+
+// watchLoop sends the initial entries (if any) and then the events that the client records
+// as it refreshes from the directory.
+func (wc *watcher) watchLoop(initial *model.KVPairList) {
+	defer wc.terminateWatcher()
+
+	if initial != nil {
+		log.WithField("NumEntries", len(initial.KVPairs)).Debug("Sending create events for each existing entry")
+		for _, kv := range initial.KVPairs {
+			if kv.Key == defaultAllowProfileResourceKey {
+				continue
+			}
+			wc.sendEvent(&api.WatchEvent{Type: api.WatchAdded, New: kv})
+		}
+	}
+
+	for {
+		wc.client.mutex.Lock()
+		events, ok := wc.client.eventsSinceLocked(wc.revision)
+		changed := wc.client.changed
+		wc.client.mutex.Unlock()
+
+		if !ok {
+			log.WithField("rev", wc.revision).Info("Watch revision has been compacted")
+			wc.sendEvent(&api.WatchEvent{
+				Type:  api.WatchError,
+				Error: kerrors.NewResourceExpired(fmt.Sprintf("revision %d is no longer available", wc.revision)),
+			})
+			return
+		}
+		for _, e := range events {
+			if ae, err := convertEvent(e, wc.list); ae != nil {
+				wc.sendEvent(ae)
+			} else if err != nil {
+				wc.sendEvent(&api.WatchEvent{Type: api.WatchError, Error: err})
+			}
+			wc.revision = e.revision
+		}
+
+		select {
+		case <-wc.ctx.Done():
+			return
+		case <-changed:
+		}
+	}
+}
+
+// convertEvent converts a recorded change to an api.WatchEvent, or nil if the event did not
+// correspond to an event that we are interested in.
+func convertEvent(e *event, l model.ListInterface) (*api.WatchEvent, error) {
+	k := l.KeyFromDefaultPath(e.key)
+	if k == nil {
+		return nil, nil
+	}
+
+	ae := &api.WatchEvent{}
+	var err error
+	switch {
+	case e.old == nil:
+		ae.Type = api.WatchAdded
+	case e.new == nil:
+		ae.Type = api.WatchDeleted
+	default:
+		ae.Type = api.WatchModified
+	}
+	if e.new != nil {
+		if ae.New, err = toKVPair(k, e.key, e.new); err != nil {
+			return nil, err
+		}
+	}
+	if e.old != nil {
+		if ae.Old, err = toKVPair(k, e.key, e.old); err != nil && ae.Type == api.WatchDeleted {
+			// We still need to report the key of a deleted entry, even if we couldn't parse it.
+			ae.Old = &model.KVPair{Key: k, Revision: strconv.FormatInt(e.revision, 10)}
+		}
+	}
+	return ae, nil
+}
+
+// terminateWatcher terminates the resources associated with the watcher.
+func (wc *watcher) terminateWatcher() {
+	log.Debug("Terminating file datastore watcher")
+	wc.cancel()
+	wc.client.removeWatcher()
+	close(wc.resultChan)
+	atomic.AddUint32(&wc.terminated, 1)
+}
+
+// sendEvent sends an event in the results channel.
+func (wc *watcher) sendEvent(e *api.WatchEvent) {
+	if len(wc.resultChan) == resultsBufSize {
+		log.Warningf("Watch events backing up: %d events", resultsBufSize)
+	}
+	select {
+	case wc.resultChan <- *e:
+	case <-wc.ctx.Done():
+	}
+}
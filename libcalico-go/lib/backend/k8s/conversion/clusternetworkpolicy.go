@@ -0,0 +1,395 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"fmt"
+	"sort"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterpolicy "github.com/projectcalico/calico/libcalico-go/lib/apis/policy.networking.k8s.io/v1alpha2"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+const (
+	// cnpOrderOffset is added to the priority of a ClusterNetworkPolicy to give its order.  AdminNetworkPolicies
+	// share the tiers and are ordered by their (integer) priority, so the offset places a ClusterNetworkPolicy
+	// after any AdminNetworkPolicy of the same priority rather than giving the two the same order.
+	cnpOrderOffset = 0.5
+
+	// cnpNodeSelector selects the host endpoints that represent Kubernetes nodes: the automatic host
+	// endpoints created by kube-controllers.  This excludes network sets and other host endpoints, which
+	// may also lack the orchestrator label.
+	cnpNodeSelector = "projectcalico.org/created-by == 'calico-kube-controllers'"
+)
+
+// K8sClusterNetworkPolicyToCalico converts a k8s ClusterNetworkPolicy to a model.KVPair.  Policies in the
+// Admin tier are placed in the AdminNetworkPolicy tier, and policies in the Baseline tier in the
+// BaselineAdminNetworkPolicy tier, ordered by their priority.
+func (c converter) K8sClusterNetworkPolicyToCalico(cnp *clusterpolicy.ClusterNetworkPolicy) (*model.KVPair, error) {
+	// Pull out important fields.
+	var policyName, tierName string
+	switch cnp.Spec.Tier {
+	case clusterpolicy.AdminTier:
+		policyName = names.K8sAdminClusterNetworkPolicyNamePrefix + cnp.Name
+		tierName = names.AdminNetworkPolicyTierName
+	case clusterpolicy.BaselineTier:
+		policyName = names.K8sBaselineClusterNetworkPolicyNamePrefix + cnp.Name
+		tierName = names.BaselineAdminNetworkPolicyTierName
+	default:
+		return nil, fmt.Errorf("unsupported cluster network policy tier %q", cnp.Spec.Tier)
+	}
+	order := float64(cnp.Spec.Priority) + cnpOrderOffset
+	errorTracker := cerrors.ErrorAdminPolicyConversion{PolicyName: cnp.Name}
+
+	// Generate the ingress rules list.
+	var ingressRules []apiv3.Rule
+	for _, r := range cnp.Spec.Ingress {
+		rules, err := k8sCNPIngressRuleToCalico(r, cnp.Spec.Tier)
+		if err != nil {
+			log.WithError(err).Warn("dropping k8s rule that couldn't be converted.")
+			// Add rule to conversion error slice
+			errorTracker.BadIngressRule(&r, fmt.Sprintf("k8s rule couldn't be converted: %s", err))
+			failClosedRule := k8sCNPHandleFailedRules(r.Action, cnp.Spec.Tier)
+			if failClosedRule != nil {
+				ingressRules = append(ingressRules, *failClosedRule)
+			}
+		} else {
+			ingressRules = append(ingressRules, rules...)
+		}
+	}
+
+	// Generate the egress rules list.
+	var egressRules []apiv3.Rule
+	for _, r := range cnp.Spec.Egress {
+		rules, skipped, err := k8sCNPEgressRuleToCalico(r, cnp.Spec.Tier)
+		if err != nil {
+			log.WithError(err).Warn("dropping k8s rule that couldn't be converted.")
+			// Add rule to conversion error slice
+			errorTracker.BadEgressRule(&r, fmt.Sprintf("k8s rule couldn't be converted: %s", err))
+			failClosedRule := k8sCNPHandleFailedRules(r.Action, cnp.Spec.Tier)
+			if failClosedRule != nil {
+				egressRules = append(egressRules, *failClosedRule)
+			}
+		} else {
+			if skipped > 0 {
+				log.WithField("rule", r.Name).Warn("skipping k8s rule peers that couldn't be converted.")
+				errorTracker.BadEgressRule(&r, fmt.Sprintf("%d domain name peer(s) of k8s rule couldn't be converted", skipped))
+			}
+			egressRules = append(egressRules, rules...)
+		}
+	}
+
+	// Either Namespaces or Pods is set. Use one of them to populate the selectors.
+	var nsSelector, podSelector string
+	switch {
+	case cnp.Spec.Subject.Namespaces != nil:
+		nsSelector = k8sSelectorToCalico(cnp.Spec.Subject.Namespaces, SelectorNamespace)
+		// Make sure projectcalico.org/orchestrator == 'k8s' label is added to exclude heps.
+		podSelector = k8sSelectorToCalico(nil, SelectorPod)
+	case cnp.Spec.Subject.Pods != nil:
+		nsSelector = k8sSelectorToCalico(&cnp.Spec.Subject.Pods.NamespaceSelector, SelectorNamespace)
+		podSelector = k8sSelectorToCalico(&cnp.Spec.Subject.Pods.PodSelector, SelectorPod)
+	default:
+		return nil, fmt.Errorf("cluster network policy %s has no subject", cnp.Name)
+	}
+
+	var uid types.UID
+	var err error
+	if cnp.UID != "" {
+		uid, err = ConvertUID(cnp.UID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	gnp := apiv3.NewGlobalNetworkPolicy()
+	gnp.ObjectMeta = metav1.ObjectMeta{
+		Name:              policyName,
+		CreationTimestamp: cnp.CreationTimestamp,
+		UID:               uid,
+		ResourceVersion:   cnp.ResourceVersion,
+	}
+	gnp.Spec = apiv3.GlobalNetworkPolicySpec{
+		Tier:              tierName,
+		Order:             &order,
+		NamespaceSelector: nsSelector,
+		Selector:          podSelector,
+		Ingress:           ingressRules,
+		Egress:            egressRules,
+		Types:             c.calculateANPPolicyTypes(ingressRules, egressRules),
+	}
+
+	// Build the KVPair.
+	kvp := &model.KVPair{
+		Key: model.ResourceKey{
+			Name: policyName,
+			Kind: apiv3.KindGlobalNetworkPolicy,
+		},
+		Value:    gnp,
+		Revision: cnp.ResourceVersion,
+	}
+
+	// Return the KVPair with conversion errors if applicable
+	return kvp, errorTracker.GetError()
+}
+
+// k8sCNPHandleFailedRules returns the rule to use in place of a rule that couldn't be converted.  Rules
+// that would have stopped traffic from being accepted are replaced with a deny-all rule so that we fail
+// closed.  Pass is not valid in the Baseline tier, so such rules are simply dropped.
+func k8sCNPHandleFailedRules(action clusterpolicy.ClusterNetworkPolicyRuleAction, tier clusterpolicy.Tier) *apiv3.Rule {
+	if action == clusterpolicy.ClusterNetworkPolicyRuleActionDeny ||
+		(action == clusterpolicy.ClusterNetworkPolicyRuleActionPass && tier == clusterpolicy.AdminTier) {
+		log.Warn("replacing failed rule with a deny-all one.")
+		return &apiv3.Rule{
+			Action: apiv3.Deny,
+		}
+	}
+	return nil
+}
+
+func k8sCNPIngressRuleToCalico(rule clusterpolicy.ClusterNetworkPolicyIngressRule, tier clusterpolicy.Tier) ([]apiv3.Rule, error) {
+	action, err := K8sClusterNetworkPolicyActionToCalico(rule.Action, tier)
+	if err != nil {
+		return nil, err
+	}
+	protocolPorts, sortedProtocols, err := unpackCNPProtocols(rule.Protocols)
+	if err != nil {
+		return nil, err
+	}
+
+	// Combine destination ports with sources to generate rules. We generate one rule per protocol
+	// and peer, with each rule containing all the allowed ports.
+	var rules []apiv3.Rule
+	for _, protocolStr := range sortedProtocols {
+		calicoPorts := SimplifyPorts(protocolPorts[protocolStr])
+		protocol := cnpProtocolToCalico(protocolStr)
+
+		for _, peer := range rule.From {
+			var selector, nsSelector string
+			switch {
+			case peer.Namespaces != nil:
+				nsSelector = k8sSelectorToCalico(peer.Namespaces, SelectorNamespace)
+			case peer.Pods != nil:
+				selector = k8sSelectorToCalico(&peer.Pods.PodSelector, SelectorPod)
+				nsSelector = k8sSelectorToCalico(&peer.Pods.NamespaceSelector, SelectorNamespace)
+			default:
+				return nil, fmt.Errorf("none of supported fields in 'From' is set.")
+			}
+
+			// Build inbound rule and append to list.
+			rules = append(rules, apiv3.Rule{
+				Metadata: k8sAdminNetworkPolicyToCalicoMetadata(rule.Name),
+				Action:   action,
+				Protocol: protocol,
+				Source: apiv3.EntityRule{
+					Selector:          selector,
+					NamespaceSelector: nsSelector,
+				},
+				Destination: apiv3.EntityRule{
+					Ports: calicoPorts,
+				},
+			})
+		}
+	}
+	return rules, nil
+}
+
+// k8sCNPEgressRuleToCalico converts a ClusterNetworkPolicy egress rule to Calico rules.  Domain name
+// peers are not supported; they are skipped, and their number returned, so that the rule still applies
+// to its other peers.  A rule with only domain name peers can't be converted at all.
+func k8sCNPEgressRuleToCalico(rule clusterpolicy.ClusterNetworkPolicyEgressRule, tier clusterpolicy.Tier) ([]apiv3.Rule, int, error) {
+	action, err := K8sClusterNetworkPolicyActionToCalico(rule.Action, tier)
+	if err != nil {
+		return nil, 0, err
+	}
+	protocolPorts, sortedProtocols, err := unpackCNPProtocols(rule.Protocols)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	skipped := 0
+	for _, peer := range rule.To {
+		if len(peer.DomainNames) != 0 {
+			skipped++
+		}
+	}
+	if skipped > 0 && skipped == len(rule.To) {
+		return nil, 0, fmt.Errorf("domain name peers are not supported")
+	}
+
+	// Combine destination ports with destinations to generate rules. We generate one rule per protocol
+	// and peer, with each rule containing all the allowed ports.
+	var rules []apiv3.Rule
+	for _, protocolStr := range sortedProtocols {
+		calicoPorts := SimplifyPorts(protocolPorts[protocolStr])
+		protocol := cnpProtocolToCalico(protocolStr)
+
+		for _, peer := range rule.To {
+			var selector, nsSelector string
+			var nets []string
+			// One and only one of the following fields is set (based on specification).
+			switch {
+			case peer.Namespaces != nil:
+				nsSelector = k8sSelectorToCalico(peer.Namespaces, SelectorNamespace)
+			case peer.Pods != nil:
+				selector = k8sSelectorToCalico(&peer.Pods.PodSelector, SelectorPod)
+				nsSelector = k8sSelectorToCalico(&peer.Pods.NamespaceSelector, SelectorNamespace)
+			case peer.Nodes != nil:
+				selector = cnpNodeSelector
+				if nodeSelector := k8sSelectorToCalico(peer.Nodes, SelectorNode); nodeSelector != "" {
+					selector += " && " + nodeSelector
+				}
+			case len(peer.Networks) != 0:
+				for _, n := range peer.Networks {
+					_, ipNet, err := cnet.ParseCIDR(string(n))
+					if err != nil {
+						return nil, 0, fmt.Errorf("invalid CIDR in ClusterNetworkPolicy rule: %w", err)
+					}
+					nets = append(nets, ipNet.String())
+				}
+			case len(peer.DomainNames) != 0:
+				// Counted as skipped above.
+				continue
+			default:
+				return nil, 0, fmt.Errorf("none of supported fields in 'To' is set.")
+			}
+
+			// Build outbound rule and append to list.
+			rules = append(rules, apiv3.Rule{
+				Metadata: k8sAdminNetworkPolicyToCalicoMetadata(rule.Name),
+				Action:   action,
+				Protocol: protocol,
+				Destination: apiv3.EntityRule{
+					Ports:             calicoPorts,
+					Selector:          selector,
+					NamespaceSelector: nsSelector,
+					Nets:              nets,
+				},
+			})
+		}
+	}
+	return rules, skipped, nil
+}
+
+// K8sClusterNetworkPolicyActionToCalico converts a ClusterNetworkPolicy rule action to the equivalent
+// Calico action.  Pass is only valid in the Admin tier, where it hands the traffic on to the default
+// tier (and hence to Kubernetes NetworkPolicy).
+func K8sClusterNetworkPolicyActionToCalico(action clusterpolicy.ClusterNetworkPolicyRuleAction, tier clusterpolicy.Tier) (apiv3.Action, error) {
+	switch action {
+	case clusterpolicy.ClusterNetworkPolicyRuleActionAccept:
+		return apiv3.Allow, nil
+	case clusterpolicy.ClusterNetworkPolicyRuleActionDeny:
+		return apiv3.Deny, nil
+	case clusterpolicy.ClusterNetworkPolicyRuleActionPass:
+		if tier == clusterpolicy.AdminTier {
+			return apiv3.Pass, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported cluster network policy action %v in tier %v", action, tier)
+}
+
+// unpackCNPProtocols converts the protocols of a ClusterNetworkPolicy rule to a map from protocol to
+// destination ports, along with the sorted list of protocols.  A nil list of ports means all ports of
+// the protocol, and the empty protocol means all protocols.
+func unpackCNPProtocols(protocols []clusterpolicy.ClusterNetworkPolicyProtocol) (map[string][]numorstring.Port, []string, error) {
+	if len(protocols) == 0 {
+		// No protocols specified, which we translate to allowing all.
+		return map[string][]numorstring.Port{"": nil}, []string{""}, nil
+	}
+
+	protocolPorts := map[string][]numorstring.Port{}
+	addPort := func(protocol string, port *numorstring.Port) {
+		if port == nil {
+			// Treat nil as 'all ports'.
+			protocolPorts[protocol] = nil
+		} else if ports, ok := protocolPorts[protocol]; !ok || len(ports) > 0 {
+			// Don't overwrite a nil (allow all ports) if present.
+			protocolPorts[protocol] = append(ports, *port)
+		}
+	}
+
+	for _, p := range protocols {
+		var found bool
+		for protocol, pp := range map[string]*clusterpolicy.ClusterNetworkPolicyProtocolPorts{
+			numorstring.ProtocolTCP:  p.TCP,
+			numorstring.ProtocolUDP:  p.UDP,
+			numorstring.ProtocolSCTP: p.SCTP,
+		} {
+			if pp == nil {
+				continue
+			}
+			port, err := k8sCNPPortToCalico(pp.DestinationPort)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse k8s port: %s", err)
+			}
+			addPort(protocol, port)
+			found = true
+		}
+		if p.DestinationNamedPort != "" {
+			// A named port may be declared with any protocol, so match it on each of them.
+			port := numorstring.NamedPort(p.DestinationNamedPort)
+			for _, protocol := range []string{numorstring.ProtocolTCP, numorstring.ProtocolUDP, numorstring.ProtocolSCTP} {
+				addPort(protocol, &port)
+			}
+			found = true
+		}
+		if !found {
+			return nil, nil, fmt.Errorf("none of supported fields in 'Protocols' is set.")
+		}
+	}
+
+	sortedProtocols := make([]string, 0, len(protocolPorts))
+	for k := range protocolPorts {
+		sortedProtocols = append(sortedProtocols, k)
+	}
+	// Ensure deterministic output
+	sort.Strings(sortedProtocols)
+	return protocolPorts, sortedProtocols, nil
+}
+
+func k8sCNPPortToCalico(port *clusterpolicy.Port) (*numorstring.Port, error) {
+	switch {
+	case port == nil:
+		return nil, nil
+	case port.Range != nil:
+		p, err := numorstring.PortFromRange(uint16(port.Range.Start), uint16(port.Range.End))
+		if err != nil {
+			return nil, err
+		}
+		return &p, nil
+	case port.Number > 0 && port.Number <= 65535:
+		p := numorstring.SinglePort(uint16(port.Number))
+		return &p, nil
+	default:
+		return nil, fmt.Errorf("invalid port %d", port.Number)
+	}
+}
+
+func cnpProtocolToCalico(protocolStr string) *numorstring.Protocol {
+	if protocolStr == "" {
+		return nil
+	}
+	p := numorstring.ProtocolFromString(protocolStr)
+	return &p
+}
@@ -0,0 +1,400 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterpolicy "github.com/projectcalico/calico/libcalico-go/lib/apis/policy.networking.k8s.io/v1alpha2"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+var _ = Describe("Test ClusterNetworkPolicy conversion", func() {
+	// Use a single instance of the Converter for these tests.
+	c := NewConverter()
+
+	protoTCP := numorstring.ProtocolFromString(numorstring.ProtocolTCP)
+	protoUDP := numorstring.ProtocolFromString(numorstring.ProtocolUDP)
+	protoSCTP := numorstring.ProtocolFromString(numorstring.ProtocolSCTP)
+
+	subject := clusterpolicy.ClusterNetworkPolicySubject{
+		Namespaces: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"label": "value"},
+		},
+	}
+
+	convertToGNP := func(cnp *clusterpolicy.ClusterNetworkPolicy, expectConversionErr bool) *apiv3.GlobalNetworkPolicy {
+		pol, err := c.K8sClusterNetworkPolicyToCalico(cnp)
+		if expectConversionErr {
+			var e cerrors.ErrorAdminPolicyConversion
+			Expect(errors.As(err, &e)).To(BeTrue())
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		gnp, ok := pol.Value.(*apiv3.GlobalNetworkPolicy)
+		Expect(ok).To(BeTrue())
+		Expect(pol.Key.(model.ResourceKey).Name).To(Equal(gnp.Name))
+		Expect(gnp.Kind).To(Equal(apiv3.KindGlobalNetworkPolicy))
+		Expect(*gnp.Spec.Order).To(Equal(float64(cnp.Spec.Priority) + 0.5))
+		return gnp
+	}
+
+	It("should place Admin and Baseline tier policies in the admin network policy tiers", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "test.policy",
+				UID:             types.UID("30316465-6365-4463-ad63-3564622d3638"),
+				ResourceVersion: "1234",
+			},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.AdminTier,
+				Priority: 10,
+				Subject:  subject,
+			},
+		}
+		gnp := convertToGNP(cnp, false)
+		Expect(gnp.Name).To(Equal(names.K8sAdminClusterNetworkPolicyNamePrefix + "test.policy"))
+		Expect(gnp.Spec.Tier).To(Equal(names.AdminNetworkPolicyTierName))
+		Expect(gnp.Spec.NamespaceSelector).To(Equal("label == 'value'"))
+		Expect(gnp.Spec.Selector).To(Equal("projectcalico.org/orchestrator == 'k8s'"))
+		Expect(gnp.Spec.Types).To(BeEmpty())
+		Expect(gnp.ResourceVersion).To(Equal("1234"))
+
+		cnp.Spec.Tier = clusterpolicy.BaselineTier
+		gnp = convertToGNP(cnp, false)
+		Expect(gnp.Name).To(Equal(names.K8sBaselineClusterNetworkPolicyNamePrefix + "test.policy"))
+		Expect(gnp.Spec.Tier).To(Equal(names.BaselineAdminNetworkPolicyTierName))
+
+		tier, err := names.TierFromPolicyName(gnp.Name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tier).To(Equal(names.BaselineAdminNetworkPolicyTierName))
+	})
+
+	It("should reject an unknown tier", func() {
+		_, err := c.K8sClusterNetworkPolicyToCalico(&clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:    "Bogus",
+				Subject: subject,
+			},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should convert ingress rules with each action and protocol", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.AdminTier,
+				Priority: 5,
+				Subject: clusterpolicy.ClusterNetworkPolicySubject{
+					Pods: &clusterpolicy.NamespacedPod{
+						NamespaceSelector: metav1.LabelSelector{},
+						PodSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "db"},
+						},
+					},
+				},
+				Ingress: []clusterpolicy.ClusterNetworkPolicyIngressRule{
+					{
+						Name:   "accept-web",
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionAccept,
+						From: []clusterpolicy.ClusterNetworkPolicyIngressPeer{{
+							Pods: &clusterpolicy.NamespacedPod{
+								NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"ns": "web"}},
+								PodSelector:       metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+							},
+						}},
+						Protocols: []clusterpolicy.ClusterNetworkPolicyProtocol{
+							{TCP: &clusterpolicy.ClusterNetworkPolicyProtocolPorts{DestinationPort: &clusterpolicy.Port{Number: 5432}}},
+							{TCP: &clusterpolicy.ClusterNetworkPolicyProtocolPorts{DestinationPort: &clusterpolicy.Port{Number: 5433}}},
+							{UDP: &clusterpolicy.ClusterNetworkPolicyProtocolPorts{DestinationPort: &clusterpolicy.Port{
+								Range: &clusterpolicy.PortRange{Start: 1000, End: 2000},
+							}}},
+						},
+					},
+					{
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionPass,
+						From: []clusterpolicy.ClusterNetworkPolicyIngressPeer{{
+							Namespaces: &metav1.LabelSelector{MatchLabels: map[string]string{"ns": "dev"}},
+						}},
+					},
+				},
+			},
+		}
+		gnp := convertToGNP(cnp, false)
+		Expect(gnp.Spec.NamespaceSelector).To(Equal("all()"))
+		Expect(gnp.Spec.Selector).To(Equal("projectcalico.org/orchestrator == 'k8s' && app == 'db'"))
+		Expect(gnp.Spec.Types).To(Equal([]apiv3.PolicyType{apiv3.PolicyTypeIngress}))
+		Expect(gnp.Spec.Ingress).To(Equal([]apiv3.Rule{
+			{
+				Metadata: k8sAdminNetworkPolicyToCalicoMetadata("accept-web"),
+				Action:   apiv3.Allow,
+				Protocol: &protoTCP,
+				Source: apiv3.EntityRule{
+					Selector:          "projectcalico.org/orchestrator == 'k8s' && app == 'web'",
+					NamespaceSelector: "ns == 'web'",
+				},
+				Destination: apiv3.EntityRule{
+					Ports: []numorstring.Port{{MinPort: 5432, MaxPort: 5433}},
+				},
+			},
+			{
+				Metadata: k8sAdminNetworkPolicyToCalicoMetadata("accept-web"),
+				Action:   apiv3.Allow,
+				Protocol: &protoUDP,
+				Source: apiv3.EntityRule{
+					Selector:          "projectcalico.org/orchestrator == 'k8s' && app == 'web'",
+					NamespaceSelector: "ns == 'web'",
+				},
+				Destination: apiv3.EntityRule{
+					Ports: []numorstring.Port{{MinPort: 1000, MaxPort: 2000}},
+				},
+			},
+			{
+				Action: apiv3.Pass,
+				Source: apiv3.EntityRule{
+					NamespaceSelector: "ns == 'dev'",
+				},
+			},
+		}))
+	})
+
+	It("should convert node, network and named port egress peers", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "egress"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.BaselineTier,
+				Priority: 50,
+				Subject:  subject,
+				Egress: []clusterpolicy.ClusterNetworkPolicyEgressRule{
+					{
+						Name:   "nodes",
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionAccept,
+						To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+							{Nodes: &metav1.LabelSelector{MatchLabels: map[string]string{"node-role.kubernetes.io/control-plane": ""}}},
+							{Nodes: &metav1.LabelSelector{}},
+						},
+						Protocols: []clusterpolicy.ClusterNetworkPolicyProtocol{
+							{TCP: &clusterpolicy.ClusterNetworkPolicyProtocolPorts{DestinationPort: &clusterpolicy.Port{Number: 6443}}},
+						},
+					},
+					{
+						Name:   "networks",
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionDeny,
+						To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+							{Networks: []clusterpolicy.CIDR{"10.0.0.1/8", "fd00::/64"}},
+						},
+						Protocols: []clusterpolicy.ClusterNetworkPolicyProtocol{
+							{DestinationNamedPort: "metrics"},
+							{TCP: &clusterpolicy.ClusterNetworkPolicyProtocolPorts{}},
+						},
+					},
+				},
+			},
+		}
+		gnp := convertToGNP(cnp, false)
+		Expect(gnp.Spec.Types).To(Equal([]apiv3.PolicyType{apiv3.PolicyTypeEgress}))
+		nets := []string{"10.0.0.0/8", "fd00::/64"}
+		Expect(gnp.Spec.Egress).To(Equal([]apiv3.Rule{
+			{
+				Metadata: k8sAdminNetworkPolicyToCalicoMetadata("nodes"),
+				Action:   apiv3.Allow,
+				Protocol: &protoTCP,
+				Destination: apiv3.EntityRule{
+					Selector: "projectcalico.org/created-by == 'calico-kube-controllers' && node-role.kubernetes.io/control-plane == ''",
+					Ports:    []numorstring.Port{numorstring.SinglePort(6443)},
+				},
+			},
+			{
+				Metadata: k8sAdminNetworkPolicyToCalicoMetadata("nodes"),
+				Action:   apiv3.Allow,
+				Protocol: &protoTCP,
+				Destination: apiv3.EntityRule{
+					Selector: "projectcalico.org/created-by == 'calico-kube-controllers'",
+					Ports:    []numorstring.Port{numorstring.SinglePort(6443)},
+				},
+			},
+			{
+				Metadata:    k8sAdminNetworkPolicyToCalicoMetadata("networks"),
+				Action:      apiv3.Deny,
+				Protocol:    &protoSCTP,
+				Destination: apiv3.EntityRule{Nets: nets, Ports: []numorstring.Port{numorstring.NamedPort("metrics")}},
+			},
+			{
+				// All TCP ports, which subsumes the named port.
+				Metadata:    k8sAdminNetworkPolicyToCalicoMetadata("networks"),
+				Action:      apiv3.Deny,
+				Protocol:    &protoTCP,
+				Destination: apiv3.EntityRule{Nets: nets},
+			},
+			{
+				Metadata:    k8sAdminNetworkPolicyToCalicoMetadata("networks"),
+				Action:      apiv3.Deny,
+				Protocol:    &protoUDP,
+				Destination: apiv3.EntityRule{Nets: nets, Ports: []numorstring.Port{numorstring.NamedPort("metrics")}},
+			},
+		}))
+	})
+
+	It("should only select the host endpoints of nodes with a node peer", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.AdminTier,
+				Priority: 5,
+				Subject:  subject,
+				Egress: []clusterpolicy.ClusterNetworkPolicyEgressRule{{
+					Action: clusterpolicy.ClusterNetworkPolicyRuleActionDeny,
+					To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+						{Nodes: &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}}},
+					},
+				}},
+			},
+		}
+		gnp := convertToGNP(cnp, false)
+		Expect(gnp.Spec.Egress).To(HaveLen(1))
+		sel, err := selector.Parse(gnp.Spec.Egress[0].Destination.Selector)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("matching the automatic host endpoint of a node")
+		hep := apiv3.NewHostEndpoint()
+		hep.Labels = map[string]string{"zone": "a", "projectcalico.org/created-by": "calico-kube-controllers"}
+		Expect(sel.Evaluate(hep.Labels)).To(BeTrue())
+
+		By("not matching a GlobalNetworkSet with the node's labels")
+		gns := apiv3.NewGlobalNetworkSet()
+		gns.Labels = map[string]string{"zone": "a"}
+		Expect(sel.Evaluate(gns.Labels)).To(BeFalse())
+
+		By("not matching a host endpoint that wasn't created for a node")
+		manualHEP := apiv3.NewHostEndpoint()
+		manualHEP.Labels = map[string]string{"zone": "a"}
+		Expect(sel.Evaluate(manualHEP.Labels)).To(BeFalse())
+	})
+
+	It("should skip domain name peers, report them and keep the rest of the rule", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "domains"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.AdminTier,
+				Priority: 1,
+				Subject:  subject,
+				Egress: []clusterpolicy.ClusterNetworkPolicyEgressRule{{
+					Name:   "mixed",
+					Action: clusterpolicy.ClusterNetworkPolicyRuleActionAccept,
+					To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+						{DomainNames: []clusterpolicy.DomainName{"*.example.com"}},
+						{Networks: []clusterpolicy.CIDR{"10.0.0.0/8"}},
+					},
+				}},
+			},
+		}
+		gnp := convertToGNP(cnp, true)
+		Expect(gnp.Spec.Egress).To(Equal([]apiv3.Rule{{
+			Metadata:    k8sAdminNetworkPolicyToCalicoMetadata("mixed"),
+			Action:      apiv3.Allow,
+			Destination: apiv3.EntityRule{Nets: []string{"10.0.0.0/8"}},
+		}}))
+	})
+
+	It("should fail closed for a Deny rule with only domain name peers", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "deny-domains"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.AdminTier,
+				Priority: 1,
+				Subject:  subject,
+				Egress: []clusterpolicy.ClusterNetworkPolicyEgressRule{{
+					Name:   "domains",
+					Action: clusterpolicy.ClusterNetworkPolicyRuleActionDeny,
+					To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+						{DomainNames: []clusterpolicy.DomainName{"*.example.com"}},
+						{DomainNames: []clusterpolicy.DomainName{"example.org"}},
+					},
+				}},
+			},
+		}
+		_, err := c.K8sClusterNetworkPolicyToCalico(cnp)
+		var e cerrors.ErrorAdminPolicyConversion
+		Expect(errors.As(err, &e)).To(BeTrue())
+		Expect(e.Rules).To(HaveLen(1))
+		Expect(e.Rules[0].EgressRule).To(Equal(&cnp.Spec.Egress[0]))
+
+		gnp := convertToGNP(cnp, true)
+		Expect(gnp.Spec.Egress).To(Equal([]apiv3.Rule{{Action: apiv3.Deny}}))
+		Expect(gnp.Spec.Types).To(Equal([]apiv3.PolicyType{apiv3.PolicyTypeEgress}))
+	})
+
+	It("should drop unsupported rules, failing closed for Deny and Pass", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "unsupported"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.AdminTier,
+				Priority: 1,
+				Subject:  subject,
+				Egress: []clusterpolicy.ClusterNetworkPolicyEgressRule{
+					{
+						Name:   "domains",
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionAccept,
+						To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+							{DomainNames: []clusterpolicy.DomainName{"*.example.com"}},
+						},
+					},
+					{
+						Name:   "bad-cidr",
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionPass,
+						To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{
+							{Networks: []clusterpolicy.CIDR{"not-a-cidr"}},
+						},
+					},
+				},
+			},
+		}
+		gnp := convertToGNP(cnp, true)
+		Expect(gnp.Spec.Egress).To(Equal([]apiv3.Rule{{Action: apiv3.Deny}}))
+	})
+
+	It("should drop Pass rules in the Baseline tier", func() {
+		cnp := &clusterpolicy.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "baseline-pass"},
+			Spec: clusterpolicy.ClusterNetworkPolicySpec{
+				Tier:     clusterpolicy.BaselineTier,
+				Priority: 1,
+				Subject:  subject,
+				Ingress: []clusterpolicy.ClusterNetworkPolicyIngressRule{{
+					Action: clusterpolicy.ClusterNetworkPolicyRuleActionPass,
+					From: []clusterpolicy.ClusterNetworkPolicyIngressPeer{{
+						Namespaces: &metav1.LabelSelector{},
+					}},
+				}},
+			},
+		}
+		gnp := convertToGNP(cnp, true)
+		Expect(gnp.Spec.Ingress).To(BeEmpty())
+		Expect(gnp.Spec.Types).To(BeEmpty())
+	})
+})
@@ -40,6 +40,23 @@ const (
 	// on older Pods.
 	AnnotationContainerID = "cni.projectcalico.org/containerID"
 
+	// AnnotationSecondaryEndpointPrefix is the prefix of the annotations that the CNI plugin uses to record
+	// a pod's secondary Calico attachments (for example, additional networks attached by Multus).  The
+	// full annotation name is the prefix followed by the pod-side interface name, and its value is a
+	// JSON-encoded SecondaryEndpoint.
+	//
+	// We set this annotation to the empty string when the WEP is deleted by the CNI plugin.
+	AnnotationSecondaryEndpointPrefix = "cni.projectcalico.org/secondaryEndpoint."
+
+	// PrimaryInterfaceName is the Endpoint of every pod's primary WorkloadEndpoint, and the pod-side
+	// interface name of the pod's primary network attachment unless the pod or the CNI configuration
+	// says otherwise.  Calico attachments to any other pod-side interface are secondary.
+	PrimaryInterfaceName = "eth0"
+
+	// AnnotationNetworkStatus is the annotation in which Multus records the networks that a pod is
+	// attached to.  The entry marked as the default is the pod's primary network.
+	AnnotationNetworkStatus = "k8s.v1.cni.cncf.io/network-status"
+
 	// NameLabel is a label that can be used to match a serviceaccount or namespace
 	// name exactly.
 	NameLabel = "projectcalico.org/name"
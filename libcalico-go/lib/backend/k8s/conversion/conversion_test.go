@@ -66,6 +66,18 @@ var _ = Describe("Test parsing strings", func() {
 		Expect(name).To(Equal("eni82111e10a96"))
 	})
 
+	It("generate distinct veth names for secondary interfaces", func() {
+		primary := c.VethNameForWorkload("namespace", "podname")
+		Expect(c.VethNameForWorkloadEndpoint("namespace", "podname", "eth0")).To(Equal(primary))
+		Expect(c.VethNameForWorkloadEndpoint("namespace", "podname", "")).To(Equal(primary))
+
+		net1 := c.VethNameForWorkloadEndpoint("namespace", "podname", "net1")
+		Expect(net1).To(HavePrefix("cali"))
+		Expect(net1).To(HaveLen(len(primary)))
+		Expect(net1).NotTo(Equal(primary))
+		Expect(c.VethNameForWorkloadEndpoint("namespace", "podname", "net2")).NotTo(Equal(net1))
+	})
+
 	It("should parse valid profile names", func() {
 		name := "kns.default"
 		ns, err := c.ProfileNameToNamespace(name)
@@ -1194,6 +1206,111 @@ var _ = Describe("Test Pod conversion", func() {
 		Expect(pod).To(Equal(makePod()), "Original pod should not be modified")
 	})
 
+	It("should parse a Pod with secondary interfaces to multiple WorkloadEndpoints", func() {
+		pod := kapiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"cni.projectcalico.org/containerID":             "abcd",
+					"cni.projectcalico.org/secondaryEndpoint.net2":  "",
+					"cni.projectcalico.org/secondaryEndpoint.net1":  `{"network": "storage", "containerID": "abcd", "ips": ["10.10.0.1/32"]}`,
+					"cni.projectcalico.org/secondaryEndpoint.bad":   "not-json",
+					"cni.projectcalico.org/secondaryEndpoint.eth0":  `{"network": "ignored"}`,
+					"cni.projectcalico.org/floatingIPs":             `["1.1.1.1"]`,
+					"qos.projectcalico.org/ingressBandwidth":        "1M",
+					"cni.projectcalico.org/allowedSourcePrefixes":   `["8.8.8.0/24"]`,
+					"k8s.v1.cni.cncf.io/network-status":             "[]",
+					"cni.projectcalico.org/secondaryEndpointsOther": "ignored",
+				},
+				Labels: map[string]string{
+					"labelA": "valueA",
+				},
+				ResourceVersion: "1234",
+			},
+			Spec: kapiv1.PodSpec{
+				NodeName: "nodeA",
+			},
+			Status: kapiv1.PodStatus{
+				PodIP: "192.168.0.1",
+			},
+		}
+
+		kvps, err := c.PodToWorkloadEndpoints(&pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(HaveLen(3))
+
+		primary := kvps[0].Value.(*libapiv3.WorkloadEndpoint)
+		Expect(primary.Name).To(Equal("nodeA-k8s-podA-eth0"))
+		Expect(primary.Spec.IPNetworks).To(ConsistOf("192.168.0.1/32"))
+		Expect(primary.Labels).NotTo(HaveKey(apiv3.LabelNetwork))
+
+		net1 := kvps[1].Value.(*libapiv3.WorkloadEndpoint)
+		Expect(kvps[1].Key.(model.ResourceKey).Name).To(Equal("nodeA-k8s-podA-net1"))
+		Expect(net1.Name).To(Equal("nodeA-k8s-podA-net1"))
+		Expect(net1.Spec.Endpoint).To(Equal("net1"))
+		Expect(net1.Spec.InterfaceName).To(Equal(c.VethNameForWorkloadEndpoint("default", "podA", "net1")))
+		Expect(net1.Spec.ContainerID).To(Equal("abcd"))
+		Expect(net1.Spec.IPNetworks).To(ConsistOf("10.10.0.1/32"))
+		Expect(net1.Spec.Profiles).To(Equal(primary.Spec.Profiles))
+		Expect(net1.Spec.IPNATs).To(BeNil())
+		Expect(net1.Spec.QoSControls).To(BeNil())
+		Expect(net1.Spec.AllowSpoofedSourcePrefixes).To(BeNil())
+		Expect(net1.Annotations).To(BeNil())
+		Expect(net1.Labels).To(Equal(map[string]string{
+			"labelA":                "valueA",
+			apiv3.LabelNamespace:    "default",
+			apiv3.LabelOrchestrator: "k8s",
+			apiv3.LabelNetwork:      "storage",
+		}))
+
+		// An empty annotation means that the interface has been torn down.
+		net2 := kvps[2].Value.(*libapiv3.WorkloadEndpoint)
+		Expect(net2.Name).To(Equal("nodeA-k8s-podA-net2"))
+		Expect(net2.Spec.IPNetworks).To(BeEmpty())
+		Expect(net2.Spec.ContainerID).To(BeEmpty())
+
+		// The primary endpoint should be unaffected by the copies.
+		Expect(primary.Spec.IPNATs).To(HaveLen(1))
+		Expect(primary.Labels).NotTo(HaveKey(apiv3.LabelNetwork))
+	})
+
+	It("should take the primary interface of a Pod from its Multus default network", func() {
+		pod := kapiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"cni.projectcalico.org/secondaryEndpoint.net0": `{"network": "ignored", "ips": ["10.10.0.1/32"]}`,
+					"cni.projectcalico.org/secondaryEndpoint.net1": `{"network": "storage", "ips": ["10.10.0.2/32"]}`,
+					"k8s.v1.cni.cncf.io/network-status": `[
+						{"name": "storage", "interface": "net1", "ips": ["10.10.0.2"]},
+						{"name": "k8s-pod-network", "interface": "net0", "ips": ["192.168.0.1"], "default": true}
+					]`,
+				},
+			},
+			Spec: kapiv1.PodSpec{
+				NodeName: "nodeA",
+			},
+			Status: kapiv1.PodStatus{
+				PodIP: "192.168.0.1",
+			},
+		}
+		Expect(PodPrimaryInterface(&pod)).To(Equal("net0"))
+
+		kvps, err := c.PodToWorkloadEndpoints(&pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(HaveLen(2))
+		Expect(kvps[0].Value.(*libapiv3.WorkloadEndpoint).Spec.Endpoint).To(Equal("eth0"))
+		Expect(kvps[1].Value.(*libapiv3.WorkloadEndpoint).Spec.Endpoint).To(Equal("net1"))
+
+		// Without a default network, the primary interface is eth0.
+		pod.Annotations["k8s.v1.cni.cncf.io/network-status"] = `[{"name": "storage", "interface": "net1"}]`
+		Expect(PodPrimaryInterface(&pod)).To(Equal("eth0"))
+		delete(pod.Annotations, "k8s.v1.cni.cncf.io/network-status")
+		Expect(PodPrimaryInterface(&pod)).To(Equal("eth0"))
+	})
+
 	It("should parse valid QoSControl annotations", func() {
 		pod := kapiv1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
@@ -51,10 +52,24 @@ type defaultWorkloadEndpointConverter struct{}
 // VethNameForWorkload returns a deterministic veth name
 // for the given Kubernetes workload (WEP) name and namespace.
 func (wc defaultWorkloadEndpointConverter) VethNameForWorkload(namespace, podname string) string {
+	return vethNameForHashInput(fmt.Sprintf("%s.%s", namespace, podname))
+}
+
+// VethNameForWorkloadEndpoint returns a deterministic veth name for the given Kubernetes
+// workload and WorkloadEndpoint Endpoint.  For the primary endpoint, this is the same as
+// VethNameForWorkload.
+func (wc defaultWorkloadEndpointConverter) VethNameForWorkloadEndpoint(namespace, podname, endpoint string) string {
+	if !IsSecondaryEndpoint(endpoint) {
+		return wc.VethNameForWorkload(namespace, podname)
+	}
+	return vethNameForHashInput(fmt.Sprintf("%s.%s.%s", namespace, podname, endpoint))
+}
+
+func vethNameForHashInput(input string) string {
 	// A SHA1 is always 20 bytes long, and so is sufficient for generating the
 	// veth name and mac addr.
 	h := sha1.New()
-	h.Write([]byte(fmt.Sprintf("%s.%s", namespace, podname)))
+	h.Write([]byte(input))
 	prefix := os.Getenv("FELIX_INTERFACEPREFIX")
 	if prefix == "" {
 		// Prefix is not set. Default to "cali"
@@ -68,13 +83,145 @@ func (wc defaultWorkloadEndpointConverter) VethNameForWorkload(namespace, podnam
 	return fmt.Sprintf("%s%s", prefix, hex.EncodeToString(h.Sum(nil))[:11])
 }
 
+// IsSecondaryEndpoint returns true if the given WorkloadEndpoint Endpoint belongs to one of a pod's
+// secondary Calico attachments.  The primary WorkloadEndpoint always uses PrimaryInterfaceName,
+// whatever the pod-side interface is called.
+func IsSecondaryEndpoint(endpoint string) bool {
+	return endpoint != "" && endpoint != PrimaryInterfaceName
+}
+
+// IsSecondaryInterface returns true if the given pod-side interface belongs to a secondary Calico
+// attachment rather than to the pod's primary network, whose interface is primaryIface.
+func IsSecondaryInterface(iface, primaryIface string) bool {
+	return IsSecondaryEndpoint(iface) && iface != primaryIface
+}
+
+// PodPrimaryInterface returns the pod-side name of the pod's primary interface.  This is taken from
+// the default network in the Multus network-status annotation, if there is one, and is
+// PrimaryInterfaceName otherwise.
+func PodPrimaryInterface(pod *kapiv1.Pod) string {
+	annotation := pod.Annotations[AnnotationNetworkStatus]
+	if annotation == "" {
+		return PrimaryInterfaceName
+	}
+	var networks []struct {
+		Interface string `json:"interface"`
+		Default   bool   `json:"default"`
+	}
+	if err := json.Unmarshal([]byte(annotation), &networks); err != nil {
+		log.WithError(err).WithField("pod", pod.Name).Debug("Failed to parse network-status annotation")
+		return PrimaryInterfaceName
+	}
+	for _, n := range networks {
+		if n.Default && n.Interface != "" {
+			return n.Interface
+		}
+	}
+	return PrimaryInterfaceName
+}
+
+// SecondaryEndpoint is the value of an AnnotationSecondaryEndpointPrefix annotation.
+type SecondaryEndpoint struct {
+	// Network is the name of the CNI network that the interface is attached to.
+	Network string `json:"network"`
+
+	// ContainerID is the ID of the sandbox that the interface was created in.
+	ContainerID string `json:"containerID,omitempty"`
+
+	// IPs are the IP networks assigned to the interface.
+	IPs []string `json:"ips,omitempty"`
+}
+
 func (wc defaultWorkloadEndpointConverter) PodToWorkloadEndpoints(pod *kapiv1.Pod) ([]*model.KVPair, error) {
 	wep, err := wc.podToDefaultWorkloadEndpoint(pod)
 	if err != nil {
 		return nil, err
 	}
 
-	return []*model.KVPair{wep}, nil
+	kvps := []*model.KVPair{wep}
+	primaryIface := PodPrimaryInterface(pod)
+	var endpoints []string
+	for k := range pod.Annotations {
+		if endpoint, ok := strings.CutPrefix(k, AnnotationSecondaryEndpointPrefix); ok && IsSecondaryInterface(endpoint, primaryIface) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	sort.Strings(endpoints)
+	for _, endpoint := range endpoints {
+		kvp, err := wc.podToSecondaryWorkloadEndpoint(pod, wep, endpoint)
+		if err != nil {
+			// Don't let a bad annotation stop us from handling the rest of the pod.
+			log.WithError(err).WithFields(log.Fields{
+				"pod":      pod.Name,
+				"endpoint": endpoint,
+			}).Warn("Failed to convert secondary endpoint annotation, ignoring")
+			continue
+		}
+		kvps = append(kvps, kvp)
+	}
+
+	return kvps, nil
+}
+
+// podToSecondaryWorkloadEndpoint builds the WorkloadEndpoint for one of the pod's secondary Calico interfaces
+// from the pod's primary WorkloadEndpoint and the annotation written by the CNI plugin.  The endpoint shares
+// the pod's labels, profiles and ports, but has its own name, interface, IPs and network label.
+func (wc defaultWorkloadEndpointConverter) podToSecondaryWorkloadEndpoint(pod *kapiv1.Pod, primary *model.KVPair, endpoint string) (*model.KVPair, error) {
+	// An empty annotation means that the CNI plugin has torn down the interface.  We still emit the endpoint,
+	// without IPs, so that watchers see the IPs being removed.
+	var secondary SecondaryEndpoint
+	if annotation := pod.Annotations[AnnotationSecondaryEndpointPrefix+endpoint]; annotation != "" {
+		if err := json.Unmarshal([]byte(annotation), &secondary); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' as JSON: %s", annotation, err)
+		}
+	}
+
+	wepids := names.WorkloadEndpointIdentifiers{
+		Node:         pod.Spec.NodeName,
+		Orchestrator: apiv3.OrchestratorKubernetes,
+		Endpoint:     endpoint,
+		Pod:          pod.Name,
+	}
+	wepName, err := wepids.CalculateWorkloadEndpointName(false)
+	if err != nil {
+		return nil, err
+	}
+
+	ipNets := []string{}
+	if !IsFinished(pod) {
+		for _, ip := range secondary.IPs {
+			_, ipNet, err := cnet.ParseCIDROrIP(ip)
+			if err != nil {
+				return nil, err
+			}
+			ipNets = append(ipNets, ipNet.String())
+		}
+	}
+
+	wep := primary.Value.(*libapiv3.WorkloadEndpoint).DeepCopy()
+	wep.Name = wepName
+	wep.Annotations = nil
+	if secondary.Network != "" {
+		wep.Labels[apiv3.LabelNetwork] = secondary.Network
+	}
+	wep.Spec.Endpoint = endpoint
+	wep.Spec.InterfaceName = wc.VethNameForWorkloadEndpoint(pod.Namespace, pod.Name, endpoint)
+	wep.Spec.ContainerID = secondary.ContainerID
+	wep.Spec.IPNetworks = ipNets
+	// Floating IPs, spoofing and QoS controls are only supported on the pod's primary interface.
+	wep.Spec.IPNATs = nil
+	wep.Spec.AllowSpoofedSourcePrefixes = nil
+	wep.Spec.QoSControls = nil
+
+	return &model.KVPair{
+		Key: model.ResourceKey{
+			Name:      wepName,
+			Namespace: pod.Namespace,
+			Kind:      libapiv3.KindWorkloadEndpoint,
+		},
+		Value:    wep,
+		Revision: pod.ResourceVersion,
+	}, nil
 }
 
 // PodToWorkloadEndpoint converts a Pod to a WorkloadEndpoint.  It assumes the calling code
@@ -238,11 +385,11 @@ func (wc defaultWorkloadEndpointConverter) podToDefaultWorkloadEndpoint(pod *kap
 		QoSControls:                qosControls,
 	}
 
-	if v, ok := pod.Annotations["k8s.v1.cni.cncf.io/network-status"]; ok {
+	if v, ok := pod.Annotations[AnnotationNetworkStatus]; ok {
 		if wep.Annotations == nil {
 			wep.Annotations = make(map[string]string)
 		}
-		wep.Annotations["k8s.v1.cni.cncf.io/network-status"] = v
+		wep.Annotations[AnnotationNetworkStatus] = v
 	}
 
 	// Embed the workload endpoint into a KVPair.
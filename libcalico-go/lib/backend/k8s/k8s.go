@@ -36,6 +36,7 @@ import (
 	adminpolicyclient "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/typed/apis/v1alpha1"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
+	clusterpolicy "github.com/projectcalico/calico/libcalico-go/lib/apis/policy.networking.k8s.io/v1alpha2"
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
@@ -62,6 +63,9 @@ type KubeClient struct {
 	// Client for interacting with K8S Admin Network Policy, and BaselineAdminNetworkPolicy.
 	k8sAdminPolicyClient *adminpolicyclient.PolicyV1alpha1Client
 
+	// Client for interacting with K8S ClusterNetworkPolicy.
+	k8sClusterPolicyClient *rest.RESTClient
+
 	disableNodePoll bool
 
 	// Contains methods for converting Kubernetes resources to
@@ -94,14 +98,20 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		return nil, fmt.Errorf("Failed to build K8S Admin Network Policy client: %v", err)
 	}
 
+	k8sClusterPolicyClient, err := buildK8SClusterPolicyClient(*config)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to build K8S Cluster Network Policy client: %v", err)
+	}
+
 	kubeClient := &KubeClient{
-		ClientSet:             cs,
-		crdClientV1:           crdClientV1,
-		k8sAdminPolicyClient:  k8sAdminPolicyClient,
-		disableNodePoll:       ca.K8sDisableNodePoll,
-		clientsByResourceKind: make(map[string]resources.K8sResourceClient),
-		clientsByKeyType:      make(map[reflect.Type]resources.K8sResourceClient),
-		clientsByListType:     make(map[reflect.Type]resources.K8sResourceClient),
+		ClientSet:              cs,
+		crdClientV1:            crdClientV1,
+		k8sAdminPolicyClient:   k8sAdminPolicyClient,
+		k8sClusterPolicyClient: k8sClusterPolicyClient,
+		disableNodePoll:        ca.K8sDisableNodePoll,
+		clientsByResourceKind:  make(map[string]resources.K8sResourceClient),
+		clientsByKeyType:       make(map[reflect.Type]resources.K8sResourceClient),
+		clientsByListType:      make(map[reflect.Type]resources.K8sResourceClient),
 	}
 
 	// Create the Calico sub-clients and register them.
@@ -111,6 +121,12 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindIPPool,
 		resources.NewIPPoolClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindIPPoolMigration,
+		resources.NewIPPoolMigrationClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -141,6 +157,12 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		model.KindKubernetesBaselineAdminNetworkPolicy,
 		resources.NewKubernetesBaselineAdminNetworkPolicyClient(k8sAdminPolicyClient),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		model.KindKubernetesClusterNetworkPolicy,
+		resources.NewKubernetesClusterNetworkPolicyClient(k8sClusterPolicyClient),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -183,6 +205,12 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindNetworkSet,
 		resources.NewNetworkSetClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindPacketCapture,
+		resources.NewPacketCaptureClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -472,7 +500,9 @@ func (c *KubeClient) Clean() error {
 		apiv3.KindTier,
 		apiv3.KindGlobalNetworkSet,
 		apiv3.KindNetworkSet,
+		apiv3.KindPacketCapture,
 		apiv3.KindIPPool,
+		apiv3.KindIPPoolMigration,
 		apiv3.KindIPReservation,
 		apiv3.KindHostEndpoint,
 		apiv3.KindKubeControllersConfiguration,
@@ -543,6 +573,16 @@ func buildK8SAdminPolicyClient(cfg *rest.Config) (*adminpolicyclient.PolicyV1alp
 	return adminpolicyclient.NewForConfig(cfg)
 }
 
+// buildK8SClusterPolicyClient builds a RESTClient configured to interact with the v1alpha2
+// ClusterNetworkPolicy API.
+func buildK8SClusterPolicyClient(cfg rest.Config) (*rest.RESTClient, error) {
+	cfg.GroupVersion = &clusterpolicy.SchemeGroupVersion
+	cfg.APIPath = "/apis"
+	cfg.ContentType = runtime.ContentTypeJSON
+	cfg.NegotiatedSerializer = serializer.WithoutConversionCodecFactory{CodecFactory: clusterpolicy.Codecs}
+	return rest.RESTClientFor(&cfg)
+}
+
 // buildCRDClientV1 builds a RESTClient configured to interact with Calico CustomResourceDefinitions
 func buildCRDClientV1(cfg rest.Config) (*rest.RESTClient, error) {
 	// Generate config using the base config.
@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+
+	clusterpolicy "github.com/projectcalico/calico/libcalico-go/lib/apis/policy.networking.k8s.io/v1alpha2"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+)
+
+// NewKubernetesClusterNetworkPolicyClient returns a new client for interacting with Kubernetes ClusterNetworkPolicy
+// objects. The restClient must be configured for the policy.networking.k8s.io/v1alpha2 API group.
+// Note that this client is only intended for use by the felix syncer in KDD mode, and as such is largely unimplemented
+// except for the functions required by the syncer.
+func NewKubernetesClusterNetworkPolicyClient(restClient rest.Interface) K8sResourceClient {
+	return &clusterNetworkPolicyClient{
+		Converter:  conversion.NewConverter(),
+		restClient: restClient,
+	}
+}
+
+// Implements the api.Client interface for Kubernetes ClusterNetworkPolicy.
+type clusterNetworkPolicyClient struct {
+	conversion.Converter
+	restClient rest.Interface
+}
+
+func (c *clusterNetworkPolicyClient) Create(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	log.Debug("Received Create request on ClusterNetworkPolicy type")
+	return nil, cerrors.ErrorOperationNotSupported{
+		Identifier: kvp.Key,
+		Operation:  "Create",
+	}
+}
+
+func (c *clusterNetworkPolicyClient) Update(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	log.Debug("Received Update request on ClusterNetworkPolicy type")
+	return nil, cerrors.ErrorOperationNotSupported{
+		Identifier: kvp.Key,
+		Operation:  "Update",
+	}
+}
+
+func (c *clusterNetworkPolicyClient) DeleteKVP(ctx context.Context, kvp *model.KVPair) (*model.KVPair, error) {
+	return c.Delete(ctx, kvp.Key, kvp.Revision, kvp.UID)
+}
+
+func (c *clusterNetworkPolicyClient) Delete(ctx context.Context, key model.Key, revision string, uid *types.UID) (*model.KVPair, error) {
+	log.Debug("Received Delete request on ClusterNetworkPolicy type")
+	return nil, cerrors.ErrorOperationNotSupported{
+		Identifier: key,
+		Operation:  "Delete",
+	}
+}
+
+func (c *clusterNetworkPolicyClient) Get(ctx context.Context, key model.Key, revision string) (*model.KVPair, error) {
+	log.Debug("Received Get request on ClusterNetworkPolicy type")
+	return nil, cerrors.ErrorOperationNotSupported{
+		Identifier: key,
+		Operation:  "Get",
+	}
+}
+
+func (c *clusterNetworkPolicyClient) List(ctx context.Context, list model.ListInterface, revision string) (*model.KVPairList, error) {
+	logContext := log.WithField("Resource", "ClusterNetworkPolicy")
+	logContext.Debug("Received List request")
+
+	listFunc := func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		result := &clusterpolicy.ClusterNetworkPolicyList{}
+		err := c.restClient.Get().
+			Resource(clusterpolicy.ResourceClusterNetworkPolicies).
+			VersionedParams(&opts, clusterpolicy.ParameterCodec).
+			Do(ctx).
+			Into(result)
+		return result, err
+	}
+	convertFunc := func(r Resource) ([]*model.KVPair, error) {
+		cnp := r.(*clusterpolicy.ClusterNetworkPolicy)
+		kvp, err := c.K8sClusterNetworkPolicyToCalico(cnp)
+		// Silently ignore rule conversion errors, as for AdminNetworkPolicy.  The conversion code
+		// ignores (or fails closed on) any rules that it cannot parse, and we pass the rest to Felix.
+		var e cerrors.ErrorAdminPolicyConversion
+		if err != nil && !errors.As(err, &e) {
+			return nil, err
+		}
+		return []*model.KVPair{kvp}, nil
+	}
+	kvps, err := pagedList(ctx, logContext, revision, list, convertFunc, listFunc)
+	if err != nil {
+		var notExist cerrors.ErrorResourceDoesNotExist
+		if errors.As(err, &notExist) {
+			// The ClusterNetworkPolicy CRD is optional.  If it's not installed then report an
+			// empty list with no revision; the watcher cache will poll until it appears.
+			logContext.Debug("ClusterNetworkPolicy API is not available")
+			return &model.KVPairList{}, nil
+		}
+		return nil, err
+	}
+	return kvps, nil
+}
+
+func (c *clusterNetworkPolicyClient) Watch(ctx context.Context, list model.ListInterface, options api.WatchOptions) (api.WatchInterface, error) {
+	_, ok := list.(model.ResourceListOptions)
+	if !ok {
+		return nil, fmt.Errorf("ListInterface is not a ResourceListOptions: %s", list)
+	}
+	log.Debugf("Watching Kubernetes ClusterNetworkPolicy at revision %q", options.Revision)
+	k8sOpts := watchOptionsToK8sListOptions(options)
+	k8sOpts.Watch = true
+	k8sRawWatch, err := c.restClient.Get().
+		Resource(clusterpolicy.ResourceClusterNetworkPolicies).
+		VersionedParams(&k8sOpts, clusterpolicy.ParameterCodec).
+		Watch(ctx)
+	if err != nil {
+		return nil, K8sErrorToCalico(err, list)
+	}
+	converter := func(r Resource) (*model.KVPair, error) {
+		cnp, ok := r.(*clusterpolicy.ClusterNetworkPolicy)
+		if !ok {
+			return nil, errors.New("Kubernetes ClusterNetworkPolicy conversion with incorrect k8s resource type")
+		}
+
+		// As for List, send the policy with any rules that could be converted.
+		kvp, err := c.K8sClusterNetworkPolicyToCalico(cnp)
+		var e cerrors.ErrorAdminPolicyConversion
+		if err != nil && !errors.As(err, &e) {
+			return nil, err
+		}
+		return kvp, nil
+	}
+	return newK8sWatcherConverter(ctx, "Kubernetes ClusterNetworkPolicy", converter, k8sRawWatch), nil
+}
+
+func (c *clusterNetworkPolicyClient) EnsureInitialized() error {
+	return nil
+}
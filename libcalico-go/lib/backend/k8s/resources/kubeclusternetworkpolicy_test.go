@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest/fake"
+
+	clusterpolicy "github.com/projectcalico/calico/libcalico-go/lib/apis/policy.networking.k8s.io/v1alpha2"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+)
+
+var _ = Describe("ClusterNetworkPolicy tests with fake REST client", func() {
+	var status int
+	var body []byte
+	var requests []*http.Request
+	var client K8sResourceClient
+
+	BeforeEach(func() {
+		requests = nil
+		restClient := &fake.RESTClient{
+			GroupVersion:         clusterpolicy.SchemeGroupVersion,
+			NegotiatedSerializer: clusterpolicy.Codecs.WithoutConversion(),
+			Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				requests = append(requests, req)
+				return &http.Response{
+					StatusCode: status,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(bytes.NewReader(body)),
+				}, nil
+			}),
+		}
+		client = NewKubernetesClusterNetworkPolicyClient(restClient)
+	})
+
+	It("should list and convert ClusterNetworkPolicies", func() {
+		list := clusterpolicy.ClusterNetworkPolicyList{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       clusterpolicy.KindClusterNetworkPolicyList,
+				APIVersion: clusterpolicy.SchemeGroupVersion.String(),
+			},
+			ListMeta: metav1.ListMeta{ResourceVersion: "42"},
+			Items: []clusterpolicy.ClusterNetworkPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "deny-all", ResourceVersion: "41"},
+				Spec: clusterpolicy.ClusterNetworkPolicySpec{
+					Tier:     clusterpolicy.AdminTier,
+					Priority: 3,
+					Subject: clusterpolicy.ClusterNetworkPolicySubject{
+						Namespaces: &metav1.LabelSelector{},
+					},
+					Egress: []clusterpolicy.ClusterNetworkPolicyEgressRule{{
+						Action: clusterpolicy.ClusterNetworkPolicyRuleActionDeny,
+						To: []clusterpolicy.ClusterNetworkPolicyEgressPeer{{
+							Networks: []clusterpolicy.CIDR{"0.0.0.0/0"},
+						}},
+					}},
+				},
+			}},
+		}
+		var err error
+		body, err = json.Marshal(list)
+		Expect(err).NotTo(HaveOccurred())
+		status = http.StatusOK
+
+		kvps, err := client.List(context.TODO(), model.ResourceListOptions{Kind: model.KindKubernetesClusterNetworkPolicy}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Path).To(HaveSuffix("/clusternetworkpolicies"))
+		Expect(kvps.Revision).To(Equal("42"))
+		Expect(kvps.KVPairs).To(HaveLen(1))
+		gnp := kvps.KVPairs[0].Value.(*apiv3.GlobalNetworkPolicy)
+		Expect(gnp.Name).To(Equal(names.K8sAdminClusterNetworkPolicyNamePrefix + "deny-all"))
+		Expect(gnp.Spec.Egress).To(HaveLen(1))
+	})
+
+	It("should return an empty list if the API is not installed", func() {
+		body = []byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+		status = http.StatusNotFound
+
+		kvps, err := client.List(context.TODO(), model.ResourceListOptions{Kind: model.KindKubernetesClusterNetworkPolicy}, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps.KVPairs).To(BeEmpty())
+		Expect(kvps.Revision).To(Equal(""))
+	})
+})
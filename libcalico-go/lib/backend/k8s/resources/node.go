@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
@@ -50,11 +52,18 @@ const (
 	nodeBgpIpv6AddrAnnotation             = "projectcalico.org/IPv6Address"
 	nodeBgpAsnAnnotation                  = "projectcalico.org/ASNumber"
 	nodeBgpCIDAnnotation                  = "projectcalico.org/RouteReflectorClusterID"
+	nodeBgpGracefulShutdownAnnotation     = "projectcalico.org/BGPGracefulShutdown"
 	nodeK8sLabelAnnotation                = "projectcalico.org/kube-labels"
 	nodeWireguardIpv4IfaceAddrAnnotation  = "projectcalico.org/IPv4WireguardInterfaceAddr"
 	nodeWireguardIpv6IfaceAddrAnnotation  = "projectcalico.org/IPv6WireguardInterfaceAddr"
 	nodeWireguardPublicKeyAnnotation      = "projectcalico.org/WireguardPublicKey"
 	nodeWireguardPublicKeyV6Annotation    = "projectcalico.org/WireguardPublicKeyV6"
+	nodeWireguardKeyTimestampAnnotation   = "projectcalico.org/WireguardPublicKeyTimestamp"
+	nodeWireguardKeyTimestampV6Annotation = "projectcalico.org/WireguardPublicKeyTimestampV6"
+	nodeWireguardNextKeyAnnotation        = "projectcalico.org/WireguardNextPublicKey"
+	nodeWireguardNextKeyV6Annotation      = "projectcalico.org/WireguardNextPublicKeyV6"
+	nodeWireguardAcceptedKeysAnnotation   = "projectcalico.org/WireguardAcceptedNextPublicKeys"
+	nodeWireguardAcceptedKeysV6Annotation = "projectcalico.org/WireguardAcceptedNextPublicKeysV6"
 )
 
 func NewNodeClient(c kubernetes.Interface, usePodCIDR bool) K8sResourceClient {
@@ -215,6 +224,7 @@ func K8sNodeToCalico(k8sNode *kapiv1.Node, usePodCIDR bool) (*model.KVPair, erro
 	bgpSpec.IPv4Address = getAnnotation(k8sNode, nodeBgpIpv4AddrAnnotation, validatorv3.ValidateCIDRv4)
 	bgpSpec.IPv6Address = getAnnotation(k8sNode, nodeBgpIpv6AddrAnnotation, validatorv3.ValidateCIDRv6)
 	bgpSpec.RouteReflectorClusterID = getAnnotation(k8sNode, nodeBgpCIDAnnotation, validatorv3.ValidateIPv4Network)
+	bgpSpec.GracefulShutdown = libapiv3.BGPGracefulShutdown(getAnnotation(k8sNode, nodeBgpGracefulShutdownAnnotation, validateBGPGracefulShutdown))
 
 	asnString, ok := annotations[nodeBgpAsnAnnotation]
 	if ok {
@@ -273,6 +283,12 @@ func K8sNodeToCalico(k8sNode *kapiv1.Node, usePodCIDR bool) (*model.KVPair, erro
 	nodeStatus := libapiv3.NodeStatus{}
 	nodeStatus.WireguardPublicKey = annotations[nodeWireguardPublicKeyAnnotation]
 	nodeStatus.WireguardPublicKeyV6 = annotations[nodeWireguardPublicKeyV6Annotation]
+	nodeStatus.WireguardPublicKeyTimestamp = getTimeAnnotation(annotations, nodeWireguardKeyTimestampAnnotation)
+	nodeStatus.WireguardPublicKeyTimestampV6 = getTimeAnnotation(annotations, nodeWireguardKeyTimestampV6Annotation)
+	nodeStatus.WireguardNextPublicKey = annotations[nodeWireguardNextKeyAnnotation]
+	nodeStatus.WireguardNextPublicKeyV6 = annotations[nodeWireguardNextKeyV6Annotation]
+	nodeStatus.WireguardAcceptedNextPublicKeys = getListAnnotation(annotations, nodeWireguardAcceptedKeysAnnotation)
+	nodeStatus.WireguardAcceptedNextPublicKeysV6 = getListAnnotation(annotations, nodeWireguardAcceptedKeysV6Annotation)
 	if !reflect.DeepEqual(nodeStatus, libapiv3.NodeStatus{}) {
 		calicoNode.Status = nodeStatus
 	}
@@ -371,6 +387,7 @@ func mergeCalicoNodeIntoK8sNode(calicoNode *libapiv3.Node, k8sNode *kapiv1.Node)
 		delete(k8sNode.Annotations, nodeBgpIpv6AddrAnnotation)
 		delete(k8sNode.Annotations, nodeBgpAsnAnnotation)
 		delete(k8sNode.Annotations, nodeBgpCIDAnnotation)
+		delete(k8sNode.Annotations, nodeBgpGracefulShutdownAnnotation)
 	} else {
 		// If the BGP spec is not nil, then handle each field within the BGP spec individually.
 		if calicoNode.Spec.BGP.IPv4Address != "" {
@@ -402,6 +419,12 @@ func mergeCalicoNodeIntoK8sNode(calicoNode *libapiv3.Node, k8sNode *kapiv1.Node)
 		} else {
 			delete(k8sNode.Annotations, nodeBgpCIDAnnotation)
 		}
+
+		if calicoNode.Spec.BGP.GracefulShutdown != "" {
+			k8sNode.Annotations[nodeBgpGracefulShutdownAnnotation] = string(calicoNode.Spec.BGP.GracefulShutdown)
+		} else {
+			delete(k8sNode.Annotations, nodeBgpGracefulShutdownAnnotation)
+		}
 	}
 
 	if calicoNode.Spec.Wireguard == nil {
@@ -433,9 +456,66 @@ func mergeCalicoNodeIntoK8sNode(calicoNode *libapiv3.Node, k8sNode *kapiv1.Node)
 		delete(k8sNode.Annotations, nodeWireguardPublicKeyV6Annotation)
 	}
 
+	// Handle Wireguard key rotation state.
+	setTimeAnnotation(k8sNode.Annotations, nodeWireguardKeyTimestampAnnotation, calicoNode.Status.WireguardPublicKeyTimestamp)
+	setTimeAnnotation(k8sNode.Annotations, nodeWireguardKeyTimestampV6Annotation, calicoNode.Status.WireguardPublicKeyTimestampV6)
+	if calicoNode.Status.WireguardNextPublicKey != "" {
+		k8sNode.Annotations[nodeWireguardNextKeyAnnotation] = calicoNode.Status.WireguardNextPublicKey
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardNextKeyAnnotation)
+	}
+	if calicoNode.Status.WireguardNextPublicKeyV6 != "" {
+		k8sNode.Annotations[nodeWireguardNextKeyV6Annotation] = calicoNode.Status.WireguardNextPublicKeyV6
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardNextKeyV6Annotation)
+	}
+	setListAnnotation(k8sNode.Annotations, nodeWireguardAcceptedKeysAnnotation, calicoNode.Status.WireguardAcceptedNextPublicKeys)
+	setListAnnotation(k8sNode.Annotations, nodeWireguardAcceptedKeysV6Annotation, calicoNode.Status.WireguardAcceptedNextPublicKeysV6)
+
 	return k8sNode, nil
 }
 
+// getTimeAnnotation parses an RFC3339 timestamp annotation, returning nil if the annotation is missing or invalid.
+func getTimeAnnotation(annotations map[string]string, key string) *metav1.Time {
+	value, ok := annotations[key]
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.WithError(err).Infof("Annotation %s=%s is not a valid timestamp, ignoring it.", key, value)
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
+
+// setTimeAnnotation stores a timestamp annotation in RFC3339 format, removing it if the timestamp is nil.
+func setTimeAnnotation(annotations map[string]string, key string, t *metav1.Time) {
+	if t == nil {
+		delete(annotations, key)
+		return
+	}
+	annotations[key] = t.UTC().Format(time.RFC3339)
+}
+
+// getListAnnotation parses a comma separated list annotation, returning nil if the annotation is missing or empty.
+func getListAnnotation(annotations map[string]string, key string) []string {
+	value := annotations[key]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// setListAnnotation stores a list annotation as a comma separated list, removing it if the list is empty.
+func setListAnnotation(annotations map[string]string, key string, values []string) {
+	if len(values) == 0 {
+		delete(annotations, key)
+		return
+	}
+	annotations[key] = strings.Join(values, ",")
+}
+
 // mergeCalicoAndK8sLabels merges the Kubernetes labels (from k8sNode.Labels) with those that are already present in
 // calicoNode (which were loaded from our annotation).  Kubernetes labels take precedence.  To make the operation
 // reversible (so that we can support write back of a Calico node that was read from Kubernetes), we also store the
@@ -539,6 +619,14 @@ func getStaticTunnelAddress(n *kapiv1.Node) (string, error) {
 
 // getAnnotation reads the annotation from node object, runs the value through the validator function
 // and returns it if the validation passes, else returns "".
+func validateBGPGracefulShutdown(value string) error {
+	switch libapiv3.BGPGracefulShutdown(value) {
+	case libapiv3.BGPGracefulShutdownSignal, libapiv3.BGPGracefulShutdownWithdraw:
+		return nil
+	}
+	return fmt.Errorf("invalid BGP graceful shutdown state %q", value)
+}
+
 func getAnnotation(n *kapiv1.Node, key string, validator validatorFunc) string {
 	value := n.ObjectMeta.Annotations[key]
 	if value == "" {
@@ -280,6 +280,23 @@ var _ = Describe("Test Node conversion", func() {
 		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeBgpAsnAnnotation))
 	})
 
+	It("should ignore an invalid BGP graceful shutdown annotation", func() {
+		node := k8sapi.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "TestNode",
+				ResourceVersion: "1234",
+				Annotations: map[string]string{
+					nodeBgpIpv4AddrAnnotation:         "172.17.17.10",
+					nodeBgpGracefulShutdownAnnotation: "Later",
+				},
+			},
+		}
+
+		n, err := K8sNodeToCalico(&node, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n.Value.(*libapiv3.Node).Spec.BGP.GracefulShutdown).To(BeEmpty())
+	})
+
 	It("Should merge Calico Nodes into K8s Nodes", func() {
 		kl := map[string]string{"net.beta.kubernetes.io/role": "control-plane"}
 		cl := map[string]string{
@@ -314,6 +331,7 @@ var _ = Describe("Test Node conversion", func() {
 				IPv6Address:             "aa:bb:cc::ffff/120",
 				ASNumber:                &asn,
 				RouteReflectorClusterID: "245.0.0.3",
+				GracefulShutdown:        libapiv3.BGPGracefulShutdownWithdraw,
 			},
 			OrchRefs: []libapiv3.OrchRef{
 				{NodeName: k8sNode.Name, Orchestrator: "k8s"},
@@ -326,6 +344,7 @@ var _ = Describe("Test Node conversion", func() {
 		Expect(newK8sNode.Annotations).To(HaveKeyWithValue(nodeBgpIpv6AddrAnnotation, "aa:bb:cc::ffff/120"))
 		Expect(newK8sNode.Annotations).To(HaveKeyWithValue(nodeBgpAsnAnnotation, "2456"))
 		Expect(newK8sNode.Annotations).To(HaveKeyWithValue(nodeBgpCIDAnnotation, "245.0.0.3"))
+		Expect(newK8sNode.Annotations).To(HaveKeyWithValue(nodeBgpGracefulShutdownAnnotation, "Withdraw"))
 
 		// The calico node annotations and labels should not have escaped directly into the node annotations
 		// and labels.
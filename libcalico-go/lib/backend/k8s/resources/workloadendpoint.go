@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -100,7 +101,11 @@ func (c *WorkloadEndpointClient) patchInAnnotations(
 	patchMode := PatchModeOf(ctx)
 	switch patchMode {
 	case PatchModeCNI:
-		annotations = c.calcCNIAnnotations(kvp)
+		var err error
+		annotations, err = c.calcCNIAnnotations(kvp)
+		if err != nil {
+			return nil, err
+		}
 		// Note: we drop the revision here because the CNI plugin can't handle a retry right now (and the kubelet
 		// ensures that only one CNI ADD for a given UID can be in progress).
 		revision = ""
@@ -114,12 +119,28 @@ func (c *WorkloadEndpointClient) patchInAnnotations(
 	return c.patchPodAnnotations(ctx, kvp.Key, revision, kvp.UID, annotations)
 }
 
-func (c *WorkloadEndpointClient) calcCNIAnnotations(kvp *model.KVPair) map[string]string {
+func (c *WorkloadEndpointClient) calcCNIAnnotations(kvp *model.KVPair) (map[string]string, error) {
 	annotations := make(map[string]string)
 	wep := kvp.Value.(*libapiv3.WorkloadEndpoint)
 	ips := wep.Spec.IPNetworks
 	if len(ips) == 0 {
-		return annotations
+		return annotations, nil
+	}
+
+	if conversion.IsSecondaryEndpoint(wep.Spec.Endpoint) {
+		// Secondary interfaces don't own the pod IP; record everything about the interface in its own
+		// annotation instead.
+		log.Debugf("PATCHing pod with IPs for secondary interface %s: %v", wep.Spec.Endpoint, ips)
+		value, err := json.Marshal(conversion.SecondaryEndpoint{
+			Network:     wep.Labels[apiv3.LabelNetwork],
+			ContainerID: wep.Spec.ContainerID,
+			IPs:         ips,
+		})
+		if err != nil {
+			return nil, err
+		}
+		annotations[conversion.AnnotationSecondaryEndpointPrefix+wep.Spec.Endpoint] = string(value)
+		return annotations, nil
 	}
 	log.Debugf("PATCHing pod with IPs: %v", ips)
 
@@ -137,7 +158,7 @@ func (c *WorkloadEndpointClient) calcCNIAnnotations(kvp *model.KVPair) map[strin
 		log.WithField("containerID", containerID).Debug("Container ID specified, including in patch")
 		annotations[conversion.AnnotationContainerID] = containerID
 	}
-	return annotations
+	return annotations, nil
 }
 
 // patchOutAnnotations sets our pod IP annotations to empty strings; this is used to signal that the IP has been removed
@@ -155,6 +176,16 @@ func (c *WorkloadEndpointClient) patchOutAnnotations(
 		conversion.AnnotationPodIP:  "",
 		conversion.AnnotationPodIPs: "",
 	}
+	wepID, err := c.converter.ParseWorkloadEndpointName(key.(model.ResourceKey).Name)
+	if err != nil {
+		return nil, err
+	}
+	if conversion.IsSecondaryEndpoint(wepID.Endpoint) {
+		// Only clear the secondary interface's own annotation; the pod IP belongs to the primary interface.
+		annotations = map[string]string{
+			conversion.AnnotationSecondaryEndpointPrefix + wepID.Endpoint: "",
+		}
+	}
 	return c.patchPodAnnotations(ctx, key, revision, uid, annotations)
 }
 
@@ -190,6 +221,12 @@ func (c *WorkloadEndpointClient) patchPodAnnotations(
 		return nil, err
 	}
 
+	// Return the WorkloadEndpoint that we were asked to update; the pod may have several.
+	for _, kvp := range kvps {
+		if kvp.Value.(*libapiv3.WorkloadEndpoint).Name == key.(model.ResourceKey).Name {
+			return kvp, nil
+		}
+	}
 	return kvps[0], nil
 }
 
@@ -150,6 +150,88 @@ var _ = Describe("WorkloadEndpointClient", func() {
 				}))
 			})
 		})
+		Context("WorkloadEndpoint is for a secondary interface", func() {
+			It("records the endpoint in its own annotation and can delete it again", func() {
+				podUID := types.UID(uuid.NewString())
+				k8sClient := fake.NewSimpleClientset(&k8sapi.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "simplePod",
+						Namespace: "testNamespace",
+						Annotations: map[string]string{
+							conversion.AnnotationPodIP:       "192.168.91.117/32",
+							conversion.AnnotationPodIPs:      "192.168.91.117/32",
+							conversion.AnnotationContainerID: "abcde12345",
+						},
+						UID: podUID,
+					},
+					Spec: k8sapi.PodSpec{
+						NodeName: "test-node",
+					},
+				})
+
+				wepClient := resources.NewWorkloadEndpointClient(k8sClient)
+				wepIDs := names.WorkloadEndpointIdentifiers{
+					Orchestrator: "k8s",
+					Node:         "test-node",
+					Pod:          "simplePod",
+					Endpoint:     "net1",
+				}
+
+				wepName, err := wepIDs.CalculateWorkloadEndpointName(false)
+				Expect(err).ShouldNot(HaveOccurred())
+				wep := &libapiv3.WorkloadEndpoint{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      wepName,
+						Namespace: "testNamespace",
+						Labels:    map[string]string{apiv3.LabelNetwork: "storage"},
+					},
+					Spec: libapiv3.WorkloadEndpointSpec{
+						Endpoint:    "net1",
+						ContainerID: "abcde12345",
+						IPNetworks:  []string{"10.10.0.1/32"},
+					},
+				}
+
+				key := model.ResourceKey{
+					Name:      wep.Name,
+					Namespace: wep.Namespace,
+					Kind:      libapiv3.KindWorkloadEndpoint,
+				}
+				ctxCNI := resources.ContextWithPatchMode(context.Background(), resources.PatchModeCNI)
+				kvp, err := wepClient.Create(ctxCNI, &model.KVPair{Key: key, Value: wep})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(kvp.Key.(model.ResourceKey).Name).To(Equal(wepName))
+
+				pod, err := k8sClient.CoreV1().Pods("testNamespace").Get(ctx, "simplePod", metav1.GetOptions{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pod.GetAnnotations()).Should(Equal(map[string]string{
+					conversion.AnnotationPodIP:                            "192.168.91.117/32",
+					conversion.AnnotationPodIPs:                           "192.168.91.117/32",
+					conversion.AnnotationContainerID:                      "abcde12345",
+					conversion.AnnotationSecondaryEndpointPrefix + "net1": `{"network":"storage","containerID":"abcde12345","ips":["10.10.0.1/32"]}`,
+				}))
+
+				By("Getting the secondary endpoint back.")
+				kvp, err = wepClient.Get(context.Background(), key, "")
+				Expect(err).NotTo(HaveOccurred())
+				got := kvp.Value.(*libapiv3.WorkloadEndpoint)
+				Expect(got.Spec.Endpoint).To(Equal("net1"))
+				Expect(got.Spec.IPNetworks).To(ConsistOf("10.10.0.1/32"))
+				Expect(got.Labels).To(HaveKeyWithValue(apiv3.LabelNetwork, "storage"))
+
+				By("Deleting it, leaving the primary interface alone.")
+				_, err = wepClient.Delete(context.Background(), key, kvp.Revision, kvp.UID)
+				Expect(err).ShouldNot(HaveOccurred())
+				pod, err = k8sClient.CoreV1().Pods("testNamespace").Get(ctx, "simplePod", metav1.GetOptions{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(pod.GetAnnotations()).Should(Equal(map[string]string{
+					conversion.AnnotationPodIP:                            "192.168.91.117/32",
+					conversion.AnnotationPodIPs:                           "192.168.91.117/32",
+					conversion.AnnotationContainerID:                      "abcde12345",
+					conversion.AnnotationSecondaryEndpointPrefix + "net1": "",
+				}))
+			})
+		})
 	})
 	Describe("Update", func() {
 		Context("WorkloadEndpoint has no IPs set", func() {
@@ -43,6 +43,8 @@ func AddCalicoResourcesToScheme() {
 					&apiv3.FelixConfigurationList{},
 					&apiv3.IPPool{},
 					&apiv3.IPPoolList{},
+					&apiv3.IPPoolMigration{},
+					&apiv3.IPPoolMigrationList{},
 					&apiv3.IPReservation{},
 					&apiv3.IPReservationList{},
 					&apiv3.BGPPeer{},
@@ -55,6 +57,8 @@ func AddCalicoResourcesToScheme() {
 					&apiv3.GlobalNetworkSetList{},
 					&apiv3.NetworkSet{},
 					&apiv3.NetworkSetList{},
+					&apiv3.PacketCapture{},
+					&apiv3.PacketCaptureList{},
 					&apiv3.GlobalNetworkPolicy{},
 					&apiv3.GlobalNetworkPolicyList{},
 					&apiv3.StagedGlobalNetworkPolicy{},
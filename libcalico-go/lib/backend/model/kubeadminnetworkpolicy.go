@@ -17,4 +17,5 @@ package model
 const (
 	KindKubernetesAdminNetworkPolicy         = "KubernetesAdminNetworkPolicy"
 	KindKubernetesBaselineAdminNetworkPolicy = "KubernetesBaselineAdminNetworkPolicy"
+	KindKubernetesClusterNetworkPolicy       = "KubernetesClusterNetworkPolicy"
 )
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"time"
 
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 	log "github.com/sirupsen/logrus"
@@ -64,6 +65,18 @@ type Wireguard struct {
 	PublicKey         string  `json:"publicKey,omitempty"`
 	InterfaceIPv6Addr *net.IP `json:"interfaceIPv6Addr,omitempty"`
 	PublicKeyV6       string  `json:"publicKeyV6,omitempty"`
+
+	// Key rotation state.  The timestamps record when the current key pairs were generated, the next keys
+	// are only set while a rotation is in progress.
+	PublicKeyTimestamp   time.Time `json:"publicKeyTimestamp,omitempty"`
+	PublicKeyTimestampV6 time.Time `json:"publicKeyTimestampV6,omitempty"`
+	NextPublicKey        string    `json:"nextPublicKey,omitempty"`
+	NextPublicKeyV6      string    `json:"nextPublicKeyV6,omitempty"`
+
+	// The next keys of rotating peers that this node has programmed.  A rotating node waits for all of its
+	// peers to accept its next key before switching to it.
+	AcceptedNextPublicKeys   []string `json:"acceptedNextPublicKeys,omitempty"`
+	AcceptedNextPublicKeysV6 []string `json:"acceptedNextPublicKeysV6,omitempty"`
 }
 
 type NodeKey struct {
@@ -116,11 +116,21 @@ func init() {
 		"kubernetesbaselineadminnetworkpolicies",
 		reflect.TypeOf(apiv3.GlobalNetworkPolicy{}),
 	)
+	registerResourceInfo(
+		KindKubernetesClusterNetworkPolicy,
+		"kubernetesclusternetworkpolicies",
+		reflect.TypeOf(apiv3.GlobalNetworkPolicy{}),
+	)
 	registerResourceInfo(
 		apiv3.KindIPPool,
 		"ippools",
 		reflect.TypeOf(apiv3.IPPool{}),
 	)
+	registerResourceInfo(
+		apiv3.KindIPPoolMigration,
+		"ippoolmigrations",
+		reflect.TypeOf(apiv3.IPPoolMigration{}),
+	)
 	registerResourceInfo(
 		apiv3.KindIPReservation,
 		"ipreservations",
@@ -156,6 +166,11 @@ func init() {
 		"networksets",
 		reflect.TypeOf(apiv3.NetworkSet{}),
 	)
+	registerResourceInfo(
+		apiv3.KindPacketCapture,
+		"packetcaptures",
+		reflect.TypeOf(apiv3.PacketCapture{}),
+	)
 	registerResourceInfo(
 		apiv3.KindTier,
 		"tiers",
@@ -83,6 +83,9 @@ type Rule struct {
 
 	// These fields allow us to pass through application layer selectors from the V3 datamodel.
 	HTTPMatch *HTTPMatch `json:"http,omitempty" validate:"omitempty"`
+	GRPCMatch *GRPCMatch `json:"grpc,omitempty" validate:"omitempty"`
+
+	Limit *RuleLimit `json:"limit,omitempty" validate:"omitempty"`
 
 	LogPrefix string `json:"log_prefix,omitempty" validate:"omitempty"`
 
@@ -90,8 +93,24 @@ type Rule struct {
 }
 
 type HTTPMatch struct {
-	Methods []string         `json:"methods,omitempty" validate:"omitempty"`
-	Paths   []apiv3.HTTPPath `json:"paths,omitempty" validate:"omitempty"`
+	Methods     []string                    `json:"methods,omitempty" validate:"omitempty"`
+	Paths       []apiv3.HTTPPath            `json:"paths,omitempty" validate:"omitempty"`
+	Hosts       []string                    `json:"hosts,omitempty" validate:"omitempty"`
+	Headers     []apiv3.HTTPHeaderMatch     `json:"headers,omitempty" validate:"omitempty"`
+	QueryParams []apiv3.HTTPQueryParamMatch `json:"query_params,omitempty" validate:"omitempty"`
+	JWT         *apiv3.HTTPJWTMatch         `json:"jwt,omitempty" validate:"omitempty"`
+}
+
+type GRPCMatch struct {
+	Services []string `json:"services,omitempty" validate:"omitempty"`
+	Methods  []string `json:"methods,omitempty" validate:"omitempty"`
+}
+
+type RuleLimit struct {
+	NewConnectionsPerSecond *uint32 `json:"new_connections_per_second,omitempty" validate:"omitempty"`
+	Burst                   *uint32 `json:"burst,omitempty" validate:"omitempty"`
+	MaxConnections          *uint32 `json:"max_connections,omitempty" validate:"omitempty"`
+	Scope                   string  `json:"scope,omitempty" validate:"omitempty"`
 }
 
 type RuleMetadata struct {
@@ -256,6 +275,30 @@ func (r Rule) String() string {
 			if len(r.HTTPMatch.Paths) > 0 {
 				toParts = append(toParts, "httpPaths", fmt.Sprintf("%+v", r.HTTPMatch.Paths))
 			}
+			if len(r.HTTPMatch.Hosts) > 0 {
+				toParts = append(toParts, "httpHosts", fmt.Sprintf("%+v", r.HTTPMatch.Hosts))
+			}
+			if len(r.HTTPMatch.Headers) > 0 {
+				toParts = append(toParts, "httpHeaders", fmt.Sprintf("%+v", r.HTTPMatch.Headers))
+			}
+			if len(r.HTTPMatch.QueryParams) > 0 {
+				toParts = append(toParts, "httpQueryParams", fmt.Sprintf("%+v", r.HTTPMatch.QueryParams))
+			}
+			if r.HTTPMatch.JWT != nil {
+				// The key set is too long to be useful here.
+				toParts = append(toParts, "httpJWT", fmt.Sprintf("{Issuer:%s Audiences:%v Claims:%+v Algorithms:%v}",
+					r.HTTPMatch.JWT.Issuer, r.HTTPMatch.JWT.Audiences, r.HTTPMatch.JWT.Claims, r.HTTPMatch.JWT.Algorithms))
+			}
+		}
+
+		// GRPCMatch are destination rules.
+		if r.GRPCMatch != nil {
+			if len(r.GRPCMatch.Services) > 0 {
+				toParts = append(toParts, "grpcServices", fmt.Sprintf("%+v", r.GRPCMatch.Services))
+			}
+			if len(r.GRPCMatch.Methods) > 0 {
+				toParts = append(toParts, "grpcMethods", fmt.Sprintf("%+v", r.GRPCMatch.Methods))
+			}
 		}
 
 		if len(toParts) > 0 {
@@ -264,5 +307,20 @@ func (r Rule) String() string {
 		}
 	}
 
+	if r.Limit != nil {
+		if r.Limit.NewConnectionsPerSecond != nil {
+			parts = append(parts, "limitRate", strconv.Itoa(int(*r.Limit.NewConnectionsPerSecond)))
+		}
+		if r.Limit.Burst != nil {
+			parts = append(parts, "limitBurst", strconv.Itoa(int(*r.Limit.Burst)))
+		}
+		if r.Limit.MaxConnections != nil {
+			parts = append(parts, "limitConnections", strconv.Itoa(int(*r.Limit.MaxConnections)))
+		}
+		if r.Limit.Scope != "" {
+			parts = append(parts, "limitScope", r.Limit.Scope)
+		}
+	}
+
 	return strings.Join(parts, " ")
 }
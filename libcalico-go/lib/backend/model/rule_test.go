@@ -49,6 +49,16 @@ var ports2 = []numorstring.Port{
 var _, cidr, _ = net.ParseCIDR("10.0.0.0/16")
 var httpMethod = &model.HTTPMatch{Methods: []string{"GET", "PUT"}}
 var httpPath = &model.HTTPMatch{Paths: []apiv3.HTTPPath{{Exact: "/foo"}, {Prefix: "/bar"}}}
+var httpHost = &model.HTTPMatch{Hosts: []string{"*.example.com"}}
+var httpHeader = &model.HTTPMatch{Headers: []apiv3.HTTPHeaderMatch{{Name: "x-user", Prefix: "admin-"}}}
+var grpcMatch = &model.GRPCMatch{Services: []string{"helloworld.Greeter"}, Methods: []string{"SayHello"}}
+var limitRate, limitBurst, limitConns = uint32(100), uint32(10), uint32(50)
+var httpJWT = &model.HTTPMatch{JWT: &apiv3.HTTPJWTMatch{
+	JWKS:       `{"keys": []}`,
+	Issuer:     "https://issuer.example.com",
+	Audiences:  []string{"orders"},
+	Algorithms: []string{"ES256"},
+}}
 
 var ruleStringTests = []ruleTest{
 	// Empty
@@ -97,7 +107,13 @@ var ruleStringTests = []ruleTest{
 
 	// Application layer rules.
 	{model.Rule{HTTPMatch: httpMethod}, "Allow to httpMethods [GET PUT]"},
-	{model.Rule{HTTPMatch: httpPath}, "Allow to httpPaths [{Exact:/foo Prefix:} {Exact: Prefix:/bar}]"},
+	{model.Rule{HTTPMatch: httpPath}, "Allow to httpPaths [{Exact:/foo Prefix: Regex:} {Exact: Prefix:/bar Regex:}]"},
+	{model.Rule{HTTPMatch: httpHost}, "Allow to httpHosts [*.example.com]"},
+	{model.Rule{HTTPMatch: httpHeader}, "Allow to httpHeaders [{Name:x-user Exact: Prefix:admin- Regex: Present:<nil>}]"},
+	{model.Rule{HTTPMatch: httpJWT}, "Allow to httpJWT {Issuer:https://issuer.example.com Audiences:[orders] Claims:[] Algorithms:[ES256]}"},
+	{model.Rule{GRPCMatch: grpcMatch}, "Allow to grpcServices [helloworld.Greeter] grpcMethods [SayHello]"},
+	{model.Rule{Limit: &model.RuleLimit{NewConnectionsPerSecond: &limitRate, Burst: &limitBurst}}, "Allow limitRate 100 limitBurst 10"},
+	{model.Rule{Limit: &model.RuleLimit{MaxConnections: &limitConns, Scope: "PerRule"}}, "Allow limitConnections 50 limitScope PerRule"},
 
 	// Complex rule.
 	{model.Rule{Protocol: &tcpProto,
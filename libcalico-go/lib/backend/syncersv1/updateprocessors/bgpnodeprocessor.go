@@ -51,7 +51,7 @@ func (c *bgpNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair, er
 
 	// Extract the separate bits of BGP config - these are stored as separate keys in the
 	// v1 model.  For a delete these will all be nil.
-	var asNum, ipv4, netv4, ipv6, netv6, rrClusterID interface{}
+	var asNum, ipv4, netv4, ipv6, netv6, rrClusterID, gracefulShutdown interface{}
 	var node *libapiv3.Node
 	var ok bool
 	if kvp.Value != nil {
@@ -91,6 +91,9 @@ func (c *bgpNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair, er
 				asNum = bgp.ASNumber.String()
 			}
 			rrClusterID = bgp.RouteReflectorClusterID
+			if bgp.GracefulShutdown != "" {
+				gracefulShutdown = string(bgp.GracefulShutdown)
+			}
 		}
 	}
 
@@ -143,6 +146,14 @@ func (c *bgpNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair, er
 			Value:    rrClusterID,
 			Revision: kvp.Revision,
 		},
+		{
+			Key: model.NodeBGPConfigKey{
+				Nodename: name,
+				Name:     "graceful_shutdown",
+			},
+			Value:    gracefulShutdown,
+			Revision: kvp.Revision,
+		},
 	}
 
 	if c.usePodCIDR {
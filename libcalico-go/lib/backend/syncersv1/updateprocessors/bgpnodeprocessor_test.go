@@ -35,7 +35,7 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 		Kind: libapiv3.KindNode,
 		Name: "bgpnode1",
 	}
-	numBgpConfigs := 6
+	numBgpConfigs := 7
 	up := updateprocessors.NewBGPNodeUpdateProcessor(false)
 
 	BeforeEach(func() {
@@ -50,12 +50,13 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 		res := libapiv3.NewNode()
 		res.Name = "bgpnode1"
 		expected := map[string]interface{}{
-			"ip_addr_v4":    "",
-			"ip_addr_v6":    "",
-			"network_v4":    nil,
-			"network_v6":    nil,
-			"as_num":        nil,
-			"rr_cluster_id": "",
+			"ip_addr_v4":        "",
+			"ip_addr_v6":        "",
+			"network_v4":        nil,
+			"network_v6":        nil,
+			"as_num":            nil,
+			"rr_cluster_id":     "",
+			"graceful_shutdown": nil,
 		}
 		kvps, err := up.Process(&model.KVPair{
 			Key:   v3NodeKey1,
@@ -93,12 +94,13 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 			IPv4Address: "1.2.3.4",
 		}
 		expected = map[string]interface{}{
-			"ip_addr_v4":    "1.2.3.4",
-			"ip_addr_v6":    "",
-			"network_v4":    "1.2.3.4/32",
-			"network_v6":    nil,
-			"as_num":        nil,
-			"rr_cluster_id": "",
+			"ip_addr_v4":        "1.2.3.4",
+			"ip_addr_v6":        "",
+			"network_v4":        "1.2.3.4/32",
+			"network_v6":        nil,
+			"as_num":            nil,
+			"rr_cluster_id":     "",
+			"graceful_shutdown": nil,
 		}
 		kvps, err = up.Process(&model.KVPair{
 			Key:   v3NodeKey1,
@@ -119,12 +121,13 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 			IPv6Address: "aa:bb:cc::",
 		}
 		expected = map[string]interface{}{
-			"ip_addr_v4":    "",
-			"ip_addr_v6":    "aa:bb:cc::",
-			"network_v4":    nil,
-			"network_v6":    "aa:bb:cc::/128",
-			"as_num":        nil,
-			"rr_cluster_id": "",
+			"ip_addr_v4":        "",
+			"ip_addr_v6":        "aa:bb:cc::",
+			"network_v4":        nil,
+			"network_v6":        "aa:bb:cc::/128",
+			"as_num":            nil,
+			"rr_cluster_id":     "",
+			"graceful_shutdown": nil,
 		}
 		kvps, err = up.Process(&model.KVPair{
 			Key:   v3NodeKey1,
@@ -148,12 +151,13 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 			ASNumber:    &asn,
 		}
 		expected = map[string]interface{}{
-			"ip_addr_v4":    "1.2.3.4",
-			"ip_addr_v6":    "aa:bb:cc::ffff",
-			"network_v4":    "1.2.3.0/24",
-			"network_v6":    "aa:bb:cc::ff00/120",
-			"as_num":        "12345",
-			"rr_cluster_id": "",
+			"ip_addr_v4":        "1.2.3.4",
+			"ip_addr_v6":        "aa:bb:cc::ffff",
+			"network_v4":        "1.2.3.0/24",
+			"network_v6":        "aa:bb:cc::ff00/120",
+			"as_num":            "12345",
+			"rr_cluster_id":     "",
+			"graceful_shutdown": nil,
 		}
 		kvps, err = up.Process(&model.KVPair{
 			Key:   v3NodeKey1,
@@ -205,12 +209,13 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 		})
 		// IPv4 address should be blank, network should be nil (deleted)
 		expected := map[string]interface{}{
-			"ip_addr_v4":    "",
-			"ip_addr_v6":    "aa:bb:cc::ffff",
-			"network_v4":    nil,
-			"network_v6":    "aa:bb:cc::ff00/120",
-			"as_num":        "12345",
-			"rr_cluster_id": "",
+			"ip_addr_v4":        "",
+			"ip_addr_v6":        "aa:bb:cc::ffff",
+			"network_v4":        nil,
+			"network_v6":        "aa:bb:cc::ff00/120",
+			"as_num":            "12345",
+			"rr_cluster_id":     "",
+			"graceful_shutdown": nil,
 		}
 		Expect(err).To(HaveOccurred())
 		checkExpectedConfigs(
@@ -233,12 +238,13 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 		})
 		// IPv6 address should be blank, network should be nil (deleted)
 		expected = map[string]interface{}{
-			"ip_addr_v4":    "1.2.3.4",
-			"ip_addr_v6":    "",
-			"network_v4":    "1.2.3.0/24",
-			"network_v6":    nil,
-			"as_num":        nil,
-			"rr_cluster_id": "",
+			"ip_addr_v4":        "1.2.3.4",
+			"ip_addr_v6":        "",
+			"network_v4":        "1.2.3.0/24",
+			"network_v6":        nil,
+			"as_num":            nil,
+			"rr_cluster_id":     "",
+			"graceful_shutdown": nil,
 		}
 		Expect(err).To(HaveOccurred())
 		checkExpectedConfigs(
@@ -257,12 +263,42 @@ var _ = Describe("Test the (BGP) Node update processor", func() {
 			RouteReflectorClusterID: "255.0.0.1",
 		}
 		expected := map[string]interface{}{
-			"ip_addr_v4":    "172.17.0.2",
-			"ip_addr_v6":    "",
-			"network_v4":    "172.17.0.0/24",
-			"network_v6":    nil,
-			"as_num":        nil,
-			"rr_cluster_id": "255.0.0.1",
+			"ip_addr_v4":        "172.17.0.2",
+			"ip_addr_v6":        "",
+			"network_v4":        "172.17.0.0/24",
+			"network_v6":        nil,
+			"as_num":            nil,
+			"rr_cluster_id":     "255.0.0.1",
+			"graceful_shutdown": nil,
+		}
+		kvps, err := up.Process(&model.KVPair{
+			Key:   v3NodeKey1,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeBgpConfig,
+			numBgpConfigs,
+			expected,
+		)
+	})
+
+	It("should handle the graceful shutdown field", func() {
+		res := libapiv3.NewNode()
+		res.Name = "bgpnode1"
+		res.Spec.BGP = &libapiv3.NodeBGPSpec{
+			IPv4Address:      "172.17.0.2/24",
+			GracefulShutdown: libapiv3.BGPGracefulShutdownSignal,
+		}
+		expected := map[string]interface{}{
+			"ip_addr_v4":        "172.17.0.2",
+			"ip_addr_v6":        "",
+			"network_v4":        "172.17.0.0/24",
+			"network_v6":        nil,
+			"as_num":            nil,
+			"rr_cluster_id":     "",
+			"graceful_shutdown": "Signal",
 		}
 		kvps, err := up.Process(&model.KVPair{
 			Key:   v3NodeKey1,
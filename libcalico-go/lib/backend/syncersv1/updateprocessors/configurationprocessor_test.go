@@ -43,7 +43,7 @@ const (
 )
 
 const (
-	numBaseFelixConfigs = 163
+	numBaseFelixConfigs = 172
 )
 
 var _ = Describe("Test the generic configuration update processor and the concrete implementations", func() {
@@ -284,6 +284,33 @@ var _ = Describe("Test the generic configuration update processor and the concre
 		)
 	})
 
+	It("should handle HostEndpointTemplates", func() {
+		cc := updateprocessors.NewFelixConfigUpdateProcessor()
+		By("converting a per-node felix KVPair with templates and checking they're converted to JSON")
+		res := apiv3.NewFelixConfiguration()
+		res.Spec.HostEndpointTemplates = []apiv3.HostEndpointTemplate{
+			{
+				GenerateName:     "uplink",
+				InterfacePattern: "eth[0-9]+",
+				Labels:           map[string]string{"role": "uplink"},
+			},
+		}
+		expected := map[string]interface{}{
+			"HostEndpointTemplates": `[{"generateName":"uplink","interfacePattern":"eth[0-9]+","labels":{"role":"uplink"}}]`,
+		}
+		kvps, err := cc.Process(&model.KVPair{
+			Key:   perNodeFelixKey,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeFelixConfig,
+			numFelixConfigs,
+			expected,
+		)
+	})
+
 	It("should handle cluster config string slice field", func() {
 		cc := updateprocessors.NewClusterInfoUpdateProcessor()
 		By("converting a global cluster info KVPair with values assigned")
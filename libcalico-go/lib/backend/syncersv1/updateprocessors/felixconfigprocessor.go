@@ -15,6 +15,7 @@
 package updateprocessors
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -42,6 +43,7 @@ func NewFelixConfigUpdateProcessor() watchersyncer.SyncerUpdateProcessor {
 			"RouteTableRanges":          routeTableRangeListToString,
 			"HealthTimeoutOverrides":    healthTimeoutOverridesToString,
 			"BPFConntrackTimeouts":      bpfConntrackTimeoutsToString,
+			"HostEndpointTemplates":     hostEndpointTemplatesToString,
 		},
 	)
 }
@@ -101,6 +103,20 @@ func healthTimeoutOverridesToString(value interface{}) interface{} {
 	return strings.Join(parts, ",")
 }
 
+// Converts the HostEndpoint templates to the JSON representation expected by Felix.
+func hostEndpointTemplatesToString(value interface{}) interface{} {
+	templates := value.([]apiv3.HostEndpointTemplate)
+	if len(templates) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(templates)
+	if err != nil {
+		log.WithError(err).Error("Unable to marshal HostEndpointTemplates to sync FelixConfiguration data in v1 format")
+		return nil
+	}
+	return string(b)
+}
+
 func structToKeyValueString(input interface{}) (string, error) {
 	// Get the type and value of the input struct
 	v := reflect.ValueOf(input)
@@ -208,12 +208,38 @@ func (c *FelixNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair,
 		// If either of interface address or public-key is set, set the WireguardKey value.
 		// If we failed to parse both the values, leave the WireguardKey value empty.
 		if wgIfaceIpv4Addr != nil || wgPubKey != "" || wgIfaceIpv6Addr != nil || wgPubKeyV6 != "" {
-			wgConfig = &model.Wireguard{
+			wgValue := &model.Wireguard{
 				InterfaceIPv4Addr: wgIfaceIpv4Addr,
 				PublicKey:         wgPubKey,
 				InterfaceIPv6Addr: wgIfaceIpv6Addr,
 				PublicKeyV6:       wgPubKeyV6,
 			}
+
+			// Include the key rotation state.  An invalid next key is ignored; the node will keep using its current
+			// key until the next key is valid.
+			if ts := node.Status.WireguardPublicKeyTimestamp; ts != nil {
+				wgValue.PublicKeyTimestamp = ts.Time
+			}
+			if ts := node.Status.WireguardPublicKeyTimestampV6; ts != nil {
+				wgValue.PublicKeyTimestampV6 = ts.Time
+			}
+			if nextKey := node.Status.WireguardNextPublicKey; nextKey != "" {
+				if _, err := wg.ParseKey(nextKey); err == nil {
+					wgValue.NextPublicKey = nextKey
+				} else {
+					log.WithField("WireguardNextPublicKey", nextKey).Warn("Failed to parse IPv4 Wireguard next public-key")
+				}
+			}
+			if nextKey := node.Status.WireguardNextPublicKeyV6; nextKey != "" {
+				if _, err := wg.ParseKey(nextKey); err == nil {
+					wgValue.NextPublicKeyV6 = nextKey
+				} else {
+					log.WithField("WireguardNextPublicKeyV6", nextKey).Warn("Failed to parse IPv6 Wireguard next public-key")
+				}
+			}
+			wgValue.AcceptedNextPublicKeys = validWireguardKeys(node.Status.WireguardAcceptedNextPublicKeys)
+			wgValue.AcceptedNextPublicKeysV6 = validWireguardKeys(node.Status.WireguardAcceptedNextPublicKeysV6)
+			wgConfig = wgValue
 		}
 	}
 
@@ -343,3 +369,16 @@ func (c *FelixNodeUpdateProcessor) extractName(k model.Key) (string, error) {
 	}
 	return rk.Name, nil
 }
+
+// validWireguardKeys returns the keys that are valid Wireguard public keys, ignoring any that are not.
+func validWireguardKeys(keys []string) []string {
+	var valid []string
+	for _, key := range keys {
+		if _, err := wg.ParseKey(key); err != nil {
+			log.WithField("key", key).Warn("Failed to parse accepted Wireguard next public-key")
+			continue
+		}
+		valid = append(valid, key)
+	}
+	return valid
+}
@@ -18,10 +18,12 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
@@ -205,6 +207,42 @@ var _ = Describe("Test the (Felix) Node update processor", func() {
 			expected,
 		)
 
+		By("converting a Node with a Wireguard key rotation in progress")
+		res = libapiv3.NewNode()
+		res.Name = "mynode"
+		nextKey := "eTpcTxlHUxsGF48HPx6FZSDaWkKvaNeMHkhG3mODCSM="
+		acceptedKey := "Hn4D2ZbDQYHSNSPJUSzQrRjkW1fUpNqhX4m9vRaLfkg="
+		keyTime := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+		res.Status = libapiv3.NodeStatus{
+			WireguardPublicKey:          key,
+			WireguardPublicKeyTimestamp: &keyTime,
+			WireguardNextPublicKey:      nextKey,
+			WireguardNextPublicKeyV6:    "not-a-key",
+			WireguardAcceptedNextPublicKeys: []string{
+				"not-a-key", acceptedKey,
+			},
+		}
+		expected = map[string]interface{}{
+			nodeMarker: res,
+			wireguardMarker: &model.Wireguard{
+				PublicKey:              key,
+				PublicKeyTimestamp:     keyTime.Time,
+				NextPublicKey:          nextKey,
+				AcceptedNextPublicKeys: []string{acceptedKey},
+			},
+		}
+		kvps, err = up.Process(&model.KVPair{
+			Key:   v3NodeKey1,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeFelixConfig,
+			numFelixConfigs,
+			expected,
+		)
+
 		By("converting a Node with IPv4 and IPv6 networks and no other config")
 		res = libapiv3.NewNode()
 		res.Name = "mynode"
@@ -202,7 +202,28 @@ func RuleAPIV3ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		OriginalDstServiceAccountSelector: dstServiceAcctMatch.Selector,
 	}
 	if ar.HTTP != nil {
-		r.HTTPMatch = &model.HTTPMatch{Methods: ar.HTTP.Methods, Paths: ar.HTTP.Paths}
+		r.HTTPMatch = &model.HTTPMatch{
+			Methods:     ar.HTTP.Methods,
+			Paths:       ar.HTTP.Paths,
+			Hosts:       ar.HTTP.Hosts,
+			Headers:     ar.HTTP.Headers,
+			QueryParams: ar.HTTP.QueryParams,
+			JWT:         ar.HTTP.JWT,
+		}
+	}
+	if ar.GRPC != nil {
+		r.GRPCMatch = &model.GRPCMatch{
+			Services: ar.GRPC.Services,
+			Methods:  ar.GRPC.Methods,
+		}
+	}
+	if ar.Limit != nil {
+		r.Limit = &model.RuleLimit{
+			NewConnectionsPerSecond: ar.Limit.NewConnectionsPerSecond,
+			Burst:                   ar.Limit.Burst,
+			MaxConnections:          ar.Limit.MaxConnections,
+			Scope:                   string(ar.Limit.Scope),
+		}
 	}
 	if ar.Metadata != nil {
 		if ar.Metadata.Annotations != nil {
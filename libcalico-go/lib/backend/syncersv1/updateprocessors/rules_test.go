@@ -23,6 +23,7 @@ import (
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 )
@@ -39,6 +40,7 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 		inproto := numorstring.ProtocolFromString("UDP")
 		port80 := numorstring.SinglePort(uint16(80))
 		port443 := numorstring.SinglePort(uint16(443))
+		limitRate := uint32(100)
 		irule := apiv3.Rule{
 			Action:    apiv3.Allow,
 			IPVersion: &v4,
@@ -72,6 +74,14 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 				Methods: []string{"GET", "PUT"},
 				Paths:   []apiv3.HTTPPath{{Exact: "/bar"}, {Prefix: "/foo1"}},
 			},
+			GRPC: &apiv3.GRPCMatch{
+				Services: []string{"helloworld.Greeter"},
+				Methods:  []string{"SayHello"},
+			},
+			Limit: &apiv3.RuleLimit{
+				NewConnectionsPerSecond: &limitRate,
+				Scope:                   apiv3.RuleLimitScopePerRule,
+			},
 			Metadata: &apiv3.RuleMetadata{
 				Annotations: map[string]string{"fizz": "buzz"}},
 		}
@@ -120,6 +130,9 @@ var _ = Describe("Test the Rules Conversion Functions", func() {
 
 		Expect(rulev1.HTTPMatch.Methods).To(Equal([]string{"GET", "PUT"}))
 		Expect(rulev1.HTTPMatch.Paths).To(Equal([]apiv3.HTTPPath{{Exact: "/bar"}, {Prefix: "/foo1"}}))
+		Expect(rulev1.GRPCMatch.Services).To(Equal([]string{"helloworld.Greeter"}))
+		Expect(rulev1.GRPCMatch.Methods).To(Equal([]string{"SayHello"}))
+		Expect(rulev1.Limit).To(Equal(&model.RuleLimit{NewConnectionsPerSecond: &limitRate, Scope: "PerRule"}))
 
 		Expect(rulev1.Metadata.Annotations).To(Equal(map[string]string{"fizz": "buzz"}))
 
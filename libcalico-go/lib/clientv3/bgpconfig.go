@@ -165,6 +165,20 @@ func (r bgpConfigurations) ValidateDefaultOnlyFields(res *apiv3.BGPConfiguration
 				Reason: "Cannot set nodeMeshMaxRestartTime on a non default BGP Configuration.",
 			})
 		}
+
+		if res.Spec.NodeMeshLongLivedStaleTime != nil {
+			errFields = append(errFields, cerrors.ErroredField{
+				Name:   "BGPConfiguration.Spec.NodeMeshLongLivedStaleTime",
+				Reason: "Cannot set nodeMeshLongLivedStaleTime on a non default BGP Configuration.",
+			})
+		}
+
+		if res.Spec.BFD != nil && res.Spec.BFD.Enabled != nil {
+			errFields = append(errFields, cerrors.ErroredField{
+				Name:   "BGPConfiguration.Spec.BFD.Enabled",
+				Reason: "Cannot set bfd.enabled on a non default BGP Configuration.",
+			})
+		}
 	}
 
 	if len(errFields) > 0 {
@@ -129,6 +129,16 @@ func (c client) NetworkSets() NetworkSetInterface {
 	return networkSets{client: c}
 }
 
+// PacketCaptures returns an interface for managing packet capture resources.
+func (c client) PacketCaptures() PacketCaptureInterface {
+	return packetCaptures{client: c}
+}
+
+// IPPoolMigrations returns an interface for managing IP pool migration resources.
+func (c client) IPPoolMigrations() IPPoolMigrationInterface {
+	return ipPoolMigrations{client: c}
+}
+
 // HostEndpoints returns an interface for managing host endpoint resources.
 func (c client) HostEndpoints() HostEndpointInterface {
 	return hostEndpoints{client: c}
@@ -29,6 +29,8 @@ type Interface interface {
 	ProfilesClient
 	GlobalNetworkSetsClient
 	NetworkSetsClient
+	PacketCapturesClient
+	IPPoolMigrationsClient
 	HostEndpointsClient
 	WorkloadEndpointsClient
 	BGPPeersClient
@@ -103,6 +105,16 @@ type NetworkSetsClient interface {
 	NetworkSets() NetworkSetInterface
 }
 
+type PacketCapturesClient interface {
+	// PacketCaptures returns an interface for managing packet capture resources.
+	PacketCaptures() PacketCaptureInterface
+}
+
+type IPPoolMigrationsClient interface {
+	// IPPoolMigrations returns an interface for managing IP pool migration resources.
+	IPPoolMigrations() IPPoolMigrationInterface
+}
+
 type HostEndpointsClient interface {
 	// HostEndpoints returns an interface for managing host endpoint resources.
 	HostEndpoints() HostEndpointInterface
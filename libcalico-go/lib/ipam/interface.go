@@ -56,6 +56,11 @@ type Interface interface {
 	// are assigned with the given handle.
 	ReleaseByHandle(ctx context.Context, handleID string) error
 
+	// HandlesByNode returns the IDs of all handles that have at least one
+	// IP address assigned with the given node recorded in its attributes,
+	// in the blocks that are affine to the node.
+	HandlesByNode(ctx context.Context, node string) ([]string, error)
+
 	// ClaimAffinity claims affinity to the given host for all blocks
 	// within the given CIDR.  The given CIDR must fall within a configured
 	// pool. If an empty string is passed as the host, then the value returned by os.Hostname is used.
@@ -76,8 +81,9 @@ type Interface interface {
 	ReleaseHostAffinities(ctx context.Context, affinityCfg AffinityConfig, mustBeEmpty bool) error
 
 	// ReleasePoolAffinities releases affinity for all blocks within
-	// the specified pool across all hosts.
-	ReleasePoolAffinities(ctx context.Context, pool cnet.IPNet) error
+	// the specified pool across all hosts.  If mustBeEmpty is true, blocks
+	// that have addresses allocated keep their affinity.
+	ReleasePoolAffinities(ctx context.Context, pool cnet.IPNet, mustBeEmpty bool) error
 
 	// ReleaseBlockAffinity releases the affinity of the exact block provided.
 	ReleaseBlockAffinity(ctx context.Context, block *model.AllocationBlock, mustBeEmpty bool) error
@@ -1479,8 +1479,8 @@ func (c ipamClient) ReleaseHostAffinities(ctx context.Context, affinityCfg Affin
 
 // ReleasePoolAffinities releases affinity for all blocks within
 // the specified pool across all hosts.
-func (c ipamClient) ReleasePoolAffinities(ctx context.Context, pool net.IPNet) error {
-	log.Infof("Releasing block affinities within pool '%s'", pool.String())
+func (c ipamClient) ReleasePoolAffinities(ctx context.Context, pool net.IPNet, mustBeEmpty bool) error {
+	log.Infof("Releasing block affinities within pool '%s'. MustBeEmpty? %v", pool.String(), mustBeEmpty)
 	for i := 0; i < ipamKeyErrRetries; i++ {
 		retry := false
 		pairs, err := c.affinityConfigsByBlocks(ctx, pool)
@@ -1497,10 +1497,12 @@ func (c ipamClient) ReleasePoolAffinities(ctx context.Context, pool net.IPNet) e
 			_, blockCIDR, _ := net.ParseCIDR(blockString)
 			logCtx := log.WithField("cidr", blockCIDR)
 			for i := 0; i < datastoreRetries; i++ {
-				err = c.blockReaderWriter.releaseBlockAffinity(ctx, affinityCfg, *blockCIDR, false)
+				err = c.blockReaderWriter.releaseBlockAffinity(ctx, affinityCfg, *blockCIDR, mustBeEmpty)
 				if err != nil {
 					if _, ok := err.(errBlockClaimConflict); ok {
 						retry = true
+					} else if _, ok := err.(errBlockNotEmpty); ok {
+						logCtx.Debugf("Block isn't empty, keeping its affinity")
 					} else if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
 						logCtx.Debugf("No such block")
 						break
@@ -1638,6 +1640,37 @@ func (c ipamClient) IPsByHandle(ctx context.Context, handleID string) ([]net.IP,
 	return assignments, nil
 }
 
+// HandlesByNode returns the IDs of all handles that have at least one
+// IP address assigned with the given node recorded in its attributes.
+// Only the blocks that are affine to the node are read, so addresses that
+// the node borrowed from other nodes' blocks are not included.
+func (c ipamClient) HandlesByNode(ctx context.Context, node string) ([]string, error) {
+	affinityCfg := AffinityConfig{AffinityType: AffinityTypeHost, Host: node}
+	handles := set.New[string]()
+	for _, version := range []int{4, 6} {
+		blockCIDRs, err := c.blockReaderWriter.getAffineBlocks(ctx, affinityCfg, version)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blockCIDR := range blockCIDRs {
+			obj, err := c.blockReaderWriter.queryBlock(ctx, blockCIDR, "")
+			if err != nil {
+				if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+					// The affinity has been claimed but the block not yet created.
+					continue
+				}
+				return nil, err
+			}
+			b := allocationBlock{obj.Value.(*model.AllocationBlock)}
+			for _, handleID := range b.handlesByNode(node) {
+				handles.Add(handleID)
+			}
+		}
+	}
+	return handles.Slice(), nil
+}
+
 // ReleaseByHandle releases all IP addresses that have been assigned
 // using the provided handle.
 func (c ipamClient) ReleaseByHandle(ctx context.Context, handleID string) error {
@@ -498,6 +498,23 @@ func (b allocationBlock) ipsByHandle(handleID string) []cnet.IP {
 	return ips
 }
 
+// handlesByNode returns the handles of all allocations in the block whose
+// attributes record the given node.
+func (b allocationBlock) handlesByNode(node string) []string {
+	var handles []string
+	for _, attrIdx := range b.Allocations {
+		if attrIdx == nil || *attrIdx >= len(b.Attributes) {
+			continue
+		}
+		attr := b.Attributes[*attrIdx]
+		if attr.AttrPrimary == nil || attr.AttrSecondary[AttributeNode] != node {
+			continue
+		}
+		handles = append(handles, sanitizeHandle(*attr.AttrPrimary))
+	}
+	return handles
+}
+
 func (b allocationBlock) attributesForIP(ip cnet.IP) (map[string]string, error) {
 	// Convert to an ordinal.
 	ordinal, err := b.IPToOrdinal(ip)
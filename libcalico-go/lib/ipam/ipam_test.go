@@ -1090,6 +1090,49 @@ var _ = testutils.E2eDatastoreDescribe("IPAM tests", testutils.DatastoreAll, fun
 				Expect(err).To(HaveOccurred())
 			})
 		})
+
+		It("should list the handles with addresses assigned to a node", func() {
+			By("creating a node", func() {
+				applyNode(bc, kc, "test-host", nil)
+			})
+
+			By("setting up an IP pool", func() {
+				deleteAllPools()
+				applyPool("10.0.0.0/24", true, "")
+			})
+
+			ctx := context.Background()
+			for _, h := range []struct{ handle, node string }{
+				{"handle-a", "test-host"},
+				{"handle-b", "test-host"},
+				{"handle-c", "other-host"},
+			} {
+				handle := h.handle
+				args := AutoAssignArgs{
+					Num4:        1,
+					HandleID:    &handle,
+					Attrs:       map[string]string{AttributeNode: h.node},
+					Hostname:    "test-host",
+					IntendedUse: v3.IPPoolAllowedUseWorkload,
+				}
+				_, _, err := ic.AutoAssign(ctx, args)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			handles, err := ic.HandlesByNode(ctx, "test-host")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handles).To(ConsistOf("handle-a", "handle-b"))
+
+			// Only the blocks affine to the node are searched.
+			handles, err = ic.HandlesByNode(ctx, "other-host")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handles).To(BeEmpty())
+
+			Expect(ic.ReleaseByHandle(ctx, "handle-a")).To(Succeed())
+			handles, err = ic.HandlesByNode(ctx, "test-host")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handles).To(ConsistOf("handle-b"))
+		})
 	})
 
 	Describe("IPAM IP borrowing", func() {
@@ -0,0 +1,97 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+)
+
+// ErrNotInSync is returned when linting with a Cache that hasn't yet loaded the datastore
+// state.
+var ErrNotInSync = errors.New("lint cache is not in sync with the datastore")
+
+// Cache holds the datastore state that the lint checks need, kept up to date by watching the
+// datastore, so that linting a resource doesn't read from the datastore.
+type Cache struct {
+	syncer bapi.Syncer
+
+	lock      sync.Mutex
+	inSync    bool
+	resources map[string]interface{}
+}
+
+// NewCache returns a Cache that watches the datastore using the given backend client.
+func NewCache(client bapi.Client) *Cache {
+	c := &Cache{resources: map[string]interface{}{}}
+	var resourceTypes []watchersyncer.ResourceType
+	for _, kind := range resourceKinds(isKDD(client)) {
+		resourceTypes = append(resourceTypes, watchersyncer.ResourceType{
+			ListInterface: model.ResourceListOptions{Kind: kind},
+		})
+	}
+	c.syncer = watchersyncer.New(client, resourceTypes, c)
+	return c
+}
+
+// Start starts watching the datastore.
+func (c *Cache) Start() {
+	c.syncer.Start()
+}
+
+// OnStatusUpdated implements the SyncerCallbacks interface.
+func (c *Cache) OnStatusUpdated(status bapi.SyncStatus) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if status == bapi.InSync && !c.inSync {
+		log.Info("Lint cache is in sync")
+		c.inSync = true
+	}
+}
+
+// OnUpdates implements the SyncerCallbacks interface.
+func (c *Cache) OnUpdates(updates []bapi.Update) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, u := range updates {
+		key := u.Key.String()
+		if u.Value == nil {
+			delete(c.resources, key)
+		} else {
+			c.resources[key] = u.Value
+		}
+	}
+}
+
+// snapshot builds a snapshot from the cached resources.
+func (c *Cache) snapshot(ctx context.Context) (*snapshot, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.inSync {
+		return nil, ErrNotInSync
+	}
+	resources := make([]interface{}, 0, len(c.resources))
+	for _, r := range c.resources {
+		resources = append(resources, r)
+	}
+	return buildSnapshot(resources), nil
+}
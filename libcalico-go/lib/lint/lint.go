@@ -0,0 +1,166 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint checks Calico policy resources against the live contents of the datastore and
+// reports problems that are valid according to the API, but almost certainly not what the user
+// intended: selectors that match nothing, references to named ports or service accounts that
+// don't exist, and rules that can never be reached because of a higher-precedence policy.
+//
+// Lint results are advisory; they are never intended to block a write.
+package lint
+
+import (
+	"context"
+	"fmt"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	clientv3 "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+)
+
+// Code identifies the class of problem reported by a Warning.
+type Code string
+
+const (
+	// CodeNoMatchingEndpoints is reported when a policy's selector does not match any existing
+	// workload or host endpoint.
+	CodeNoMatchingEndpoints Code = "NoMatchingEndpoints"
+	// CodeUnknownLabel is reported when a selector requires a label that is not present on any
+	// resource that the selector could match.
+	CodeUnknownLabel Code = "UnknownLabel"
+	// CodeUnknownNamedPort is reported when a rule references a named port that is not
+	// declared by any endpoint.
+	CodeUnknownNamedPort Code = "UnknownNamedPort"
+	// CodeUnknownServiceAccount is reported when a rule references a service account that
+	// does not exist.
+	CodeUnknownServiceAccount Code = "UnknownServiceAccount"
+	// CodeShadowedRules is reported when a higher-precedence policy allows or denies all
+	// traffic for every endpoint that the policy selects, so its rules are never evaluated.
+	CodeShadowedRules Code = "ShadowedRules"
+)
+
+// Warning is a single problem found in a resource.
+type Warning struct {
+	// Code identifies the class of problem.
+	Code Code `json:"code"`
+	// Field is the path to the offending field within the resource, for example
+	// "spec.ingress[0].destination.selector".  Empty if the warning applies to the whole
+	// resource.
+	Field string `json:"field,omitempty"`
+	// Message is a human-readable description of the problem.
+	Message string `json:"message"`
+}
+
+func (w Warning) String() string {
+	if w.Field == "" {
+		return fmt.Sprintf("%s: %s", w.Code, w.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", w.Code, w.Field, w.Message)
+}
+
+// Linter checks policy resources against the current contents of the datastore.
+type Linter struct {
+	snapshot func(ctx context.Context) (*snapshot, error)
+}
+
+// NewLinter returns a Linter that reads the datastore state using the given client each time
+// it lints a resource.  This suits one-off checks; a long-running process that lints many
+// resources should use NewCachedLinter.
+func NewLinter(client clientv3.Interface) *Linter {
+	return &Linter{snapshot: func(ctx context.Context) (*snapshot, error) {
+		return loadSnapshot(ctx, client)
+	}}
+}
+
+// NewCachedLinter returns a Linter that reads the datastore state from the given cache.  The
+// cache must be started.
+func NewCachedLinter(cache *Cache) *Linter {
+	return &Linter{snapshot: cache.snapshot}
+}
+
+// Lint checks the given resource and returns any warnings.  Only NetworkPolicy,
+// GlobalNetworkPolicy and their staged equivalents are linted; any other resource returns no
+// warnings.  An error is only returned if the datastore state could not be read.
+func (l *Linter) Lint(ctx context.Context, obj runtime.Object) ([]Warning, error) {
+	p, err := policyFromObject(obj)
+	if err != nil || p == nil {
+		// Invalid policies are rejected by validation, so there is nothing useful to add here.
+		return nil, nil
+	}
+	s, err := l.snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.lint(p), nil
+}
+
+type backendClient interface {
+	Backend() bapi.Client
+}
+
+// resourceKinds returns the kinds of resource that the lint checks read.  With the Kubernetes
+// datastore, Kubernetes network policies and admin network policies are read directly; with
+// etcd, kube-controllers copies Kubernetes network policies into Calico network policies.
+func resourceKinds(kdd bool) []string {
+	kinds := []string{
+		apiv3.KindProfile,
+		libapiv3.KindWorkloadEndpoint,
+		apiv3.KindHostEndpoint,
+		apiv3.KindNetworkSet,
+		apiv3.KindGlobalNetworkSet,
+		apiv3.KindTier,
+		apiv3.KindNetworkPolicy,
+		apiv3.KindGlobalNetworkPolicy,
+	}
+	if kdd {
+		kinds = append(kinds,
+			model.KindKubernetesNetworkPolicy,
+			model.KindKubernetesAdminNetworkPolicy,
+			model.KindKubernetesBaselineAdminNetworkPolicy,
+			model.KindKubernetesClusterNetworkPolicy,
+		)
+	}
+	return kinds
+}
+
+func isKDD(c bapi.Client) bool {
+	_, ok := c.(*k8s.KubeClient)
+	return ok
+}
+
+// loadSnapshot reads the resources that the lint checks need from the datastore.
+func loadSnapshot(ctx context.Context, c clientv3.Interface) (*snapshot, error) {
+	bc, ok := c.(backendClient)
+	if !ok {
+		return nil, fmt.Errorf("client does not provide access to the datastore")
+	}
+	backend := bc.Backend()
+
+	var resources []interface{}
+	for _, kind := range resourceKinds(isKDD(backend)) {
+		kvps, err := backend.List(ctx, model.ResourceListOptions{Kind: kind}, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s resources: %w", kind, err)
+		}
+		for _, kvp := range kvps.KVPairs {
+			resources = append(resources, kvp.Value)
+		}
+	}
+	return buildSnapshot(resources), nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"reflect"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+)
+
+// policy is the kind-independent view of a NetworkPolicy or GlobalNetworkPolicy used by the
+// lint checks.
+type policy struct {
+	kind      string
+	namespace string
+	name      string
+	tier      string
+	order     *float64
+
+	selector               string
+	namespaceSelector      string
+	serviceAccountSelector string
+	types                  []apiv3.PolicyType
+	ingress                []apiv3.Rule
+	egress                 []apiv3.Rule
+
+	// selectorV1 is the fully-expanded selector used by Felix, including the implicit
+	// namespace and service account terms.
+	selectorV1 string
+}
+
+// policyFromObject converts a supported policy resource into a policy.  It returns nil (and
+// no error) for resources that are not linted, including staged deletes.
+func policyFromObject(obj runtime.Object) (*policy, error) {
+	switch o := obj.(type) {
+	case *apiv3.NetworkPolicy:
+		v1, err := updateprocessors.ConvertNetworkPolicyV3ToV1Value(o)
+		if err != nil {
+			return nil, err
+		}
+		return &policy{
+			kind:                   apiv3.KindNetworkPolicy,
+			namespace:              o.Namespace,
+			name:                   o.Name,
+			tier:                   names.TierOrDefault(o.Spec.Tier),
+			order:                  o.Spec.Order,
+			selector:               o.Spec.Selector,
+			serviceAccountSelector: o.Spec.ServiceAccountSelector,
+			types:                  o.Spec.Types,
+			ingress:                o.Spec.Ingress,
+			egress:                 o.Spec.Egress,
+			selectorV1:             v1.(*model.Policy).Selector,
+		}, nil
+	case *apiv3.GlobalNetworkPolicy:
+		v1, err := updateprocessors.ConvertGlobalNetworkPolicyV3ToV1Value(o)
+		if err != nil {
+			return nil, err
+		}
+		return &policy{
+			kind:                   apiv3.KindGlobalNetworkPolicy,
+			name:                   o.Name,
+			tier:                   names.TierOrDefault(o.Spec.Tier),
+			order:                  o.Spec.Order,
+			selector:               o.Spec.Selector,
+			namespaceSelector:      o.Spec.NamespaceSelector,
+			serviceAccountSelector: o.Spec.ServiceAccountSelector,
+			types:                  o.Spec.Types,
+			ingress:                o.Spec.Ingress,
+			egress:                 o.Spec.Egress,
+			selectorV1:             v1.(*model.Policy).Selector,
+		}, nil
+	case *apiv3.StagedNetworkPolicy:
+		action, enforced := apiv3.ConvertStagedPolicyToEnforced(o)
+		if action == apiv3.StagedActionDelete {
+			return nil, nil
+		}
+		p, err := policyFromObject(enforced)
+		if err != nil {
+			return nil, err
+		}
+		p.kind = apiv3.KindStagedNetworkPolicy
+		return p, nil
+	case *apiv3.StagedGlobalNetworkPolicy:
+		action, enforced := apiv3.ConvertStagedGlobalPolicyToEnforced(o)
+		if action == apiv3.StagedActionDelete {
+			return nil, nil
+		}
+		p, err := policyFromObject(enforced)
+		if err != nil {
+			return nil, err
+		}
+		p.kind = apiv3.KindStagedGlobalNetworkPolicy
+		return p, nil
+	}
+	return nil, nil
+}
+
+// id returns a string that identifies the policy in warning messages.
+func (p *policy) id() string {
+	if p.namespace != "" {
+		return fmt.Sprintf("%s %s/%s", p.kind, p.namespace, p.name)
+	}
+	return fmt.Sprintf("%s %s", p.kind, p.name)
+}
+
+// appliesTo returns true if the policy has rules for the given direction, applying the same
+// defaulting as the API when Types is empty.
+func (p *policy) appliesTo(dir apiv3.PolicyType) bool {
+	if len(p.types) == 0 {
+		return dir == apiv3.PolicyTypeIngress || len(p.egress) > 0
+	}
+	for _, t := range p.types {
+		if t == dir {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *policy) rules(dir apiv3.PolicyType) []apiv3.Rule {
+	if dir == apiv3.PolicyTypeIngress {
+		return p.ingress
+	}
+	return p.egress
+}
+
+// terminalAction returns the action that the policy applies to all traffic in the given
+// direction, or "" if some traffic may fall through to later policies.  A policy is terminal
+// if it has an Allow or Deny rule with no match criteria that is not preceded by a Pass rule.
+func (p *policy) terminalAction(dir apiv3.PolicyType) apiv3.Action {
+	for _, r := range p.rules(dir) {
+		if r.Action == apiv3.Pass {
+			return ""
+		}
+		if (r.Action == apiv3.Allow || r.Action == apiv3.Deny) && matchesAllTraffic(r) {
+			return r.Action
+		}
+	}
+	return ""
+}
+
+func matchesAllTraffic(r apiv3.Rule) bool {
+	r.Action = ""
+	r.Metadata = nil
+	return reflect.DeepEqual(r, apiv3.Rule{})
+}
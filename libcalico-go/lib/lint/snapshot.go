@@ -0,0 +1,488 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+// maxSuggestionDistance is the largest edit distance between an unknown label and a known
+// label for which we suggest the known label as a correction.
+const maxSuggestionDistance = 2
+
+type endpoint struct {
+	// labels includes the labels inherited from the endpoint's profiles.
+	labels map[string]string
+}
+
+// snapshot holds the datastore state that the lint checks run against.
+type snapshot struct {
+	endpoints []endpoint
+	policies  []*policy
+
+	// tierOrders maps tier name to the order of the tier.
+	tierOrders map[string]*float64
+	// profileLabels maps profile name to the labels that the profile applies to endpoints.
+	profileLabels map[string]map[string]string
+
+	endpointLabels       set.Set[string]
+	networkSetLabels     set.Set[string]
+	namespaceLabels      set.Set[string]
+	serviceAccountLabels set.Set[string]
+	namedPorts           set.Set[string]
+
+	// serviceAccounts holds "<namespace>/<name>" for each service account.
+	serviceAccounts set.Set[string]
+	// serviceAccountNames holds the name of each service account, in any namespace.
+	serviceAccountNames set.Set[string]
+}
+
+func newSnapshot() *snapshot {
+	// The tiers that Calico creates are seeded with their default orders, in case the Tier
+	// resources can't be read.
+	defaultOrder := apiv3.DefaultTierOrder
+	anpOrder := apiv3.AdminNetworkPolicyTierOrder
+	banpOrder := apiv3.BaselineAdminNetworkPolicyTierOrder
+	return &snapshot{
+		tierOrders: map[string]*float64{
+			names.DefaultTierName:                    &defaultOrder,
+			names.AdminNetworkPolicyTierName:         &anpOrder,
+			names.BaselineAdminNetworkPolicyTierName: &banpOrder,
+		},
+		profileLabels:        map[string]map[string]string{},
+		endpointLabels:       set.New[string](),
+		networkSetLabels:     set.New[string](),
+		namespaceLabels:      set.New[string](),
+		serviceAccountLabels: set.New[string](),
+		namedPorts:           set.New[string](),
+		serviceAccounts:      set.New[string](),
+		serviceAccountNames:  set.New[string](),
+	}
+}
+
+// buildSnapshot builds a snapshot from the given resources, in any order.
+func buildSnapshot(resources []interface{}) *snapshot {
+	s := newSnapshot()
+
+	// Profiles are needed to resolve the labels of endpoints, so add them first.
+	for _, r := range resources {
+		if p, ok := r.(*apiv3.Profile); ok {
+			s.addProfile(p)
+		}
+	}
+
+	for _, r := range resources {
+		switch r := r.(type) {
+		case *libapiv3.WorkloadEndpoint:
+			var ports []string
+			for _, p := range r.Spec.Ports {
+				ports = append(ports, p.Name)
+			}
+			s.addEndpoint(r.Labels, r.Spec.Profiles, ports)
+		case *apiv3.HostEndpoint:
+			var ports []string
+			for _, p := range r.Spec.Ports {
+				ports = append(ports, p.Name)
+			}
+			s.addEndpoint(r.Labels, r.Spec.Profiles, ports)
+		case *apiv3.NetworkSet:
+			s.addNetworkSet(r.Labels)
+		case *apiv3.GlobalNetworkSet:
+			s.addNetworkSet(r.Labels)
+		case *apiv3.Tier:
+			s.tierOrders[r.Name] = r.Spec.Order
+		case *apiv3.NetworkPolicy, *apiv3.GlobalNetworkPolicy:
+			// This includes Kubernetes network policies and admin network policies, which are
+			// converted to Calico policies in their own tiers.
+			if p, err := policyFromObject(r.(runtime.Object)); err == nil && p != nil {
+				s.policies = append(s.policies, p)
+			}
+		}
+	}
+	return s
+}
+
+// addProfile records a profile.  Profiles must be added before the endpoints that use them.
+// The profiles generated for Kubernetes namespaces and service accounts are also used to
+// discover namespace labels, service account labels and the set of service accounts.
+func (s *snapshot) addProfile(p *apiv3.Profile) {
+	s.profileLabels[p.Name] = p.Spec.LabelsToApply
+
+	switch {
+	case strings.HasPrefix(p.Name, conversion.NamespaceProfileNamePrefix):
+		for k := range p.Spec.LabelsToApply {
+			if strings.HasPrefix(k, conversion.NamespaceLabelPrefix) {
+				s.namespaceLabels.Add(strings.TrimPrefix(k, conversion.NamespaceLabelPrefix))
+			}
+		}
+	case strings.HasPrefix(p.Name, conversion.ServiceAccountProfileNamePrefix):
+		// Namespace names can't contain a ".", so the first one separates the namespace
+		// from the service account name.
+		nsAndName := strings.TrimPrefix(p.Name, conversion.ServiceAccountProfileNamePrefix)
+		if ns, name, ok := strings.Cut(nsAndName, "."); ok {
+			s.serviceAccounts.Add(ns + "/" + name)
+			s.serviceAccountNames.Add(name)
+		}
+		for k := range p.Spec.LabelsToApply {
+			if strings.HasPrefix(k, conversion.ServiceAccountLabelPrefix) {
+				s.serviceAccountLabels.Add(strings.TrimPrefix(k, conversion.ServiceAccountLabelPrefix))
+			}
+		}
+	}
+}
+
+// addEndpoint records a workload or host endpoint.
+func (s *snapshot) addEndpoint(labels map[string]string, profiles []string, ports []string) {
+	// Labels on the endpoint take precedence over inherited labels.
+	merged := map[string]string{}
+	for _, p := range profiles {
+		for k, v := range s.profileLabels[p] {
+			merged[k] = v
+		}
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	for k := range merged {
+		s.endpointLabels.Add(k)
+	}
+	for _, p := range ports {
+		s.namedPorts.Add(p)
+	}
+	s.endpoints = append(s.endpoints, endpoint{labels: merged})
+}
+
+// addNetworkSet records the labels of a NetworkSet or GlobalNetworkSet, which may be selected
+// by rule selectors.
+func (s *snapshot) addNetworkSet(labels map[string]string) {
+	for k := range labels {
+		s.networkSetLabels.Add(k)
+	}
+}
+
+// lint runs all of the checks against the given policy.
+func (s *snapshot) lint(p *policy) []Warning {
+	var warnings []Warning
+	warnings = append(warnings, s.checkSelectors(p)...)
+	warnings = append(warnings, s.checkSelectsEndpoints(p)...)
+	warnings = append(warnings, s.checkRuleReferences(p)...)
+	warnings = append(warnings, s.checkShadowed(p)...)
+	return warnings
+}
+
+// checkSelectors looks for selectors that require a label that doesn't exist on any resource
+// that the selector could match.  These are usually typos.
+func (s *snapshot) checkSelectors(p *policy) []Warning {
+	var warnings []Warning
+	check := func(field, sel string, known ...set.Set[string]) {
+		if w := checkSelectorLabels(field, sel, known...); w != nil {
+			warnings = append(warnings, *w)
+		}
+	}
+
+	check("spec.selector", p.selector, s.endpointLabels)
+	check("spec.namespaceSelector", p.namespaceSelector, s.namespaceLabels)
+	check("spec.serviceAccountSelector", p.serviceAccountSelector, s.serviceAccountLabels)
+
+	for _, dir := range []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress} {
+		for i, r := range p.rules(dir) {
+			for _, e := range entityRules(dir, i, r) {
+				check(e.field+".selector", e.rule.Selector, s.endpointLabels, s.networkSetLabels)
+				check(e.field+".namespaceSelector", e.rule.NamespaceSelector, s.namespaceLabels)
+				if e.rule.ServiceAccounts != nil {
+					check(e.field+".serviceAccounts.selector", e.rule.ServiceAccounts.Selector, s.serviceAccountLabels)
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+// checkSelectorLabels returns a warning if the selector requires a label that isn't in any of
+// the known label sets.
+func checkSelectorLabels(field, sel string, known ...set.Set[string]) *Warning {
+	if sel == "" {
+		return nil
+	}
+	parsed, err := selector.Parse(sel)
+	if err != nil {
+		// Validation rejects invalid selectors.
+		return nil
+	}
+
+	var missing []string
+	for k, r := range parsed.LabelRestrictions() {
+		label := k.Value()
+		if !r.MustBePresent || containsAny(label, known) {
+			continue
+		}
+		missing = append(missing, label)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+
+	msg := fmt.Sprintf("selector requires label %q, which is not present on any resource, so it will never match", missing[0])
+	if suggestion := closestLabel(missing[0], known); suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", suggestion)
+	}
+	return &Warning{Code: CodeUnknownLabel, Field: field, Message: msg}
+}
+
+// checkSelectsEndpoints warns if the policy doesn't apply to any existing endpoint.
+func (s *snapshot) checkSelectsEndpoints(p *policy) []Warning {
+	if len(s.selectedEndpoints(p)) > 0 {
+		return nil
+	}
+	return []Warning{{
+		Code:    CodeNoMatchingEndpoints,
+		Field:   "spec.selector",
+		Message: "policy does not select any existing workload or host endpoint",
+	}}
+}
+
+// checkRuleReferences looks for rules that reference named ports or service accounts that
+// don't exist.
+func (s *snapshot) checkRuleReferences(p *policy) []Warning {
+	var warnings []Warning
+	for _, dir := range []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress} {
+		for i, r := range p.rules(dir) {
+			for _, e := range entityRules(dir, i, r) {
+				for j, port := range e.rule.Ports {
+					if port.PortName != "" && !s.namedPorts.Contains(port.PortName) {
+						warnings = append(warnings, Warning{
+							Code:    CodeUnknownNamedPort,
+							Field:   fmt.Sprintf("%s.ports[%d]", e.field, j),
+							Message: fmt.Sprintf("no endpoint declares a port named %q", port.PortName),
+						})
+					}
+				}
+				for j, port := range e.rule.NotPorts {
+					if port.PortName != "" && !s.namedPorts.Contains(port.PortName) {
+						warnings = append(warnings, Warning{
+							Code:    CodeUnknownNamedPort,
+							Field:   fmt.Sprintf("%s.notPorts[%d]", e.field, j),
+							Message: fmt.Sprintf("no endpoint declares a port named %q", port.PortName),
+						})
+					}
+				}
+				if e.rule.ServiceAccounts == nil {
+					continue
+				}
+				for j, name := range e.rule.ServiceAccounts.Names {
+					if s.serviceAccountExists(p.namespace, name) {
+						continue
+					}
+					msg := fmt.Sprintf("service account %q does not exist", name)
+					if p.namespace != "" {
+						msg = fmt.Sprintf("service account %q does not exist in namespace %q", name, p.namespace)
+					}
+					warnings = append(warnings, Warning{
+						Code:    CodeUnknownServiceAccount,
+						Field:   fmt.Sprintf("%s.serviceAccounts.names[%d]", e.field, j),
+						Message: msg,
+					})
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+func (s *snapshot) serviceAccountExists(namespace, name string) bool {
+	if namespace == "" {
+		return s.serviceAccountNames.Contains(name)
+	}
+	return s.serviceAccounts.Contains(namespace + "/" + name)
+}
+
+// checkShadowed warns if the rules for a direction will never be evaluated because an earlier
+// policy allows or denies all traffic in that direction for every endpoint that the policy
+// selects.
+func (s *snapshot) checkShadowed(p *policy) []Warning {
+	selected := s.selectedEndpoints(p)
+	if len(selected) == 0 {
+		return nil
+	}
+
+	// Check the policies in the order that they're evaluated so that we report the first
+	// policy that shadows this one.
+	others := make([]*policy, 0, len(s.policies))
+	for _, q := range s.policies {
+		if q.kind == p.kind && q.namespace == p.namespace && q.name == p.name {
+			continue
+		}
+		if s.precedes(q, p) {
+			others = append(others, q)
+		}
+	}
+	sort.SliceStable(others, func(i, j int) bool {
+		return s.precedes(others[i], others[j])
+	})
+
+	var warnings []Warning
+	for _, dir := range []apiv3.PolicyType{apiv3.PolicyTypeIngress, apiv3.PolicyTypeEgress} {
+		if !p.appliesTo(dir) {
+			continue
+		}
+		for _, q := range others {
+			if !q.appliesTo(dir) {
+				continue
+			}
+			action := q.terminalAction(dir)
+			if action == "" || !s.selectsAll(q, selected) {
+				continue
+			}
+			verb := "allows"
+			if action == apiv3.Deny {
+				verb = "denies"
+			}
+			warnings = append(warnings, Warning{
+				Code:  CodeShadowedRules,
+				Field: "spec." + strings.ToLower(string(dir)),
+				Message: fmt.Sprintf("%s rules will never be evaluated: %s in tier %q is evaluated first and %s all %s traffic for every endpoint that this policy selects",
+					strings.ToLower(string(dir)), q.id(), q.tier, verb, strings.ToLower(string(dir))),
+			})
+			break
+		}
+	}
+	return warnings
+}
+
+// selectedEndpoints returns the indices of the endpoints that the policy applies to.
+func (s *snapshot) selectedEndpoints(p *policy) []int {
+	sel, err := selector.Parse(p.selectorV1)
+	if err != nil {
+		return nil
+	}
+	var selected []int
+	for i, ep := range s.endpoints {
+		if sel.Evaluate(ep.labels) {
+			selected = append(selected, i)
+		}
+	}
+	return selected
+}
+
+// selectsAll returns true if the policy applies to all of the given endpoints.
+func (s *snapshot) selectsAll(p *policy, endpoints []int) bool {
+	sel, err := selector.Parse(p.selectorV1)
+	if err != nil {
+		return false
+	}
+	for _, i := range endpoints {
+		if !sel.Evaluate(s.endpoints[i].labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// precedes returns true if policy a is evaluated before policy b.  As in Felix, tiers are
+// sorted by order and then name, and policies within a tier by order and then name.  A nil
+// order sorts last.
+func (s *snapshot) precedes(a, b *policy) bool {
+	if a.tier != b.tier {
+		ao, bo := orderOrMax(s.tierOrders[a.tier]), orderOrMax(s.tierOrders[b.tier])
+		if ao != bo {
+			return ao < bo
+		}
+		return a.tier < b.tier
+	}
+	ao, bo := orderOrMax(a.order), orderOrMax(b.order)
+	if ao != bo {
+		return ao < bo
+	}
+	return a.name < b.name
+}
+
+func orderOrMax(order *float64) float64 {
+	if order == nil {
+		return math.Inf(1)
+	}
+	return *order
+}
+
+type namedEntityRule struct {
+	field string
+	rule  apiv3.EntityRule
+}
+
+// entityRules returns the source and destination of a rule, along with their field paths.
+func entityRules(dir apiv3.PolicyType, i int, r apiv3.Rule) []namedEntityRule {
+	prefix := fmt.Sprintf("spec.%s[%d]", strings.ToLower(string(dir)), i)
+	return []namedEntityRule{
+		{field: prefix + ".source", rule: r.Source},
+		{field: prefix + ".destination", rule: r.Destination},
+	}
+}
+
+func containsAny(label string, sets []set.Set[string]) bool {
+	for _, s := range sets {
+		if s.Contains(label) {
+			return true
+		}
+	}
+	return false
+}
+
+// closestLabel returns the known label closest to the given label, or "" if there is no
+// label that is close enough to be a likely typo.
+func closestLabel(label string, sets []set.Set[string]) string {
+	best, bestDist := "", maxSuggestionDistance+1
+	for _, s := range sets {
+		for _, known := range s.Slice() {
+			d := editDistance(label, known)
+			if d < bestDist || (d == bestDist && known < best) {
+				best, bestDist = known, d
+			}
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+)
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func profile(name string, labels map[string]string) *apiv3.Profile {
+	p := apiv3.NewProfile()
+	p.Name = name
+	p.Spec.LabelsToApply = labels
+	return p
+}
+
+func networkPolicy(name, selector string, order *float64) *apiv3.NetworkPolicy {
+	np := apiv3.NewNetworkPolicy()
+	np.ObjectMeta = metav1.ObjectMeta{Name: name, Namespace: "default"}
+	np.Spec.Selector = selector
+	np.Spec.Order = order
+	np.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress}
+	return np
+}
+
+func mustPolicy(obj runtime.Object) *policy {
+	p, err := policyFromObject(obj)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, p).NotTo(BeNil())
+	return p
+}
+
+func codes(warnings []Warning) []Code {
+	var c []Code
+	for _, w := range warnings {
+		c = append(c, w.Code)
+	}
+	return c
+}
+
+var _ = Describe("Policy lint checks", func() {
+	var s *snapshot
+
+	BeforeEach(func() {
+		s = newSnapshot()
+		s.tierOrders["default"] = float(apiv3.DefaultTierOrder)
+		s.tierOrders["security"] = float(100)
+		s.addProfile(profile("kns.default", map[string]string{
+			"pcns.projectcalico.org/name": "default",
+			"pcns.team":                   "blue",
+		}))
+		s.addProfile(profile("ksa.default.frontend", map[string]string{
+			"pcsa.projectcalico.org/name": "frontend",
+		}))
+		s.addEndpoint(map[string]string{
+			apiv3.LabelNamespace: "default",
+			"app":                "web",
+		}, []string{"kns.default", "ksa.default.frontend"}, []string{"http"})
+		s.addEndpoint(map[string]string{
+			apiv3.LabelNamespace: "default",
+			"app":                "db",
+		}, []string{"kns.default"}, []string{"postgres"})
+		s.addNetworkSet(map[string]string{"external": "true"})
+	})
+
+	It("should not warn about a policy with no problems", func() {
+		np := networkPolicy("allow-web", "app == 'web'", nil)
+		np.Spec.Ingress = []apiv3.Rule{{
+			Action: apiv3.Allow,
+			Source: apiv3.EntityRule{
+				Selector:        "external == 'true'",
+				ServiceAccounts: &apiv3.ServiceAccountMatch{Names: []string{"frontend"}},
+			},
+			Destination: apiv3.EntityRule{
+				Ports: []numorstring.Port{numorstring.NamedPort("http")},
+			},
+		}}
+		Expect(s.lint(mustPolicy(np))).To(BeEmpty())
+	})
+
+	It("should warn about a selector label typo and suggest a correction", func() {
+		np := networkPolicy("typo", "ap == 'web'", nil)
+		warnings := s.lint(mustPolicy(np))
+		Expect(codes(warnings)).To(ConsistOf(CodeUnknownLabel, CodeNoMatchingEndpoints))
+		Expect(warnings[0].Field).To(Equal("spec.selector"))
+		Expect(warnings[0].Message).To(ContainSubstring(`did you mean "app"?`))
+	})
+
+	It("should check namespace selectors against namespace labels", func() {
+		gnp := apiv3.NewGlobalNetworkPolicy()
+		gnp.Name = "ns"
+		gnp.Spec.NamespaceSelector = "teem == 'blue'"
+		warnings := s.lint(mustPolicy(gnp))
+		Expect(codes(warnings)).To(ContainElement(CodeUnknownLabel))
+		Expect(warnings[0].Field).To(Equal("spec.namespaceSelector"))
+		Expect(warnings[0].Message).To(ContainSubstring(`did you mean "team"?`))
+	})
+
+	It("should not flag labels in an alternative of an 'or'", func() {
+		np := networkPolicy("or", "app == 'web' || ap == 'web'", nil)
+		Expect(s.lint(mustPolicy(np))).To(BeEmpty())
+	})
+
+	It("should warn about a policy that selects nothing", func() {
+		np := networkPolicy("nothing", "app == 'cache'", nil)
+		Expect(codes(s.lint(mustPolicy(np)))).To(ConsistOf(CodeNoMatchingEndpoints))
+	})
+
+	It("should warn about unknown named ports and service accounts", func() {
+		np := networkPolicy("refs", "app == 'db'", nil)
+		np.Spec.Ingress = []apiv3.Rule{{
+			Action: apiv3.Allow,
+			Source: apiv3.EntityRule{
+				ServiceAccounts: &apiv3.ServiceAccountMatch{Names: []string{"backend"}},
+			},
+			Destination: apiv3.EntityRule{
+				Ports: []numorstring.Port{numorstring.SinglePort(5432), numorstring.NamedPort("postgress")},
+			},
+		}}
+		warnings := s.lint(mustPolicy(np))
+		Expect(warnings).To(ConsistOf(
+			Warning{
+				Code:    CodeUnknownNamedPort,
+				Field:   "spec.ingress[0].destination.ports[1]",
+				Message: `no endpoint declares a port named "postgress"`,
+			},
+			Warning{
+				Code:    CodeUnknownServiceAccount,
+				Field:   "spec.ingress[0].source.serviceAccounts.names[0]",
+				Message: `service account "backend" does not exist in namespace "default"`,
+			},
+		))
+	})
+
+	Describe("shadowing", func() {
+		BeforeEach(func() {
+			gnp := apiv3.NewGlobalNetworkPolicy()
+			gnp.Name = "deny-all"
+			gnp.Spec.Tier = "security"
+			gnp.Spec.Selector = "all()"
+			gnp.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress}
+			gnp.Spec.Ingress = []apiv3.Rule{{Action: apiv3.Deny}}
+			s.policies = append(s.policies, mustPolicy(gnp))
+
+			np := networkPolicy("allow-db", "app == 'db'", float(10))
+			np.Spec.Ingress = []apiv3.Rule{
+				{Action: apiv3.Allow, Source: apiv3.EntityRule{Selector: "app == 'web'"}},
+				{Action: apiv3.Allow},
+			}
+			s.policies = append(s.policies, mustPolicy(np))
+		})
+
+		It("should warn when an earlier tier denies all traffic", func() {
+			np := networkPolicy("allow-web", "app == 'web'", nil)
+			warnings := s.lint(mustPolicy(np))
+			Expect(codes(warnings)).To(ConsistOf(CodeShadowedRules))
+			Expect(warnings[0].Field).To(Equal("spec.ingress"))
+			Expect(warnings[0].Message).To(ContainSubstring(`GlobalNetworkPolicy deny-all in tier "security"`))
+			Expect(warnings[0].Message).To(ContainSubstring("denies all ingress traffic"))
+		})
+
+		It("should not warn about the shadowing policy itself", func() {
+			gnp := apiv3.NewGlobalNetworkPolicy()
+			gnp.Name = "deny-all"
+			gnp.Spec.Tier = "security"
+			gnp.Spec.Selector = "all()"
+			gnp.Spec.Ingress = []apiv3.Rule{{Action: apiv3.Deny}}
+			Expect(s.lint(mustPolicy(gnp))).To(BeEmpty())
+		})
+
+		It("should warn when a lower order policy in the same tier allows all traffic", func() {
+			s.policies = s.policies[1:]
+			np := networkPolicy("later", "app == 'db'", float(20))
+			warnings := s.lint(mustPolicy(np))
+			Expect(codes(warnings)).To(ConsistOf(CodeShadowedRules))
+			Expect(warnings[0].Message).To(ContainSubstring("NetworkPolicy default/allow-db"))
+			Expect(warnings[0].Message).To(ContainSubstring("allows all ingress traffic"))
+		})
+
+		It("should not warn about a policy evaluated before the shadowing policy", func() {
+			s.policies = s.policies[1:]
+			np := networkPolicy("earlier", "app == 'db'", float(5))
+			Expect(s.lint(mustPolicy(np))).To(BeEmpty())
+		})
+
+		It("should not warn if the earlier policy passes some traffic", func() {
+			s.policies = s.policies[1:]
+			s.policies[0].ingress = append([]apiv3.Rule{{Action: apiv3.Pass, Protocol: protocolTCP()}}, s.policies[0].ingress...)
+			np := networkPolicy("later", "app == 'db'", float(20))
+			Expect(s.lint(mustPolicy(np))).To(BeEmpty())
+		})
+
+		It("should not warn if the earlier policy doesn't select every endpoint", func() {
+			s.policies = s.policies[1:]
+			np := networkPolicy("later", "has(app)", float(20))
+			Expect(s.lint(mustPolicy(np))).To(BeEmpty())
+		})
+	})
+
+	It("should build a snapshot from a cache, including converted admin network policies", func() {
+		wep := libapiv3.NewWorkloadEndpoint()
+		wep.Labels = map[string]string{apiv3.LabelNamespace: "default", "app": "web"}
+		wep.Spec.Profiles = []string{"kns.default"}
+		anp := apiv3.NewGlobalNetworkPolicy()
+		anp.Name = names.K8sAdminNetworkPolicyNamePrefix + "deny-all"
+		anp.Spec.Tier = names.AdminNetworkPolicyTierName
+		anp.Spec.Order = float(10)
+		anp.Spec.Selector = "all()"
+		anp.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress}
+		anp.Spec.Ingress = []apiv3.Rule{{Action: apiv3.Deny}}
+
+		c := &Cache{resources: map[string]interface{}{}}
+		_, err := c.snapshot(context.Background())
+		Expect(err).To(Equal(ErrNotInSync))
+
+		// Endpoints may arrive before the profiles that they use.
+		c.OnUpdates([]bapi.Update{
+			{KVPair: model.KVPair{Key: model.ResourceKey{Kind: libapiv3.KindWorkloadEndpoint, Name: "wep", Namespace: "default"}, Value: wep}},
+			{KVPair: model.KVPair{Key: model.ResourceKey{Kind: apiv3.KindProfile, Name: "kns.default"}, Value: profile("kns.default", map[string]string{"pcns.team": "blue"})}},
+			{KVPair: model.KVPair{Key: model.ResourceKey{Kind: model.KindKubernetesAdminNetworkPolicy, Name: anp.Name}, Value: anp}},
+		})
+		c.OnStatusUpdated(bapi.InSync)
+		cached, err := c.snapshot(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cached.endpoints).To(HaveLen(1))
+		Expect(cached.endpoints[0].labels).To(HaveKeyWithValue("pcns.team", "blue"))
+
+		By("reporting policies in the default tier shadowed by the admin network policy")
+		warnings := cached.lint(mustPolicy(networkPolicy("allow-web", "app == 'web'", nil)))
+		Expect(codes(warnings)).To(ConsistOf(CodeShadowedRules))
+		Expect(warnings[0].Message).To(ContainSubstring(`in tier "adminnetworkpolicy"`))
+
+		By("removing deleted resources")
+		c.OnUpdates([]bapi.Update{
+			{KVPair: model.KVPair{Key: model.ResourceKey{Kind: model.KindKubernetesAdminNetworkPolicy, Name: anp.Name}}},
+		})
+		cached, err = c.snapshot(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cached.lint(mustPolicy(networkPolicy("allow-web", "app == 'web'", nil)))).To(BeEmpty())
+	})
+
+	It("should compute edit distances", func() {
+		Expect(editDistance("", "")).To(Equal(0))
+		Expect(editDistance("app", "ap")).To(Equal(1))
+		Expect(editDistance("kitten", "sitting")).To(Equal(3))
+	})
+})
+
+func protocolTCP() *numorstring.Protocol {
+	p := numorstring.ProtocolFromString("TCP")
+	return &p
+}
@@ -38,6 +38,11 @@ const (
 	// BaselineAdminNetworkPolicy resource, which is cluster-scoped and lives
 	// in a tier after the default tier.
 	K8sBaselineAdminNetworkPolicyNamePrefix = "kbanp.baselineadminnetworkpolicy."
+	// K8sAdminClusterNetworkPolicyNamePrefix and K8sBaselineClusterNetworkPolicyNamePrefix are
+	// the prefixes for Kubernetes ClusterNetworkPolicy resources in the Admin and Baseline tiers,
+	// which share the tiers of AdminNetworkPolicy and BaselineAdminNetworkPolicy respectively.
+	K8sAdminClusterNetworkPolicyNamePrefix    = "kcnp.adminnetworkpolicy."
+	K8sBaselineClusterNetworkPolicyNamePrefix = "kcnp.baselineadminnetworkpolicy."
 
 	// OpenStackNetworkPolicyNamePrefix is the prefix for OpenStack security groups.
 	OpenStackNetworkPolicyNamePrefix = "ossg."
@@ -57,10 +62,12 @@ func TierFromPolicyName(name string) (string, error) {
 	if strings.HasPrefix(name, K8sNetworkPolicyNamePrefix) {
 		return DefaultTierName, nil
 	}
-	if strings.HasPrefix(name, K8sAdminNetworkPolicyNamePrefix) {
+	if strings.HasPrefix(name, K8sAdminNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(name, K8sAdminClusterNetworkPolicyNamePrefix) {
 		return AdminNetworkPolicyTierName, nil
 	}
-	if strings.HasPrefix(name, K8sBaselineAdminNetworkPolicyNamePrefix) {
+	if strings.HasPrefix(name, K8sBaselineAdminNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(name, K8sBaselineClusterNetworkPolicyNamePrefix) {
 		return BaselineAdminNetworkPolicyTierName, nil
 	}
 	// Policy derived from OpenStack security groups is named as "ossg.default.<security group
@@ -173,6 +180,8 @@ func policyNameIsFormatted(policy string) bool {
 	return strings.HasPrefix(policy, K8sNetworkPolicyNamePrefix) ||
 		strings.HasPrefix(policy, K8sAdminNetworkPolicyNamePrefix) ||
 		strings.HasPrefix(policy, K8sBaselineAdminNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(policy, K8sAdminClusterNetworkPolicyNamePrefix) ||
+		strings.HasPrefix(policy, K8sBaselineClusterNetworkPolicyNamePrefix) ||
 		strings.HasPrefix(policy, OpenStackNetworkPolicyNamePrefix)
 }
 
@@ -194,6 +203,7 @@ func TierOrDefault(tier string) string {
 // -  <namespace>/knp.default.<name> for a k8s NetworkPolicies
 // -  kanp.adminnetworkpolicy.<name> for a k8s AdminNetworkPolicies
 // -  kbanp.baselineadminnetworkpolicy.<name> for a k8s BaselineAdminNetworkPolicies
+// -  kcnp.<adminnetworkpolicy|baselineadminnetworkpolicy>.<name> for k8s ClusterNetworkPolicies
 // and for the staged counterparts, respectively:
 // -  <namespace>/staged:<tier>.<name>
 // -  staged:<tier>.<name>
@@ -241,6 +251,13 @@ func DeconstructPolicyName(name string) (string, string, string, error) {
 	if strings.HasPrefix(name, K8sBaselineAdminNetworkPolicyNamePrefix) {
 		return namespace, BaselineAdminNetworkPolicyTierName, stagedPrefix + name, nil
 	}
+	// If policy name starts with "kcnp." then this is a k8s cluster network policy in one of the admin tiers.
+	if strings.HasPrefix(name, K8sAdminClusterNetworkPolicyNamePrefix) {
+		return namespace, AdminNetworkPolicyTierName, stagedPrefix + name, nil
+	}
+	if strings.HasPrefix(name, K8sBaselineClusterNetworkPolicyNamePrefix) {
+		return namespace, BaselineAdminNetworkPolicyTierName, stagedPrefix + name, nil
+	}
 
 	// This is a non-kubernetes policy, so extract the tier name from the policy name.
 	if parts = strings.SplitN(name, ".", 2); len(parts) == 2 {
@@ -35,6 +35,8 @@ var _ = DescribeTable("Parse Tiered policy name",
 	Entry("K8s network policy", "knp.default.foopolicy", false, "default"),
 	Entry("K8s admin network policy", "kanp.adminnetworkpolicy.barpolicy", false, "adminnetworkpolicy"),
 	Entry("K8s baseline admin network policy", "kbanp.baselineadminnetworkpolicy.barpolicy", false, "baselineadminnetworkpolicy"),
+	Entry("K8s admin cluster network policy", "kcnp.adminnetworkpolicy.barpolicy", false, "adminnetworkpolicy"),
+	Entry("K8s baseline cluster network policy", "kcnp.baselineadminnetworkpolicy.barpolicy", false, "baselineadminnetworkpolicy"),
 	Entry("Policy name without tier", "foopolicy", false, "default"),
 	Entry("Correct tiered policy name", "baztier.foopolicy", false, "baztier"),
 	Entry("OpenStack-derived policy name", "ossg.default.19bed2d3-12fc-4cc0-92d7-bea430a28a85", false, "default"),
@@ -57,6 +59,7 @@ var _ = DescribeTable("Backend Tiered policy name",
 	Entry("K8s Network Policy and empty tier", "knp.default.foobar", "", false, "knp.default.foobar"),
 	Entry("K8s Admin Network Policy and empty tier", "kanp.adminnetworkpolicy.foobar", "", false, "kanp.adminnetworkpolicy.foobar"),
 	Entry("K8s Baseline Admin Network Policy and empty tier", "kbanp.baselineadminnetworkpolicy.foobar", "", false, "kbanp.baselineadminnetworkpolicy.foobar"),
+	Entry("K8s Cluster Network Policy and empty tier", "kcnp.adminnetworkpolicy.foobar", "", false, "kcnp.adminnetworkpolicy.foobar"),
 	Entry("Network Policy and empty tier", "foobar", "", false, "default.foobar"),
 	Entry("Matching tier spec and correctly formatted tiered policy name", "footier.bazpolicy", "footier", false, "footier.bazpolicy"),
 )
@@ -92,4 +95,5 @@ var _ = DescribeTable("Client Tiered policy name",
 	Entry("K8s Network Policy", "knp.default.bazpolicy", false, "knp.default.bazpolicy"),
 	Entry("K8s Admin Network Policy", "kanp.adminnetworkpolicy.bazpolicy", false, "kanp.adminnetworkpolicy.bazpolicy"),
 	Entry("K8s Baseline Admin Network Policy", "kbanp.baselineadminnetworkpolicy.bazpolicy", false, "kbanp.baselineadminnetworkpolicy.bazpolicy"),
+	Entry("K8s Cluster Network Policy", "kcnp.baselineadminnetworkpolicy.bazpolicy", false, "kcnp.baselineadminnetworkpolicy.bazpolicy"),
 )
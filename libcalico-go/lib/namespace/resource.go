@@ -34,7 +34,8 @@ func IsNamespaced(kind string) bool {
 		apiv3.KindNetworkPolicy,
 		apiv3.KindStagedNetworkPolicy,
 		apiv3.KindStagedKubernetesNetworkPolicy,
-		apiv3.KindNetworkSet:
+		apiv3.KindNetworkSet,
+		apiv3.KindPacketCapture:
 		return true
 	case KindKubernetesNetworkPolicy:
 		// KindKubernetesNetworkPolicy is a special-case resource. We don't expose it over the
@@ -0,0 +1,329 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policyindex answers "which policies select this endpoint?" and "which endpoints does
+// this policy select?" for a snapshot of datastore state.
+//
+// Resources are converted to the data model used by Felix with the same update processors as
+// the Felix syncer, and matched using Felix's label inheritance index, so the results include
+// the labels that endpoints inherit from their namespace and service account profiles, and the
+// namespace and service account terms that are implicitly added to policy and rule selectors.
+package policyindex
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/labelindex"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+// FieldSelector is the Match field of a policy's main selector, which selects the endpoints that
+// the policy applies to.
+const FieldSelector = "spec.selector"
+
+// Resource identifies a resource by kind, namespace and name.
+type Resource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+func (r Resource) String() string {
+	if r.Namespace != "" {
+		return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+	}
+	return fmt.Sprintf("%s %s", r.Kind, r.Name)
+}
+
+// IsEndpoint returns true if the resource is a workload or host endpoint.
+func (r Resource) IsEndpoint() bool {
+	return r.Kind == libapiv3.KindWorkloadEndpoint || r.Kind == apiv3.KindHostEndpoint
+}
+
+// Match records that a selector in a policy matches a resource.
+type Match struct {
+	Policy Resource `json:"policy"`
+	// Field is the path of the matching selector within the policy: FieldSelector if the
+	// policy applies to the resource, or the path of a rule's source or destination (for
+	// example, "spec.ingress[0].source") if the resource is a peer of the rule.
+	Field    string   `json:"field"`
+	Resource Resource `json:"resource"`
+}
+
+type selectorID struct {
+	policy Resource
+	field  string
+}
+
+type policyData struct {
+	tier   string
+	order  *float64
+	fields []string
+}
+
+// Index indexes the matches between the selectors in policies and the labels of endpoints and
+// network sets.  It is not safe for concurrent use.
+type Index struct {
+	labels     *labelindex.InheritIndex
+	processors map[string]watchersyncer.SyncerUpdateProcessor
+
+	policies   map[Resource]*policyData
+	tierOrders map[string]*float64
+
+	resourcesBySelector map[selectorID]set.Set[Resource]
+	selectorsByResource map[Resource]set.Set[selectorID]
+}
+
+// New returns an empty Index.
+func New() *Index {
+	idx := &Index{
+		processors: map[string]watchersyncer.SyncerUpdateProcessor{
+			apiv3.KindNetworkPolicy:             updateprocessors.NewNetworkPolicyUpdateProcessor(),
+			apiv3.KindGlobalNetworkPolicy:       updateprocessors.NewGlobalNetworkPolicyUpdateProcessor(),
+			apiv3.KindStagedNetworkPolicy:       updateprocessors.NewStagedNetworkPolicyUpdateProcessor(),
+			apiv3.KindStagedGlobalNetworkPolicy: updateprocessors.NewStagedGlobalNetworkPolicyUpdateProcessor(),
+			libapiv3.KindWorkloadEndpoint:       updateprocessors.NewWorkloadEndpointUpdateProcessor(),
+			apiv3.KindHostEndpoint:              updateprocessors.NewHostEndpointUpdateProcessor(),
+			apiv3.KindNetworkSet:                updateprocessors.NewNetworkSetUpdateProcessor(),
+			apiv3.KindGlobalNetworkSet:          updateprocessors.NewGlobalNetworkSetUpdateProcessor(),
+		},
+		policies:            map[Resource]*policyData{},
+		tierOrders:          map[string]*float64{},
+		resourcesBySelector: map[selectorID]set.Set[Resource]{},
+		selectorsByResource: map[Resource]set.Set[selectorID]{},
+	}
+	idx.labels = labelindex.NewInheritIndex(idx.onMatchStarted, idx.onMatchStopped)
+	return idx
+}
+
+// OnUpdate adds or updates a resource in the index, or removes it if the value is nil.  The key
+// must be a model.ResourceKey and the value the corresponding v3 resource.  Tiers, profiles,
+// policies, endpoints and network sets are indexed; other resources are ignored.
+func (idx *Index) OnUpdate(kvp *model.KVPair) error {
+	key, ok := kvp.Key.(model.ResourceKey)
+	if !ok {
+		return fmt.Errorf("unexpected key type %T", kvp.Key)
+	}
+	res := Resource{Kind: key.Kind, Namespace: key.Namespace, Name: key.Name}
+
+	switch key.Kind {
+	case apiv3.KindTier:
+		if kvp.Value == nil {
+			delete(idx.tierOrders, key.Name)
+		} else {
+			idx.tierOrders[key.Name] = kvp.Value.(*apiv3.Tier).Spec.Order
+		}
+		return nil
+	case apiv3.KindProfile:
+		if kvp.Value == nil {
+			idx.labels.DeleteParentLabels(key.Name)
+		} else {
+			idx.labels.UpdateParentLabels(key.Name, kvp.Value.(*apiv3.Profile).Spec.LabelsToApply)
+		}
+		return nil
+	}
+
+	processor := idx.processors[key.Kind]
+	if processor == nil {
+		return nil
+	}
+	var kvps []*model.KVPair
+	if kvp.Value != nil {
+		var err error
+		if kvps, err = processor.Process(kvp); err != nil {
+			return fmt.Errorf("failed to convert %s: %w", res, err)
+		}
+	}
+	var value interface{}
+	if len(kvps) > 0 {
+		value = kvps[0].Value
+	}
+
+	switch v := value.(type) {
+	case *model.Policy:
+		idx.updatePolicy(res, kvps[0].Key.(model.PolicyKey).Tier, v)
+	case *model.WorkloadEndpoint:
+		idx.labels.UpdateLabels(res, v.Labels, v.ProfileIDs)
+	case *model.HostEndpoint:
+		idx.labels.UpdateLabels(res, v.Labels, v.ProfileIDs)
+	case *model.NetworkSet:
+		idx.labels.UpdateLabels(res, v.Labels, v.ProfileIDs)
+	case nil:
+		// Deleted, or converted to nothing (for example, a staged delete).
+		if _, ok := idx.policies[res]; ok {
+			idx.deletePolicy(res)
+		} else {
+			idx.labels.DeleteLabels(res)
+		}
+	default:
+		return fmt.Errorf("unexpected value type %T for %s", value, res)
+	}
+	return nil
+}
+
+func (idx *Index) updatePolicy(res Resource, tier string, p *model.Policy) {
+	idx.deletePolicy(res)
+
+	selectors := map[string]string{FieldSelector: p.Selector}
+	for i, r := range p.InboundRules {
+		selectors[fmt.Sprintf("spec.ingress[%d].source", i)] = r.SrcSelector
+		selectors[fmt.Sprintf("spec.ingress[%d].destination", i)] = r.DstSelector
+	}
+	for i, r := range p.OutboundRules {
+		selectors[fmt.Sprintf("spec.egress[%d].source", i)] = r.SrcSelector
+		selectors[fmt.Sprintf("spec.egress[%d].destination", i)] = r.DstSelector
+	}
+
+	pd := &policyData{tier: tier, order: p.Order}
+	idx.policies[res] = pd
+	for field, sel := range selectors {
+		if sel == "" && field != FieldSelector {
+			// A rule with no selector does not restrict the peer by label.
+			continue
+		}
+		parsed, err := selector.Parse(sel)
+		if err != nil {
+			// Selectors are validated before they are written to the datastore.
+			log.WithError(err).WithFields(log.Fields{"policy": res, "field": field}).Warn("Ignoring invalid selector")
+			continue
+		}
+		pd.fields = append(pd.fields, field)
+		idx.labels.UpdateSelector(selectorID{policy: res, field: field}, parsed)
+	}
+}
+
+func (idx *Index) deletePolicy(res Resource) {
+	pd := idx.policies[res]
+	if pd == nil {
+		return
+	}
+	for _, field := range pd.fields {
+		idx.labels.DeleteSelector(selectorID{policy: res, field: field})
+	}
+	delete(idx.policies, res)
+}
+
+func (idx *Index) onMatchStarted(selID, labelID interface{}) {
+	sid := selID.(selectorID)
+	res := labelID.(Resource)
+	if sid.field == FieldSelector && !res.IsEndpoint() {
+		// Policies only apply to endpoints.
+		return
+	}
+	if idx.resourcesBySelector[sid] == nil {
+		idx.resourcesBySelector[sid] = set.New[Resource]()
+	}
+	idx.resourcesBySelector[sid].Add(res)
+	if idx.selectorsByResource[res] == nil {
+		idx.selectorsByResource[res] = set.New[selectorID]()
+	}
+	idx.selectorsByResource[res].Add(sid)
+}
+
+func (idx *Index) onMatchStopped(selID, labelID interface{}) {
+	sid := selID.(selectorID)
+	res := labelID.(Resource)
+	if s := idx.resourcesBySelector[sid]; s != nil {
+		s.Discard(res)
+		if s.Len() == 0 {
+			delete(idx.resourcesBySelector, sid)
+		}
+	}
+	if s := idx.selectorsByResource[res]; s != nil {
+		s.Discard(sid)
+		if s.Len() == 0 {
+			delete(idx.selectorsByResource, res)
+		}
+	}
+}
+
+// PoliciesSelecting returns the policy selectors that match the given endpoint or network set,
+// in the order that the policies are evaluated.
+func (idx *Index) PoliciesSelecting(res Resource) []Match {
+	sids := idx.selectorsByResource[res]
+	if sids == nil {
+		return nil
+	}
+	var matches []Match
+	for _, sid := range sids.Slice() {
+		matches = append(matches, Match{Policy: sid.policy, Field: sid.field, Resource: res})
+	}
+	idx.sort(matches)
+	return matches
+}
+
+// SelectedBy returns the endpoints and network sets matched by the selectors in the given policy.
+func (idx *Index) SelectedBy(policy Resource) []Match {
+	pd := idx.policies[policy]
+	if pd == nil {
+		return nil
+	}
+	var matches []Match
+	for _, field := range pd.fields {
+		resources := idx.resourcesBySelector[selectorID{policy: policy, field: field}]
+		if resources == nil {
+			continue
+		}
+		for _, res := range resources.Slice() {
+			matches = append(matches, Match{Policy: policy, Field: field, Resource: res})
+		}
+	}
+	idx.sort(matches)
+	return matches
+}
+
+// sort orders matches by policy evaluation order (tier order and then policy order), then by
+// field and resource.
+func (idx *Index) sort(matches []Match) {
+	orderOf := func(o *float64) float64 {
+		if o == nil {
+			return math.Inf(1)
+		}
+		return *o
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.Policy != b.Policy {
+			pa, pb := idx.policies[a.Policy], idx.policies[b.Policy]
+			if ta, tb := orderOf(idx.tierOrders[pa.tier]), orderOf(idx.tierOrders[pb.tier]); ta != tb {
+				return ta < tb
+			}
+			if pa.tier != pb.tier {
+				return pa.tier < pb.tier
+			}
+			if oa, ob := orderOf(pa.order), orderOf(pb.order); oa != ob {
+				return oa < ob
+			}
+			return a.Policy.String() < b.Policy.String()
+		}
+		if a.Field != b.Field {
+			// The main selector sorts first.
+			if a.Field == FieldSelector || b.Field == FieldSelector {
+				return a.Field == FieldSelector
+			}
+			return a.Field < b.Field
+		}
+		return a.Resource.String() < b.Resource.String()
+	})
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyindex
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func update(idx *Index, kind, namespace, name string, value interface{}) {
+	err := idx.OnUpdate(&model.KVPair{
+		Key:   model.ResourceKey{Kind: kind, Namespace: namespace, Name: name},
+		Value: value,
+	})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+}
+
+func workloadEndpoint(pod, serviceAccount string, labels map[string]string) *libapiv3.WorkloadEndpoint {
+	wep := libapiv3.NewWorkloadEndpoint()
+	wep.ObjectMeta = metav1.ObjectMeta{
+		Name:      "node1-k8s-" + pod + "-eth0",
+		Namespace: "default",
+		Labels: map[string]string{
+			apiv3.LabelNamespace:      "default",
+			apiv3.LabelOrchestrator:   apiv3.OrchestratorKubernetes,
+			apiv3.LabelServiceAccount: serviceAccount,
+		},
+	}
+	for k, v := range labels {
+		wep.Labels[k] = v
+	}
+	wep.Spec = libapiv3.WorkloadEndpointSpec{
+		Orchestrator:  apiv3.OrchestratorKubernetes,
+		Node:          "node1",
+		Pod:           pod,
+		Endpoint:      "eth0",
+		InterfaceName: "cali" + pod,
+		IPNetworks:    []string{"10.0.0.1/32"},
+		Profiles:      []string{"kns.default", "ksa.default." + serviceAccount},
+	}
+	return wep
+}
+
+func profile(name string, labels map[string]string) *apiv3.Profile {
+	p := apiv3.NewProfile()
+	p.Name = name
+	p.Spec.LabelsToApply = labels
+	return p
+}
+
+func networkPolicy(name, selector string, order *float64) *apiv3.NetworkPolicy {
+	np := apiv3.NewNetworkPolicy()
+	np.ObjectMeta = metav1.ObjectMeta{Name: name, Namespace: "default"}
+	np.Spec.Tier = "default"
+	np.Spec.Selector = selector
+	np.Spec.Order = order
+	return np
+}
+
+var (
+	frontend = Resource{Kind: libapiv3.KindWorkloadEndpoint, Namespace: "default", Name: "node1-k8s-frontend-eth0"}
+	backend  = Resource{Kind: libapiv3.KindWorkloadEndpoint, Namespace: "default", Name: "node1-k8s-backend-eth0"}
+	external = Resource{Kind: apiv3.KindGlobalNetworkSet, Name: "external"}
+)
+
+var _ = Describe("Policy index", func() {
+	var idx *Index
+
+	BeforeEach(func() {
+		idx = New()
+		update(idx, apiv3.KindTier, "", "default", &apiv3.Tier{Spec: apiv3.TierSpec{Order: float(apiv3.DefaultTierOrder)}})
+		update(idx, apiv3.KindTier, "", "security", &apiv3.Tier{Spec: apiv3.TierSpec{Order: float(100)}})
+		update(idx, apiv3.KindProfile, "", "kns.default", profile("kns.default", map[string]string{
+			"pcns.projectcalico.org/name": "default",
+			"pcns.team":                   "blue",
+		}))
+		update(idx, apiv3.KindProfile, "", "ksa.default.web", profile("ksa.default.web", map[string]string{
+			"pcsa.projectcalico.org/name": "web",
+			"pcsa.role":                   "frontend",
+		}))
+		update(idx, libapiv3.KindWorkloadEndpoint, "default", frontend.Name, workloadEndpoint("frontend", "web", map[string]string{"app": "frontend"}))
+		update(idx, libapiv3.KindWorkloadEndpoint, "default", backend.Name, workloadEndpoint("backend", "db", map[string]string{"app": "backend"}))
+
+		gns := apiv3.NewGlobalNetworkSet()
+		gns.Name = "external"
+		gns.Labels = map[string]string{"external": "true"}
+		update(idx, apiv3.KindGlobalNetworkSet, "", "external", gns)
+	})
+
+	It("should find the policies selecting an endpoint in evaluation order", func() {
+		update(idx, apiv3.KindNetworkPolicy, "default", "default.later", networkPolicy("default.later", "app == 'frontend'", float(20)))
+		update(idx, apiv3.KindNetworkPolicy, "default", "default.earlier", networkPolicy("default.earlier", "all()", float(10)))
+		gnp := apiv3.NewGlobalNetworkPolicy()
+		gnp.Name = "security.ns"
+		gnp.Spec.Tier = "security"
+		gnp.Spec.NamespaceSelector = "team == 'blue'"
+		update(idx, apiv3.KindGlobalNetworkPolicy, "", gnp.Name, gnp)
+
+		var policies []string
+		for _, m := range idx.PoliciesSelecting(frontend) {
+			Expect(m.Field).To(Equal(FieldSelector))
+			Expect(m.Resource).To(Equal(frontend))
+			policies = append(policies, m.Policy.Name)
+		}
+		Expect(policies).To(Equal([]string{"security.ns", "default.earlier", "default.later"}))
+	})
+
+	It("should match service account selectors using inherited labels", func() {
+		np := networkPolicy("default.sa", "", nil)
+		np.Spec.ServiceAccountSelector = "role == 'frontend'"
+		update(idx, apiv3.KindNetworkPolicy, "default", np.Name, np)
+
+		policy := Resource{Kind: apiv3.KindNetworkPolicy, Namespace: "default", Name: np.Name}
+		Expect(idx.SelectedBy(policy)).To(Equal([]Match{{Policy: policy, Field: FieldSelector, Resource: frontend}}))
+		Expect(idx.PoliciesSelecting(backend)).To(BeEmpty())
+	})
+
+	It("should report rule peers, including network sets", func() {
+		np := networkPolicy("default.rules", "app == 'backend'", nil)
+		np.Spec.Ingress = []apiv3.Rule{
+			{Action: apiv3.Allow, Source: apiv3.EntityRule{Selector: "app == 'frontend'"}},
+			{Action: apiv3.Allow, Source: apiv3.EntityRule{Selector: "external == 'true'", NamespaceSelector: "global()"}},
+		}
+		update(idx, apiv3.KindNetworkPolicy, "default", np.Name, np)
+
+		policy := Resource{Kind: apiv3.KindNetworkPolicy, Namespace: "default", Name: np.Name}
+		Expect(idx.SelectedBy(policy)).To(Equal([]Match{
+			{Policy: policy, Field: FieldSelector, Resource: backend},
+			{Policy: policy, Field: "spec.ingress[0].source", Resource: frontend},
+			{Policy: policy, Field: "spec.ingress[1].source", Resource: external},
+		}))
+		Expect(idx.PoliciesSelecting(external)).To(Equal([]Match{
+			{Policy: policy, Field: "spec.ingress[1].source", Resource: external},
+		}))
+	})
+
+	It("should not report network sets as selected by a policy's main selector", func() {
+		gnp := apiv3.NewGlobalNetworkPolicy()
+		gnp.Name = "default.all"
+		gnp.Spec.Selector = "all()"
+		update(idx, apiv3.KindGlobalNetworkPolicy, "", gnp.Name, gnp)
+		Expect(idx.PoliciesSelecting(external)).To(BeEmpty())
+		Expect(idx.PoliciesSelecting(frontend)).To(HaveLen(1))
+	})
+
+	It("should handle updates and deletions", func() {
+		update(idx, apiv3.KindNetworkPolicy, "default", "default.np", networkPolicy("default.np", "app == 'frontend'", nil))
+		Expect(idx.PoliciesSelecting(frontend)).To(HaveLen(1))
+
+		update(idx, apiv3.KindNetworkPolicy, "default", "default.np", networkPolicy("default.np", "app == 'backend'", nil))
+		Expect(idx.PoliciesSelecting(frontend)).To(BeEmpty())
+		Expect(idx.PoliciesSelecting(backend)).To(HaveLen(1))
+
+		update(idx, libapiv3.KindWorkloadEndpoint, "default", backend.Name, nil)
+		Expect(idx.PoliciesSelecting(backend)).To(BeEmpty())
+
+		update(idx, apiv3.KindNetworkPolicy, "default", "default.np", nil)
+		Expect(idx.SelectedBy(Resource{Kind: apiv3.KindNetworkPolicy, Namespace: "default", Name: "default.np"})).To(BeNil())
+	})
+
+	It("should ignore staged deletes", func() {
+		snp := apiv3.NewStagedNetworkPolicy()
+		snp.ObjectMeta = metav1.ObjectMeta{Name: "default.staged", Namespace: "default"}
+		snp.Spec.StagedAction = apiv3.StagedActionDelete
+		update(idx, apiv3.KindStagedNetworkPolicy, "default", snp.Name, snp)
+		Expect(idx.PoliciesSelecting(frontend)).To(BeEmpty())
+
+		snp.Spec.StagedAction = apiv3.StagedActionSet
+		snp.Spec.Selector = "all()"
+		update(idx, apiv3.KindStagedNetworkPolicy, "default", snp.Name, snp)
+		Expect(idx.PoliciesSelecting(frontend)).To(HaveLen(1))
+	})
+})
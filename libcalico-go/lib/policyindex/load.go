@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyindex
+
+import (
+	"context"
+	"fmt"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	clientv3 "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// Load returns an Index populated with the current contents of the datastore.
+func Load(ctx context.Context, c clientv3.Interface) (*Index, error) {
+	idx := New()
+	add := func(kind, namespace, name string, value interface{}) error {
+		return idx.OnUpdate(&model.KVPair{
+			Key:   model.ResourceKey{Kind: kind, Namespace: namespace, Name: name},
+			Value: value,
+		})
+	}
+
+	// Tiers and profiles are loaded first so that policies and endpoints are indexed with
+	// their final tier order and inherited labels.
+	tiers, err := c.Tiers().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tiers: %w", err)
+	}
+	for i := range tiers.Items {
+		t := &tiers.Items[i]
+		if err := add(apiv3.KindTier, "", t.Name, t); err != nil {
+			return nil, err
+		}
+	}
+
+	profiles, err := c.Profiles().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+	for i := range profiles.Items {
+		p := &profiles.Items[i]
+		if err := add(apiv3.KindProfile, "", p.Name, p); err != nil {
+			return nil, err
+		}
+	}
+
+	weps, err := c.WorkloadEndpoints().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workload endpoints: %w", err)
+	}
+	for i := range weps.Items {
+		wep := &weps.Items[i]
+		if err := add(libapiv3.KindWorkloadEndpoint, wep.Namespace, wep.Name, wep); err != nil {
+			return nil, err
+		}
+	}
+
+	heps, err := c.HostEndpoints().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list host endpoints: %w", err)
+	}
+	for i := range heps.Items {
+		hep := &heps.Items[i]
+		if err := add(apiv3.KindHostEndpoint, "", hep.Name, hep); err != nil {
+			return nil, err
+		}
+	}
+
+	netsets, err := c.NetworkSets().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network sets: %w", err)
+	}
+	for i := range netsets.Items {
+		ns := &netsets.Items[i]
+		if err := add(apiv3.KindNetworkSet, ns.Namespace, ns.Name, ns); err != nil {
+			return nil, err
+		}
+	}
+
+	gnetsets, err := c.GlobalNetworkSets().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list global network sets: %w", err)
+	}
+	for i := range gnetsets.Items {
+		ns := &gnetsets.Items[i]
+		if err := add(apiv3.KindGlobalNetworkSet, "", ns.Name, ns); err != nil {
+			return nil, err
+		}
+	}
+
+	nps, err := c.NetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list network policies: %w", err)
+	}
+	for i := range nps.Items {
+		np := &nps.Items[i]
+		if err := add(apiv3.KindNetworkPolicy, np.Namespace, np.Name, np); err != nil {
+			return nil, err
+		}
+	}
+
+	gnps, err := c.GlobalNetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list global network policies: %w", err)
+	}
+	for i := range gnps.Items {
+		gnp := &gnps.Items[i]
+		if err := add(apiv3.KindGlobalNetworkPolicy, "", gnp.Name, gnp); err != nil {
+			return nil, err
+		}
+	}
+
+	snps, err := c.StagedNetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staged network policies: %w", err)
+	}
+	for i := range snps.Items {
+		snp := &snps.Items[i]
+		if err := add(apiv3.KindStagedNetworkPolicy, snp.Namespace, snp.Name, snp); err != nil {
+			return nil, err
+		}
+	}
+
+	sgnps, err := c.StagedGlobalNetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staged global network policies: %w", err)
+	}
+	for i := range sgnps.Items {
+		sgnp := &sgnps.Items[i]
+		if err := add(apiv3.KindStagedGlobalNetworkPolicy, "", sgnp.Name, sgnp); err != nil {
+			return nil, err
+		}
+	}
+
+	return idx, nil
+}
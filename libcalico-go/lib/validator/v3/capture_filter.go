@@ -0,0 +1,368 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// This file checks the syntax of PacketCapture filters, which use the tcpdump (pcap-filter)
+// syntax.  Felix compiles the filters with libpcap, which the validator can't link against, so
+// this is a syntax check only: it catches malformed expressions, but not, for example, host
+// names that don't resolve.
+
+var (
+	captureFilterProtos = captureFilterWords("ether", "fddi", "tr", "wlan", "ppp", "slip", "link", "ip", "ip6",
+		"arp", "rarp", "tcp", "udp", "sctp", "icmp", "icmp6", "igmp", "igrp", "pim", "vrrp", "carp",
+		"ah", "esp", "atalk", "aarp", "decnet", "iso", "stp", "ipx", "netbeui", "lat", "sca",
+		"moprc", "mopdl", "radio")
+	captureFilterDirs  = captureFilterWords("src", "dst", "ra", "ta", "addr1", "addr2", "addr3", "addr4")
+	captureFilterTypes = captureFilterWords("host", "net", "port", "portrange", "gateway", "proto", "protochain")
+	// Primitives that may be followed by an optional number.
+	captureFilterTagged = captureFilterWords("vlan", "mpls", "pppoes", "geneve")
+	// Primitives that stand alone.
+	captureFilterFlags    = captureFilterWords("broadcast", "multicast", "inbound", "outbound", "pppoed")
+	captureFilterOther    = captureFilterWords("and", "or", "not", "less", "greater", "mask", "len")
+	captureFilterRelOps   = captureFilterWords("=", "==", "!=", "<", "<=", ">", ">=")
+	captureFilterArithOps = captureFilterWords("+", "-", "*", "/", "%", "&", "|", "^", "<<", ">>")
+
+	captureFilterNumber     = regexp.MustCompile(`^(0x[0-9a-fA-F]+|[0-9]+)$`)
+	captureFilterIndex      = regexp.MustCompile(`^(0x[0-9a-fA-F]+|[0-9]+):[124]$`)
+	captureFilterIdentifier = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+)
+
+func captureFilterWords(items ...string) map[string]bool {
+	s := map[string]bool{}
+	for _, i := range items {
+		s[i] = true
+	}
+	return s
+}
+
+func isCaptureFilterKeyword(tok string) bool {
+	return captureFilterProtos[tok] || captureFilterDirs[tok] || captureFilterTypes[tok] ||
+		captureFilterTagged[tok] || captureFilterFlags[tok] || captureFilterOther[tok]
+}
+
+// validateCaptureFilter returns an error if the given tcpdump-style filter expression is not
+// syntactically valid.
+func validateCaptureFilter(filter string) error {
+	toks, err := tokenizeCaptureFilter(filter)
+	if err != nil {
+		return err
+	}
+	if len(toks) == 0 {
+		return nil
+	}
+	p := &captureFilterParser{toks: toks}
+	if err := p.parseOr(); err != nil {
+		return err
+	}
+	if !p.atEnd() {
+		return fmt.Errorf("unexpected %q", p.peek())
+	}
+	return nil
+}
+
+func tokenizeCaptureFilter(filter string) ([]string, error) {
+	var toks []string
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.ContainsRune("()[]", rune(c)):
+			toks = append(toks, filter[i:i+1])
+			i++
+		case i+1 < len(filter) && captureFilterTwoCharOp(filter[i:i+2]):
+			toks = append(toks, filter[i:i+2])
+			i += 2
+		case strings.ContainsRune("!&|=<>+-*/%^", rune(c)):
+			toks = append(toks, filter[i:i+1])
+			i++
+		case isCaptureFilterValueChar(c) || c == '\\':
+			j := i + 1
+			for j < len(filter) && isCaptureFilterValueChar(filter[j]) {
+				j++
+			}
+			toks = append(toks, filter[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+	}
+	return toks, nil
+}
+
+func captureFilterTwoCharOp(s string) bool {
+	switch s {
+	case "&&", "||", "==", "!=", "<=", ">=", "<<", ">>":
+		return true
+	}
+	return false
+}
+
+func isCaptureFilterValueChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.IndexByte("_.:/-", c) >= 0
+}
+
+type captureFilterParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *captureFilterParser) atEnd() bool {
+	return p.pos >= len(p.toks)
+}
+
+func (p *captureFilterParser) peek() string {
+	if p.atEnd() {
+		return ""
+	}
+	return p.toks[p.pos]
+}
+
+func (p *captureFilterParser) next() (string, error) {
+	if p.atEnd() {
+		return "", errors.New("unexpected end of filter")
+	}
+	p.pos++
+	return p.toks[p.pos-1], nil
+}
+
+func (p *captureFilterParser) expect(want string) error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return fmt.Errorf("expected %q but found %q", want, tok)
+	}
+	return nil
+}
+
+// parseOr parses a sequence of terms joined by "or".
+func (p *captureFilterParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.peek() == "or" || p.peek() == "||" {
+		p.pos++
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseAnd parses a sequence of terms joined by "and".
+func (p *captureFilterParser) parseAnd() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.peek() == "and" || p.peek() == "&&" {
+		p.pos++
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *captureFilterParser) parseUnary() error {
+	if p.peek() == "not" || p.peek() == "!" {
+		p.pos++
+		return p.parseUnary()
+	}
+
+	// A term is either a comparison of packet data, such as "tcp[13] & 2 != 0", a parenthesised
+	// expression or a primitive.  Comparisons may start with a parenthesis too, so try them first.
+	start := p.pos
+	if err := p.parseRelation(); err == nil {
+		return nil
+	}
+	p.pos = start
+	if p.peek() == "(" {
+		p.pos++
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		return p.expect(")")
+	}
+	return p.parsePrimitive()
+}
+
+func (p *captureFilterParser) parseRelation() error {
+	if err := p.parseArith(); err != nil {
+		return err
+	}
+	op, err := p.next()
+	if err != nil {
+		return err
+	}
+	if !captureFilterRelOps[op] {
+		return fmt.Errorf("expected a comparison but found %q", op)
+	}
+	return p.parseArith()
+}
+
+func (p *captureFilterParser) parseArith() error {
+	if err := p.parseFactor(); err != nil {
+		return err
+	}
+	for captureFilterArithOps[p.peek()] {
+		p.pos++
+		if err := p.parseFactor(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *captureFilterParser) parseFactor() error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	switch {
+	case tok == "(":
+		if err := p.parseArith(); err != nil {
+			return err
+		}
+		return p.expect(")")
+	case tok == "-":
+		return p.parseFactor()
+	case tok == "len" || captureFilterNumber.MatchString(tok):
+		return nil
+	case captureFilterProtos[tok]:
+		// Packet data access, such as "ip[6:2]".
+		if err := p.expect("["); err != nil {
+			return err
+		}
+		return p.parseIndex()
+	case captureFilterIdentifier.MatchString(tok) && !isCaptureFilterKeyword(tok):
+		// A named constant, such as "tcpflags" or "icmp-echo".
+		return nil
+	}
+	return fmt.Errorf("unexpected %q in expression", tok)
+}
+
+// parseIndex parses the offset and optional size of a packet data access, after the "[".
+func (p *captureFilterParser) parseIndex() error {
+	// The tokenizer keeps "13:1" together since colons are also part of IPv6 addresses.
+	if captureFilterIndex.MatchString(p.peek()) {
+		p.pos++
+		return p.expect("]")
+	}
+	if err := p.parseArith(); err != nil {
+		return err
+	}
+	if p.peek() == ":" {
+		p.pos++
+		size, err := p.next()
+		if err != nil {
+			return err
+		}
+		if size != "1" && size != "2" && size != "4" {
+			return fmt.Errorf("invalid data size %q, must be 1, 2 or 4", size)
+		}
+	}
+	return p.expect("]")
+}
+
+// parsePrimitive parses a primitive such as "tcp", "src host 10.0.0.1", "ip6 net fd00::/8" or
+// "less 64".  A bare value takes the qualifiers of the previous primitive, as in "port 80 or 443".
+func (p *captureFilterParser) parsePrimitive() error {
+	tok := p.peek()
+	switch {
+	case tok == "less" || tok == "greater":
+		p.pos++
+		return p.parseNumber()
+	case captureFilterFlags[tok]:
+		p.pos++
+		return nil
+	case captureFilterTagged[tok]:
+		p.pos++
+		if captureFilterNumber.MatchString(p.peek()) {
+			p.pos++
+		}
+		return nil
+	}
+
+	sawProto := false
+	if captureFilterProtos[tok] {
+		sawProto = true
+		p.pos++
+		if p.peek() == "broadcast" || p.peek() == "multicast" {
+			p.pos++
+			return nil
+		}
+	}
+
+	sawDir := false
+	if captureFilterDirs[p.peek()] {
+		sawDir = true
+		dir := p.pos
+		p.pos++
+		// "src or dst" and "src and dst" are single qualifiers.
+		if (p.peek() == "or" || p.peek() == "and") && dir+2 < len(p.toks) {
+			if other := p.toks[dir+2]; other != p.toks[dir] && (other == "src" || other == "dst") {
+				p.pos += 2
+			}
+		}
+	}
+
+	if typ := p.peek(); captureFilterTypes[typ] {
+		p.pos++
+		if err := p.parseValue(); err != nil {
+			return err
+		}
+		if typ == "net" && p.peek() == "mask" {
+			p.pos++
+			return p.parseValue()
+		}
+		return nil
+	}
+	if sawProto && !sawDir {
+		return nil
+	}
+	return p.parseValue()
+}
+
+func (p *captureFilterParser) parseValue() error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if isCaptureFilterKeyword(tok) || !isCaptureFilterValueChar(tok[0]) && tok[0] != '\\' {
+		return fmt.Errorf("expected a value but found %q", tok)
+	}
+	return nil
+}
+
+func (p *captureFilterParser) parseNumber() error {
+	tok, err := p.next()
+	if err != nil {
+		return err
+	}
+	if !captureFilterNumber.MatchString(tok) {
+		return fmt.Errorf("expected a number but found %q", tok)
+	}
+	return nil
+}
@@ -15,12 +15,14 @@
 package v3
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
@@ -98,12 +100,16 @@ var (
 	bpfServiceModeRegex     = regexp.MustCompile("^(Tunnel|DSR)$")
 	bpfCTLBRegex            = regexp.MustCompile("^(Disabled|Enabled|TCP)$")
 	bpfHostNatRegex         = regexp.MustCompile("^(Disabled|Enabled)$")
-	datastoreType           = regexp.MustCompile("^(etcdv3|kubernetes)$")
+	datastoreType           = regexp.MustCompile("^(etcdv3|kubernetes|file)$")
 	routeSource             = regexp.MustCompile("^(WorkloadIPs|CalicoIPAM)$")
 	dropAcceptReturnRegex   = regexp.MustCompile("^(Drop|Accept|Return)$")
 	acceptReturnRegex       = regexp.MustCompile("^(Accept|Return)$")
 	dropRejectRegex         = regexp.MustCompile("^(Drop|Reject)$")
 	ipTypeRegex             = regexp.MustCompile("^(CalicoNodeIP|InternalIP|ExternalIP)$")
+	grpcServiceRegex        = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	grpcMethodRegex         = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	jwtAlgorithmRegex       = regexp.MustCompile("^(RS256|RS384|RS512|PS256|PS384|PS512|ES256|ES384|ES512)$")
+	ruleLimitScopeRegex     = regexp.MustCompile("^(PerSource|PerRule)$")
 	standardCommunity       = regexp.MustCompile(`^(\d+):(\d+)$`)
 	largeCommunity          = regexp.MustCompile(`^(\d+):(\d+):(\d+)$`)
 	number                  = regexp.MustCompile(`(\d+)`)
@@ -116,6 +122,7 @@ var (
 	protocolPortsMsg        = "rules that specify ports must set protocol to TCP or UDP or SCTP"
 	protocolIcmpMsg         = "rules that specify ICMP fields must set protocol to ICMP"
 	protocolAndHTTPMsg      = "rules that specify HTTP fields must set protocol to TCP or empty"
+	protocolAndGRPCMsg      = "rules that specify gRPC fields must set protocol to TCP or empty"
 	globalSelectorEntRule   = fmt.Sprintf("%v can only be used in an EntityRule namespaceSelector", globalSelector)
 	globalSelectorOnly      = fmt.Sprintf("%v cannot be combined with other selectors", globalSelector)
 
@@ -215,6 +222,7 @@ func init() {
 	registerFieldValidator("routeSource", validateRouteSource)
 	registerFieldValidator("wireguardPublicKey", validateWireguardPublicKey)
 	registerFieldValidator("IP:port", validateIPPort)
+	registerFieldValidator("ruleLimitScope", RegexValidator("Scope", ruleLimitScopeRegex))
 	registerFieldValidator("reachableBy", validateReachableByField)
 
 	// Register filter action and match operator validators (used in BGPFilter)
@@ -253,6 +261,7 @@ func init() {
 	registerStructValidator(validate, validateObjectMeta, metav1.ObjectMeta{})
 	registerStructValidator(validate, validateTier, api.Tier{})
 	registerStructValidator(validate, validateHTTPRule, api.HTTPMatch{})
+	registerStructValidator(validate, validateGRPCRule, api.GRPCMatch{})
 	registerStructValidator(validate, validateFelixConfigSpec, api.FelixConfigurationSpec{})
 	registerStructValidator(validate, validateWorkloadEndpointSpec, libapi.WorkloadEndpointSpec{})
 	registerStructValidator(validate, validateHostEndpointSpec, api.HostEndpointSpec{})
@@ -268,10 +277,14 @@ func init() {
 	registerStructValidator(validate, validateStagedKubernetesNetworkPolicy, api.StagedKubernetesNetworkPolicy{})
 	registerStructValidator(validate, validateGlobalNetworkSet, api.GlobalNetworkSet{})
 	registerStructValidator(validate, validateNetworkSet, api.NetworkSet{})
+	registerStructValidator(validate, validatePacketCaptureSpec, api.PacketCaptureSpec{})
+	registerStructValidator(validate, validateIPPoolMigrationSpec, api.IPPoolMigrationSpec{})
 	registerStructValidator(validate, validateRuleMetadata, api.RuleMetadata{})
+	registerStructValidator(validate, validateRuleLimit, api.RuleLimit{})
 	registerStructValidator(validate, validateRouteTableIDRange, api.RouteTableIDRange{})
 	registerStructValidator(validate, validateRouteTableRange, api.RouteTableRange{})
 	registerStructValidator(validate, validateBGPConfigurationSpec, api.BGPConfigurationSpec{})
+	registerStructValidator(validate, validateBFDConfiguration, api.BFDConfiguration{})
 	registerStructValidator(validate, validateBlockAffinitySpec, libapi.BlockAffinitySpec{})
 	registerStructValidator(validate, validateHealthTimeoutOverride, api.HealthTimeoutOverride{})
 }
@@ -868,16 +881,28 @@ func validateHTTPMethods(methods []string) error {
 // validateHTTPPaths checks if the HTTP path match clauses are valid.
 func validateHTTPPaths(paths []api.HTTPPath) error {
 	for _, path := range paths {
-		if path.Exact != "" && path.Prefix != "" {
-			return fmt.Errorf("Invalid path match. Both 'exact' and 'prefix' are set")
+		set := 0
+		for _, v := range []string{path.Exact, path.Prefix, path.Regex} {
+			if v != "" {
+				set++
+			}
+		}
+		if set > 1 {
+			return fmt.Errorf("Invalid path match. Only one of 'exact', 'prefix' and 'regex' may be set")
+		}
+		if set == 0 {
+			return fmt.Errorf("Invalid path match. One of 'exact', 'prefix' or 'regex' must be set")
+		}
+		if path.Regex != "" {
+			if _, err := regexp.Compile(path.Regex); err != nil {
+				return fmt.Errorf("Invalid path regex %s: %v", path.Regex, err)
+			}
+			continue
 		}
 		v := path.Exact
 		if v == "" {
 			v = path.Prefix
 		}
-		if v == "" {
-			return fmt.Errorf("Invalid path match. Either 'exact' or 'prefix' must be set")
-		}
 		// Checks from https://tools.ietf.org/html/rfc3986#page-22
 		if !strings.HasPrefix(v, "/") ||
 			strings.ContainsAny(v, "? #") {
@@ -887,6 +912,73 @@ func validateHTTPPaths(paths []api.HTTPPath) error {
 	return nil
 }
 
+// validateHTTPHosts checks if the HTTP host match clauses are valid.
+func validateHTTPHosts(hosts []string) error {
+	for _, host := range hosts {
+		if host == "" || strings.ContainsAny(host, "/ ") || strings.Contains(strings.TrimPrefix(host, "*."), "*") {
+			return fmt.Errorf("Invalid host %q. (must not be empty or contain `/` or spaces, and may only use `*.` as a prefix)", host)
+		}
+	}
+	return nil
+}
+
+// validateHTTPKeyValueMatch checks that exactly one of the value matches of a header or query
+// parameter match is set, and that its regex, if any, compiles.
+func validateHTTPKeyValueMatch(kind, name, exact, prefix, regex string, present *bool) error {
+	if name == "" {
+		return fmt.Errorf("Invalid %s match. 'name' must be set", kind)
+	}
+	set := 0
+	for _, v := range []string{exact, prefix, regex} {
+		if v != "" {
+			set++
+		}
+	}
+	if present != nil {
+		set++
+	}
+	if set != 1 {
+		return fmt.Errorf("Invalid %s match for %s. Exactly one of 'exact', 'prefix', 'regex' and 'present' must be set", kind, name)
+	}
+	if regex != "" {
+		if _, err := regexp.Compile(regex); err != nil {
+			return fmt.Errorf("Invalid %s regex %s: %v", kind, regex, err)
+		}
+	}
+	return nil
+}
+
+// validateHTTPJWT checks that the JWT match has a usable key set and well-formed claim matches.
+func validateHTTPJWT(jwt *api.HTTPJWTMatch) error {
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(jwt.JWKS), &jwks); err != nil {
+		return fmt.Errorf("Invalid JWKS: %v", err)
+	}
+	if len(jwks.Keys) == 0 {
+		return fmt.Errorf("Invalid JWKS: no keys")
+	}
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" && k.Kty != "EC" {
+			return fmt.Errorf("Invalid JWKS: unsupported key type %q (must be RSA or EC)", k.Kty)
+		}
+	}
+	for _, c := range jwt.Claims {
+		if c.Name == "" || strings.HasPrefix(c.Name, ".") || strings.HasSuffix(c.Name, ".") {
+			return fmt.Errorf("Invalid JWT claim name %q", c.Name)
+		}
+	}
+	for _, alg := range jwt.Algorithms {
+		if !jwtAlgorithmRegex.MatchString(alg) {
+			return fmt.Errorf("Invalid JWT algorithm %q (must be RS256, RS384, RS512, PS256, PS384, PS512, ES256, ES384 or ES512)", alg)
+		}
+	}
+	return nil
+}
+
 func validateHTTPRule(structLevel validator.StructLevel) {
 	h := structLevel.Current().Interface().(api.HTTPMatch)
 	log.Debugf("Validate HTTP Rule: %v", h)
@@ -896,6 +988,41 @@ func validateHTTPRule(structLevel validator.StructLevel) {
 	if err := validateHTTPPaths(h.Paths); err != nil {
 		structLevel.ReportError(reflect.ValueOf(h.Paths), "Paths", "", reason(err.Error()), "")
 	}
+	if err := validateHTTPHosts(h.Hosts); err != nil {
+		structLevel.ReportError(reflect.ValueOf(h.Hosts), "Hosts", "", reason(err.Error()), "")
+	}
+	for _, m := range h.Headers {
+		if err := validateHTTPKeyValueMatch("header", m.Name, m.Exact, m.Prefix, m.Regex, m.Present); err != nil {
+			structLevel.ReportError(reflect.ValueOf(h.Headers), "Headers", "", reason(err.Error()), "")
+		}
+	}
+	for _, m := range h.QueryParams {
+		if err := validateHTTPKeyValueMatch("query parameter", m.Name, m.Exact, m.Prefix, m.Regex, m.Present); err != nil {
+			structLevel.ReportError(reflect.ValueOf(h.QueryParams), "QueryParams", "", reason(err.Error()), "")
+		}
+	}
+	if h.JWT != nil {
+		if err := validateHTTPJWT(h.JWT); err != nil {
+			structLevel.ReportError(reflect.ValueOf(h.JWT), "JWT", "", reason(err.Error()), "")
+		}
+	}
+}
+
+func validateGRPCRule(structLevel validator.StructLevel) {
+	g := structLevel.Current().Interface().(api.GRPCMatch)
+	log.Debugf("Validate gRPC Rule: %v", g)
+	for _, service := range g.Services {
+		if !grpcServiceRegex.MatchString(service) {
+			structLevel.ReportError(reflect.ValueOf(g.Services), "Services", "",
+				reason(fmt.Sprintf("Invalid gRPC service %q. (must be a fully-qualified service name, e.g. helloworld.Greeter)", service)), "")
+		}
+	}
+	for _, method := range g.Methods {
+		if !grpcMethodRegex.MatchString(method) {
+			structLevel.ReportError(reflect.ValueOf(g.Methods), "Methods", "",
+				reason(fmt.Sprintf("Invalid gRPC method %q. (must be a method name, e.g. SayHello)", method)), "")
+		}
+	}
 }
 
 func validatePort(structLevel validator.StructLevel) {
@@ -1297,6 +1424,14 @@ func validateRule(structLevel validator.StructLevel) {
 		}
 	}
 
+	// Check that gRPC must not use non-TCP protocols
+	if rule.GRPC != nil && rule.Protocol != nil {
+		tcp := numorstring.ProtocolFromString("TCP")
+		if *rule.Protocol != tcp {
+			structLevel.ReportError(reflect.ValueOf(rule.Protocol), "Protocol", "", reason(protocolAndGRPCMsg), "")
+		}
+	}
+
 	icmp := numorstring.ProtocolFromString("ICMP")
 	icmpv6 := numorstring.ProtocolFromString("ICMPv6")
 	if rule.ICMP != nil && (rule.Protocol == nil || (*rule.Protocol != icmp && *rule.Protocol != icmpv6)) {
@@ -1353,6 +1488,11 @@ func validateRule(structLevel validator.StructLevel) {
 			"", reason("only valid for Allow rules"), "")
 	}
 
+	if rule.Limit != nil && rule.Action != api.Allow {
+		structLevel.ReportError(reflect.ValueOf(rule.Limit), "Limit",
+			"", reason("only valid for Allow rules"), "")
+	}
+
 	// Check that destination service rules do not use ports.
 	// Destination service rules use ports specified on the endpoints.
 	if rule.Destination.Services != nil && len(rule.Destination.Ports) != 0 {
@@ -1365,6 +1505,19 @@ func validateRule(structLevel validator.StructLevel) {
 	}
 }
 
+func validateRuleLimit(structLevel validator.StructLevel) {
+	limit := structLevel.Current().Interface().(api.RuleLimit)
+
+	if limit.NewConnectionsPerSecond == nil && limit.MaxConnections == nil {
+		structLevel.ReportError(reflect.ValueOf(limit), "Limit", "",
+			reason("must specify newConnectionsPerSecond or maxConnections"), "")
+	}
+	if limit.Burst != nil && limit.NewConnectionsPerSecond == nil {
+		structLevel.ReportError(reflect.ValueOf(limit.Burst), "Burst", "",
+			reason("only valid with newConnectionsPerSecond"), "")
+	}
+}
+
 func validateEntityRule(structLevel validator.StructLevel) {
 	rule := structLevel.Current().Interface().(api.EntityRule)
 	if strings.Contains(rule.Selector, globalSelector) {
@@ -1460,6 +1613,10 @@ func validateBGPPeerSpec(structLevel validator.StructLevel) {
 		structLevel.ReportError(reflect.ValueOf(ps.PeerIP), "PeerSelector", "",
 			reason("PeerSelector field must be empty when LocalWorkloadSelector is specified"), "")
 	}
+	if ps.LongLivedStaleTime != nil && ps.LongLivedStaleTime.Duration < time.Second {
+		structLevel.ReportError(reflect.ValueOf(ps.LongLivedStaleTime), "LongLivedStaleTime", "",
+			reason("must be at least 1s"), "")
+	}
 	ok, msg := validateReachableBy(ps.ReachableBy, ps.PeerIP)
 	if !ok {
 		structLevel.ReportError(reflect.ValueOf(ps.ReachableBy), "ReachableBy", "",
@@ -1843,6 +2000,29 @@ func validateNetworkSet(structLevel validator.StructLevel) {
 	}
 }
 
+func validatePacketCaptureSpec(structLevel validator.StructLevel) {
+	spec := structLevel.Current().Interface().(api.PacketCaptureSpec)
+	if spec.Duration != nil && spec.Duration.Duration <= 0 {
+		structLevel.ReportError(reflect.ValueOf(spec.Duration), "Duration", "",
+			reason("duration must be positive"), "")
+	}
+	if strings.ContainsAny(spec.Filter, "\n\r\x00") {
+		structLevel.ReportError(reflect.ValueOf(spec.Filter), "Filter", "",
+			reason("filter must be a single line"), "")
+	} else if err := validateCaptureFilter(spec.Filter); err != nil {
+		structLevel.ReportError(reflect.ValueOf(spec.Filter), "Filter", "",
+			reason(fmt.Sprintf("invalid filter: %v", err)), "")
+	}
+}
+
+func validateIPPoolMigrationSpec(structLevel validator.StructLevel) {
+	spec := structLevel.Current().Interface().(api.IPPoolMigrationSpec)
+	if spec.SourcePool == spec.TargetPool {
+		structLevel.ReportError(reflect.ValueOf(spec.TargetPool), "TargetPool", "",
+			reason("target pool must be different to the source pool"), "")
+	}
+}
+
 func validateGlobalNetworkSet(structLevel validator.StructLevel) {
 	gns := structLevel.Current().Interface().(api.GlobalNetworkSet)
 	for k := range gns.GetLabels() {
@@ -1885,6 +2065,18 @@ func validateGlobalNetworkPolicySpec(spec *api.GlobalNetworkPolicySpec, structLe
 			"PolicySpec.ApplyOnForward", "", reason("ApplyOnForward must be true if either PreDNAT or DoNotTrack is true, for a given PolicySpec"), "")
 	}
 
+	if spec.DoNotTrack {
+		// Limits rely on connection tracking.
+		for _, rules := range [][]api.Rule{spec.Ingress, spec.Egress} {
+			for _, r := range rules {
+				if r.Limit != nil {
+					structLevel.ReportError(reflect.ValueOf(r.Limit),
+						"PolicySpec.Limit", "", reason("rule limits are not supported in DoNotTrack policies"), "")
+				}
+			}
+		}
+	}
+
 	// Check (and disallow) any repeats in Types field.
 	mp := map[api.PolicyType]bool{}
 	for _, t := range spec.Types {
@@ -2256,6 +2448,26 @@ func validateBGPConfigurationSpec(structLevel validator.StructLevel) {
 	if spec.NodeMeshMaxRestartTime != nil && spec.NodeToNodeMeshEnabled != nil && !*spec.NodeToNodeMeshEnabled {
 		structLevel.ReportError(reflect.ValueOf(spec), "Spec.NodeMeshMaxRestartTime", "", reason("spec.NodeMeshMaxRestartTime cannot be set if spec.NodeToNodeMesh is disabled"), "")
 	}
+
+	// Check that node mesh long-lived stale time cannot be set if node to node mesh is disabled.
+	if spec.NodeMeshLongLivedStaleTime != nil && spec.NodeToNodeMeshEnabled != nil && !*spec.NodeToNodeMeshEnabled {
+		structLevel.ReportError(reflect.ValueOf(spec), "Spec.NodeMeshLongLivedStaleTime", "", reason("spec.NodeMeshLongLivedStaleTime cannot be set if spec.NodeToNodeMesh is disabled"), "")
+	}
+	if spec.NodeMeshLongLivedStaleTime != nil && spec.NodeMeshLongLivedStaleTime.Duration < time.Second {
+		structLevel.ReportError(reflect.ValueOf(spec.NodeMeshLongLivedStaleTime), "Spec.NodeMeshLongLivedStaleTime", "", reason("must be at least 1s"), "")
+	}
+}
+
+func validateBFDConfiguration(structLevel validator.StructLevel) {
+	bfd := structLevel.Current().Interface().(api.BFDConfiguration)
+
+	// BIRD configures BFD intervals in milliseconds.
+	if bfd.MinRxInterval != nil && bfd.MinRxInterval.Duration < time.Millisecond {
+		structLevel.ReportError(reflect.ValueOf(bfd.MinRxInterval), "MinRxInterval", "", reason("must be at least 1ms"), "")
+	}
+	if bfd.MinTxInterval != nil && bfd.MinTxInterval.Duration < time.Millisecond {
+		structLevel.ReportError(reflect.ValueOf(bfd.MinTxInterval), "MinTxInterval", "", reason("must be at least 1ms"), "")
+	}
 }
 
 func validateBlockAffinitySpec(structLevel validator.StructLevel) {
@@ -2323,5 +2535,8 @@ func ruleUsesAppLayerPolicy(rule *api.Rule) (bool, reflect.Value, string) {
 	if rule.HTTP != nil {
 		return true, reflect.ValueOf(rule.HTTP), "HTTP"
 	}
+	if rule.GRPC != nil {
+		return true, reflect.ValueOf(rule.GRPC), "GRPC"
+	}
 	return false, reflect.Value{}, ""
 }
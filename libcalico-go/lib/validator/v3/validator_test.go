@@ -445,6 +445,94 @@ func init() {
 			},
 			false,
 		),
+		Entry("should accept PacketCaptureSpec with selector, filter and limits",
+			api.PacketCaptureSpec{
+				Selector:         "app == 'db'",
+				Filter:           "tcp port 5432",
+				Duration:         &metav1.Duration{Duration: 10 * time.Minute},
+				MaxFileSizeBytes: &V128,
+				MaxFiles:         &V4,
+			},
+			true,
+		),
+		Entry("should accept empty PacketCaptureSpec", api.PacketCaptureSpec{}, true),
+		Entry("should reject PacketCaptureSpec with bad selector",
+			api.PacketCaptureSpec{Selector: "app == "}, false),
+		Entry("should reject PacketCaptureSpec with negative duration",
+			api.PacketCaptureSpec{Duration: &metav1.Duration{Duration: -time.Second}}, false),
+		Entry("should reject PacketCaptureSpec with zero max files",
+			api.PacketCaptureSpec{MaxFiles: &V0}, false),
+		Entry("should reject PacketCaptureSpec with zero max file size",
+			api.PacketCaptureSpec{MaxFileSizeBytes: &V0}, false),
+		Entry("should reject PacketCaptureSpec with multi-line filter",
+			api.PacketCaptureSpec{Filter: "tcp\nport 80"}, false),
+		Entry("should accept PacketCaptureSpec with filter host 10.0.0.1 and not port 22",
+			api.PacketCaptureSpec{Filter: "host 10.0.0.1 and not port 22"}, true),
+		Entry("should accept PacketCaptureSpec with filter src or dst net 10.0.0.0/8 and tcp",
+			api.PacketCaptureSpec{Filter: "src or dst net 10.0.0.0/8 and tcp"}, true),
+		Entry("should accept PacketCaptureSpec with filter ip6 host fe80::1",
+			api.PacketCaptureSpec{Filter: "ip6 host fe80::1"}, true),
+		Entry("should accept PacketCaptureSpec with filter port 80 or 443",
+			api.PacketCaptureSpec{Filter: "port 80 or 443"}, true),
+		Entry("should accept PacketCaptureSpec with filter not (port 80 or port 443)",
+			api.PacketCaptureSpec{Filter: "not (port 80 or port 443)"}, true),
+		Entry("should accept PacketCaptureSpec with filter tcp[tcpflags] & (tcp-syn|tcp-fin) != 0",
+			api.PacketCaptureSpec{Filter: "tcp[tcpflags] & (tcp-syn|tcp-fin) != 0"}, true),
+		Entry("should accept PacketCaptureSpec with filter ip[6:2] & 0x1fff = 0",
+			api.PacketCaptureSpec{Filter: "ip[6:2] & 0x1fff = 0"}, true),
+		Entry("should accept PacketCaptureSpec with filter icmp[icmptype] == icmp-echo",
+			api.PacketCaptureSpec{Filter: "icmp[icmptype] == icmp-echo"}, true),
+		Entry("should accept PacketCaptureSpec with filter vlan 100 && udp portrange 1000-2000",
+			api.PacketCaptureSpec{Filter: "vlan 100 && udp portrange 1000-2000"}, true),
+		Entry("should accept PacketCaptureSpec with filter ether host 00:11:22:33:44:55",
+			api.PacketCaptureSpec{Filter: "ether host 00:11:22:33:44:55"}, true),
+		Entry("should accept PacketCaptureSpec with filter less 128",
+			api.PacketCaptureSpec{Filter: "less 128"}, true),
+		Entry("should accept PacketCaptureSpec with filter ip proto \\tcp",
+			api.PacketCaptureSpec{Filter: "ip proto \\tcp"}, true),
+		Entry("should accept PacketCaptureSpec with filter ether broadcast",
+			api.PacketCaptureSpec{Filter: "ether broadcast"}, true),
+		Entry("should accept PacketCaptureSpec with filter net 10.0.0.0 mask 255.0.0.0",
+			api.PacketCaptureSpec{Filter: "net 10.0.0.0 mask 255.0.0.0"}, true),
+		Entry("should reject PacketCaptureSpec with filter tcp port",
+			api.PacketCaptureSpec{Filter: "tcp port"}, false),
+		Entry("should reject PacketCaptureSpec with filter port 80 and",
+			api.PacketCaptureSpec{Filter: "port 80 and"}, false),
+		Entry("should reject PacketCaptureSpec with filter (tcp or udp",
+			api.PacketCaptureSpec{Filter: "(tcp or udp"}, false),
+		Entry("should reject PacketCaptureSpec with filter tcp)",
+			api.PacketCaptureSpec{Filter: "tcp)"}, false),
+		Entry("should reject PacketCaptureSpec with filter tcp 80",
+			api.PacketCaptureSpec{Filter: "tcp 80"}, false),
+		Entry("should reject PacketCaptureSpec with filter host and",
+			api.PacketCaptureSpec{Filter: "host and"}, false),
+		Entry("should reject PacketCaptureSpec with filter tcp[13 != 0",
+			api.PacketCaptureSpec{Filter: "tcp[13 != 0"}, false),
+		Entry("should reject PacketCaptureSpec with filter tcp[2:3] = 0",
+			api.PacketCaptureSpec{Filter: "tcp[2:3] = 0"}, false),
+		Entry("should reject PacketCaptureSpec with filter less foo",
+			api.PacketCaptureSpec{Filter: "less foo"}, false),
+		Entry("should reject PacketCaptureSpec with filter port 80; rm -rf /",
+			api.PacketCaptureSpec{Filter: "port 80; rm -rf /"}, false),
+		Entry("should reject PacketCaptureSpec with filter udp or or tcp",
+			api.PacketCaptureSpec{Filter: "udp or or tcp"}, false),
+		Entry("should accept IPPoolMigrationSpec",
+			api.IPPoolMigrationSpec{
+				SourcePool:        "old-pool",
+				TargetPool:        "new-pool",
+				NamespaceSelector: "migrate == 'true'",
+				PodsPerMinute:     &V4,
+			},
+			true,
+		),
+		Entry("should reject IPPoolMigrationSpec with the same source and target pool",
+			api.IPPoolMigrationSpec{SourcePool: "pool", TargetPool: "pool"}, false),
+		Entry("should reject IPPoolMigrationSpec without a source pool",
+			api.IPPoolMigrationSpec{TargetPool: "new-pool"}, false),
+		Entry("should reject IPPoolMigrationSpec with bad namespace selector",
+			api.IPPoolMigrationSpec{SourcePool: "old-pool", TargetPool: "new-pool", NamespaceSelector: "migrate == "}, false),
+		Entry("should reject IPPoolMigrationSpec with zero pods per minute",
+			api.IPPoolMigrationSpec{SourcePool: "old-pool", TargetPool: "new-pool", PodsPerMinute: &V0}, false),
 		Entry("should accept NetworkSet with labels",
 			api.NetworkSet{
 				ObjectMeta: v1.ObjectMeta{
@@ -533,6 +621,32 @@ func init() {
 				NodeMeshMaxRestartTime: &v1.Duration{Duration: 200 * time.Second},
 			}, false,
 		),
+		Entry("should reject a node mesh long-lived stale time if node to node mesh is disabled",
+			api.BGPConfigurationSpec{
+				NodeToNodeMeshEnabled:      &Vfalse,
+				NodeMeshLongLivedStaleTime: &v1.Duration{Duration: time.Hour},
+			}, false,
+		),
+		Entry("should accept BFD configuration",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{
+					Enabled:       &Vtrue,
+					MinRxInterval: &v1.Duration{Duration: 300 * time.Millisecond},
+					MinTxInterval: &v1.Duration{Duration: 300 * time.Millisecond},
+					Multiplier:    &[]int32{3}[0],
+				},
+			}, true,
+		),
+		Entry("should reject a BFD interval of less than 1ms",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{MinRxInterval: &v1.Duration{Duration: 500 * time.Microsecond}},
+			}, false,
+		),
+		Entry("should reject a BFD multiplier of 0",
+			api.BGPConfigurationSpec{
+				BFD: &api.BFDConfiguration{Multiplier: &[]int32{0}[0]},
+			}, false,
+		),
 		Entry("should accept valid interface names",
 			api.BGPConfigurationSpec{
 				IgnoredInterfaces: []string{"valid_iface*", "interface_name"},
@@ -806,6 +920,13 @@ func init() {
 		Entry("should reject HealthTimeoutOverride -1", api.FelixConfigurationSpec{HealthTimeoutOverrides: []api.HealthTimeoutOverride{{Name: "Valid", Timeout: metav1.Duration{Duration: -1}}}}, false),
 		Entry("should reject HealthTimeoutOverride with bad name", api.FelixConfigurationSpec{HealthTimeoutOverrides: []api.HealthTimeoutOverride{{Name: "%", Timeout: metav1.Duration{Duration: 10}}}}, false),
 		Entry("should reject HealthTimeoutOverride with no name", api.FelixConfigurationSpec{HealthTimeoutOverrides: []api.HealthTimeoutOverride{{Name: "", Timeout: metav1.Duration{Duration: 10}}}}, false),
+		Entry("should accept a valid HostEndpointTemplate", api.FelixConfigurationSpec{HostEndpointTemplates: []api.HostEndpointTemplate{{
+			GenerateName: "uplink", InterfacePattern: "eth[0-9]+", Labels: map[string]string{"role": "uplink"}, Profiles: []string{"allow-all"},
+		}}}, true),
+		Entry("should reject HostEndpointTemplate with no name", api.FelixConfigurationSpec{HostEndpointTemplates: []api.HostEndpointTemplate{{InterfacePattern: "eth0"}}}, false),
+		Entry("should reject HostEndpointTemplate with bad interface pattern", api.FelixConfigurationSpec{HostEndpointTemplates: []api.HostEndpointTemplate{{GenerateName: "uplink", InterfacePattern: "eth[0-9"}}}, false),
+		Entry("should reject HostEndpointTemplate with bad label", api.FelixConfigurationSpec{HostEndpointTemplates: []api.HostEndpointTemplate{{GenerateName: "uplink", InterfacePattern: "eth0", Labels: map[string]string{"%": "x"}}}}, false),
+		Entry("should reject HostEndpointTemplate with bad profile", api.FelixConfigurationSpec{HostEndpointTemplates: []api.HostEndpointTemplate{{GenerateName: "uplink", InterfacePattern: "eth0", Profiles: []string{"%"}}}}, false),
 
 		// (API) Protocol
 		Entry("should accept protocol TCP", protocolFromString("TCP"), true),
@@ -1615,6 +1736,57 @@ func init() {
 				Action: "Allow",
 				HTTP:   &api.HTTPMatch{Methods: []string{"GET"}},
 			}, true),
+		Entry("should accept Allow rule with gRPC clause",
+			api.Rule{
+				Action: "Allow",
+				GRPC:   &api.GRPCMatch{Services: []string{"helloworld.Greeter"}},
+			}, true),
+		Entry("should reject Deny rule with gRPC clause",
+			api.Rule{
+				Action: "Deny",
+				GRPC:   &api.GRPCMatch{Services: []string{"helloworld.Greeter"}},
+			}, false),
+		Entry("should reject non-TCP protocol with gRPC clause",
+			api.Rule{
+				Action:   "Allow",
+				Protocol: protocolFromString("UDP"),
+				GRPC:     &api.GRPCMatch{Methods: []string{"SayHello"}},
+			}, false),
+		Entry("should accept Allow rule with a new connection rate limit",
+			api.Rule{
+				Action: "Allow",
+				Limit:  &api.RuleLimit{NewConnectionsPerSecond: uint32Helper(100), Burst: uint32Helper(10)},
+			}, true),
+		Entry("should accept Allow rule with a per-rule connection limit",
+			api.Rule{
+				Action: "Allow",
+				Limit:  &api.RuleLimit{MaxConnections: uint32Helper(50), Scope: api.RuleLimitScopePerRule},
+			}, true),
+		Entry("should reject Deny rule with a limit",
+			api.Rule{
+				Action: "Deny",
+				Limit:  &api.RuleLimit{MaxConnections: uint32Helper(50)},
+			}, false),
+		Entry("should reject an empty limit",
+			api.Rule{
+				Action: "Allow",
+				Limit:  &api.RuleLimit{},
+			}, false),
+		Entry("should reject a limit with a burst but no rate",
+			api.Rule{
+				Action: "Allow",
+				Limit:  &api.RuleLimit{MaxConnections: uint32Helper(50), Burst: uint32Helper(10)},
+			}, false),
+		Entry("should reject a zero rate limit",
+			api.Rule{
+				Action: "Allow",
+				Limit:  &api.RuleLimit{NewConnectionsPerSecond: uint32Helper(0)},
+			}, false),
+		Entry("should reject an unknown limit scope",
+			api.Rule{
+				Action: "Allow",
+				Limit:  &api.RuleLimit{MaxConnections: uint32Helper(50), Scope: "PerDestination"},
+			}, false),
 		Entry("should accept Rule with valid annotations",
 			api.Rule{
 				Action:   "Allow",
@@ -1967,6 +2139,22 @@ func init() {
 			PeerIP:                ipv4_1,
 			ASNumber:              as61234,
 		}, false),
+		Entry("should accept BGPPeer with BFD and long-lived graceful restart", api.BGPPeerSpec{
+			PeerIP:             ipv4_1,
+			LongLivedStaleTime: &v1.Duration{Duration: time.Hour},
+			BFD: &api.BFDConfiguration{
+				Enabled:       &Vtrue,
+				MinTxInterval: &v1.Duration{Duration: 50 * time.Millisecond},
+			},
+		}, true),
+		Entry("should reject BGPPeer with BFD multiplier over 255", api.BGPPeerSpec{
+			PeerIP: ipv4_1,
+			BFD:    &api.BFDConfiguration{Multiplier: &[]int32{256}[0]},
+		}, false),
+		Entry("should reject BGPPeer with a long-lived stale time of less than 1s", api.BGPPeerSpec{
+			PeerIP:             ipv4_1,
+			LongLivedStaleTime: &v1.Duration{Duration: time.Millisecond},
+		}, false),
 		Entry("should reject BGPPeer with ReachableBy but without PeerIP", api.BGPPeerSpec{
 			ReachableBy: ipv4_2,
 		}, false),
@@ -2163,6 +2351,19 @@ func init() {
 				},
 			}, true,
 		),
+		Entry("should reject DoNotTrack GlobalNetworkPolicy with rule limits",
+			&api.GlobalNetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.GlobalNetworkPolicySpec{
+					DoNotTrack:     true,
+					ApplyOnForward: true,
+					Ingress: []api.Rule{{
+						Action: "Allow",
+						Limit:  &api.RuleLimit{NewConnectionsPerSecond: uint32Helper(100)},
+					}},
+				},
+			}, false,
+		),
 		Entry("should reject pre-DNAT GlobalNetworkPolicy egress rules",
 			&api.GlobalNetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -2842,6 +3043,15 @@ func init() {
 				},
 			}, false,
 		),
+		Entry("disallow gRPC in egress rule",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{{Action: "Allow", GRPC: &api.GRPCMatch{Methods: []string{"SayHello"}}}},
+					Types:  []api.PolicyType{api.PolicyTypeIngress, api.PolicyTypeEgress},
+				},
+			}, false,
+		),
 		Entry("disallow global() in selector field",
 			&api.NetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -3318,6 +3528,98 @@ func init() {
 			&api.HTTPMatch{Methods: []string{"GET", "GET", "Foo"}},
 			false,
 		),
+		Entry("allow HTTP Path with a regex match clause",
+			&api.HTTPMatch{Paths: []api.HTTPPath{{Regex: "/users/[0-9]+"}}},
+			true,
+		),
+		Entry("disallow HTTP Path with an invalid regex",
+			&api.HTTPMatch{Paths: []api.HTTPPath{{Regex: "/users/[0-9"}}},
+			false,
+		),
+		Entry("disallow HTTP Path with both prefix and regex",
+			&api.HTTPMatch{Paths: []api.HTTPPath{{Prefix: "/users", Regex: "/users/[0-9]+"}}},
+			false,
+		),
+		Entry("allow HTTP hosts",
+			&api.HTTPMatch{Hosts: []string{"api.example.com", "*.example.com"}},
+			true,
+		),
+		Entry("disallow an HTTP host with a wildcard that isn't a prefix",
+			&api.HTTPMatch{Hosts: []string{"api.*.com"}},
+			false,
+		),
+		Entry("allow HTTP header and query parameter matches",
+			&api.HTTPMatch{
+				Headers:     []api.HTTPHeaderMatch{{Name: "x-user", Regex: "admin-.*"}, {Name: "x-debug", Present: &Vfalse}},
+				QueryParams: []api.HTTPQueryParamMatch{{Name: "version", Exact: "2"}},
+			},
+			true,
+		),
+		Entry("disallow an HTTP header match without a value match",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "x-user"}}},
+			false,
+		),
+		Entry("disallow an HTTP header match with two value matches",
+			&api.HTTPMatch{Headers: []api.HTTPHeaderMatch{{Name: "x-user", Exact: "a", Present: &Vtrue}}},
+			false,
+		),
+		Entry("disallow an HTTP query parameter match without a name",
+			&api.HTTPMatch{QueryParams: []api.HTTPQueryParamMatch{{Exact: "2"}}},
+			false,
+		),
+		Entry("allow an HTTP JWT match with an RSA key",
+			&api.HTTPMatch{JWT: &api.HTTPJWTMatch{
+				JWKS:   `{"keys":[{"kty":"RSA","kid":"1","n":"AQAB","e":"AQAB"}]}`,
+				Issuer: "https://issuer.example.com",
+				Claims: []api.HTTPJWTClaimMatch{{Name: "realm_access.roles", Values: []string{"admin"}}},
+			}},
+			true,
+		),
+		Entry("disallow an HTTP JWT match with an empty key set",
+			&api.HTTPMatch{JWT: &api.HTTPJWTMatch{JWKS: `{"keys":[]}`}},
+			false,
+		),
+		Entry("disallow an HTTP JWT match with a symmetric key",
+			&api.HTTPMatch{JWT: &api.HTTPJWTMatch{JWKS: `{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`}},
+			false,
+		),
+		Entry("allow an HTTP JWT match with asymmetric algorithms",
+			&api.HTTPMatch{JWT: &api.HTTPJWTMatch{
+				JWKS:       `{"keys":[{"kty":"RSA","kid":"1","n":"AQAB","e":"AQAB"}]}`,
+				Algorithms: []string{"RS256", "PS512", "ES384"},
+			}},
+			true,
+		),
+		Entry("disallow an HTTP JWT match with a symmetric algorithm",
+			&api.HTTPMatch{JWT: &api.HTTPJWTMatch{
+				JWKS:       `{"keys":[{"kty":"RSA","kid":"1","n":"AQAB","e":"AQAB"}]}`,
+				Algorithms: []string{"HS256"},
+			}},
+			false,
+		),
+		Entry("disallow an HTTP JWT match with the none algorithm",
+			&api.HTTPMatch{JWT: &api.HTTPJWTMatch{
+				JWKS:       `{"keys":[{"kty":"RSA","kid":"1","n":"AQAB","e":"AQAB"}]}`,
+				Algorithms: []string{"none"},
+			}},
+			false,
+		),
+		Entry("allow gRPC services and methods",
+			&api.GRPCMatch{Services: []string{"helloworld.Greeter", "Health"}, Methods: []string{"SayHello", "say_hello2"}},
+			true,
+		),
+		Entry("disallow a gRPC service that isn't fully-qualified",
+			&api.GRPCMatch{Services: []string{"/helloworld.Greeter"}},
+			false,
+		),
+		Entry("disallow a gRPC service with an empty component",
+			&api.GRPCMatch{Services: []string{"helloworld..Greeter"}},
+			false,
+		),
+		Entry("disallow a gRPC method that includes the service",
+			&api.GRPCMatch{Methods: []string{"helloworld.Greeter/SayHello"}},
+			false,
+		),
 		Entry("should not accept an invalid IP address",
 			api.FelixConfigurationSpec{NATOutgoingAddress: bad_ipv4_1}, false,
 		),
@@ -3620,3 +3922,7 @@ func mustParsePortRange(min, max uint16) numorstring.Port {
 func int32Helper(i int32) *int32 {
 	return &i
 }
+
+func uint32Helper(i uint32) *uint32 {
+	return &i
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/projectcalico/calico/node/pkg/flowlogs"
 	"github.com/projectcalico/calico/node/pkg/health"
 	"github.com/projectcalico/calico/node/pkg/hostpathinit"
+	"github.com/projectcalico/calico/node/pkg/lifecycle/drain"
 	"github.com/projectcalico/calico/node/pkg/lifecycle/shutdown"
 	"github.com/projectcalico/calico/node/pkg/lifecycle/startup"
 	"github.com/projectcalico/calico/node/pkg/nodeinit"
@@ -55,6 +56,7 @@ var (
 	runAllocateTunnelAddrs     = flagSet.Bool("allocate-tunnel-addrs", false, "Configure tunnel addresses for this node")
 	allocateTunnelAddrsRunOnce = flagSet.Bool("allocate-tunnel-addrs-run-once", false, "Run allocate-tunnel-addrs in oneshot mode")
 	monitorToken               = flagSet.Bool("monitor-token", false, "Watch for Kubernetes token changes, update CNI config")
+	monitorDrain               = flagSet.Bool("monitor-drain", false, "Gracefully shut down BGP when the node is cordoned or drained")
 )
 
 // Options for liveness checks.
@@ -89,6 +91,10 @@ var (
 	confdRunOnce = flagSet.Bool("confd-run-once", false, "Run confd in oneshot mode")
 	confdKeep    = flagSet.Bool("confd-keep-stage-file", false, "Keep stage file when running confd")
 	confdConfDir = flagSet.String("confd-confdir", "/etc/calico/confd", "Confd configuration directory.")
+
+	confdRender         = flagSet.Bool("confd-render", false, "Render the confd templates once and print them, with a diff against the current files, without writing them")
+	confdRenderNode     = flagSet.String("confd-render-node", "", "Node to render the confd templates for (defaults to NODENAME)")
+	confdRenderSnapshot = flagSet.String("confd-render-snapshot", "", "Render from a BGP syncer snapshot file, as written by typha-client, instead of the datastore")
 )
 
 // non-root hostpath init flags
@@ -111,7 +117,7 @@ func main() {
 
 	// Perform some validation on the parsed flags. Only one of the following may be
 	// specified at a time.
-	onlyOne := []*bool{version, runFelix, runStartup, runConfd, monitorAddrs}
+	onlyOne := []*bool{version, runFelix, runStartup, runConfd, confdRender, monitorAddrs}
 	oneSelected := false
 	for _, o := range onlyOne {
 		if oneSelected && *o {
@@ -167,6 +173,20 @@ func main() {
 		cfg.KeepStageFile = *confdKeep
 		cfg.Onetime = *confdRunOnce
 		confd.Run(cfg)
+	} else if *confdRender {
+		// Command-line tools should log to stderr to avoid confusion with the output.
+		logrus.SetOutput(os.Stderr)
+		cfg, err := confdConfig.InitConfig(true)
+		if err != nil {
+			panic(err)
+		}
+		cfg.ConfDir = *confdConfDir
+		cfg.Snapshot = *confdRenderSnapshot
+		if err := confd.Render(cfg, *confdRenderNode, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
 	} else if *runAllocateTunnelAddrs {
 		logrus.SetFormatter(&logutils.Formatter{Component: "tunnel-ip-allocator"})
 		if *allocateTunnelAddrsRunOnce {
@@ -177,6 +197,9 @@ func main() {
 	} else if *monitorToken {
 		logrus.SetFormatter(&logutils.Formatter{Component: "cni-config-monitor"})
 		cni.Run()
+	} else if *monitorDrain {
+		logrus.SetFormatter(&logutils.Formatter{Component: "monitor-drain"})
+		drain.Run()
 	} else if *initHostpaths {
 		logrus.SetFormatter(&logutils.Formatter{Component: "hostpath-init"})
 		hostpathinit.Run()
@@ -1535,6 +1535,14 @@ func (c shimClient) NetworkSets() client.NetworkSetInterface {
 	return c.client.NetworkSets()
 }
 
+func (c shimClient) PacketCaptures() client.PacketCaptureInterface {
+	return c.client.PacketCaptures()
+}
+
+func (c shimClient) IPPoolMigrations() client.IPPoolMigrationInterface {
+	return c.client.IPPoolMigrations()
+}
+
 // HostEndpoints returns an interface for managing host endpoint resources.
 func (c shimClient) HostEndpoints() client.HostEndpointInterface {
 	return c.client.HostEndpoints()
@@ -21,6 +21,7 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	"unique"
 
 	"github.com/sirupsen/logrus"
 
@@ -56,12 +57,17 @@ func StartAndWatch(num int) {
 func flowToString(f *types.Flow) string {
 	startTime := time.Unix(f.StartTime, 0)
 	policyTrace := types.FlowLogPolicyToProto(f.Key.Policies())
+	var grpcCalls string
+	if f.GrpcCalls != (unique.Handle[string]{}) && f.GrpcCalls.Value() != "" {
+		grpcCalls = fmt.Sprintf("  gRPC calls: %s\n", f.GrpcCalls.Value())
+	}
 	return fmt.Sprintf(
 		"- Time=%v Reporter=%v Action=%v\n"+
 			"  Src={%s(%s/%s)} Dst={%s(%s/%s) Svc:%s/%s} Proto={%s(%v) Svc:%s/%v}\n"+
 			"  Counts={Ingress: %vPkts/%vBytes Egress:%vPkts/%vBytes} Connections={Started:%v Completed:%v Live:%v}\n"+
 			"  Enforced:\n%v\n"+
-			"  Pending:\n%v\n",
+			"  Pending:\n%v\n"+
+			"%s",
 		startTime.Local(), f.Key.Reporter(), f.Key.Action(),
 		endpointTypeToString(f.Key.SourceType()), f.Key.SourceNamespace(), f.Key.SourceName(),
 		endpointTypeToString(f.Key.DestType()), f.Key.DestNamespace(), f.Key.DestName(),
@@ -70,6 +76,7 @@ func flowToString(f *types.Flow) string {
 		f.PacketsIn, f.BytesIn, f.PacketsOut, f.BytesOut,
 		f.NumConnectionsStarted, f.NumConnectionsCompleted, f.NumConnectionsLive,
 		policyHitsToString(policyTrace.EnforcedPolicies), policyHitsToString(policyTrace.PendingPolicies),
+		grpcCalls,
 	)
 }
 
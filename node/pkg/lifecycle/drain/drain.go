@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drain gracefully shuts down the BGP sessions of a node that is cordoned or drained
+// for maintenance.
+//
+// A graceful shutdown has two phases.  First, the node's routes (including any LoadBalancer
+// and external service IPs) are advertised with the GRACEFUL_SHUTDOWN community defined by
+// RFC 8326, so that peers that honour it move traffic to alternative paths while the routes
+// are still valid.  After a configurable drain time, the routes are withdrawn.  A cordoned node
+// keeps its routes until its workload pods have also been evicted, so that pods that remain on
+// the node, such as those of DaemonSets, stay reachable.  Both phases are driven through the BGP graceful shutdown field of the Calico Node resource, which confd
+// renders into the BIRD export filters.
+package drain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	log "github.com/sirupsen/logrus"
+	kapiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/node/pkg/lifecycle/utils"
+)
+
+const (
+	// AnnotationGracefulShutdown may be set to "true" on a Kubernetes node to gracefully shut
+	// down its BGP sessions without cordoning it.
+	AnnotationGracefulShutdown = "projectcalico.org/bgp-graceful-shutdown"
+
+	defaultDrainTime = 30 * time.Second
+)
+
+// Config is the configuration of the graceful shutdown of a node.
+type Config struct {
+	// NodeName is the name of the Calico node.
+	NodeName string
+	// K8sNodeName is the name of the Kubernetes node.
+	K8sNodeName string
+	// OnCordon starts a graceful shutdown when the node is cordoned, as well as when it
+	// has the graceful shutdown annotation.
+	OnCordon bool
+	// DrainTime is the time between signalling the graceful shutdown to peers and
+	// withdrawing the node's routes.
+	DrainTime time.Duration
+	// TerminationTime is the time to signal the graceful shutdown to peers before calico/node
+	// exits when its pod is terminated.  Zero disables graceful shutdown on termination.
+	TerminationTime time.Duration
+}
+
+// ConfigFromEnv loads the configuration from the environment.
+func ConfigFromEnv(nodeName string) (Config, error) {
+	cfg := Config{
+		NodeName:    nodeName,
+		K8sNodeName: nodeName,
+		OnCordon:    true,
+		DrainTime:   defaultDrainTime,
+	}
+	if nodeRef := os.Getenv("CALICO_K8S_NODE_REF"); nodeRef != "" {
+		cfg.K8sNodeName = nodeRef
+	}
+	if v := os.Getenv("CALICO_BGP_GRACEFUL_SHUTDOWN_ON_CORDON"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid CALICO_BGP_GRACEFUL_SHUTDOWN_ON_CORDON %q: %w", v, err)
+		}
+		cfg.OnCordon = b
+	}
+	if v := os.Getenv("CALICO_BGP_GRACEFUL_SHUTDOWN_DRAIN_TIME"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return cfg, fmt.Errorf("invalid CALICO_BGP_GRACEFUL_SHUTDOWN_DRAIN_TIME %q", v)
+		}
+		cfg.DrainTime = d
+	}
+	if v := os.Getenv("CALICO_BGP_GRACEFUL_SHUTDOWN_TERMINATION_TIME"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return cfg, fmt.Errorf("invalid CALICO_BGP_GRACEFUL_SHUTDOWN_TERMINATION_TIME %q", v)
+		}
+		cfg.TerminationTime = d
+	}
+	return cfg, nil
+}
+
+// Monitor tracks whether a node is being drained and drives the graceful shutdown of its BGP
+// sessions.
+type Monitor struct {
+	cfg    Config
+	status *apiv3.CalicoNodeBGPGracefulShutdownStatus
+
+	// applied is the graceful shutdown state last written to the Calico node, or nil if it has
+	// not been written since the monitor started.
+	applied *libapiv3.BGPGracefulShutdown
+
+	// For testing.
+	apply func(ctx context.Context, gs libapiv3.BGPGracefulShutdown) error
+	save  func(status *apiv3.CalicoNodeBGPGracefulShutdownStatus) error
+	now   func() time.Time
+}
+
+// NewMonitor returns a Monitor for the node, resuming any graceful shutdown that was in
+// progress when calico/node last stopped.
+func NewMonitor(cfg Config, c client.Interface) *Monitor {
+	status, err := utils.LoadBGPGracefulShutdownStatus()
+	if err != nil {
+		log.WithError(err).Warn("Failed to load BGP graceful shutdown status, ignoring it")
+	}
+	return &Monitor{
+		cfg:    cfg,
+		status: status,
+		apply: func(ctx context.Context, gs libapiv3.BGPGracefulShutdown) error {
+			return SetBGPGracefulShutdown(ctx, c, cfg.NodeName, gs)
+		},
+		save: utils.SaveBGPGracefulShutdownStatus,
+		now:  time.Now,
+	}
+}
+
+// reason returns the reason that the node should be shut down gracefully, or "" if it
+// should not be.
+func (m *Monitor) reason(node *kapiv1.Node) apiv3.BGPGracefulShutdownReason {
+	if node == nil {
+		return ""
+	}
+	if node.Annotations[AnnotationGracefulShutdown] == "true" {
+		return apiv3.BGPGracefulShutdownReasonAnnotation
+	}
+	if m.cfg.OnCordon && node.Spec.Unschedulable {
+		return apiv3.BGPGracefulShutdownReasonCordoned
+	}
+	return ""
+}
+
+// canWithdraw returns true if the node's routes may be withdrawn once the drain time has
+// elapsed.  A node that is only cordoned may still be running pods, which would be blackholed,
+// so its routes are kept until its workload pods have gone.
+func (m *Monitor) canWithdraw(status *apiv3.CalicoNodeBGPGracefulShutdownStatus, workloadPods int) bool {
+	return status.Reason == apiv3.BGPGracefulShutdownReasonAnnotation || workloadPods == 0
+}
+
+// Sync updates the graceful shutdown of the node to match the current state of its Kubernetes
+// node and the number of workload (non-host-networked) pods still running on it.  It returns
+// the time after which Sync must be called again to move the shutdown on to its next phase, or
+// zero if the shutdown can only move on when the node or its pods change.
+func (m *Monitor) Sync(ctx context.Context, node *kapiv1.Node, workloadPods int) (time.Duration, error) {
+	now := m.now()
+	status := m.status.DeepCopy()
+
+	if reason := m.reason(node); reason == "" {
+		status = nil
+	} else if status == nil {
+		log.WithFields(log.Fields{"reason": reason, "drainTime": m.cfg.DrainTime}).Info("Starting graceful BGP shutdown")
+		status = &apiv3.CalicoNodeBGPGracefulShutdownStatus{
+			Phase:        apiv3.BGPGracefulShutdownPhaseSignalling,
+			Reason:       reason,
+			StartTime:    metav1.Time{Time: now},
+			WithdrawTime: metav1.Time{Time: now.Add(m.cfg.DrainTime)},
+		}
+	} else if status.Reason == apiv3.BGPGracefulShutdownReasonTerminating {
+		// We were shut down gracefully when calico/node last stopped, and the node is now being
+		// drained; the drain time starts now.
+		status.Reason = reason
+		status.StartTime = metav1.Time{Time: now}
+		status.WithdrawTime = metav1.Time{Time: now.Add(m.cfg.DrainTime)}
+	}
+
+	var next time.Duration
+	desired := libapiv3.BGPGracefulShutdown("")
+	if status != nil {
+		switch {
+		case status.Phase == apiv3.BGPGracefulShutdownPhaseSignalling && !now.Before(status.WithdrawTime.Time):
+			if m.canWithdraw(status, workloadPods) {
+				log.Info("Drain time has elapsed, withdrawing BGP routes")
+				status.Phase = apiv3.BGPGracefulShutdownPhaseWithdrawn
+			} else {
+				log.WithField("workloadPods", workloadPods).Debug("Drain time has elapsed, waiting for pods to be evicted")
+			}
+		case status.Phase == apiv3.BGPGracefulShutdownPhaseWithdrawn && !m.canWithdraw(status, workloadPods):
+			// Pods have started on the node again, for example those of a DaemonSet.
+			log.WithField("workloadPods", workloadPods).Info("Pods are running on the node, advertising BGP routes again")
+			status.Phase = apiv3.BGPGracefulShutdownPhaseSignalling
+		}
+		switch status.Phase {
+		case apiv3.BGPGracefulShutdownPhaseSignalling:
+			desired = libapiv3.BGPGracefulShutdownSignal
+			if now.Before(status.WithdrawTime.Time) {
+				next = status.WithdrawTime.Sub(now)
+			}
+		default:
+			desired = libapiv3.BGPGracefulShutdownWithdraw
+		}
+	} else if m.status != nil {
+		log.Info("Node is no longer being drained, ending graceful BGP shutdown")
+	}
+
+	if m.applied == nil || *m.applied != desired {
+		if err := m.apply(ctx, desired); err != nil {
+			return 0, err
+		}
+		m.applied = &desired
+	}
+	if !statusEqual(status, m.status) {
+		if err := m.save(status); err != nil {
+			return 0, err
+		}
+		m.status = status
+	}
+	return next, nil
+}
+
+// isWorkloadPod returns true if the pod is running, or may still run, on the pod network of
+// its node, so its node's routes must not be withdrawn.
+func isWorkloadPod(pod *kapiv1.Pod) bool {
+	if pod.Spec.HostNetwork {
+		return false
+	}
+	return pod.Status.Phase != kapiv1.PodSucceeded && pod.Status.Phase != kapiv1.PodFailed
+}
+
+func statusEqual(a, b *apiv3.CalicoNodeBGPGracefulShutdownStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Phase == b.Phase && a.Reason == b.Reason && a.StartTime.Equal(&b.StartTime) && a.WithdrawTime.Equal(&b.WithdrawTime)
+}
+
+// Terminate signals a graceful shutdown to the node's BGP peers and waits for the configured
+// termination time, so that traffic can move to other nodes before calico/node exits.  It does
+// nothing if the node's routes have already been withdrawn.
+func Terminate(ctx context.Context, cfg Config, c client.Interface) error {
+	if cfg.TerminationTime == 0 {
+		return nil
+	}
+	status, err := utils.LoadBGPGracefulShutdownStatus()
+	if err != nil {
+		log.WithError(err).Warn("Failed to load BGP graceful shutdown status, ignoring it")
+	}
+	if status != nil {
+		// A graceful shutdown is already in progress; leave it to the monitor.
+		return nil
+	}
+
+	now := time.Now()
+	status = &apiv3.CalicoNodeBGPGracefulShutdownStatus{
+		Phase:        apiv3.BGPGracefulShutdownPhaseSignalling,
+		Reason:       apiv3.BGPGracefulShutdownReasonTerminating,
+		StartTime:    metav1.Time{Time: now},
+		WithdrawTime: metav1.Time{Time: now.Add(cfg.TerminationTime)},
+	}
+	if err := utils.SaveBGPGracefulShutdownStatus(status); err != nil {
+		return err
+	}
+	if err := SetBGPGracefulShutdown(ctx, c, cfg.NodeName, libapiv3.BGPGracefulShutdownSignal); err != nil {
+		return err
+	}
+	log.WithField("terminationTime", cfg.TerminationTime).Info("Signalled graceful BGP shutdown, waiting for traffic to drain")
+	select {
+	case <-time.After(cfg.TerminationTime):
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// SetBGPGracefulShutdown sets the BGP graceful shutdown state of a Calico node.  It does
+// nothing if the node has no BGP configuration.
+func SetBGPGracefulShutdown(ctx context.Context, c client.Interface, nodeName string, gs libapiv3.BGPGracefulShutdown) error {
+	for i := 0; i < 5; i++ {
+		node, err := c.Nodes().Get(ctx, nodeName, options.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if node.Spec.BGP == nil || node.Spec.BGP.GracefulShutdown == gs {
+			return nil
+		}
+		node.Spec.BGP.GracefulShutdown = gs
+		_, err = c.Nodes().Update(ctx, node, options.SetOptions{})
+		if err == nil {
+			log.WithField("state", gs).Info("Updated BGP graceful shutdown state of node")
+			return nil
+		}
+		if _, ok := err.(cerrors.ErrorResourceUpdateConflict); !ok {
+			return err
+		}
+		log.Info("Conflict updating node, retrying")
+	}
+	return fmt.Errorf("too many conflicts updating node %s", nodeName)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	kapiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/node/pkg/lifecycle/utils"
+)
+
+var _ = Describe("BGP graceful shutdown monitor", func() {
+	var (
+		m       *Monitor
+		now     time.Time
+		applied []libapiv3.BGPGracefulShutdown
+		node    *kapiv1.Node
+		dir     string
+		ctx     = context.Background()
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "drain")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Setenv("CALICO_BGP_GRACEFUL_SHUTDOWN_STATUS_FILE", filepath.Join(dir, "status"))).To(Succeed())
+
+		now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		applied = nil
+		node = &kapiv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}}
+		m = NewMonitor(Config{NodeName: "node1", K8sNodeName: "node1", OnCordon: true, DrainTime: time.Minute}, nil)
+		m.now = func() time.Time { return now }
+		m.apply = func(_ context.Context, gs libapiv3.BGPGracefulShutdown) error {
+			applied = append(applied, gs)
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("CALICO_BGP_GRACEFUL_SHUTDOWN_STATUS_FILE")
+		os.RemoveAll(dir)
+	})
+
+	It("should clear the graceful shutdown state of a node that is not draining", func() {
+		next, err := m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(BeZero())
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{""}))
+
+		// The state is only written once.
+		_, err = m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(HaveLen(1))
+
+		status, err := utils.LoadBGPGracefulShutdownStatus()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(BeNil())
+	})
+
+	It("should signal and then withdraw when the node is cordoned", func() {
+		node.Spec.Unschedulable = true
+		next, err := m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(Equal(time.Minute))
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{libapiv3.BGPGracefulShutdownSignal}))
+
+		status, err := utils.LoadBGPGracefulShutdownStatus()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Phase).To(Equal(apiv3.BGPGracefulShutdownPhaseSignalling))
+		Expect(status.Reason).To(Equal(apiv3.BGPGracefulShutdownReasonCordoned))
+		Expect(status.WithdrawTime.Time.Equal(now.Add(time.Minute))).To(BeTrue())
+
+		now = now.Add(20 * time.Second)
+		next, err = m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(Equal(40 * time.Second))
+		Expect(applied).To(HaveLen(1))
+
+		now = now.Add(40 * time.Second)
+		next, err = m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(BeZero())
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{
+			libapiv3.BGPGracefulShutdownSignal,
+			libapiv3.BGPGracefulShutdownWithdraw,
+		}))
+
+		status, err = utils.LoadBGPGracefulShutdownStatus()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Phase).To(Equal(apiv3.BGPGracefulShutdownPhaseWithdrawn))
+
+		By("uncordoning the node")
+		node.Spec.Unschedulable = false
+		_, err = m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied[len(applied)-1]).To(BeEquivalentTo(""))
+		status, err = utils.LoadBGPGracefulShutdownStatus()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(BeNil())
+	})
+
+	It("should keep advertising a cordoned node's routes until its pods have gone", func() {
+		node.Spec.Unschedulable = true
+		_, err := m.Sync(ctx, node, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{libapiv3.BGPGracefulShutdownSignal}))
+
+		By("waiting past the drain time with pods still running")
+		now = now.Add(10 * time.Minute)
+		next, err := m.Sync(ctx, node, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(BeZero())
+		Expect(applied).To(HaveLen(1))
+		Expect(m.status.Phase).To(Equal(apiv3.BGPGracefulShutdownPhaseSignalling))
+
+		By("evicting the last pod")
+		_, err = m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied[len(applied)-1]).To(Equal(libapiv3.BGPGracefulShutdownWithdraw))
+		Expect(m.status.Phase).To(Equal(apiv3.BGPGracefulShutdownPhaseWithdrawn))
+
+		By("starting a pod on the node again")
+		_, err = m.Sync(ctx, node, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied[len(applied)-1]).To(Equal(libapiv3.BGPGracefulShutdownSignal))
+		Expect(m.status.Phase).To(Equal(apiv3.BGPGracefulShutdownPhaseSignalling))
+	})
+
+	It("should withdraw the routes after the drain time when annotated, even with pods running", func() {
+		node.Annotations = map[string]string{AnnotationGracefulShutdown: "true"}
+		_, err := m.Sync(ctx, node, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(time.Minute)
+		_, err = m.Sync(ctx, node, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{
+			libapiv3.BGPGracefulShutdownSignal,
+			libapiv3.BGPGracefulShutdownWithdraw,
+		}))
+	})
+
+	It("should ignore a cordon if configured to", func() {
+		m.cfg.OnCordon = false
+		node.Spec.Unschedulable = true
+		_, err := m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{""}))
+
+		node.Annotations = map[string]string{AnnotationGracefulShutdown: "true"}
+		_, err = m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(applied).To(Equal([]libapiv3.BGPGracefulShutdown{"", libapiv3.BGPGracefulShutdownSignal}))
+		Expect(m.status.Reason).To(Equal(apiv3.BGPGracefulShutdownReasonAnnotation))
+	})
+
+	It("should resume a graceful shutdown after a restart", func() {
+		node.Spec.Unschedulable = true
+		_, err := m.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		restarted := NewMonitor(m.cfg, nil)
+		restarted.now = func() time.Time { return now.Add(2 * time.Minute) }
+		restarted.apply = m.apply
+		next, err := restarted.Sync(ctx, node, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(BeZero())
+		Expect(applied[len(applied)-1]).To(Equal(libapiv3.BGPGracefulShutdownWithdraw))
+	})
+
+	It("should retry if the node cannot be updated", func() {
+		node.Spec.Unschedulable = true
+		m.apply = func(context.Context, libapiv3.BGPGracefulShutdown) error { return os.ErrDeadlineExceeded }
+		_, err := m.Sync(ctx, node, 0)
+		Expect(err).To(HaveOccurred())
+		Expect(m.status).To(BeNil())
+	})
+})
+
+var _ = Describe("Workload pod counting", func() {
+	It("should only count pods that aren't host-networked or finished", func() {
+		pod := func(hostNetwork bool, phase kapiv1.PodPhase) *kapiv1.Pod {
+			return &kapiv1.Pod{
+				Spec:   kapiv1.PodSpec{HostNetwork: hostNetwork},
+				Status: kapiv1.PodStatus{Phase: phase},
+			}
+		}
+		Expect(countWorkloadPods([]interface{}{
+			pod(false, kapiv1.PodRunning),
+			pod(false, kapiv1.PodPending),
+			pod(true, kapiv1.PodRunning),
+			pod(false, kapiv1.PodSucceeded),
+			pod(false, kapiv1.PodFailed),
+		})).To(Equal(2))
+	})
+})
+
+var _ = Describe("BGP graceful shutdown config", func() {
+	AfterEach(func() {
+		os.Unsetenv("CALICO_BGP_GRACEFUL_SHUTDOWN_ON_CORDON")
+		os.Unsetenv("CALICO_BGP_GRACEFUL_SHUTDOWN_DRAIN_TIME")
+		os.Unsetenv("CALICO_BGP_GRACEFUL_SHUTDOWN_TERMINATION_TIME")
+	})
+
+	It("should have sensible defaults", func() {
+		cfg, err := ConfigFromEnv("node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg).To(Equal(Config{NodeName: "node1", K8sNodeName: "node1", OnCordon: true, DrainTime: 30 * time.Second}))
+	})
+
+	It("should load the config from the environment", func() {
+		os.Setenv("CALICO_BGP_GRACEFUL_SHUTDOWN_ON_CORDON", "false")
+		os.Setenv("CALICO_BGP_GRACEFUL_SHUTDOWN_DRAIN_TIME", "2m")
+		os.Setenv("CALICO_BGP_GRACEFUL_SHUTDOWN_TERMINATION_TIME", "10s")
+		cfg, err := ConfigFromEnv("node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.OnCordon).To(BeFalse())
+		Expect(cfg.DrainTime).To(Equal(2 * time.Minute))
+		Expect(cfg.TerminationTime).To(Equal(10 * time.Second))
+	})
+
+	It("should reject an invalid drain time", func() {
+		os.Setenv("CALICO_BGP_GRACEFUL_SHUTDOWN_DRAIN_TIME", "-1s")
+		_, err := ConfigFromEnv("node1")
+		Expect(err).To(HaveOccurred())
+	})
+})
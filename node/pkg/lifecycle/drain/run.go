@@ -0,0 +1,134 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"os"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	kapiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/winutils"
+	"github.com/projectcalico/calico/node/pkg/calicoclient"
+	"github.com/projectcalico/calico/node/pkg/lifecycle/startup"
+)
+
+// retryInterval is the interval at which a failed update of the graceful shutdown is retried.
+const retryInterval = 5 * time.Second
+
+// Run watches the Kubernetes node and its pods for a cordon, drain or graceful shutdown
+// annotation and gracefully shuts down the node's BGP sessions while it is being drained.
+func Run() {
+	startup.ConfigureLogging()
+
+	// This binary is only ever invoked _after_ the startup binary has been invoked and the
+	// modified environments have been sourced.  Therefore, the NODENAME environment will
+	// always be set at this point.
+	nodeName := os.Getenv("NODENAME")
+	if nodeName == "" {
+		log.Panic("NODENAME environment is not set")
+	}
+
+	cfg, err := ConfigFromEnv(nodeName)
+	if err != nil {
+		log.WithError(err).Fatal("Invalid BGP graceful shutdown configuration")
+	}
+
+	config, err := winutils.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+	if err != nil {
+		// Not running under Kubernetes, so there is nothing to drain.  Block rather than
+		// exiting so that the service is not restarted continuously.
+		log.WithError(err).Info("Kubernetes is not available, not monitoring for node drain")
+		select {}
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to create clientset")
+	}
+	_, c := calicoclient.CreateClient()
+
+	// Watch only this node and the pods scheduled to it, and wake up the monitor whenever
+	// either changes.
+	changed := make(chan struct{}, 1)
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify(changed) },
+		UpdateFunc: func(interface{}, interface{}) { notify(changed) },
+		DeleteFunc: func(interface{}) { notify(changed) },
+	}
+	restClient := clientset.CoreV1().RESTClient()
+	nodes, nodeInformer := cache.NewInformerWithOptions(cache.InformerOptions{
+		ListerWatcher: cache.NewListWatchFromClient(restClient, "nodes", "",
+			fields.OneTermEqualSelector("metadata.name", cfg.K8sNodeName)),
+		ObjectType: &kapiv1.Node{},
+		Handler:    handler,
+	})
+	pods, podInformer := cache.NewInformerWithOptions(cache.InformerOptions{
+		ListerWatcher: cache.NewListWatchFromClient(restClient, "pods", kapiv1.NamespaceAll,
+			fields.OneTermEqualSelector("spec.nodeName", cfg.K8sNodeName)),
+		ObjectType: &kapiv1.Pod{},
+		Handler:    handler,
+	})
+	stopCh := make(chan struct{})
+	go nodeInformer.Run(stopCh)
+	go podInformer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, nodeInformer.HasSynced, podInformer.HasSynced) {
+		log.Fatal("Failed to sync Kubernetes node and pods")
+	}
+
+	m := NewMonitor(cfg, c)
+	ctx := context.Background()
+	for {
+		var delay time.Duration
+		if obj, exists, err := nodes.GetByKey(cfg.K8sNodeName); err != nil || !exists {
+			log.WithError(err).Warn("Kubernetes node not found")
+		} else if next, err := m.Sync(ctx, obj.(*kapiv1.Node), countWorkloadPods(pods.List())); err != nil {
+			log.WithError(err).Warn("Failed to update BGP graceful shutdown")
+			delay = retryInterval
+		} else {
+			delay = next
+		}
+
+		var timer <-chan time.Time
+		if delay > 0 {
+			timer = time.After(delay)
+		}
+		select {
+		case <-changed:
+		case <-timer:
+		}
+	}
+}
+
+func notify(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+func countWorkloadPods(objs []interface{}) int {
+	n := 0
+	for _, obj := range objs {
+		if pod, ok := obj.(*kapiv1.Pod); ok && isWorkloadPod(pod) {
+			n++
+		}
+	}
+	return n
+}
@@ -14,6 +14,7 @@
 package shutdown
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -21,12 +22,15 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/winutils"
+	"github.com/projectcalico/calico/node/pkg/calicoclient"
+	"github.com/projectcalico/calico/node/pkg/lifecycle/drain"
 	"github.com/projectcalico/calico/node/pkg/lifecycle/utils"
 )
 
 // This file contains the main shutdown processing for the calico/node.  This
 // includes:
 // -  Save time stamp to shutdown file.
+// -  Signal a graceful BGP shutdown to peers, if configured.
 // -  Set node condition to "networkUnavailable=true"
 func Run() {
 	// Save shutdown timestamp immediately.
@@ -40,6 +44,13 @@ func Run() {
 	nodeName := utils.DetermineNodeName()
 	log.Infof("Shutting down node %s", nodeName)
 
+	// Gracefully shut down BGP before the node becomes unavailable, so that peers can move
+	// traffic to other nodes while our routes are still valid.
+	backend := os.Getenv("CALICO_NETWORKING_BACKEND")
+	if backend == "" || backend == "bird" {
+		gracefulBGPShutdown(nodeName)
+	}
+
 	var clientset *kubernetes.Clientset
 
 	// If running under kubernetes with secrets to call k8s API
@@ -81,3 +92,18 @@ func Run() {
 		}
 	}
 }
+
+func gracefulBGPShutdown(nodeName string) {
+	cfg, err := drain.ConfigFromEnv(nodeName)
+	if err != nil {
+		log.WithError(err).Error("Invalid BGP graceful shutdown configuration")
+		return
+	}
+	if cfg.TerminationTime == 0 {
+		return
+	}
+	_, c := calicoclient.CreateClient()
+	if err := drain.Terminate(context.Background(), cfg, c); err != nil {
+		log.WithError(err).Error("Unable to gracefully shut down BGP")
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -32,7 +33,10 @@ const (
 	AUTODETECTION_METHOD_INTERFACE      = "interface="
 	AUTODETECTION_METHOD_SKIP_INTERFACE = "skip-interface="
 	AUTODETECTION_METHOD_CIDR           = "cidr="
+	AUTODETECTION_METHOD_ROUTE_TABLE    = "route-table="
+	AUTODETECTION_METHOD_VRF            = "vrf="
 	K8S_INTERNAL_IP                     = "kubernetes-internal-ip"
+	K8S_ANNOTATION                      = "kubernetes-annotation="
 )
 
 // autoDetectCIDR auto-detects the IP and Network using the requested
@@ -81,6 +85,27 @@ func AutoDetectCIDR(method string, version int, k8sNode *v1.Node, getInterfaces
 			return nil
 		}
 		return autoDetectUsingK8sInternalIP(version, k8sNode, getInterfaces)
+	} else if strings.HasPrefix(method, K8S_ANNOTATION) {
+		// The address of the right family from the named K8s Node annotation is used.
+		if k8sNode == nil {
+			log.Error("Cannot use method 'kubernetes-annotation' when not running on a Kubernetes cluster")
+			return nil
+		}
+		annotation := strings.TrimPrefix(method, K8S_ANNOTATION)
+		return autoDetectUsingK8sAnnotation(annotation, version, k8sNode, getInterfaces)
+	} else if strings.HasPrefix(method, AUTODETECTION_METHOD_ROUTE_TABLE) {
+		// Autodetect the IP from the default route in a routing table.
+		tableStr := strings.TrimPrefix(method, AUTODETECTION_METHOD_ROUTE_TABLE)
+		table, err := parseRouteTable(tableStr)
+		if err != nil {
+			log.WithError(err).Errorf("Invalid routing table for IP autodetection method: %s", method)
+			return nil
+		}
+		return autoDetectCIDRByRouteTable(table, version)
+	} else if strings.HasPrefix(method, AUTODETECTION_METHOD_VRF) {
+		// Autodetect the IP from the interfaces in a VRF.
+		vrf := strings.TrimPrefix(method, AUTODETECTION_METHOD_VRF)
+		return autoDetectCIDRByVRF(vrf, version)
 	}
 
 	// The autodetection method is not recognised and is required.  Exit.
@@ -192,6 +217,83 @@ func autoDetectUsingK8sInternalIP(version int, k8sNode *v1.Node, getInterfaces f
 	return ipNet
 }
 
+// autoDetectUsingK8sAnnotation reads the address of the given IP version from the named K8s Node
+// annotation.  The annotation may hold a single address or CIDR, or a comma-separated list of
+// them, for example an IPv4 and an IPv6 address.
+func autoDetectUsingK8sAnnotation(annotation string, version int, k8sNode *v1.Node, getInterfaces func([]string, []string, int) ([]Interface, error)) *cnet.IPNet {
+	value, ok := k8sNode.Annotations[annotation]
+	if !ok {
+		log.Warnf("Unable to auto-detect an IPv%d address: node has no annotation %s", version, annotation)
+		return nil
+	}
+
+	for _, addr := range regexp.MustCompile(`\s*,\s*`).Split(strings.TrimSpace(value), -1) {
+		ip := strings.Split(addr, "/")[0]
+		if (version == 4 && !utils.IsIPv4String(ip)) || (version == 6 && !utils.IsIPv6String(ip)) {
+			continue
+		}
+		address, err := GetLocalCIDR(addr, version, getInterfaces)
+		if err != nil {
+			log.WithError(err).Warnf("Unable to use address %s from annotation %s", addr, annotation)
+			return nil
+		}
+		ipAddr, ipNet, err := cnet.ParseCIDR(address)
+		if err != nil {
+			log.Errorf("Unable to parse CIDR %v : %v", address, err)
+			return nil
+		}
+		// As for the InternalIP, preserve the full IP address in the CIDR.
+		ipNet.IP = ipAddr.IP
+		log.Infof("Using IPv%d address %s from node annotation %s", version, ipNet.String(), annotation)
+		return ipNet
+	}
+
+	log.Warnf("Unable to auto-detect an IPv%d address: annotation %s=%q has no IPv%d address", version, annotation, value, version)
+	return nil
+}
+
+// autoDetectCIDRByRouteTable auto-detects the IP and Network that the default route in the given
+// routing table uses as its source.
+func autoDetectCIDRByRouteTable(table int, version int) *cnet.IPNet {
+	cidr, err := RouteTableSource(table, version)
+	if err != nil {
+		log.Warnf("Unable to auto-detect an IPv%d address from the default route in routing table %d: %s", version, table, err)
+		return nil
+	}
+	log.Infof("Using autodetected IPv%d address %s, the source of the default route in routing table %d", version, cidr.String(), table)
+	return cidr
+}
+
+// autoDetectCIDRByVRF auto-detects the first valid Network on the interfaces that belong to the
+// given VRF.
+func autoDetectCIDRByVRF(vrf string, version int) *cnet.IPNet {
+	iface, cidr, err := VRFEnumeration(vrf, version)
+	if err != nil {
+		log.Warnf("Unable to auto-detect an IPv%d address in VRF %s: %s", version, vrf, err)
+		return nil
+	}
+	log.Infof("Using autodetected IPv%d address %s on interface %s in VRF %s", version, cidr.String(), iface.Name, vrf)
+	return cidr
+}
+
+// parseRouteTable parses a routing table number, or the name of one of the tables that is always
+// defined.
+func parseRouteTable(s string) (int, error) {
+	switch s {
+	case "main":
+		return 254, nil
+	case "default":
+		return 253, nil
+	case "local":
+		return 255, nil
+	}
+	table, err := strconv.Atoi(s)
+	if err != nil || table <= 0 {
+		return 0, fmt.Errorf("%q is not a routing table number", s)
+	}
+	return table, nil
+}
+
 // getLocalCIDR attempts to merge CIDR information from the host with the given IP address.
 // If a CIDR is provided, then it is simply returned.
 // If an IP is provided, it attempts to find the matching interface on the host to detect the appropriate prefix length.
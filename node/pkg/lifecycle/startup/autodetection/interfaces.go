@@ -14,6 +14,7 @@
 package autodetection
 
 import (
+	"fmt"
 	"net"
 	"regexp"
 	"strings"
@@ -103,3 +104,26 @@ func convertInterface(i *net.Interface, version int) (*Interface, error) {
 
 	return iface, nil
 }
+
+// localCIDRForIP returns the CIDR (IP + network) of the given IP address from the local interface
+// that has it.
+func localCIDRForIP(ip net.IP, version int) (*cnet.IPNet, error) {
+	// Get a full list of interface and IPs and find the CIDR matching the
+	// found IP.
+	ifaces, err := GetInterfaces(net.Interfaces, nil, nil, version)
+	if err != nil {
+		return nil, err
+	}
+	for _, iface := range ifaces {
+		log.WithField("Name", iface.Name).Debug("Checking interface CIDRs")
+		for _, cidr := range iface.Cidrs {
+			log.WithField("CIDR", cidr.String()).Debug("Checking CIDR")
+			if cidr.IP.Equal(ip) {
+				log.WithField("CIDR", cidr.String()).Debug("Found matching interface CIDR")
+				return &cidr, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("autodetected IPv%d address does not match any addresses found on local interfaces: %s", version, ip.String())
+}
@@ -46,22 +46,6 @@ func ReachDestination(dest string, version int) (*net.IPNet, error) {
 	udpAddr := addr.(*gonet.UDPAddr)
 	log.WithFields(log.Fields{"IP": udpAddr.IP, "Destination": dest}).Info("Auto-detected address by connecting to remote")
 
-	// Get a full list of interface and IPs and find the CIDR matching the
-	// found IP.
-	ifaces, err := GetInterfaces(gonet.Interfaces, nil, nil, version)
-	if err != nil {
-		return nil, err
-	}
-	for _, iface := range ifaces {
-		log.WithField("Name", iface.Name).Debug("Checking interface CIDRs")
-		for _, cidr := range iface.Cidrs {
-			log.WithField("CIDR", cidr.String()).Debug("Checking CIDR")
-			if cidr.IP.Equal(udpAddr.IP) {
-				log.WithField("CIDR", cidr.String()).Debug("Found matching interface CIDR")
-				return &cidr, nil
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("autodetected IPv%d address does not match any addresses found on local interfaces: %s", version, udpAddr.IP.String())
+	// Find the CIDR of the interface that has the found IP.
+	return localCIDRForIP(udpAddr.IP, version)
 }
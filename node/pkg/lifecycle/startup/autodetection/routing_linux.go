@@ -0,0 +1,148 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package autodetection
+
+import (
+	"errors"
+	"fmt"
+	gonet "net"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// RouteTableSource auto-detects the interface Network from the default route in the given routing
+// table.  If the route has a preferred source address, that address is used.  Otherwise, the
+// address is taken from the route's interface, preferring an address in the same subnet as the
+// gateway.
+func RouteTableSource(table int, version int) (*net.IPNet, error) {
+	family := netlinkFamily(version)
+	routes, err := netlink.RouteListFiltered(family, &netlink.Route{Table: table}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		return nil, err
+	}
+	route := defaultRoute(routes)
+	if route == nil {
+		return nil, errors.New("no default route")
+	}
+	log.WithField("Route", route).Debug("Found default route")
+
+	if route.Src != nil {
+		return localCIDRForIP(route.Src, version)
+	}
+
+	linkIndex, gw := route.LinkIndex, route.Gw
+	if linkIndex == 0 && len(route.MultiPath) > 0 {
+		// For a multipath route, use the first next hop.
+		linkIndex, gw = route.MultiPath[0].LinkIndex, route.MultiPath[0].Gw
+	}
+	link, err := netlink.LinkByIndex(linkIndex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up interface of default route: %w", err)
+	}
+	addrs, err := netlink.AddrList(link, family)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addresses of interface %s: %w", link.Attrs().Name, err)
+	}
+	cidr := selectGatewayAddr(addrs, gw)
+	if cidr == nil {
+		return nil, fmt.Errorf("no valid IPv%d addresses found on interface %s", version, link.Attrs().Name)
+	}
+	return cidr, nil
+}
+
+// defaultRoute returns the default route with the lowest metric from the given routes, or nil if
+// there isn't one.
+func defaultRoute(routes []netlink.Route) *netlink.Route {
+	var best *netlink.Route
+	for i := range routes {
+		r := &routes[i]
+		if r.Dst != nil {
+			if ones, _ := r.Dst.Mask.Size(); ones != 0 {
+				continue
+			}
+		}
+		if r.Type != 0 && r.Type != unix.RTN_UNICAST {
+			// Skip blackhole, unreachable and similar routes.
+			continue
+		}
+		if best == nil || r.Priority < best.Priority {
+			best = r
+		}
+	}
+	return best
+}
+
+// selectGatewayAddr returns the first global unicast address that is in the same subnet as the
+// gateway or, if there is no such address, the first global unicast address.
+func selectGatewayAddr(addrs []netlink.Addr, gw gonet.IP) *net.IPNet {
+	var first *net.IPNet
+	for _, a := range addrs {
+		if a.IPNet == nil || !a.IP.IsGlobalUnicast() {
+			continue
+		}
+		cidr := &net.IPNet{IPNet: *a.IPNet}
+		if gw != nil && a.Contains(gw) {
+			return cidr
+		}
+		if first == nil {
+			first = cidr
+		}
+	}
+	return first
+}
+
+// VRFEnumeration returns the first valid IP address and network on the interfaces that are
+// enslaved to the given VRF device, in interface index order.
+func VRFEnumeration(vrfName string, version int) (*Interface, *net.IPNet, error) {
+	vrf, err := netlink.LinkByName(vrfName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to look up VRF: %w", err)
+	}
+	if vrf.Type() != "vrf" {
+		return nil, nil, fmt.Errorf("%s is a %s device, not a VRF", vrfName, vrf.Type())
+	}
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	family := netlinkFamily(version)
+	for _, link := range links {
+		if link.Attrs().MasterIndex != vrf.Attrs().Index {
+			continue
+		}
+		log.WithField("Name", link.Attrs().Name).Debug("Check interface in VRF")
+		addrs, err := netlink.AddrList(link, family)
+		if err != nil {
+			log.WithError(err).WithField("Name", link.Attrs().Name).Warn("Cannot get interface address(es)")
+			continue
+		}
+		if cidr := selectGatewayAddr(addrs, nil); cidr != nil {
+			return &Interface{Name: link.Attrs().Name, Cidrs: []net.IPNet{*cidr}}, cidr, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("no valid IPv%d addresses found on the interfaces in VRF %s", version, vrfName)
+}
+
+func netlinkFamily(version int) int {
+	if version == 6 {
+		return netlink.FAMILY_V6
+	}
+	return netlink.FAMILY_V4
+}
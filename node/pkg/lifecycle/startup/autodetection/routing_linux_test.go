@@ -0,0 +1,87 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package autodetection
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+func mustParseAddr(cidr string) netlink.Addr {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	Expect(err).NotTo(HaveOccurred())
+	ipNet.IP = ip
+	return netlink.Addr{IPNet: ipNet}
+}
+
+var _ = Describe("Routing table autodetection", func() {
+	_, defaultDst, _ := net.ParseCIDR("0.0.0.0/0")
+	_, otherDst, _ := net.ParseCIDR("10.0.0.0/8")
+
+	It("should choose the default route with the lowest metric", func() {
+		routes := []netlink.Route{
+			{Dst: otherDst, LinkIndex: 1},
+			{Dst: nil, LinkIndex: 2, Priority: 200},
+			{Dst: defaultDst, LinkIndex: 3, Priority: 100},
+			{Dst: nil, LinkIndex: 4, Priority: 50, Type: unix.RTN_UNREACHABLE},
+		}
+		Expect(defaultRoute(routes).LinkIndex).To(Equal(3))
+	})
+
+	It("should return nil if there is no default route", func() {
+		Expect(defaultRoute([]netlink.Route{{Dst: otherDst, LinkIndex: 1}})).To(BeNil())
+	})
+
+	DescribeTable("selecting an address on the default route's interface",
+		func(addrs []string, gw string, expected string) {
+			var nlAddrs []netlink.Addr
+			for _, a := range addrs {
+				nlAddrs = append(nlAddrs, mustParseAddr(a))
+			}
+			cidr := selectGatewayAddr(nlAddrs, net.ParseIP(gw))
+			if expected == "" {
+				Expect(cidr).To(BeNil())
+			} else {
+				Expect(cidr).NotTo(BeNil())
+				Expect(cidr.String()).To(Equal(expected))
+			}
+		},
+		Entry("address in the gateway's subnet", []string{"172.16.0.5/24", "10.0.1.5/24"}, "10.0.1.1", "10.0.1.5/24"),
+		Entry("no address in the gateway's subnet", []string{"172.16.0.5/24", "10.0.1.5/24"}, "192.168.0.1", "172.16.0.5/24"),
+		Entry("no gateway", []string{"172.16.0.5/24"}, "", "172.16.0.5/24"),
+		Entry("link-local addresses are skipped", []string{"fe80::1/64", "2001:db8::5/64"}, "fe80::ff", "2001:db8::5/64"),
+		Entry("no valid addresses", []string{"127.0.0.1/8"}, "", ""),
+	)
+
+	DescribeTable("parsing the routing table",
+		func(table string, expected int, expectErr bool) {
+			t, err := parseRouteTable(table)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(t).To(Equal(expected))
+			}
+		},
+		Entry("number", "100", 100, false),
+		Entry("main", "main", 254, false),
+		Entry("not a number", "foo", 0, true),
+		Entry("zero", "0", 0, true),
+	)
+})
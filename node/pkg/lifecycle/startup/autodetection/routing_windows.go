@@ -0,0 +1,30 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package autodetection
+
+import (
+	"errors"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// RouteTableSource is not supported on Windows, which has no numbered routing tables.
+func RouteTableSource(table int, version int) (*net.IPNet, error) {
+	return nil, errors.New("routing table autodetection is not supported on Windows")
+}
+
+// VRFEnumeration is not supported on Windows, which has no VRF devices.
+func VRFEnumeration(vrfName string, version int) (*Interface, *net.IPNet, error) {
+	return nil, nil, errors.New("VRF autodetection is not supported on Windows")
+}
@@ -32,7 +32,10 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
 	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
@@ -336,6 +339,16 @@ func MonitorIPAddressSubnets() {
 		}
 	}
 
+	// Record an Event on the Kubernetes node when its addresses change, so that the change shows
+	// up alongside the node's other events.
+	var recorder record.EventRecorder
+	if clientset != nil {
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+		defer broadcaster.Shutdown()
+		recorder = broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "calico-node", Host: nodeName})
+	}
+
 	for {
 		<-time.After(pollInterval)
 		log.Debugf("Checking node IP address every %v", pollInterval)
@@ -351,6 +364,7 @@ func MonitorIPAddressSubnets() {
 
 		// Every polling interval, try to get new node configuration.
 		node = getNode(ctx, cli, nodeName)
+		oldIPv4, oldIPv6 := nodeIPs(node)
 
 		updated := configureAndCheckIPAddressSubnets(ctx, cli, node, k8sNode)
 		if updated {
@@ -360,6 +374,9 @@ func MonitorIPAddressSubnets() {
 				_, err := CreateOrUpdate(ctx, cli, node)
 				if err == nil {
 					log.Info("Updated node IP addresses")
+					if recorder != nil && k8sNode != nil {
+						recordAddressChanges(recorder, k8sNode, oldIPv4, oldIPv6, node)
+					}
 					break
 				}
 				log.WithError(err).Error("Unable to set node resource configuration, retrying...")
@@ -368,6 +385,30 @@ func MonitorIPAddressSubnets() {
 	}
 }
 
+// nodeIPs returns the node's IPv4 and IPv6 addresses, or "" for any that aren't set.
+func nodeIPs(node *libapi.Node) (string, string) {
+	if node.Spec.BGP == nil {
+		return "", ""
+	}
+	return node.Spec.BGP.IPv4Address, node.Spec.BGP.IPv6Address
+}
+
+// recordAddressChanges records an Event on the Kubernetes node for each of the node's addresses
+// that has changed from the given old value.
+func recordAddressChanges(recorder record.EventRecorder, k8sNode *v1.Node, oldIPv4, oldIPv6 string, node *libapi.Node) {
+	newIPv4, newIPv6 := nodeIPs(node)
+	for _, c := range []struct {
+		version  int
+		old, new string
+	}{{4, oldIPv4, newIPv4}, {6, oldIPv6, newIPv6}} {
+		if c.old == c.new {
+			continue
+		}
+		recorder.Eventf(k8sNode, v1.EventTypeNormal, "CalicoNodeAddressChanged",
+			"Calico node IPv%d address changed from %q to %q", c.version, c.old, c.new)
+	}
+}
+
 // configureNodeRef will attempt to discover the cluster type it is running on, check to ensure we
 // have not already set it on this Node, and set it if need be.
 // Returns true if the node object needs to updated.
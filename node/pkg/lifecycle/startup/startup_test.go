@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
 	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
@@ -1056,6 +1057,36 @@ var _ = Describe("UT for CIDR returned by IP address autodetection k8s-internal-
 	})
 })
 
+var _ = Describe("UT for autodetection method kubernetes-annotation", func() {
+	mockGetInterface := func([]string, []string, int) ([]autodetection.Interface, error) {
+		return []autodetection.Interface{
+			{Name: "eth1", Cidrs: []net.IPNet{net.MustParseCIDR("192.168.1.10/24"), net.MustParseCIDR("2001:db8:85a3:8d3:1319:8a2e:370:7348/64")}},
+		}, nil
+	}
+	method := autodetection.K8S_ANNOTATION + "example.com/node-ips"
+
+	It("should use the address of the right family from the annotation", func() {
+		k8sNode := &v1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"example.com/node-ips": "192.168.1.10, 2001:db8:85a3:8d3:1319:8a2e:370:7348",
+		}}}
+		Expect(autodetection.AutoDetectCIDR(method, 4, k8sNode, mockGetInterface).String()).To(Equal("192.168.1.10/24"))
+		Expect(autodetection.AutoDetectCIDR(method, 6, k8sNode, mockGetInterface).String()).To(Equal("2001:db8:85a3:8d3:1319:8a2e:370:7348/64"))
+	})
+
+	It("should use a CIDR from the annotation as is", func() {
+		k8sNode := &v1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			"example.com/node-ips": "2001:db8::1/120",
+		}}}
+		Expect(autodetection.AutoDetectCIDR(method, 6, k8sNode, mockGetInterface).String()).To(Equal("2001:db8::1/120"))
+		Expect(autodetection.AutoDetectCIDR(method, 4, k8sNode, mockGetInterface)).To(BeNil())
+	})
+
+	It("should fail if the annotation is missing", func() {
+		Expect(autodetection.AutoDetectCIDR(method, 4, &v1.Node{}, mockGetInterface)).To(BeNil())
+		Expect(autodetection.AutoDetectCIDR(method, 4, nil, mockGetInterface)).To(BeNil())
+	})
+})
+
 var _ = Describe("FV tests against K8s API server.", func() {
 	It("should not throw an error when multiple Nodes configure the same global CRD value.", func() {
 		ctx := context.Background()
@@ -1260,6 +1291,14 @@ var _ = Describe("UTs for monitor-addresses option", func() {
 		os.Setenv("AUTODETECT_POLL_INTERVAL", "30m")
 		Expect(getMonitorPollInterval()).To(Equal(30 * time.Minute))
 	})
+	It("records an Event for each changed address", func() {
+		recorder := record.NewFakeRecorder(10)
+		node := libapi.NewNode()
+		node.Spec.BGP = &libapi.NodeBGPSpec{IPv4Address: "10.0.0.2/24", IPv6Address: "2001:db8::1/64"}
+		recordAddressChanges(recorder, &v1.Node{}, "10.0.0.1/24", "2001:db8::1/64", node)
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal(`Normal CalicoNodeAddressChanged Calico node IPv4 address changed from "10.0.0.1/24" to "10.0.0.2/24"`))
+	})
 })
 
 var _ = Describe("UT for IP and IP6", func() {
@@ -24,6 +24,7 @@ import (
 	"strings"
 	"time"
 
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	log "github.com/sirupsen/logrus"
 	kapiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +38,7 @@ const (
 	defaultShutdownTimestampFileWindows = `c:\CalicoWindows\shutdownTS`
 	defaultNodenameFileLinux            = `/var/lib/calico/nodename`
 	defaultNodenameFileWindows          = `c:\CalicoWindows\nodename`
+	defaultGracefulShutdownFileLinux    = `/var/lib/calico/bgpGracefulShutdown`
 )
 
 // For testing purposes we define an exit function that we can override.
@@ -109,6 +111,54 @@ func SaveShutdownTimestamp() error {
 	return nil
 }
 
+// gracefulShutdownFileName returns the file name used for saving the BGP graceful shutdown status.
+func gracefulShutdownFileName() string {
+	if fn := os.Getenv("CALICO_BGP_GRACEFUL_SHUTDOWN_STATUS_FILE"); fn != "" {
+		return fn
+	}
+	return defaultGracefulShutdownFileLinux
+}
+
+// SaveBGPGracefulShutdownStatus saves the status of a graceful BGP shutdown of this node so
+// that it survives a restart of calico/node and can be reported by the node status reporter.
+// A nil status removes the file.
+func SaveBGPGracefulShutdownStatus(status *apiv3.CalicoNodeBGPGracefulShutdownStatus) error {
+	filename := gracefulShutdownFileName()
+	if status == nil {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Failed to remove " + filename)
+			return err
+		}
+		return nil
+	}
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		log.WithError(err).Error("Unable to write to " + filename)
+		return err
+	}
+	return nil
+}
+
+// LoadBGPGracefulShutdownStatus loads the status saved by SaveBGPGracefulShutdownStatus.  It
+// returns nil if the node is not shutting down.
+func LoadBGPGracefulShutdownStatus() (*apiv3.CalicoNodeBGPGracefulShutdownStatus, error) {
+	data, err := os.ReadFile(gracefulShutdownFileName())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	status := &apiv3.CalicoNodeBGPGracefulShutdownStatus{}
+	if err := json.Unmarshal(data, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
 // DetermineNodeName is called to determine the node name to use for this instance
 // of calico/node.
 func DetermineNodeName() string {
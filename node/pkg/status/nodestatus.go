@@ -17,6 +17,8 @@ package status
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	log "github.com/sirupsen/logrus"
@@ -26,6 +28,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/nodestatussyncer"
 	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/metricsserver"
 	"github.com/projectcalico/calico/node/pkg/calicoclient"
 	"github.com/projectcalico/calico/node/pkg/lifecycle/startup"
 	populator "github.com/projectcalico/calico/node/pkg/status/populators"
@@ -36,6 +39,9 @@ import (
 
 // This file contains the main processing and common logic for node status reporter.
 
+// bfdMetricsInterval is how often the BFD session gauges are refreshed from BIRD.
+const bfdMetricsInterval = 10 * time.Second
+
 // Run runs the node status reporter.
 func Run() {
 	startup.ConfigureLogging()
@@ -75,10 +81,35 @@ func Run() {
 		syncer.Start()
 	}
 
+	// Serve Prometheus metrics if a port has been configured.
+	if port := os.Getenv("CALICO_STATUS_REPORTER_METRICS_PORT"); port != "" {
+		startMetricsReporting(port)
+	}
+
 	// Run the NodeStatusReporter.
 	r.Run()
 }
 
+// startMetricsReporting serves Prometheus metrics on the given port and keeps the BFD session
+// gauges up to date.  BFD sessions are reported whether or not a CalicoNodeStatus resource exists.
+func startMetricsReporting(port string) {
+	p, err := strconv.Atoi(port)
+	if err != nil || p <= 0 {
+		log.WithField("port", port).Error("Invalid CALICO_STATUS_REPORTER_METRICS_PORT, not serving metrics")
+		return
+	}
+	go metricsserver.ServePrometheusMetricsForever("", p)
+
+	go func() {
+		for {
+			for _, ipv := range []populator.IPFamily{populator.IPFamilyV4, populator.IPFamilyV6} {
+				populator.UpdateBFDMetrics(ipv)
+			}
+			time.Sleep(bfdMetricsInterval)
+		}
+	}()
+}
+
 // Map IPFamily to a map from each class to a populator.
 // Currently all the reporters would have the same populator for each class but
 // it can be extended in the future.
@@ -0,0 +1,260 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+var gaugeBFDSessionState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "calico_bfd_session_state",
+	Help: "State of each BFD session with a BGP peer; 1 for the session's current state and 0 for the others.",
+}, []string{"ip_version", "peer_ip", "interface", "state"})
+
+func init() {
+	prometheus.MustRegister(gaugeBFDSessionState)
+}
+
+// Expected BIRD BFD session table columns
+var birdBFDExpectedHeadings = []string{"IP", "address", "Interface", "State", "Since", "Interval", "Timeout"}
+
+// bfdSession is a structure containing details about a BFD session.
+type bfdSession struct {
+	peerIP   string
+	iface    string
+	state    string
+	since    string
+	interval string
+	timeout  string
+}
+
+var birdStateToBFDState = map[string]apiv3.BFDSessionState{
+	"AdminDown": apiv3.BFDSessionStateAdminDown,
+	"Down":      apiv3.BFDSessionStateDown,
+	"Init":      apiv3.BFDSessionStateInit,
+	"Up":        apiv3.BFDSessionStateUp,
+}
+
+func (s *bfdSession) toNodeStatusAPI() apiv3.CalicoNodeBFDSession {
+	return apiv3.CalicoNodeBFDSession{
+		PeerIP:    s.peerIP,
+		Interface: s.iface,
+		State:     birdStateToBFDState[s.state],
+		Since:     s.since,
+		Interval:  s.interval,
+		Timeout:   s.timeout,
+	}
+}
+
+// Unmarshal a BFD session from a line in the BIRD "show bfd sessions" output.  Returns true if
+// successful, false otherwise.
+func (s *bfdSession) unmarshalBIRD(line string) bool {
+	// Split into fields.  We expect 6 columns:
+	// 	IP address, interface, state, since, interval and timeout.
+	// The since column may hold a date and a time, in which case there are 7 columns.
+	log.Debugf("Parsing line: %s", line)
+
+	columns := strings.Fields(line)
+	if len(columns) < 6 {
+		log.Debug("Not a valid line: fewer than 6 columns.")
+		return false
+	}
+	if net.ParseIP(columns[0]) == nil {
+		log.Debugf("Not a valid line(%s): not a peer IP address", line)
+		return false
+	}
+	if _, ok := birdStateToBFDState[columns[2]]; !ok {
+		log.Debugf("Not a valid line(%s): state '%s' is not recognized", line, columns[2])
+		return false
+	}
+
+	n := len(columns)
+	s.peerIP = columns[0]
+	s.iface = columns[1]
+	s.state = columns[2]
+	s.since = strings.Join(columns[3:n-2], " ")
+	s.interval = columns[n-2]
+	s.timeout = columns[n-1]
+	return true
+}
+
+// readBIRDBFDSessions queries BIRD and returns BFD session info.
+func readBIRDBFDSessions(bc *birdConn) ([]*bfdSession, error) {
+	log.Debugf("Getting BFD sessions for IPv%s", bc.ipv)
+
+	_, err := bc.conn.Write([]byte("show bfd sessions\n"))
+	if err != nil {
+		return nil, fmt.Errorf("Error executing command: unable to write to BIRD socket: %s", err)
+	}
+
+	sessions, err := scanBIRDBFDSessions(bc.conn)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing command: %v", err)
+	}
+	return sessions, nil
+}
+
+// scanBIRDBFDSessions scans through BIRD output to return a slice of bfdSession structs.
+func scanBIRDBFDSessions(conn net.Conn) ([]*bfdSession, error) {
+	// The following is sample output from BIRD
+	//
+	// 	0001 BIRD 1.6.8 ready.
+	// 	1020-bfd1:
+	// 	 IP address                Interface  State      Since       Interval  Timeout
+	// 	 10.192.0.254              eth0       Up         2016-11-21    0.300    0.900
+	// 	0000
+	//
+	// BIRD responds with a 9001 error if BFD is not configured, which we treat as no sessions.
+	scanner := bufio.NewScanner(conn)
+	sessions := []*bfdSession{}
+
+	// Set a time-out for reading from the socket connection.
+	err := conn.SetReadDeadline(time.Now().Add(birdTimeOut))
+	if err != nil {
+		return nil, errors.New("failed to set time-out")
+	}
+
+	for scanner.Scan() {
+		// Process the next line that has been read by the scanner.
+		str := scanner.Text()
+		log.Debugf("Read: %s\n", str)
+
+		if strings.HasPrefix(str, "0000") {
+			// "0000" means end of data
+			break
+		} else if strings.HasPrefix(str, "0001") {
+			// "0001" code means BIRD is ready.
+		} else if strings.HasPrefix(str, "9001") {
+			// "9001" code means the BFD protocol is not running.
+			log.Debugf("No BFD sessions: %s", str)
+			break
+		} else if strings.HasPrefix(str, "1020") {
+			// "1020" code means start of a BFD protocol's sessions.
+		} else if strings.HasPrefix(str, " ") {
+			// Row starting with a " " is either the headings or a row of data.
+			if f := strings.Fields(str); len(f) > 0 && f[0] == "IP" {
+				if !reflect.DeepEqual(f, birdBFDExpectedHeadings) {
+					return nil, errors.New("unknown BIRD BFD table output format")
+				}
+			} else {
+				session := bfdSession{}
+				if session.unmarshalBIRD(str[1:]) {
+					sessions = append(sessions, &session)
+				}
+			}
+		} else {
+			// Format of row is unexpected.
+			return nil, fmt.Errorf("unexpected output line from BIRD: %s", str)
+		}
+
+		// Before reading the next line, adjust the time-out for
+		// reading from the socket connection.
+		err = conn.SetReadDeadline(time.Now().Add(birdTimeOut))
+		if err != nil {
+			return nil, errors.New("failed to adjust time-out")
+		}
+	}
+
+	return sessions, scanner.Err()
+}
+
+func getBFDSessions(ipv IPFamily) ([]*bfdSession, error) {
+	bc, err := getBirdConn(ipv)
+	if err != nil {
+		return nil, err
+	}
+	defer bc.Close()
+
+	sessions, err := readBIRDBFDSessions(bc)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get bird BFD sessions")
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// bfdSessionsStatus returns the BFD sessions in the node status format.  Failing to read the
+// sessions is not fatal to the BGP status, so errors are logged and no sessions are returned.
+func bfdSessionsStatus(ipv IPFamily) []apiv3.CalicoNodeBFDSession {
+	sessions, err := getBFDSessions(ipv)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get IPv%s BFD sessions", ipv)
+		return nil
+	}
+
+	result := []apiv3.CalicoNodeBFDSession{}
+	for _, s := range sessions {
+		result = append(result, s.toNodeStatusAPI())
+	}
+	return result
+}
+
+// UpdateBFDMetrics refreshes the BFD session gauges for the given IP family from BIRD.  If BIRD
+// cannot be queried, the family's series are removed rather than left reporting a stale state.
+func UpdateBFDMetrics(ipv IPFamily) {
+	sessions, err := getBFDSessions(ipv)
+	if err != nil {
+		log.WithError(err).Debugf("Unable to read IPv%s BFD sessions for metrics", ipv)
+		sessions = nil
+	}
+	recordBFDSessions(ipv, sessions)
+}
+
+// recordBFDSessions replaces the gauge series for the given IP family with the given sessions.
+func recordBFDSessions(ipv IPFamily, sessions []*bfdSession) {
+	gaugeBFDSessionState.DeletePartialMatch(prometheus.Labels{"ip_version": ipv.String()})
+	for _, s := range sessions {
+		for birdState := range birdStateToBFDState {
+			value := 0.0
+			if birdState == s.state {
+				value = 1
+			}
+			gaugeBFDSessionState.WithLabelValues(ipv.String(), s.peerIP, s.iface, birdState).Set(value)
+		}
+	}
+}
+
+// printBFDSessions prints out the slice of BFD sessions in table format.
+func printBFDSessions(sessions []*bfdSession, out io.Writer) {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"Peer address", "Interface", "State", "Since", "Interval", "Timeout"})
+
+	for _, s := range sessions {
+		row := []string{
+			s.peerIP,
+			s.iface,
+			s.state,
+			s.since,
+			s.interval,
+			s.timeout,
+		}
+		table.Append(row)
+	}
+
+	table.Render()
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package populator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Test BIRD BFD session Scanner", func() {
+	It("should be able to scan a table of BFD sessions", func() {
+		table := `0001 BIRD 1.6.8 ready.
+1020-bfd1:
+ IP address                Interface  State      Since       Interval  Timeout
+ 10.192.0.254              eth0       Up         2016-11-21    0.300    0.900
+ 10.192.0.3                ---        Init       12:01:02      1.000    5.000
+ 172.17.8.1                eth1       Bogus      2016-11-21    1.000    5.000
+0000
+We never get here
+`
+		sessions, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV4, table))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(Equal([]*bfdSession{
+			{
+				peerIP:   "10.192.0.254",
+				iface:    "eth0",
+				state:    "Up",
+				since:    "2016-11-21",
+				interval: "0.300",
+				timeout:  "0.900",
+			},
+			{
+				peerIP:   "10.192.0.3",
+				iface:    "---",
+				state:    "Init",
+				since:    "12:01:02",
+				interval: "1.000",
+				timeout:  "5.000",
+			},
+		}))
+		Expect(sessions[0].toNodeStatusAPI()).To(Equal(v3.CalicoNodeBFDSession{
+			PeerIP:    "10.192.0.254",
+			Interface: "eth0",
+			State:     v3.BFDSessionStateUp,
+			Since:     "2016-11-21",
+			Interval:  "0.300",
+			Timeout:   "0.900",
+		}))
+
+		// Check we can print sessions.
+		printBFDSessions(sessions, GinkgoWriter)
+	})
+
+	It("should return no sessions when BFD is not running", func() {
+		table := `0001 BIRD 1.6.8 ready.
+9001 There is no BFD protocol running
+`
+		sessions, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV6, table))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sessions).To(BeEmpty())
+	})
+
+	It("should not allow a table with invalid headings", func() {
+		table := `0001 BIRD 1.6.8 ready.
+1020-bfd1:
+ IP address                Interface  State      Since       Foo  Timeout
+0000
+`
+		_, err := readBIRDBFDSessions(getMockBirdConn(IPFamilyV4, table))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should export the state of each BFD session as a gauge", func() {
+		recordBFDSessions(IPFamilyV4, []*bfdSession{
+			{peerIP: "10.192.0.254", iface: "eth0", state: "Up"},
+			{peerIP: "10.192.0.3", iface: "eth1", state: "Down"},
+		})
+		recordBFDSessions(IPFamilyV6, []*bfdSession{
+			{peerIP: "fd00::1", iface: "eth0", state: "Init"},
+		})
+		Expect(testutil.ToFloat64(gaugeBFDSessionState.WithLabelValues("4", "10.192.0.254", "eth0", "Up"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(gaugeBFDSessionState.WithLabelValues("4", "10.192.0.254", "eth0", "Down"))).To(Equal(0.0))
+		Expect(testutil.ToFloat64(gaugeBFDSessionState.WithLabelValues("4", "10.192.0.3", "eth1", "Down"))).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(gaugeBFDSessionState)).To(Equal(12))
+
+		// A refresh for one family drops that family's stale peers and leaves the other alone.
+		recordBFDSessions(IPFamilyV4, []*bfdSession{
+			{peerIP: "10.192.0.3", iface: "eth1", state: "Up"},
+		})
+		Expect(testutil.CollectAndCount(gaugeBFDSessionState)).To(Equal(8))
+		Expect(testutil.ToFloat64(gaugeBFDSessionState.WithLabelValues("4", "10.192.0.3", "eth1", "Up"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(gaugeBFDSessionState.WithLabelValues("6", "fd00::1", "eth0", "Init"))).To(Equal(1.0))
+
+		recordBFDSessions(IPFamilyV4, nil)
+		recordBFDSessions(IPFamilyV6, nil)
+		Expect(testutil.CollectAndCount(gaugeBFDSessionState)).To(Equal(0))
+	})
+})
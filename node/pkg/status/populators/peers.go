@@ -29,6 +29,8 @@ import (
 	"github.com/olekukonko/tablewriter"
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/node/pkg/lifecycle/utils"
 )
 
 // Check for Word_<IP> where every octate is separated by "_", regardless of IP protocols
@@ -360,6 +362,7 @@ func (b BirdBGPPeers) Populate(status *apiv3.CalicoNodeStatus) error {
 			} else {
 				status.Status.BGP = apiv3.CalicoNodeBGPStatus{}
 			}
+			status.Status.BGP.GracefulShutdown = gracefulShutdownStatus()
 			return nil
 		}
 		log.WithError(err).Errorf("failed to get bird BGP peers")
@@ -385,13 +388,27 @@ func (b BirdBGPPeers) Populate(status *apiv3.CalicoNodeStatus) error {
 	bgp := &status.Status.BGP
 	if b.ipv == IPFamilyV4 {
 		bgp.PeersV4, bgp.NumberEstablishedV4, bgp.NumberNotEstablishedV4 = convert(peers)
+		bgp.BFDSessionsV4 = bfdSessionsStatus(b.ipv)
 	} else {
 		bgp.PeersV6, bgp.NumberEstablishedV6, bgp.NumberNotEstablishedV6 = convert(peers)
+		bgp.BFDSessionsV6 = bfdSessionsStatus(b.ipv)
 	}
+	bgp.GracefulShutdown = gracefulShutdownStatus()
 
 	return nil
 }
 
+// gracefulShutdownStatus returns the progress of a graceful BGP shutdown, which is saved by
+// the drain monitor, or nil if the node is not shutting down.
+func gracefulShutdownStatus() *apiv3.CalicoNodeBGPGracefulShutdownStatus {
+	gs, err := utils.LoadBGPGracefulShutdownStatus()
+	if err != nil {
+		log.WithError(err).Warn("failed to load BGP graceful shutdown status")
+		return nil
+	}
+	return gs
+}
+
 // Show displays bgp peers.
 func (b BirdBGPPeers) Show() {
 	peers, err := getBGPPeers(b.ipv)
@@ -402,6 +419,16 @@ func (b BirdBGPPeers) Show() {
 
 	fmt.Printf("\nbird v%s BGP peers\n", b.ipv.String())
 	printPeers(peers, os.Stdout)
+
+	sessions, err := getBFDSessions(b.ipv)
+	if err != nil {
+		fmt.Printf("Error getting bird BFD sessions: %v\n", err)
+		return
+	}
+	if len(sessions) > 0 {
+		fmt.Printf("\nbird v%s BFD sessions\n", b.ipv.String())
+		printBFDSessions(sessions, os.Stdout)
+	}
 }
 
 // printPeers prints out the slice of peers in table format.
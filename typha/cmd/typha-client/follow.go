@@ -0,0 +1,160 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/pkg/buildinfo"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+)
+
+// follower is a SyncerCallbacks that prints the updates that it receives for keys with one of the
+// given prefixes, and counts the updates for each key.
+type follower struct {
+	out        io.Writer
+	prefixes   []string
+	showValues bool
+
+	lock   sync.Mutex
+	counts map[string]int
+}
+
+func newFollower(out io.Writer, prefixes []string, showValues bool) *follower {
+	return &follower{
+		out:        out,
+		prefixes:   prefixes,
+		showValues: showValues,
+		counts:     map[string]int{},
+	}
+}
+
+func (f *follower) OnStatusUpdated(status api.SyncStatus) {
+	_, _ = fmt.Fprintf(f.out, "%s status %v\n", time.Now().Format(time.RFC3339Nano), status)
+}
+
+func (f *follower) OnUpdates(updates []api.Update) {
+	f.OnUpdatesKeysKnown(updates, nil)
+}
+
+func (f *follower) OnUpdatesKeysKnown(updates []api.Update, keys []string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	now := time.Now().Format(time.RFC3339Nano)
+	for i, u := range updates {
+		var k string
+		if i < len(keys) {
+			k = keys[i]
+		} else {
+			var err error
+			k, err = model.KeyToDefaultPath(u.Key)
+			if err != nil {
+				log.WithError(err).WithField("key", u.Key).Warn("Failed to serialise key, skipping.")
+				continue
+			}
+		}
+		if !f.matches(k) {
+			continue
+		}
+		f.counts[k]++
+		if u.Value == nil {
+			_, _ = fmt.Fprintf(f.out, "%s delete %s\n", now, k)
+			continue
+		}
+		if !f.showValues {
+			_, _ = fmt.Fprintf(f.out, "%s update %s\n", now, k)
+			continue
+		}
+		v, err := model.SerializeValue(&u.KVPair)
+		if err != nil {
+			log.WithError(err).WithField("key", k).Warn("Failed to serialise value.")
+		}
+		_, _ = fmt.Fprintf(f.out, "%s update %s %s\n", now, k, v)
+	}
+}
+
+func (f *follower) matches(key string) bool {
+	if len(f.prefixes) == 0 {
+		return true
+	}
+	for _, p := range f.prefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeCounts writes the number of updates received for each key, busiest keys first.
+func (f *follower) writeCounts() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	keys := make([]string, 0, len(f.counts))
+	total := 0
+	for k, n := range f.counts {
+		keys = append(keys, k)
+		total += n
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if f.counts[keys[i]] != f.counts[keys[j]] {
+			return f.counts[keys[i]] > f.counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	_, _ = fmt.Fprintf(f.out, "Updates per key (%d updates to %d keys):\n", total, len(keys))
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(f.out, "%8d %s\n", f.counts[k], k)
+	}
+}
+
+// follow connects to the Typha at the given address and prints the updates that it sends until
+// interrupted, the connection fails or, if non-zero, the duration expires.  It then prints the
+// per-key update counts.
+func (f *follower) follow(addr string, options *syncclient.Options, duration time.Duration) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if duration > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, duration)
+		defer cancelTimeout()
+	}
+
+	hostname, _ := os.Hostname()
+	discoverer := discovery.New(discovery.WithAddrOverride(addr))
+	client := syncclient.New(discoverer, buildinfo.Version, hostname, "typha command-line client", f, options)
+	if err := client.Start(ctx); err != nil {
+		return err
+	}
+	client.Finished.Wait()
+	if ctx.Err() == nil {
+		log.Warn("Connection to Typha failed.")
+	}
+	f.writeCounts()
+	return nil
+}
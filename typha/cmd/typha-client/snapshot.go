@@ -0,0 +1,273 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/pkg/buildinfo"
+	"github.com/projectcalico/calico/typha/pkg/calc"
+	"github.com/projectcalico/calico/typha/pkg/daemon"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+// snapshot maps from the serialised model key of each KV in a snapshot to its serialised value.
+// The values are serialised the same way that Typha serialises them on the wire, so snapshots
+// from Typha and from the datastore can be compared directly.
+type snapshot map[string]string
+
+// sortedKeys returns the keys of the snapshot in order.
+func (s snapshot) sortedKeys() []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// write writes the snapshot to w in the given format, "yaml" or "json".
+func (s snapshot) write(w io.Writer, format string) error {
+	values := map[string]interface{}{}
+	for k, v := range s {
+		values[k] = displayValue(v)
+	}
+	var data []byte
+	var err error
+	switch format {
+	case "yaml":
+		data, err = yaml.Marshal(values)
+	case "json":
+		data, err = json.MarshalIndent(values, "", "  ")
+		data = append(data, '\n')
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// displayValue returns a serialised value in a form that is printed as a nested object if the
+// value is JSON, or as a plain string otherwise.  A few types, such as IPAM config and ready
+// flags, are serialised as raw strings rather than JSON.
+func displayValue(v string) interface{} {
+	if json.Valid([]byte(v)) {
+		return json.RawMessage(v)
+	}
+	return v
+}
+
+// diffSnapshots writes the differences between two snapshots to w, in a format similar to a
+// unified diff: keys that are only in the first snapshot are prefixed with "-", keys that are
+// only in the second with "+", and keys whose values differ with "~".  It returns true if there
+// were any differences.
+func diffSnapshots(w io.Writer, nameA string, a snapshot, nameB string, b snapshot, showValues bool) bool {
+	keys := a.sortedKeys()
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	numDiffs := 0
+	_, _ = fmt.Fprintf(w, "--- %s (%d keys)\n+++ %s (%d keys)\n", nameA, len(a), nameB, len(b))
+	for _, k := range keys {
+		va, inA := a[k]
+		vb, inB := b[k]
+		switch {
+		case !inB:
+			_, _ = fmt.Fprintf(w, "- %s\n", k)
+			if showValues {
+				_, _ = fmt.Fprintf(w, "    %s\n", va)
+			}
+		case !inA:
+			_, _ = fmt.Fprintf(w, "+ %s\n", k)
+			if showValues {
+				_, _ = fmt.Fprintf(w, "    %s\n", vb)
+			}
+		case va != vb:
+			_, _ = fmt.Fprintf(w, "~ %s\n", k)
+			if showValues {
+				_, _ = fmt.Fprintf(w, "  - %s\n  + %s\n", va, vb)
+			}
+		default:
+			continue
+		}
+		numDiffs++
+	}
+	_, _ = fmt.Fprintf(w, "%d keys differ\n", numDiffs)
+	return numDiffs > 0
+}
+
+// snapshotCollector is a SyncerCallbacks that records the KVs that it receives until the syncer
+// reports that it is in sync.
+type snapshotCollector struct {
+	lock     sync.Mutex
+	snapshot snapshot
+	inSync   chan struct{}
+}
+
+func newSnapshotCollector() *snapshotCollector {
+	return &snapshotCollector{
+		snapshot: snapshot{},
+		inSync:   make(chan struct{}),
+	}
+}
+
+func (c *snapshotCollector) OnStatusUpdated(status api.SyncStatus) {
+	log.WithField("status", status).Info("Status received")
+	if status != api.InSync {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	select {
+	case <-c.inSync:
+	default:
+		close(c.inSync)
+	}
+}
+
+func (c *snapshotCollector) OnUpdates(updates []api.Update) {
+	c.OnUpdatesKeysKnown(updates, nil)
+}
+
+func (c *snapshotCollector) OnUpdatesKeysKnown(updates []api.Update, keys []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	select {
+	case <-c.inSync:
+		// Ignore any updates after the snapshot is complete.
+		return
+	default:
+	}
+	for i, u := range updates {
+		var k string
+		if i < len(keys) {
+			k = keys[i]
+		} else {
+			var err error
+			k, err = model.KeyToDefaultPath(u.Key)
+			if err != nil {
+				log.WithError(err).WithField("key", u.Key).Warn("Failed to serialise key, skipping.")
+				continue
+			}
+		}
+		if u.Value == nil {
+			delete(c.snapshot, k)
+			continue
+		}
+		v, err := model.SerializeValue(&u.KVPair)
+		if err != nil {
+			log.WithError(err).WithField("key", k).Warn("Failed to serialise value, skipping.")
+			continue
+		}
+		c.snapshot[k] = string(v)
+	}
+}
+
+// snapshotFromTypha connects to the Typha at the given address and returns the snapshot that it
+// sends, once the snapshot is complete.
+func snapshotFromTypha(addr string, options *syncclient.Options, timeout time.Duration) (snapshot, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := newSnapshotCollector()
+	hostname, _ := os.Hostname()
+	discoverer := discovery.New(discovery.WithAddrOverride(addr))
+	client := syncclient.New(discoverer, buildinfo.Version, hostname, "typha command-line client", c, options)
+	if err := client.Start(ctx); err != nil {
+		return nil, err
+	}
+	clientFinished := make(chan struct{})
+	go func() {
+		client.Finished.Wait()
+		close(clientFinished)
+	}()
+
+	var err error
+	select {
+	case <-c.inSync:
+	case <-clientFinished:
+		err = errors.New("connection to Typha failed before the snapshot was complete")
+	case <-time.After(timeout):
+		err = errors.New("timed out waiting for the snapshot to be complete")
+	}
+	cancel()
+	<-clientFinished
+	if err != nil {
+		return nil, err
+	}
+	return c.snapshot, nil
+}
+
+// snapshotFromDatastore runs the syncer of the given type against the datastore, as Typha would,
+// and returns its snapshot once it is in sync.  The updates go through the same validation as in
+// Typha, so that values that Typha would drop don't show up as differences.
+func snapshotFromDatastore(configFile string, syncerType syncproto.SyncerType, timeout time.Duration) (snapshot, error) {
+	cfg, err := apiconfig.LoadClientConfig(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load datastore config: %w", err)
+	}
+	client, err := daemon.New().NewClientV3(*cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to datastore: %w", err)
+	}
+
+	c := newSnapshotCollector()
+	validator := calc.NewValidationFilter(c)
+	var syncer api.Syncer
+	switch syncerType {
+	case syncproto.SyncerTypeFelix, "":
+		syncer = client.FelixSyncerByIface(validator)
+	case syncproto.SyncerTypeBGP:
+		syncer = client.BGPSyncerByIface(validator)
+	case syncproto.SyncerTypeTunnelIPAllocation:
+		syncer = client.TunnelIPAllocationSyncerByIface(validator)
+	case syncproto.SyncerTypeNodeStatus:
+		syncer = client.NodeStatusSyncerByIface(validator)
+	default:
+		return nil, fmt.Errorf("unknown syncer type %q", syncerType)
+	}
+	syncer.Start()
+	defer syncer.Stop()
+
+	select {
+	case <-c.inSync:
+	case <-time.After(timeout):
+		return nil, errors.New("timed out waiting for the datastore syncer to be in sync")
+	}
+	return c.snapshot, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		a, b       snapshot
+		showValues bool
+		expDiff    bool
+		expOutput  string
+	}{
+		{
+			name:      "empty",
+			a:         snapshot{},
+			b:         snapshot{},
+			expOutput: "--- a (0 keys)\n+++ b (0 keys)\n0 keys differ\n",
+		},
+		{
+			name:      "identical",
+			a:         snapshot{"/calico/k1": "v1", "/calico/k2": "v2"},
+			b:         snapshot{"/calico/k1": "v1", "/calico/k2": "v2"},
+			expOutput: "--- a (2 keys)\n+++ b (2 keys)\n0 keys differ\n",
+		},
+		{
+			name:      "add",
+			a:         snapshot{"/calico/k1": "v1"},
+			b:         snapshot{"/calico/k1": "v1", "/calico/k2": "v2"},
+			expDiff:   true,
+			expOutput: "--- a (1 keys)\n+++ b (2 keys)\n+ /calico/k2\n1 keys differ\n",
+		},
+		{
+			name:      "delete",
+			a:         snapshot{"/calico/k1": "v1", "/calico/k2": "v2"},
+			b:         snapshot{"/calico/k2": "v2"},
+			expDiff:   true,
+			expOutput: "--- a (2 keys)\n+++ b (1 keys)\n- /calico/k1\n1 keys differ\n",
+		},
+		{
+			name:      "modify",
+			a:         snapshot{"/calico/k1": "v1"},
+			b:         snapshot{"/calico/k1": "v1-changed"},
+			expDiff:   true,
+			expOutput: "--- a (1 keys)\n+++ b (1 keys)\n~ /calico/k1\n1 keys differ\n",
+		},
+		{
+			name:    "mixed changes are sorted by key",
+			a:       snapshot{"/calico/c": "c", "/calico/a": "a", "/calico/d": "d"},
+			b:       snapshot{"/calico/d": "d2", "/calico/b": "b", "/calico/a": "a"},
+			expDiff: true,
+			expOutput: "--- a (3 keys)\n+++ b (3 keys)\n" +
+				"+ /calico/b\n" +
+				"- /calico/c\n" +
+				"~ /calico/d\n" +
+				"3 keys differ\n",
+		},
+		{
+			name:       "mixed changes with values",
+			a:          snapshot{"/calico/c": "c", "/calico/a": "a", "/calico/d": "d"},
+			b:          snapshot{"/calico/d": "d2", "/calico/b": "b", "/calico/a": "a"},
+			showValues: true,
+			expDiff:    true,
+			expOutput: "--- a (3 keys)\n+++ b (3 keys)\n" +
+				"+ /calico/b\n    b\n" +
+				"- /calico/c\n    c\n" +
+				"~ /calico/d\n  - d\n  + d2\n" +
+				"3 keys differ\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			var out bytes.Buffer
+			diff := diffSnapshots(&out, "a", tc.a, "b", tc.b, tc.showValues)
+			Expect(diff).To(Equal(tc.expDiff))
+			Expect(out.String()).To(Equal(tc.expOutput))
+		})
+	}
+}
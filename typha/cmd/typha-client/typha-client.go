@@ -34,8 +34,22 @@ import (
 
 const usage = `Test client for Typha, Calico's fan-out proxy.
 
+With no command, connects to Typha and logs a summary of the updates that it receives.  The
+other commands write their output to stdout and log to stderr.
+
+  snapshot  Waits for Typha to send a complete snapshot for the syncer type and prints it,
+            keyed by model key.
+  diff      Compares the snapshot from Typha with the snapshot from another Typha, or with
+            the equivalent snapshot listed directly from the datastore.  Exits with status 1 if
+            they differ.
+  follow    Prints the updates that Typha sends, optionally filtered by key prefix, until
+            interrupted.  Then prints the number of updates received for each key.
+
 Usage:
   typha-client [options]
+  typha-client snapshot [options]
+  typha-client diff [options] (--other-server=<ADDR> | --datastore)
+  typha-client follow [options] [--prefix=<PREFIX>...]
 
 Options:
   --version                    Print the version and exit.
@@ -47,6 +61,17 @@ Options:
   --ca-file=<FILE>             TLS: CA certificate file.  Used to authenticate the server's certificate.
   --server-cn=<NAME>           TLS: expected server common name.  Used to authenticate the server's certificate.
   --server-uri=<URI>           TLS: expected server URI SAN.  Used to authenticate the server's certificate.
+  --output=<FORMAT>            snapshot: output format, yaml or json [default: yaml].
+  --timeout=<DURATION>         snapshot, diff: how long to wait for a complete snapshot [default: 60s].
+  --other-server=<ADDR>        diff: compare with the snapshot from this server.  The TLS options apply
+                               to both servers.
+  --datastore                  diff: compare with the snapshot from the datastore.  The datastore is
+                               configured as for calicoctl, using the DATASTORE_TYPE, KUBECONFIG etc.
+                               environment variables, or the file given by --datastore-config.
+  --datastore-config=<FILE>    diff: datastore configuration file.
+  --show-values                diff, follow: print the values as well as the keys.
+  --prefix=<PREFIX>            follow: only print updates for keys with this prefix.  May be repeated.
+  --duration=<DURATION>        follow: stop after this long, rather than when interrupted.
 
 `
 
@@ -69,13 +94,6 @@ func (s *syncerCallbacks) OnUpdates(updates []api.Update) {
 }
 
 func main() {
-	// Set up logging.
-	logutils.ConfigureEarlyLogging()
-	logutils.ConfigureLogging(&config.Config{
-		LogSeverityScreen:       "info",
-		DebugDisableLogDropping: true,
-	})
-
 	// Parse command-line args.
 	version := "Version:            " + buildinfo.Version + "\n" +
 		"Full git commit ID: " + buildinfo.GitRevision + "\n" +
@@ -86,6 +104,26 @@ func main() {
 		println(usage)
 		log.Fatalf("Failed to parse usage, exiting: %v", err)
 	}
+
+	// Set up logging.  The commands write their output to stdout so, for those, only log
+	// warnings and errors, to stderr.
+	logutils.ConfigureEarlyLogging()
+	command := ""
+	for _, c := range []string{"snapshot", "diff", "follow"} {
+		if arguments[c] == true {
+			command = c
+		}
+	}
+	if command == "" {
+		logutils.ConfigureLogging(&config.Config{
+			LogSeverityScreen:       "info",
+			DebugDisableLogDropping: true,
+		})
+	} else {
+		log.SetOutput(os.Stderr)
+		log.SetLevel(log.WarnLevel)
+	}
+
 	buildInfoLogCxt := log.WithFields(log.Fields{
 		"version":    buildinfo.Version,
 		"buildDate":  buildinfo.BuildDate,
@@ -95,7 +133,6 @@ func main() {
 	buildInfoLogCxt.Info("Typha client starting up")
 	log.Infof("Command line arguments: %v", arguments)
 
-	callbacks := &syncerCallbacks{}
 	addr := arguments["--server"].(string)
 	var syncerType syncproto.SyncerType
 	if t, ok := arguments["--type"].(string); ok {
@@ -103,20 +140,77 @@ func main() {
 	}
 	options := &syncclient.Options{
 		SyncerType:   syncerType,
-		KeyFile:      arguments["--key-file"].(string),
-		CertFile:     arguments["--cert-file"].(string),
-		CAFile:       arguments["--ca-file"].(string),
-		ServerCN:     arguments["--server-cn"].(string),
-		ServerURISAN: arguments["--server-uri"].(string),
+		KeyFile:      stringArg(arguments, "--key-file"),
+		CertFile:     stringArg(arguments, "--cert-file"),
+		CAFile:       stringArg(arguments, "--ca-file"),
+		ServerCN:     stringArg(arguments, "--server-cn"),
+		ServerURISAN: stringArg(arguments, "--server-uri"),
+	}
+
+	switch command {
+	case "snapshot":
+		snap, err := snapshotFromTypha(addr, options, durationArg(arguments, "--timeout"))
+		if err != nil {
+			log.WithError(err).Fatal("Failed to get snapshot from Typha")
+		}
+		if err := snap.write(os.Stdout, arguments["--output"].(string)); err != nil {
+			log.WithError(err).Fatal("Failed to write snapshot")
+		}
+	case "diff":
+		timeout := durationArg(arguments, "--timeout")
+		snap, err := snapshotFromTypha(addr, options, timeout)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to get snapshot from Typha")
+		}
+		var other snapshot
+		var otherName string
+		if arguments["--datastore"] == true {
+			otherName = "datastore"
+			other, err = snapshotFromDatastore(stringArg(arguments, "--datastore-config"), syncerType, timeout)
+		} else {
+			otherName = arguments["--other-server"].(string)
+			other, err = snapshotFromTypha(otherName, options, timeout)
+		}
+		if err != nil {
+			log.WithError(err).WithField("source", otherName).Fatal("Failed to get snapshot to compare with")
+		}
+		if diffSnapshots(os.Stdout, addr, snap, otherName, other, arguments["--show-values"] == true) {
+			os.Exit(1)
+		}
+	case "follow":
+		prefixes, _ := arguments["--prefix"].([]string)
+		var duration time.Duration
+		if arguments["--duration"] != nil {
+			duration = durationArg(arguments, "--duration")
+		}
+		f := newFollower(os.Stdout, prefixes, arguments["--show-values"] == true)
+		if err := f.follow(addr, options, duration); err != nil {
+			log.WithError(err).Fatal("Failed to follow Typha")
+		}
+	default:
+		callbacks := &syncerCallbacks{}
+		hostname, _ := os.Hostname()
+		discoverer := discovery.New(discovery.WithAddrOverride(addr))
+		client := syncclient.New(discoverer, buildinfo.Version, hostname, "typha command-line client", callbacks, options)
+		err = client.Start(context.Background())
+		if err != nil {
+			log.WithError(err).Panic("Client failed")
+		}
+		client.Finished.Wait()
+		log.Panic("Client failed")
 	}
+}
+
+// stringArg returns the value of an optional string argument, or "" if it wasn't given.
+func stringArg(arguments map[string]interface{}, name string) string {
+	s, _ := arguments[name].(string)
+	return s
+}
 
-	hostname, _ := os.Hostname()
-	discoverer := discovery.New(discovery.WithAddrOverride(addr))
-	client := syncclient.New(discoverer, buildinfo.Version, hostname, "typha command-line client", callbacks, options)
-	err = client.Start(context.Background())
+func durationArg(arguments map[string]interface{}, name string) time.Duration {
+	d, err := time.ParseDuration(stringArg(arguments, name))
 	if err != nil {
-		log.WithError(err).Panic("Client failed")
+		log.WithError(err).Fatalf("Failed to parse %s", name)
 	}
-	client.Finished.Wait()
-	log.Panic("Client failed")
+	return d
 }
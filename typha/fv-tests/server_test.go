@@ -49,6 +49,7 @@ import (
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/syncserver"
 	"github.com/projectcalico/calico/typha/pkg/tlsutils"
+	"github.com/projectcalico/calico/typha/pkg/upstream"
 )
 
 var (
@@ -1769,3 +1770,121 @@ var _ = Describe("with server requiring TLS", func() {
 	})
 
 })
+
+var _ = Describe("With a leaf Typha relaying from an in-process root Typha", func() {
+	// Updates flow from the root's pipeline, through the leaf's upstream syncer and its own cache
+	// and server, to a client of the leaf:
+	//
+	//    root harness -> upstream -> decoupler -> snapshot -> leaf -> client
+	//                    syncer                   cache        server
+	//
+	var (
+		h                *ServerHarness
+		leafCacheCxt     context.Context
+		leafCacheCancel  context.CancelFunc
+		leafServerCancel context.CancelFunc
+		leafSyncer       *upstream.Syncer
+		leafServer       *syncserver.Server
+		client           *syncclient.SyncerClient
+		clientCancel     context.CancelFunc
+		recorder         *StateRecorder
+		recorderCancel   context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		h = NewHarness()
+		h.Start()
+
+		leafCacheCxt, leafCacheCancel = context.WithCancel(context.Background())
+		decoupler := calc.NewSyncerCallbacksDecoupler()
+		leafCache := snapcache.New(snapcache.Config{
+			MaxBatchSize:   10,
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+		leafSyncer = upstream.New(h.Discoverer(), "test-version", "leaf", "test-info", decoupler,
+			&syncclient.Options{SyncerType: syncproto.SyncerTypeFelix})
+		go decoupler.SendToContext(leafCacheCxt, leafCache)
+		leafCache.Start(leafCacheCxt)
+		leafServer = syncserver.New(
+			map[syncproto.SyncerType]syncserver.BreadcrumbProvider{syncproto.SyncerTypeFelix: leafCache},
+			syncserver.Config{
+				PingInterval: 10 * time.Second,
+				Port:         syncserver.PortRandom,
+				DropInterval: 50 * time.Millisecond,
+			})
+		var leafServerCxt context.Context
+		leafServerCxt, leafServerCancel = context.WithCancel(context.Background())
+		leafServer.Start(leafServerCxt)
+		leafSyncer.Start()
+
+		recorder = NewRecorder()
+		client = syncclient.New(
+			discovery.New(discovery.WithAddrOverride(fmt.Sprintf("127.0.0.1:%d", leafServer.Port()))),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			nil,
+		)
+		var clientCxt, recorderCxt context.Context
+		clientCxt, clientCancel = context.WithCancel(context.Background())
+		recorderCxt, recorderCancel = context.WithCancel(context.Background())
+		go recorder.Loop(recorderCxt)
+		Expect(client.Start(clientCxt)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		clientCancel()
+		client.Finished.Wait()
+		recorderCancel()
+		leafSyncer.Stop()
+		// Stop the leaf's server before its cache; the server's connections wait for the cache.
+		leafServerCancel()
+		leafServer.Finished.Wait()
+		leafCacheCancel()
+		h.Stop()
+	})
+
+	It("should relay the snapshot and subsequent updates", func() {
+		kvs := h.SendInitialSnapshotConfigs(15)
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+		Eventually(recorder.KVCompareFn(kvs)).ShouldNot(HaveOccurred())
+
+		for k, v := range h.SendConfigUpdates(5) {
+			kvs[k] = v
+		}
+		Eventually(recorder.KVCompareFn(kvs)).ShouldNot(HaveOccurred())
+	})
+
+	It("should resync without disconnecting its clients when the upstream connection fails", func() {
+		kvs := h.SendInitialSnapshotConfigs(15)
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+		Eventually(recorder.KVCompareFn(kvs)).ShouldNot(HaveOccurred())
+
+		// Drop the leaf's upstream connection, and keep dropping it while we change the root's
+		// data.
+		h.Server.SetMaxConns(0)
+		Eventually(h.Server.NumActiveConnections).Should(Equal(0))
+		var deleted string
+		for k, v := range kvs {
+			deleted = k
+			h.Decoupler.OnUpdates([]api.Update{{
+				KVPair:     model.KVPair{Key: v.Key},
+				UpdateType: api.UpdateTypeKVDeleted,
+			}})
+			break
+		}
+		delete(kvs, deleted)
+		for k, v := range h.SendConfigUpdates(5) {
+			kvs[k] = v
+		}
+
+		// Once the leaf can reconnect, it should converge on the new data, including the deletion.
+		h.Server.SetMaxConns(1)
+		Eventually(recorder.KVCompareFn(kvs), "5s").ShouldNot(HaveOccurred())
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+
+		// The client of the leaf should have stayed connected throughout.
+		Expect(leafServer.NumActiveConnections()).To(Equal(1))
+	})
+})
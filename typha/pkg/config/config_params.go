@@ -134,6 +134,23 @@ type Config struct {
 	ClientCN       string `config:"string;"`
 	ClientURISAN   string `config:"string;"`
 
+	// Upstream Typha config.  If UpstreamTyphaAddr or UpstreamTyphaK8sServiceName is set, Typha
+	// runs as a "leaf": instead of watching the datastore, it connects to an upstream "root"
+	// Typha for each syncer type and re-serves the stream to its own clients.  The TLS parameters
+	// are the client-side equivalents of the server-side ones above; if any of them are
+	// specified, they _all_ must be - except that either UpstreamTyphaCN or UpstreamTyphaURISAN
+	// may be left unset.
+	UpstreamTyphaAddr           string        `config:"authority;;local"`
+	UpstreamTyphaK8sServiceName string        `config:"string;;local"`
+	UpstreamTyphaK8sNamespace   string        `config:"string;kube-system;local"`
+	UpstreamTyphaReadTimeout    time.Duration `config:"seconds;30"`
+	UpstreamTyphaWriteTimeout   time.Duration `config:"seconds;10"`
+	UpstreamTyphaKeyFile        string        `config:"file(must-exist);;local"`
+	UpstreamTyphaCertFile       string        `config:"file(must-exist);;local"`
+	UpstreamTyphaCAFile         string        `config:"file(must-exist);;local"`
+	UpstreamTyphaCN             string        `config:"string;"`
+	UpstreamTyphaURISAN         string        `config:"string;"`
+
 	DebugMemoryProfilePath  string `config:"file;;"`
 	DebugDisableLogDropping bool   `config:"bool;false"`
 
@@ -311,6 +328,18 @@ func (config *Config) requiringTLS() bool {
 	return config.ServerKeyFile+config.ServerCertFile+config.CAFile+config.ClientCN+config.ClientURISAN != ""
 }
 
+// LeafMode returns true if Typha should get its updates from an upstream Typha rather than the
+// datastore.
+func (config *Config) LeafMode() bool {
+	return config.UpstreamTyphaAddr != "" || config.UpstreamTyphaK8sServiceName != ""
+}
+
+func (config *Config) requiringUpstreamTLS() bool {
+	// True if any of the upstream TLS parameters are set.
+	return config.UpstreamTyphaKeyFile+config.UpstreamTyphaCertFile+config.UpstreamTyphaCAFile+
+		config.UpstreamTyphaCN+config.UpstreamTyphaURISAN != ""
+}
+
 // Validate() performs cross-field validation.
 func (config *Config) Validate() (err error) {
 	if config.DatastoreType == "etcdv3" && len(config.EtcdEndpoints) == 0 {
@@ -335,6 +364,21 @@ func (config *Config) Validate() (err error) {
 				" - except that either ClientCN or ClientURISAN may be left unset.")
 		}
 	}
+
+	// Likewise for the client-side TLS config for the connection to the upstream Typha.
+	if config.requiringUpstreamTLS() {
+		if config.UpstreamTyphaKeyFile == "" ||
+			config.UpstreamTyphaCertFile == "" ||
+			config.UpstreamTyphaCAFile == "" ||
+			(config.UpstreamTyphaCN == "" && config.UpstreamTyphaURISAN == "") {
+			err = errors.New("If any upstream Typha TLS config parameters are specified," +
+				" they _all_ must be" +
+				" - except that either UpstreamTyphaCN or UpstreamTyphaURISAN may be left unset.")
+		}
+		if !config.LeafMode() {
+			err = errors.New("Upstream Typha TLS config parameters require UpstreamTyphaAddr or UpstreamTyphaK8sServiceName")
+		}
+	}
 	return
 }
 
@@ -125,4 +125,26 @@ var _ = DescribeTable("Config validation",
 		"ClientCN":       "typha-peer",
 		"ClientURISAN":   "spiffe://k8s.example.com/typha-peer",
 	}, true),
+	Entry("upstream Typha without TLS", map[string]string{
+		"UpstreamTyphaAddr": "10.0.0.1:5473",
+	}, true),
+	Entry("upstream Typha TLS certs and key but no CN or URI SAN", map[string]string{
+		"UpstreamTyphaAddr":     "10.0.0.1:5473",
+		"UpstreamTyphaKeyFile":  "/usr",
+		"UpstreamTyphaCertFile": "/usr",
+		"UpstreamTyphaCAFile":   "/usr",
+	}, false),
+	Entry("all upstream Typha TLS params", map[string]string{
+		"UpstreamTyphaK8sServiceName": "calico-typha-root",
+		"UpstreamTyphaKeyFile":        "/usr",
+		"UpstreamTyphaCertFile":       "/usr",
+		"UpstreamTyphaCAFile":         "/usr",
+		"UpstreamTyphaCN":             "typha-server",
+	}, true),
+	Entry("upstream Typha TLS params without an upstream Typha", map[string]string{
+		"UpstreamTyphaKeyFile":  "/usr",
+		"UpstreamTyphaCertFile": "/usr",
+		"UpstreamTyphaCAFile":   "/usr",
+		"UpstreamTyphaCN":       "typha-server",
+	}, false),
 )
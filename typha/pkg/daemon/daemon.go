@@ -16,6 +16,7 @@ package daemon
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"runtime"
@@ -45,12 +46,15 @@ import (
 	"github.com/projectcalico/calico/pkg/buildinfo"
 	"github.com/projectcalico/calico/typha/pkg/calc"
 	"github.com/projectcalico/calico/typha/pkg/config"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
 	"github.com/projectcalico/calico/typha/pkg/jitter"
 	"github.com/projectcalico/calico/typha/pkg/k8s"
 	"github.com/projectcalico/calico/typha/pkg/logutils"
 	"github.com/projectcalico/calico/typha/pkg/snapcache"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/syncserver"
+	"github.com/projectcalico/calico/typha/pkg/upstream"
 )
 
 const usage = `Typha, Calico's fan-out proxy.
@@ -247,6 +251,14 @@ configRetry:
 	t.BuildInfoLogCxt.WithField("config", configParams).Info(
 		"Successfully loaded configuration.")
 
+	if configParams.LeafMode() {
+		// We don't watch the datastore ourselves in leaf mode.  The upstream Typha makes sure that
+		// the datastore is migrated and initialized before it serves any data.
+		log.Info("Running as a leaf Typha, skipping datastore migration and initialization.")
+		t.ConfigParams = configParams
+		return nil
+	}
+
 	if datastoreConfig.Spec.DatastoreType == apiconfig.Kubernetes {
 		// Special case: for KDD v1 datamodel to v3 datamodel upgrade, we need to ensure that the datastore migration
 		// has completed before we start serving requests.  Otherwise, we might serve partially-migrated data to
@@ -367,16 +379,61 @@ func (t *TyphaDaemon) addSyncerPipeline(
 	t.CachesBySyncerType[syncerType] = cache
 }
 
+// newUpstreamSyncerFn returns a function that creates a Syncer that gets its updates for the given
+// syncer type from the upstream Typha.
+func (t *TyphaDaemon) newUpstreamSyncerFn(syncerType syncproto.SyncerType) func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+	return func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+		cfg := t.ConfigParams
+		// Each syncer gets its own Discoverer, since a Discoverer isn't safe for concurrent use.
+		discoveryOpts := []discovery.Option{discovery.WithAddrOverride(cfg.UpstreamTyphaAddr)}
+		if cfg.UpstreamTyphaK8sServiceName != "" {
+			discoveryOpts = append(discoveryOpts,
+				discovery.WithInClusterKubeClient(),
+				discovery.WithKubeService(cfg.UpstreamTyphaK8sNamespace, cfg.UpstreamTyphaK8sServiceName),
+			)
+		}
+		hostname, err := os.Hostname()
+		if err != nil {
+			log.WithError(err).Warn("Failed to get hostname to identify ourselves to the upstream Typha.")
+		}
+		return upstream.New(
+			discovery.New(discoveryOpts...),
+			buildinfo.Version,
+			hostname,
+			fmt.Sprintf("Typha leaf; Revision: %s; Build date: %s", buildinfo.GitRevision, buildinfo.BuildDate),
+			callbacks,
+			&syncclient.Options{
+				SyncerType:   syncerType,
+				ReadTimeout:  cfg.UpstreamTyphaReadTimeout,
+				WriteTimeout: cfg.UpstreamTyphaWriteTimeout,
+				KeyFile:      cfg.UpstreamTyphaKeyFile,
+				CertFile:     cfg.UpstreamTyphaCertFile,
+				CAFile:       cfg.UpstreamTyphaCAFile,
+				ServerCN:     cfg.UpstreamTyphaCN,
+				ServerURISAN: cfg.UpstreamTyphaURISAN,
+			},
+		)
+	}
+}
+
 // CreateServer creates and configures (but does not start) the server components.
 func (t *TyphaDaemon) CreateServer() {
 	// Health monitoring, for liveness and readiness endpoints.
 	t.healthAggregator = health.NewHealthAggregator()
 
 	// Now create the Syncer and caching layer (one pipeline for each syncer we support).
-	t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	if t.ConfigParams.LeafMode() {
+		// In leaf mode, the syncers are replaced by connections to the upstream Typha.
+		log.Info("Running as a leaf Typha, will get updates from the upstream Typha.")
+		for _, syncerType := range syncproto.AllSyncerTypes {
+			t.addSyncerPipeline(syncerType, t.newUpstreamSyncerFn(syncerType))
+		}
+	} else {
+		t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	}
 
 	// Create the server, which listens for connections from Felix.
 	t.Server = syncserver.New(
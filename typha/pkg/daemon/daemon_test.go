@@ -37,6 +37,7 @@ import (
 	"github.com/projectcalico/calico/typha/pkg/syncclient"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/syncserver"
+	"github.com/projectcalico/calico/typha/pkg/upstream"
 )
 
 var configContents = []byte(`[default]
@@ -237,6 +238,50 @@ var _ = Describe("Daemon", func() {
 			})
 		})
 	})
+
+	Describe("with a leaf mode config file loaded", func() {
+		var configFile *os.File
+
+		BeforeEach(func() {
+			var err error
+			configFile, err = os.CreateTemp("", "typha")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = configFile.Write(append(configContents, []byte("UpstreamTyphaAddr=127.0.0.1:5473\n")...))
+			Expect(err).NotTo(HaveOccurred())
+			err = configFile.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			d.ParseCommandLineArgs([]string{"-c", configFile.Name()})
+
+			cxt, cancelFunc := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancelFunc()
+			err = d.LoadConfiguration(cxt)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := os.Remove(configFile.Name())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not initialize the datastore", func() {
+			Expect(d.ConfigParams.LeafMode()).To(BeTrue())
+			Expect(datastore.getNumInitCalls()).To(BeZero())
+		})
+
+		It("should create upstream syncers instead of datastore syncers", func() {
+			d.CreateServer()
+			Expect(d.CachesBySyncerType).To(HaveLen(syncproto.NumSyncerTypes))
+			for _, p := range d.SyncerPipelines {
+				Expect(p.Syncer).To(BeAssignableToTypeOf(&upstream.Syncer{}))
+			}
+			Expect(datastore.bgpSyncerCalled).To(BeFalse())
+			Expect(datastore.felixSyncerCalled).To(BeFalse())
+			Expect(datastore.allocateTunnelIpSyncerCalled).To(BeFalse())
+			Expect(datastore.nodestatusSyncerCalled).To(BeFalse())
+		})
+	})
 })
 
 type mockDatastore struct {
@@ -386,6 +431,14 @@ func (b *mockDatastore) NetworkSets() clientv3.NetworkSetInterface {
 	panic("not implemented")
 }
 
+func (b *mockDatastore) PacketCaptures() clientv3.PacketCaptureInterface {
+	panic("not implemented")
+}
+
+func (b *mockDatastore) IPPoolMigrations() clientv3.IPPoolMigrationInterface {
+	panic("not implemented")
+}
+
 // KubeControllersConfiguration returns an interface for managing the kubecontrollers configuration resources.
 func (b *mockDatastore) KubeControllersConfiguration() clientv3.KubeControllersConfigurationInterface {
 	panic("not implemented")
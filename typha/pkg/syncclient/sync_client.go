@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"io"
 	"net"
-	"os"
 	"sync"
 	"time"
 
@@ -151,6 +150,8 @@ type SyncerClient struct {
 	connInfo                      *discovery.Typha
 	myHostname, myVersion, myInfo string
 	options                       *Options
+	// certs holds the TLS certificates, once loaded, and picks up changes to them.
+	certs *calicotls.CertReloader
 
 	connection                  net.Conn
 	connR                       io.Reader
@@ -216,6 +217,14 @@ func (s *SyncerClient) Start(cxt context.Context) error {
 	s.Finished.Add(1)
 	go s.loop(cxt, cancelFn)
 
+	if s.certs != nil {
+		s.Finished.Add(1)
+		go func() {
+			defer s.Finished.Done()
+			s.certs.Run(cxt)
+		}()
+	}
+
 	s.Finished.Add(1)
 	go func() {
 		// Broadcast that we're finished.
@@ -276,16 +285,17 @@ func (s *SyncerClient) connect(cxt context.Context, typhaAddr discovery.Typha) e
 
 	var connFunc func(string) (net.Conn, error)
 	if s.options.requiringTLS() {
-		cert, err := tls.LoadX509KeyPair(s.options.CertFile, s.options.KeyFile)
-		if err != nil {
-			log.WithError(err).Error("Failed to load certificate and key")
-			return err
+		if s.certs == nil {
+			s.certs, err = calicotls.NewCertReloader("typha-client", s.options.CertFile, s.options.KeyFile, s.options.CAFile)
+			if err != nil {
+				log.WithError(err).Error("Failed to load certificates")
+				return err
+			}
 		}
 		tlsConfig, err := calicotls.NewTLSConfig()
 		if err != nil {
 			return fmt.Errorf("failed to create TLS Config: %w", err)
 		}
-		tlsConfig.Certificates = []tls.Certificate{cert}
 		// Typha API is a private binary API so we can enforce a recent TLS variant without
 		// worrying about back-compatibility with old browsers (for example).
 		tlsConfig.MinVersion = tls.VersionTLS12
@@ -295,20 +305,10 @@ func (s *SyncerClient) connect(cxt context.Context, typhaAddr discovery.Typha) e
 		// we don't always want that.  We will do certificate chain verification ourselves
 		// inside CertificateVerifier.
 		tlsConfig.InsecureSkipVerify = true
-		caPEMBlock, err := os.ReadFile(s.options.CAFile)
-		if err != nil {
-			log.WithError(err).Error("Failed to read CA data")
-			return err
-		}
-		tlsConfig.RootCAs = x509.NewCertPool()
-		ok := tlsConfig.RootCAs.AppendCertsFromPEM(caPEMBlock)
-		if !ok {
-			log.Error("Failed to add CA data to pool")
-			return errors.New("Failed to add CA data to pool")
-		}
-		tlsConfig.VerifyPeerCertificate = tlsutils.CertificateVerifier(
+		s.certs.ConfigureClient(tlsConfig)
+		tlsConfig.VerifyPeerCertificate = tlsutils.CertificateVerifierWithRoots(
 			logCxt,
-			tlsConfig.RootCAs,
+			s.certs.CAPool,
 			s.options.ServerCN,
 			s.options.ServerURISAN,
 		)
@@ -18,7 +18,6 @@ import (
 	"bufio"
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"encoding/gob"
 	"errors"
 	"fmt"
@@ -339,38 +338,38 @@ func (s *Server) serve(cxt context.Context) {
 	if s.config.requiringTLS() {
 		pwd, _ := os.Getwd()
 		logCxt.WithField("pwd", pwd).Info("Opening TLS listen socket")
-		cert, tlsErr := tls.LoadX509KeyPair(s.config.CertFile, s.config.KeyFile)
+		certs, tlsErr := calicotls.NewCertReloader("typha", s.config.CertFile, s.config.KeyFile, s.config.CAFile)
 		if tlsErr != nil {
 			logCxt.WithFields(log.Fields{
 				"certFile": s.config.CertFile,
 				"keyFile":  s.config.KeyFile,
-			}).WithError(tlsErr).Panic("Failed to load certificate and key")
+				"caFile":   s.config.CAFile,
+			}).WithError(tlsErr).Panic("Failed to load certificates")
 		}
+		// Pick up rotated certificates without restarting, which would force all the clients
+		// to reconnect.
+		s.Finished.Add(1)
+		go func() {
+			defer s.Finished.Done()
+			certs.Run(cxt)
+		}()
+
 		var tlsConfig *tls.Config
 		tlsConfig, err = calicotls.NewTLSConfig()
 		if err != nil {
 			logCxt.WithError(err).Panic("Failed to create TLS Config")
 		}
-		tlsConfig.Certificates = []tls.Certificate{cert}
 
 		// Arrange for server to verify the clients' certificates.
 		logCxt.Info("Will verify client certificates")
 		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
-		caPEMBlock, tlsErr := os.ReadFile(s.config.CAFile)
-		if tlsErr != nil {
-			logCxt.WithError(tlsErr).Panic("Failed to read CA data")
-		}
-		tlsConfig.ClientCAs = x509.NewCertPool()
-		ok := tlsConfig.ClientCAs.AppendCertsFromPEM(caPEMBlock)
-		if !ok {
-			logCxt.Panic("Failed to add CA data to pool")
-		}
-		tlsConfig.VerifyPeerCertificate = tlsutils.CertificateVerifier(
+		tlsConfig.VerifyPeerCertificate = tlsutils.CertificateVerifierWithRoots(
 			logCxt,
-			tlsConfig.ClientCAs,
+			certs.CAPool,
 			s.config.ClientCN,
 			s.config.ClientURISAN,
 		)
+		certs.ConfigureServer(tlsConfig)
 
 		laddr := fmt.Sprintf("0.0.0.0:%v", s.config.ListenPort())
 		l, err = tls.Listen("tcp", laddr, tlsConfig)
@@ -37,6 +37,12 @@ func CertificateVerifier(logCxt *log.Entry, roots *x509.CertPool, requiredCN, re
 		"requiredCN":     requiredCN,
 		"requiredURISAN": requiredURISAN,
 	}).Info("Make certificate verifier")
+	return CertificateVerifierWithRoots(logCxt, func() *x509.CertPool { return roots }, requiredCN, requiredURISAN)
+}
+
+// CertificateVerifierWithRoots is like CertificateVerifier, but gets the trusted roots from the
+// given function each time it verifies a certificate chain, so that the roots can be reloaded.
+func CertificateVerifierWithRoots(logCxt *log.Entry, roots func() *x509.CertPool, requiredCN, requiredURISAN string) func([][]byte, [][]*x509.Certificate) error {
 	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
 		if len(verifiedChains) == 0 {
 			// We haven't yet verified that the peer certificate is signed by a trusted
@@ -54,7 +60,7 @@ func CertificateVerifier(logCxt *log.Entry, roots *x509.CertPool, requiredCN, re
 			}
 
 			opts := x509.VerifyOptions{
-				Roots:         roots,
+				Roots:         roots(),
 				Intermediates: x509.NewCertPool(),
 			}
 
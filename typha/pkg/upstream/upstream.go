@@ -0,0 +1,198 @@
+// Copyright (c) 2025 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upstream implements a Syncer that gets its updates from an upstream Typha instead of the
+// datastore.  It's used when Typha runs as a "leaf" in a two-level distribution tree: the leaf
+// connects to a "root" Typha for each syncer type and re-serves the stream to its own clients.
+package upstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+)
+
+const defaultRetryInterval = time.Second
+
+// Syncer implements api.Syncer by connecting to an upstream Typha.  Unlike Felix, which restarts if
+// it loses its connection to Typha, the Syncer reconnects, so that the downstream clients of a leaf
+// Typha aren't disconnected when the leaf's upstream connection fails.  After reconnecting, it
+// sends deletions for any keys that are missing from the new upstream snapshot once that snapshot
+// is complete.  Sync status updates from upstream are passed through unchanged.
+type Syncer struct {
+	discoverer                    *discovery.Discoverer
+	myVersion, myHostname, myInfo string
+	options                       *syncclient.Options
+	retryInterval                 time.Duration
+	callbacks                     *resyncingCallbacks
+
+	cancel              context.CancelFunc
+	finished            sync.WaitGroup
+	startOnce, stopOnce sync.Once
+}
+
+func New(
+	discoverer *discovery.Discoverer,
+	myVersion, myHostname, myInfo string,
+	callbacks api.SyncerCallbacks,
+	options *syncclient.Options,
+) *Syncer {
+	return &Syncer{
+		discoverer:    discoverer,
+		myVersion:     myVersion,
+		myHostname:    myHostname,
+		myInfo:        myInfo,
+		options:       options,
+		retryInterval: defaultRetryInterval,
+		callbacks:     newResyncingCallbacks(callbacks),
+	}
+}
+
+func (s *Syncer) Start() {
+	s.startOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		s.cancel = cancel
+		s.finished.Add(1)
+		go s.loop(ctx)
+	})
+}
+
+func (s *Syncer) Stop() {
+	s.stopOnce.Do(func() {
+		if s.cancel != nil {
+			s.cancel()
+		}
+		s.finished.Wait()
+	})
+}
+
+func (s *Syncer) loop(ctx context.Context) {
+	defer s.finished.Done()
+	logCxt := log.WithField("syncerType", s.options.SyncerType)
+	for ctx.Err() == nil {
+		s.callbacks.startResync()
+		client := syncclient.New(s.discoverer, s.myVersion, s.myHostname, s.myInfo, s.callbacks, s.options)
+		if err := client.Start(ctx); err != nil {
+			logCxt.WithError(err).Error("Failed to connect to upstream Typha, will retry")
+		} else {
+			logCxt.Info("Connected to upstream Typha")
+			client.Finished.Wait()
+			if ctx.Err() != nil {
+				break
+			}
+			logCxt.Warn("Connection to upstream Typha failed, will reconnect")
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.retryInterval):
+		}
+	}
+	logCxt.Info("Upstream Typha syncer stopped")
+}
+
+// resyncingCallbacks sits between the Typha client and the downstream callbacks.  It tracks which
+// keys have been sent downstream so that, after a reconnection, it can delete any that weren't in
+// the new snapshot.  Only one client uses it at a time.
+type resyncingCallbacks struct {
+	callbacks api.SyncerCallbacks
+
+	lock sync.Mutex
+	// liveKeys contains the keys that have been sent downstream and not since deleted.
+	liveKeys map[string]model.Key
+	// seenKeys contains the keys that have been received on the current connection, until it
+	// reaches InSync.  It is nil once the connection is in sync.
+	seenKeys set.Set[string]
+}
+
+func newResyncingCallbacks(callbacks api.SyncerCallbacks) *resyncingCallbacks {
+	return &resyncingCallbacks{
+		callbacks: callbacks,
+		liveKeys:  map[string]model.Key{},
+	}
+}
+
+func (r *resyncingCallbacks) startResync() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.seenKeys = set.New[string]()
+}
+
+func (r *resyncingCallbacks) OnStatusUpdated(status api.SyncStatus) {
+	r.lock.Lock()
+	if status == api.InSync && r.seenKeys != nil {
+		var deletions []api.Update
+		for k, key := range r.liveKeys {
+			if r.seenKeys.Contains(k) {
+				continue
+			}
+			deletions = append(deletions, api.Update{
+				KVPair:     model.KVPair{Key: key},
+				UpdateType: api.UpdateTypeKVDeleted,
+			})
+			delete(r.liveKeys, k)
+		}
+		r.seenKeys = nil
+		if len(deletions) > 0 {
+			log.WithField("numDeletions", len(deletions)).Info(
+				"Resync with upstream Typha complete, deleting keys that are no longer present")
+			r.callbacks.OnUpdates(deletions)
+		}
+	}
+	r.lock.Unlock()
+	r.callbacks.OnStatusUpdated(status)
+}
+
+func (r *resyncingCallbacks) OnUpdates(updates []api.Update) {
+	r.OnUpdatesKeysKnown(updates, nil)
+}
+
+// OnUpdatesKeysKnown is like OnUpdates, but it allows for the serialised keys, which the Typha
+// client already has, to be passed in to avoid serialising them again.
+func (r *resyncingCallbacks) OnUpdatesKeysKnown(updates []api.Update, keys []string) {
+	r.lock.Lock()
+	for i, u := range updates {
+		var k string
+		if i < len(keys) {
+			k = keys[i]
+		} else {
+			var err error
+			k, err = model.KeyToDefaultPath(u.Key)
+			if err != nil {
+				log.WithError(err).WithField("key", u.Key).Error("Failed to serialise key, skipping.")
+				continue
+			}
+		}
+		if u.Value == nil {
+			delete(r.liveKeys, k)
+			if r.seenKeys != nil {
+				r.seenKeys.Discard(k)
+			}
+		} else {
+			r.liveKeys[k] = u.Key
+			if r.seenKeys != nil {
+				r.seenKeys.Add(k)
+			}
+		}
+	}
+	r.lock.Unlock()
+	r.callbacks.OnUpdates(updates)
+}